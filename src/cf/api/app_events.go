@@ -15,6 +15,8 @@ import (
 
 type AppEventsRepository interface {
 	ListEvents(appGuid string, cb func(models.EventFields) bool) error
+	ListEventsInSpace(spaceGuid string, since time.Time, cb func(models.EventFields) bool) error
+	ListEventsInOrg(orgGuid string, since time.Time, cb func(models.EventFields) bool) error
 }
 
 type CloudControllerAppEventsRepository struct {
@@ -54,6 +56,30 @@ func (repo CloudControllerAppEventsRepository) ListEvents(appGuid string, cb fun
 	return apiErr
 }
 
+func (repo CloudControllerAppEventsRepository) ListEventsInSpace(spaceGuid string, since time.Time, cb func(models.EventFields) bool) error {
+	return repo.listEventsMatching(fmt.Sprintf("space_guid:%s", spaceGuid), since, cb)
+}
+
+func (repo CloudControllerAppEventsRepository) ListEventsInOrg(orgGuid string, since time.Time, cb func(models.EventFields) bool) error {
+	return repo.listEventsMatching(fmt.Sprintf("organization_guid:%s", orgGuid), since, cb)
+}
+
+func (repo CloudControllerAppEventsRepository) listEventsMatching(filter string, since time.Time, cb func(models.EventFields) bool) error {
+	path := fmt.Sprintf("/v2/events?q=%s", url.QueryEscape(filter))
+	if !since.IsZero() {
+		path = path + fmt.Sprintf("&q=%s", url.QueryEscape("timestamp>="+since.UTC().Format(APP_EVENT_TIMESTAMP_FORMAT)))
+	}
+
+	return repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		repo.config.AccessToken(),
+		path,
+		EventResourceNewV2{},
+		func(resource interface{}) bool {
+			return cb(resource.(EventResourceNewV2).ToFields())
+		})
+}
+
 const APP_EVENT_TIMESTAMP_FORMAT = "2006-01-02T15:04:05-07:00"
 
 // FIXME: needs semantic versioning
@@ -69,10 +95,12 @@ type EventResourceOldV2 struct {
 
 func (resource EventResourceOldV2) ToFields() models.EventFields {
 	return models.EventFields{
-		Guid:        resource.Metadata.Guid,
-		Name:        "app crashed",
-		Timestamp:   resource.Entity.Timestamp,
-		Description: fmt.Sprintf("instance: %d, reason: %s, exit_status: %s", resource.Entity.InstanceIndex, resource.Entity.ExitDescription, strconv.Itoa(resource.Entity.ExitStatus)),
+		Guid:            resource.Metadata.Guid,
+		Name:            "app crashed",
+		Timestamp:       resource.Entity.Timestamp,
+		Description:     fmt.Sprintf("instance: %d, reason: %s, exit_status: %s", resource.Entity.InstanceIndex, resource.Entity.ExitDescription, strconv.Itoa(resource.Entity.ExitStatus)),
+		ExitStatus:      resource.Entity.ExitStatus,
+		ExitDescription: resource.Entity.ExitDescription,
 	}
 }
 
@@ -81,6 +109,9 @@ type EventResourceNewV2 struct {
 	Entity struct {
 		Timestamp time.Time
 		Type      string
+		Actor     string
+		ActorName string `json:"actor_name"`
+		ActeeName string `json:"actee_name"`
 		Metadata  map[string]interface{}
 	}
 }
@@ -105,12 +136,24 @@ func (resource EventResourceNewV2) ToFields() models.EventFields {
 		metadata = generic.NewMap(metadata.Get("request"))
 	}
 
-	return models.EventFields{
+	event := models.EventFields{
 		Guid:        resource.Metadata.Guid,
 		Name:        resource.Entity.Type,
 		Timestamp:   resource.Entity.Timestamp,
 		Description: formatDescription(metadata, KNOWN_METADATA_KEYS),
+		Actor:       resource.Entity.Actor,
+		ActorName:   resource.Entity.ActorName,
+		ActeeName:   resource.Entity.ActeeName,
+	}
+
+	if exitStatus, ok := metadata.Get("exit_status").(float64); ok {
+		event.ExitStatus = int(exitStatus)
 	}
+	if exitDescription, ok := metadata.Get("exit_description").(string); ok {
+		event.ExitDescription = exitDescription
+	}
+
+	return event
 }
 
 func formatDescription(metadata generic.Map, keys []string) string {
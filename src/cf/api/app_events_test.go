@@ -119,14 +119,18 @@ var _ = Describe("App Events Repo", func() {
 
 		expectedEvents := []models.EventFields{
 			models.EventFields{
-				Name:        "app crashed",
-				Description: "instance: 1, reason: app instance exited, exit_status: 1",
-				Timestamp:   testtime.MustParse(APP_EVENT_TIMESTAMP_FORMAT, "2013-10-07T16:51:07+00:00"),
+				Name:            "app crashed",
+				Description:     "instance: 1, reason: app instance exited, exit_status: 1",
+				ExitStatus:      1,
+				ExitDescription: "app instance exited",
+				Timestamp:       testtime.MustParse(APP_EVENT_TIMESTAMP_FORMAT, "2013-10-07T16:51:07+00:00"),
 			},
 			models.EventFields{
-				Name:        "app crashed",
-				Description: "instance: 2, reason: app instance was stopped, exit_status: 2",
-				Timestamp:   testtime.MustParse(APP_EVENT_TIMESTAMP_FORMAT, "2013-10-07T17:51:07+00:00"),
+				Name:            "app crashed",
+				Description:     "instance: 2, reason: app instance was stopped, exit_status: 2",
+				ExitStatus:      2,
+				ExitDescription: "app instance was stopped",
+				Timestamp:       testtime.MustParse(APP_EVENT_TIMESTAMP_FORMAT, "2013-10-07T17:51:07+00:00"),
 			},
 		}
 
@@ -222,6 +226,77 @@ var _ = Describe("App Events Repo", func() {
 		Expect(events[1].Name).To(Equal("app.crash"))
 	})
 
+	It("lists the events in a space since a given time", func() {
+		deps := setupEventTest([]testnet.TestRequest{
+			testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/events?q=space_guid%3Amy-space-guid&q=timestamp%3E%3D2014-01-01T00%3A00%3A00%2B00%3A00",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{
+					  "resources": [
+						{
+						  "metadata": {
+							"guid": "event-1-guid"
+						  },
+						  "entity": {
+							"type": "audit.app.update",
+							"actor": "user-guid",
+							"actor_name": "admin",
+							"actee_name": "dora",
+							"timestamp": "2014-01-21T00:20:11+00:00",
+							"metadata": {
+							  "request": {
+								"instances": 2
+							  }
+							}
+						  }
+						}
+					  ]
+					}`}},
+		})
+		defer teardownEventTest(deps)
+
+		repo := NewCloudControllerAppEventsRepository(deps.config, deps.gateway)
+
+		events := []models.EventFields{}
+		apiErr := repo.ListEventsInSpace("my-space-guid", time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC), func(e models.EventFields) bool {
+			events = append(events, e)
+			return true
+		})
+
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(deps.handler).To(testnet.HaveAllRequestsCalled())
+		Expect(len(events)).To(Equal(1))
+		Expect(events[0].Name).To(Equal("audit.app.update"))
+		Expect(events[0].Actor).To(Equal("user-guid"))
+		Expect(events[0].ActorName).To(Equal("admin"))
+		Expect(events[0].ActeeName).To(Equal("dora"))
+		Expect(events[0].Description).To(Equal("instances: 2"))
+	})
+
+	It("lists the events in an org", func() {
+		deps := setupEventTest([]testnet.TestRequest{
+			testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/events?q=organization_guid%3Amy-org-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   `{"resources": []}`,
+				}},
+		})
+		defer teardownEventTest(deps)
+
+		repo := NewCloudControllerAppEventsRepository(deps.config, deps.gateway)
+
+		apiErr := repo.ListEventsInOrg("my-org-guid", time.Time{}, func(e models.EventFields) bool {
+			return true
+		})
+
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(deps.handler).To(testnet.HaveAllRequestsCalled())
+	})
+
 	It("TestListOldV2EventsApiError", func() {
 		deps := setupEventTest([]testnet.TestRequest{
 			newV2NotFoundRequest,
@@ -243,9 +318,11 @@ var _ = Describe("App Events Repo", func() {
 
 		expectedEvents := []models.EventFields{
 			models.EventFields{
-				Name:        "app crashed",
-				Description: "instance: 1, reason: app instance exited, exit_status: 1",
-				Timestamp:   firstExpectedTime,
+				Name:            "app crashed",
+				Description:     "instance: 1, reason: app instance exited, exit_status: 1",
+				ExitStatus:      1,
+				ExitDescription: "app instance exited",
+				Timestamp:       firstExpectedTime,
 			},
 		}
 
@@ -282,6 +359,9 @@ var _ = Describe("App Events Repo", func() {
 		Expect(eventFields.Name).To(Equal("app.crash"))
 		Expect(eventFields.Timestamp).To(Equal(testtime.MustParse(APP_EVENT_TIMESTAMP_FORMAT, "2013-10-07T16:51:07+00:00")))
 		Expect(eventFields.Description).To(Equal(`index: 3, reason: CRASHED, exit_description: unknown, exit_status: -1`))
+		Expect(eventFields.IsCrash()).To(BeTrue())
+		Expect(eventFields.ExitStatus).To(Equal(-1))
+		Expect(eventFields.ExitDescription).To(Equal("unknown"))
 	})
 
 	It("TestUnmarshalUpdateAppEvent", func() {
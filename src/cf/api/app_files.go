@@ -2,12 +2,15 @@ package api
 
 import (
 	"cf/configuration"
+	"cf/errors"
 	"cf/net"
 	"fmt"
+	"net/http"
 )
 
 type AppFilesRepository interface {
-	ListFiles(appGuid, path string) (files string, apiErr error)
+	ListFiles(appGuid string, instance int, path string) (files string, apiErr error)
+	ReadFileFrom(appGuid string, instance int, path string, offset int64) (contents string, apiErr error)
 }
 
 type CloudControllerAppFilesRepository struct {
@@ -21,9 +24,8 @@ func NewCloudControllerAppFilesRepository(config configuration.Reader, gateway n
 	return
 }
 
-func (repo CloudControllerAppFilesRepository) ListFiles(appGuid, path string) (files string, apiErr error) {
-	url := fmt.Sprintf("%s/v2/apps/%s/instances/0/files/%s", repo.config.ApiEndpoint(), appGuid, path)
-	request, apiErr := repo.gateway.NewRequest("GET", url, repo.config.AccessToken(), nil)
+func (repo CloudControllerAppFilesRepository) ListFiles(appGuid string, instance int, path string) (files string, apiErr error) {
+	request, apiErr := repo.gateway.NewRequest("GET", repo.filesUrl(appGuid, instance, path), repo.config.AccessToken(), nil)
 	if apiErr != nil {
 		return
 	}
@@ -31,3 +33,35 @@ func (repo CloudControllerAppFilesRepository) ListFiles(appGuid, path string) (f
 	files, _, apiErr = repo.gateway.PerformRequestForTextResponse(request)
 	return
 }
+
+func (repo CloudControllerAppFilesRepository) ReadFileFrom(appGuid string, instance int, path string, offset int64) (contents string, apiErr error) {
+	request, apiErr := repo.gateway.NewRequest("GET", repo.filesUrl(appGuid, instance, path), repo.config.AccessToken(), nil)
+	if apiErr != nil {
+		return
+	}
+	request.HttpReq.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+
+	bytes, _, rawResponse, apiErr := repo.gateway.PerformRequestForResponseBytes(request)
+	if httpErr, ok := apiErr.(errors.HttpError); ok && httpErr.StatusCode() == http.StatusRequestedRangeNotSatisfiable {
+		apiErr = nil
+		return
+	}
+	if apiErr != nil {
+		return
+	}
+
+	// servers that ignore the range header send the whole file
+	if rawResponse.StatusCode != http.StatusPartialContent {
+		if int64(len(bytes)) <= offset {
+			return
+		}
+		bytes = bytes[offset:]
+	}
+
+	contents = string(bytes)
+	return
+}
+
+func (repo CloudControllerAppFilesRepository) filesUrl(appGuid string, instance int, path string) string {
+	return fmt.Sprintf("%s/v2/apps/%s/instances/%d/files/%s", repo.config.ApiEndpoint(), appGuid, instance, path)
+}
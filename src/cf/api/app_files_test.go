@@ -55,10 +55,96 @@ var _ = Describe("AppFilesRepository", func() {
 
 		gateway := net.NewCloudControllerGateway(configRepo)
 		repo := NewCloudControllerAppFilesRepository(configRepo, gateway)
-		list, err := repo.ListFiles("my-app-guid", "some/path")
+		list, err := repo.ListFiles("my-app-guid", 0, "some/path")
 
 		Expect(handler).To(testnet.HaveAllRequestsCalled())
 		Expect(err).ToNot(HaveOccurred())
 		Expect(list).To(Equal(expectedResponse))
 	})
+
+	It("lists files on a specific instance", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/apps/my-app-guid/instances/3/files/some/path",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: "file 1"},
+		})
+
+		ts, handler, repo := createAppFilesRepo(req)
+		defer ts.Close()
+
+		list, err := repo.ListFiles("my-app-guid", 3, "some/path")
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(Equal("file 1\n"))
+	})
+
+	Describe("reading part of a file", func() {
+		var rangeMatcher = func(expectedRange string) testnet.RequestMatcher {
+			return func(request *http.Request) {
+				Expect(request.Header.Get("Range")).To(Equal(expectedRange))
+			}
+		}
+
+		It("requests the bytes after the offset", func() {
+			req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/apps/my-app-guid/instances/1/files/logs/stdout.log",
+				Matcher:  rangeMatcher("bytes=10-"),
+				Response: testnet.TestResponse{Status: http.StatusPartialContent, Body: "new line"},
+			})
+
+			ts, handler, repo := createAppFilesRepo(req)
+			defer ts.Close()
+
+			contents, err := repo.ReadFileFrom("my-app-guid", 1, "logs/stdout.log", 10)
+
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(Equal("new line\n"))
+		})
+
+		It("returns nothing when there are no new bytes", func() {
+			req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/apps/my-app-guid/instances/1/files/logs/stdout.log",
+				Response: testnet.TestResponse{Status: http.StatusRequestedRangeNotSatisfiable},
+			})
+
+			ts, handler, repo := createAppFilesRepo(req)
+			defer ts.Close()
+
+			contents, err := repo.ReadFileFrom("my-app-guid", 1, "logs/stdout.log", 10)
+
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(Equal(""))
+		})
+
+		It("skips the bytes before the offset when the server ignores the range", func() {
+			req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/apps/my-app-guid/instances/1/files/logs/stdout.log",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: "old line\nnew line"},
+			})
+
+			ts, handler, repo := createAppFilesRepo(req)
+			defer ts.Close()
+
+			contents, err := repo.ReadFileFrom("my-app-guid", 1, "logs/stdout.log", 9)
+
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(Equal("new line\n"))
+		})
+	})
 })
+
+func createAppFilesRepo(request testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo AppFilesRepository) {
+	ts, handler = testnet.NewServer([]testnet.TestRequest{request})
+	configRepo := testconfig.NewRepositoryWithDefaults()
+	configRepo.SetApiEndpoint(ts.URL)
+	gateway := net.NewCloudControllerGateway(configRepo)
+	repo = NewCloudControllerAppFilesRepository(configRepo, gateway)
+	return
+}
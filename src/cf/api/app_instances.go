@@ -33,6 +33,7 @@ type InstanceStatsApiResponse struct {
 
 type AppInstancesRepository interface {
 	GetInstances(appGuid string) (instances []models.AppInstanceFields, apiErr error)
+	DeleteInstance(appGuid string, index int) (apiErr error)
 }
 
 type CloudControllerAppInstancesRepository struct {
@@ -76,6 +77,11 @@ func (repo CloudControllerAppInstancesRepository) GetInstances(appGuid string) (
 	return repo.updateInstancesWithStats(appGuid, instances)
 }
 
+func (repo CloudControllerAppInstancesRepository) DeleteInstance(appGuid string, index int) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/apps/%s/instances/%d", repo.config.ApiEndpoint(), appGuid, index)
+	return repo.gateway.DeleteResource(path, repo.config.AccessToken())
+}
+
 func (repo CloudControllerAppInstancesRepository) updateInstancesWithStats(guid string, instances []models.AppInstanceFields) (updatedInst []models.AppInstanceFields, apiErr error) {
 	path := fmt.Sprintf("%s/v2/apps/%s/stats", repo.config.ApiEndpoint(), guid)
 	statsResponse := StatsApiResponse{}
@@ -83,6 +83,7 @@ type DomainSummary struct {
 
 type AppSummaryRepository interface {
 	GetSummariesInCurrentSpace() (apps []models.AppSummary, apiErr error)
+	GetSummariesInSpace(spaceGuid string) (apps []models.AppSummary, apiErr error)
 	GetSummary(appGuid string) (summary models.AppSummary, apiErr error)
 }
 
@@ -98,9 +99,13 @@ func NewCloudControllerAppSummaryRepository(config configuration.Reader, gateway
 }
 
 func (repo CloudControllerAppSummaryRepository) GetSummariesInCurrentSpace() (apps []models.AppSummary, apiErr error) {
+	return repo.GetSummariesInSpace(repo.config.SpaceFields().Guid)
+}
+
+func (repo CloudControllerAppSummaryRepository) GetSummariesInSpace(spaceGuid string) (apps []models.AppSummary, apiErr error) {
 	resources := new(ApplicationSummaries)
 
-	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), repo.config.SpaceFields().Guid)
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), spaceGuid)
 	apiErr = repo.gateway.GetResource(path, repo.config.AccessToken(), resources)
 	if apiErr != nil {
 		return
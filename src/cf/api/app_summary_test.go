@@ -52,6 +52,25 @@ var _ = Describe("AppSummaryRepository", func() {
 		Expect(app2.RunningInstances).To(Equal(1))
 		Expect(app2.Memory).To(Equal(uint64(512)))
 	})
+
+	It("gets the app summaries in a given space", func() {
+		getAppSummariesRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/spaces/other-space-guid/summary",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: getAppSummariesResponseBody},
+		})
+
+		ts, handler, repo := createAppSummaryRepo([]testnet.TestRequest{getAppSummariesRequest})
+		defer ts.Close()
+
+		apps, apiErr := repo.GetSummariesInSpace("other-space-guid")
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(apps)).To(Equal(2))
+		Expect(apps[0].Name).To(Equal("app1"))
+		Expect(apps[1].InstanceCount).To(Equal(3))
+	})
 })
 
 var getAppSummariesResponseBody = `
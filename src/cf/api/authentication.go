@@ -4,16 +4,18 @@ import (
 	"cf/configuration"
 	"cf/errors"
 	"cf/net"
-	"cf/terminal"
 	"encoding/base64"
 	"fmt"
 	"net/url"
-	"os"
 	"strings"
 )
 
+// users log in through the cf client, which has no secret
+const DEFAULT_UAA_CLIENT = "cf"
+
 type AuthenticationRepository interface {
 	Authenticate(credentials map[string]string) (apiErr error)
+	AuthenticateWithClientCredentials(clientId, clientSecret string) (apiErr error)
 	RefreshAuthToken() (updatedToken string, apiErr error)
 	GetLoginPromptsAndSaveUAAServerURL() (map[string]configuration.AuthPrompt, error)
 }
@@ -42,13 +44,46 @@ func (uaa UAAAuthenticationRepository) Authenticate(credentials map[string]strin
 	switch response := apiErr.(type) {
 	case errors.HttpError:
 		if response.StatusCode() == 401 {
-			apiErr = errors.New("Password is incorrect, please try again.")
+			if _, ok := credentials["passcode"]; ok {
+				apiErr = errors.New("Passcode is incorrect or has expired, please try again.")
+			} else {
+				apiErr = errors.New("Password is incorrect, please try again.")
+			}
 		}
 	}
 
 	return
 }
 
+// the client is kept in the config, so the session can be renewed by
+// requesting a new token; client credentials grants have no refresh token
+func (uaa UAAAuthenticationRepository) AuthenticateWithClientCredentials(clientId, clientSecret string) (apiErr error) {
+	uaa.config.SetUAAOAuthClient(clientId)
+	uaa.config.SetUAAOAuthClientSecret(clientSecret)
+
+	apiErr = uaa.getClientCredentialsToken()
+	if apiErr == nil {
+		return
+	}
+
+	uaa.config.SetUAAOAuthClient("")
+	uaa.config.SetUAAOAuthClientSecret("")
+
+	switch response := apiErr.(type) {
+	case errors.HttpError:
+		if response.StatusCode() == 401 {
+			apiErr = errors.New("Client ID or secret is incorrect, please try again.")
+		}
+	}
+	return
+}
+
+func (uaa UAAAuthenticationRepository) getClientCredentialsToken() error {
+	return uaa.getAuthToken(url.Values{
+		"grant_type": {"client_credentials"},
+	})
+}
+
 type LoginResource struct {
 	Prompts map[string][]string
 	Links   map[string]string
@@ -67,6 +102,15 @@ func (r *LoginResource) parsePrompts() (prompts map[string]configuration.AuthPro
 			DisplayName: val[1],
 		}
 	}
+
+	// login servers that support single sign-on hand out one-time passcodes
+	// even when they don't advertise the prompt for them
+	if _, found := prompts["passcode"]; !found && r.Links["login"] != "" {
+		prompts["passcode"] = configuration.AuthPrompt{
+			Type:        configuration.AuthPromptTypePassword,
+			DisplayName: fmt.Sprintf("One Time Code (Get one at %s/passcode)", strings.TrimRight(r.Links["login"], "/")),
+		}
+	}
 	return
 }
 
@@ -85,20 +129,21 @@ func (uaa UAAAuthenticationRepository) GetLoginPromptsAndSaveUAAServerURL() (pro
 }
 
 func (uaa UAAAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiErr error) {
-	data := url.Values{
-		"refresh_token": {uaa.config.RefreshToken()},
-		"grant_type":    {"refresh_token"},
-		"scope":         {""},
+	if uaa.config.UAAOAuthClientSecret() != "" {
+		apiErr = uaa.getClientCredentialsToken()
+	} else {
+		apiErr = uaa.getAuthToken(url.Values{
+			"refresh_token": {uaa.config.RefreshToken()},
+			"grant_type":    {"refresh_token"},
+			"scope":         {""},
+		})
 	}
-
-	apiErr = uaa.getAuthToken(data)
-	updatedToken = uaa.config.AccessToken()
-
 	if apiErr != nil {
-		fmt.Printf("%s\n\n", terminal.NotLoggedInText())
-		os.Exit(1)
+		apiErr = errors.NewTokenRefreshError(apiErr)
+		return
 	}
 
+	updatedToken = uaa.config.AccessToken()
 	return
 }
 
@@ -115,8 +160,13 @@ func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) error {
 		Error        uaaErrorResponse `json:"error"`
 	}
 
+	client, secret := uaa.config.UAAOAuthClient(), uaa.config.UAAOAuthClientSecret()
+	if client == "" {
+		client, secret = DEFAULT_UAA_CLIENT, ""
+	}
+
 	path := fmt.Sprintf("%s/oauth/token", uaa.config.AuthenticationEndpoint())
-	request, err := uaa.gateway.NewRequest("POST", path, "Basic "+base64.StdEncoding.EncodeToString([]byte("cf:")), strings.NewReader(data.Encode()))
+	request, err := uaa.gateway.NewRequest("POST", path, "Basic "+base64.StdEncoding.EncodeToString([]byte(client+":"+secret)), strings.NewReader(data.Encode()))
 	if err != nil {
 		return errors.NewWithError("Failed to start oauth request", err)
 	}
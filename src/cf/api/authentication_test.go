@@ -3,6 +3,7 @@ package api_test
 import (
 	. "cf/api"
 	"cf/configuration"
+	"cf/errors"
 	"cf/net"
 	"encoding/base64"
 	"fmt"
@@ -132,6 +133,10 @@ var _ = Describe("AuthenticationRepository", func() {
 						DisplayName: "PIN Number",
 						Type:        configuration.AuthPromptTypePassword,
 					},
+					"passcode": configuration.AuthPrompt{
+						DisplayName: "One Time Code (Get one at https://login.run.pivotal.io/passcode)",
+						Type:        configuration.AuthPromptTypePassword,
+					},
 				}))
 			})
 
@@ -160,6 +165,87 @@ var _ = Describe("AuthenticationRepository", func() {
 			It("presumes that the authorization server is the UAA", func() {
 				Expect(config.UaaEndpoint()).To(Equal(config.AuthenticationEndpoint()))
 			})
+
+			It("does not offer a passcode", func() {
+				_, found := prompts["passcode"]
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the login server advertises a passcode prompt", func() {
+			BeforeEach(func() {
+				setupTestServer(passcodeLoginRequest)
+			})
+
+			It("keeps the prompt it advertises", func() {
+				Expect(prompts["passcode"]).To(Equal(configuration.AuthPrompt{
+					DisplayName: "One Time Code (Get one at https://sso.example.com/passcode)",
+					Type:        configuration.AuthPromptTypePassword,
+				}))
+			})
+		})
+	})
+
+	Describe("authenticating with a passcode", func() {
+		It("tells when the passcode is wrong", func() {
+			setupTestServer(unsuccessfulLoginRequest)
+
+			err := auth.Authenticate(map[string]string{"passcode": "bad-code"})
+
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(err.Error()).To(Equal("Passcode is incorrect or has expired, please try again."))
+		})
+	})
+
+	Describe("authenticating with client credentials", func() {
+		It("requests a token as the client and remembers the client", func() {
+			setupTestServer(clientCredentialsLoginRequest)
+
+			err := auth.AuthenticateWithClientCredentials("my-robot", "robot-secret")
+
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("BEARER my_client_token"))
+			Expect(config.RefreshToken()).To(BeEmpty())
+			Expect(config.UAAOAuthClient()).To(Equal("my-robot"))
+			Expect(config.UAAOAuthClientSecret()).To(Equal("robot-secret"))
+		})
+
+		It("forgets the client when the credentials are wrong", func() {
+			setupTestServer(unsuccessfulLoginRequest)
+
+			err := auth.AuthenticateWithClientCredentials("my-robot", "wrong-secret")
+
+			Expect(err.Error()).To(Equal("Client ID or secret is incorrect, please try again."))
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.UAAOAuthClient()).To(BeEmpty())
+			Expect(config.UAAOAuthClientSecret()).To(BeEmpty())
+		})
+
+		It("requests a new token instead of refreshing it", func() {
+			setupTestServer(clientCredentialsLoginRequest)
+			config.SetUAAOAuthClient("my-robot")
+			config.SetUAAOAuthClientSecret("robot-secret")
+
+			token, err := auth.RefreshAuthToken()
+
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("BEARER my_client_token"))
+		})
+	})
+
+	Describe("refreshing the auth token", func() {
+		It("returns a token refresh error when the refresh token is rejected", func() {
+			setupTestServer(unsuccessfulLoginRequest)
+			config.SetRefreshToken("my_refresh_token")
+
+			_, err := auth.RefreshAuthToken()
+
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			_, ok := err.(*errors.TokenRefreshError)
+			Expect(ok).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("please log in again"))
 		})
 	})
 
@@ -201,6 +287,28 @@ var successfulLoginMatcher = func(request *http.Request) {
 	Expect(request.Form.Get("scope")).To(Equal(""))
 }
 
+var clientCredentialsLoginRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: http.Header{
+		"authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("my-robot:robot-secret"))},
+	},
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.Form.Get("grant_type")).To(Equal("client_credentials"))
+		Expect(request.Form.Get("refresh_token")).To(BeEmpty())
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_client_token",
+  "token_type": "BEARER",
+  "expires_in": 43199
+}`},
+}
+
 var unsuccessfulLoginRequest = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
@@ -263,6 +371,26 @@ var loginServerLoginRequest = testnet.TestRequest{
 	},
 }
 
+var passcodeLoginRequest = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/login",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+	"links":{
+	    "login":"https://login.example.com",
+	    "uaa":"https://uaa.example.com"
+	 },
+	"prompts":{
+		"username": ["text","Email"],
+		"password": ["password","Password"],
+		"passcode": ["password","One Time Code (Get one at https://sso.example.com/passcode)"]
+	}
+}`,
+	},
+}
+
 var loginServerLoginFailureRequest = testnet.TestRequest{
 	Method: "GET",
 	Path:   "/login",
@@ -7,6 +7,7 @@ import (
 	"cf/errors"
 	"cf/models"
 	"cf/net"
+	"crypto/sha1"
 	"crypto/tls"
 	"crypto/x509"
 	"fileutils"
@@ -20,8 +21,14 @@ import (
 	"strings"
 )
 
+var buildpackScripts = []string{"bin/detect", "bin/compile", "bin/release"}
+
 type BuildpackBitsRepository interface {
 	UploadBuildpack(buildpack models.Buildpack, dir string) (apiErr error)
+	InspectBuildpack(buildpackLocation string) (report models.BuildpackReport, apiErr error)
+	ExtractBuildpack(buildpackLocation, destDir string) (apiErr error)
+	DownloadBuildpack(buildpack models.Buildpack, destPath string) (apiErr error)
+	FetchBuildpack(buildpackLocation string, cb func(localPath string, apiErr error))
 }
 
 type CloudControllerBuildpackBitsRepository struct {
@@ -88,6 +95,252 @@ func (repo CloudControllerBuildpackBitsRepository) UploadBuildpack(buildpack mod
 	return
 }
 
+// InspectBuildpack looks at a buildpack the same way UploadBuildpack reads it,
+// without uploading anything, and reports the problems that would break staging
+func (repo CloudControllerBuildpackBitsRepository) InspectBuildpack(buildpackLocation string) (report models.BuildpackReport, apiErr error) {
+	fileutils.TempFile("buildpack-inspect", func(archive *os.File, err error) {
+		if err != nil {
+			apiErr = errors.NewWithError("Couldn't create temp file for inspection", err)
+			return
+		}
+
+		apiErr = repo.readBuildpackArchive(buildpackLocation, archive)
+		if apiErr != nil {
+			return
+		}
+
+		report, apiErr = inspectBuildpackArchive(archive)
+	})
+
+	return
+}
+
+// ExtractBuildpack unpacks a buildpack from a directory, zip file or URL into
+// destDir, moving a nested buildpack to the root the same way uploads do
+func (repo CloudControllerBuildpackBitsRepository) ExtractBuildpack(buildpackLocation, destDir string) (apiErr error) {
+	fileutils.TempFile("buildpack-extract", func(archive *os.File, err error) {
+		if err != nil {
+			apiErr = errors.NewWithError("Couldn't create temp file for extraction", err)
+			return
+		}
+
+		apiErr = repo.readBuildpackArchive(buildpackLocation, archive)
+		if apiErr != nil {
+			return
+		}
+
+		err = extractBuildpackArchive(archive, destDir)
+		if err != nil {
+			apiErr = errors.NewWithError("Couldn't extract buildpack", err)
+		}
+	})
+
+	return
+}
+
+// DownloadBuildpack saves the bits uploaded for a buildpack to destPath
+func (repo CloudControllerBuildpackBitsRepository) DownloadBuildpack(buildpack models.Buildpack, destPath string) (apiErr error) {
+	url := fmt.Sprintf("%s/v2/buildpacks/%s/download", repo.config.ApiEndpoint(), buildpack.Guid)
+	request, apiErr := repo.gateway.NewRequest("GET", url, repo.config.AccessToken(), nil)
+	if apiErr != nil {
+		return
+	}
+
+	response, apiErr := repo.gateway.PerformRequestForResponse(request)
+	if apiErr != nil {
+		return
+	}
+	defer response.Body.Close()
+
+	err := fileutils.CopyReaderToPath(response.Body, destPath)
+	if err != nil {
+		apiErr = errors.NewWithError("Couldn't write buildpack file", err)
+	}
+	return
+}
+
+// FetchBuildpack downloads a buildpack given by URL once, so the bits that are
+// inspected are the bits that get uploaded; other locations are passed through
+func (repo CloudControllerBuildpackBitsRepository) FetchBuildpack(buildpackLocation string, cb func(localPath string, apiErr error)) {
+	if !isWebURL(buildpackLocation) {
+		cb(buildpackLocation, nil)
+		return
+	}
+
+	fileutils.TempDir("buildpack-fetch", func(dir string, err error) {
+		if err != nil {
+			cb("", errors.NewWithError("Couldn't create temp dir for download", err))
+			return
+		}
+
+		localPath := filepath.Join(dir, path.Base(buildpackLocation))
+		repo.downloadBuildpack(buildpackLocation, func(downloadFile *os.File, downloadErr error) {
+			if downloadErr != nil {
+				err = downloadErr
+				return
+			}
+
+			err = fileutils.CopyReaderToPath(downloadFile, localPath)
+		})
+
+		if err != nil {
+			cb("", errors.NewWithError("Couldn't download buildpack", err))
+			return
+		}
+
+		cb(localPath, nil)
+	})
+}
+
+// copies the buildpack as a zip archive into archive
+func (repo CloudControllerBuildpackBitsRepository) readBuildpackArchive(buildpackLocation string, archive *os.File) (apiErr error) {
+	var err error
+	if isWebURL(buildpackLocation) {
+		repo.downloadBuildpack(buildpackLocation, func(downloadFile *os.File, downloadErr error) {
+			if downloadErr != nil {
+				err = downloadErr
+				return
+			}
+
+			_, err = io.Copy(archive, downloadFile)
+		})
+	} else {
+		var stats os.FileInfo
+		stats, err = os.Stat(buildpackLocation)
+		if err != nil {
+			apiErr = errors.NewWithError("Error opening buildpack file", err)
+			return
+		}
+
+		if stats.IsDir() {
+			err = repo.zipper.Zip(buildpackLocation, archive)
+		} else {
+			err = fileutils.CopyPathToWriter(buildpackLocation, archive)
+		}
+	}
+
+	if err != nil {
+		apiErr = errors.NewWithError("Couldn't read buildpack", err)
+	}
+	return
+}
+
+func extractBuildpackArchive(archive *os.File, destDir string) (err error) {
+	stats, err := archive.Stat()
+	if err != nil {
+		return
+	}
+
+	reader, err := zip.NewReader(archive, stats.Size())
+	if err != nil {
+		return
+	}
+
+	parentPath, hasBuildpack := findBuildpackPath(reader.File)
+	if !hasBuildpack {
+		return errors.New("Zip archive does not contain a buildpack")
+	}
+
+	for _, file := range reader.File {
+		name := path.Clean(file.Name)
+		if parentPath != "." {
+			if !strings.HasPrefix(name, parentPath+"/") {
+				continue
+			}
+			name = strings.TrimPrefix(name, parentPath+"/")
+		}
+
+		if strings.HasPrefix(name, "../") || path.IsAbs(name) {
+			return errors.New(fmt.Sprintf("Zip archive contains an invalid path %s", file.Name))
+		}
+
+		destPath := filepath.Join(destDir, filepath.FromSlash(name))
+		if file.FileInfo().IsDir() {
+			err = os.MkdirAll(destPath, os.ModeDir|os.ModePerm)
+			if err != nil {
+				return
+			}
+			continue
+		}
+
+		err = extractZipFile(file, destPath)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func extractZipFile(file *zip.File, destPath string) (err error) {
+	reader, err := file.Open()
+	if err != nil {
+		return
+	}
+	defer reader.Close()
+
+	err = fileutils.CopyReaderToPath(reader, destPath)
+	if err != nil {
+		return
+	}
+	return os.Chmod(destPath, file.Mode())
+}
+
+func inspectBuildpackArchive(archive *os.File) (report models.BuildpackReport, apiErr error) {
+	stats, err := archive.Stat()
+	if err != nil {
+		apiErr = errors.NewWithError("Couldn't read buildpack", err)
+		return
+	}
+	report.Size = stats.Size()
+
+	archive.Seek(0, 0)
+	hash := sha1.New()
+	_, err = io.Copy(hash, archive)
+	if err != nil {
+		apiErr = errors.NewWithError("Couldn't read buildpack", err)
+		return
+	}
+	report.Checksum = fmt.Sprintf("%x", hash.Sum(nil))
+
+	reader, err := zip.NewReader(archive, stats.Size())
+	if err != nil {
+		report.Layout = "unknown"
+		report.Problems = append(report.Problems, "buildpack is not a valid zip archive")
+		return
+	}
+
+	parentPath, found := findBuildpackPath(reader.File)
+	switch {
+	case !found:
+		parentPath = "."
+		report.Layout = "no buildpack found in the archive"
+	case parentPath == ".":
+		report.Layout = "buildpack at the root of the archive"
+	default:
+		report.Layout = fmt.Sprintf("buildpack nested in %s/, it will be moved to the root when uploaded", parentPath)
+	}
+
+	filesByName := map[string]*zip.File{}
+	for _, file := range reader.File {
+		if !file.FileInfo().IsDir() {
+			report.Files++
+		}
+		filesByName[file.Name] = file
+	}
+
+	for _, script := range buildpackScripts {
+		file, found := filesByName[path.Join(parentPath, script)]
+		if !found {
+			report.Problems = append(report.Problems, fmt.Sprintf("%s is missing", script))
+			continue
+		}
+		if file.Mode()&0111 == 0 {
+			report.Problems = append(report.Problems, fmt.Sprintf("%s is not executable", script))
+		}
+	}
+	return
+}
+
 func normalizeBuildpackArchive(inputFile *os.File, outputFile *os.File) (err error) {
 	stats, err := inputFile.Stat()
 	if err != nil {
@@ -18,6 +18,8 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
+	testapi "testhelpers/api"
 	testconfig "testhelpers/configuration"
 	testnet "testhelpers/net"
 )
@@ -159,6 +161,200 @@ var _ = Describe("BuildpackBitsRepository", func() {
 			})
 		})
 	})
+
+	Describe("#InspectBuildpack", func() {
+		It("reports the layout, size and checksum of a valid zipped buildpack", func() {
+			report, apiErr := repo.InspectBuildpack(filepath.Join(buildpacksDir, "example-buildpack.zip"))
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(report.IsValid()).To(BeTrue())
+			Expect(report.Layout).To(Equal("buildpack at the root of the archive"))
+			Expect(report.Files).To(Equal(4))
+			Expect(report.Size).To(Equal(int64(692)))
+			Expect(report.Checksum).To(Equal("dd7d0a6a0d56a8595b942088efe864e6a70aac8e"))
+		})
+
+		It("reports a buildpack nested in a top-level directory", func() {
+			report, apiErr := repo.InspectBuildpack(filepath.Join(buildpacksDir, "example-buildpack-in-dir.zip"))
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(report.IsValid()).To(BeTrue())
+			Expect(report.Layout).To(ContainSubstring("nested in example-buildpack/"))
+		})
+
+		It("reports archives that are not zip files", func() {
+			report, apiErr := repo.InspectBuildpack(filepath.Join(buildpacksDir, "bad-buildpack.zip"))
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(report.Problems).To(Equal([]string{"buildpack is not a valid zip archive"}))
+		})
+
+		It("reports missing and non-executable scripts in a directory", func() {
+			dir, err := ioutil.TempDir("", "inspect-buildpack")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			os.Mkdir(filepath.Join(dir, "bin"), 0755)
+			ioutil.WriteFile(filepath.Join(dir, "bin", "detect"), []byte("#!/bin/sh"), 0755)
+			ioutil.WriteFile(filepath.Join(dir, "bin", "compile"), []byte("#!/bin/sh"), 0644)
+
+			report, apiErr := repo.InspectBuildpack(dir)
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(report.Files).To(Equal(2))
+			Expect(report.Problems).To(Equal([]string{
+				"bin/compile is not executable",
+				"bin/release is missing",
+			}))
+		})
+
+		It("downloads and inspects a buildpack URL", func() {
+			fileServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				f, err := os.Open(filepath.Join(buildpacksDir, "example-buildpack.zip"))
+				Expect(err).NotTo(HaveOccurred())
+				io.Copy(writer, f)
+			}))
+			defer fileServer.Close()
+
+			report, apiErr := repo.InspectBuildpack(fileServer.URL + "/example-buildpack.zip")
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(report.IsValid()).To(BeTrue())
+			Expect(report.Checksum).To(Equal("dd7d0a6a0d56a8595b942088efe864e6a70aac8e"))
+		})
+
+		It("fails when the path does not exist", func() {
+			_, apiErr := repo.InspectBuildpack("/foo/bar")
+			Expect(apiErr).To(HaveOccurred())
+			Expect(apiErr.Error()).To(ContainSubstring("Error opening buildpack file"))
+		})
+	})
+
+	Describe("#FetchBuildpack", func() {
+		It("downloads a buildpack URL once to a file with the same name", func() {
+			requestCount := 0
+			fileServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				requestCount++
+				f, err := os.Open(filepath.Join(buildpacksDir, "example-buildpack.zip"))
+				Expect(err).NotTo(HaveOccurred())
+				io.Copy(writer, f)
+			}))
+			defer fileServer.Close()
+
+			var fetchedPath string
+			repo.FetchBuildpack(fileServer.URL+"/example-buildpack.zip", func(localPath string, apiErr error) {
+				Expect(apiErr).NotTo(HaveOccurred())
+				fetchedPath = localPath
+				Expect(filepath.Base(localPath)).To(Equal("example-buildpack.zip"))
+
+				report, apiErr := repo.InspectBuildpack(localPath)
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(report.Checksum).To(Equal("dd7d0a6a0d56a8595b942088efe864e6a70aac8e"))
+			})
+
+			Expect(requestCount).To(Equal(1))
+			_, err := os.Stat(fetchedPath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("passes local paths through", func() {
+			var fetchedPath string
+			repo.FetchBuildpack("my-dir", func(localPath string, apiErr error) {
+				Expect(apiErr).NotTo(HaveOccurred())
+				fetchedPath = localPath
+			})
+
+			Expect(fetchedPath).To(Equal("my-dir"))
+		})
+	})
+
+	Describe("#ExtractBuildpack", func() {
+		var destDir string
+
+		BeforeEach(func() {
+			var err error
+			destDir, err = ioutil.TempDir("", "extract-buildpack")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(destDir)
+		})
+
+		It("moves a nested buildpack to the root of the directory", func() {
+			apiErr := repo.ExtractBuildpack(filepath.Join(buildpacksDir, "example-buildpack-in-dir.zip"), destDir)
+			Expect(apiErr).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(filepath.Join(destDir, "bin", "compile"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring("the-compile-script"))
+			_, err = os.Stat(filepath.Join(destDir, "lib", "helper"))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = os.Stat(filepath.Join(destDir, "example-buildpack"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("keeps the executable bits of a buildpack directory", func() {
+			if runtime.GOOS == "windows" {
+				return
+			}
+
+			apiErr := repo.ExtractBuildpack(filepath.Join(buildpacksDir, "example-buildpack"), destDir)
+			Expect(apiErr).NotTo(HaveOccurred())
+
+			stats, err := os.Stat(filepath.Join(destDir, "bin", "detect"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.Mode() & 0111).NotTo(BeZero())
+		})
+
+		It("fails when the archive does not contain a buildpack", func() {
+			apiErr := repo.ExtractBuildpack(filepath.Join(buildpacksDir, "bad-buildpack.zip"), destDir)
+			Expect(apiErr).To(HaveOccurred())
+			Expect(apiErr.Error()).To(ContainSubstring("Couldn't extract buildpack"))
+		})
+	})
+
+	Describe("#DownloadBuildpack", func() {
+		It("saves the bits of a buildpack to a file", func() {
+			downloadServer, downloadHandler := testnet.NewServer([]testnet.TestRequest{
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "GET",
+					Path:     "/v2/buildpacks/my-cool-buildpack-guid/download",
+					Response: testnet.TestResponse{Status: http.StatusOK, Body: "buildpack-zip-contents"},
+				}),
+			})
+			defer downloadServer.Close()
+			configRepo.SetApiEndpoint(downloadServer.URL)
+
+			dir, err := ioutil.TempDir("", "download-buildpack")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			destPath := filepath.Join(dir, "my-cool-buildpack.zip")
+			apiErr := repo.DownloadBuildpack(buildpack, destPath)
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(downloadHandler).To(testnet.HaveAllRequestsCalled())
+			contents, err := ioutil.ReadFile(destPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.TrimSpace(string(contents))).To(Equal("buildpack-zip-contents"))
+		})
+
+		It("returns errors from the server", func() {
+			downloadServer, _ := testnet.NewServer([]testnet.TestRequest{
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "GET",
+					Path:     "/v2/buildpacks/my-cool-buildpack-guid/download",
+					Response: testnet.TestResponse{Status: http.StatusNotFound},
+				}),
+			})
+			defer downloadServer.Close()
+			configRepo.SetApiEndpoint(downloadServer.URL)
+
+			apiErr := repo.DownloadBuildpack(buildpack, filepath.Join(os.TempDir(), "never-written.zip"))
+			Expect(apiErr).To(HaveOccurred())
+		})
+	})
 })
 
 func uploadBuildpackRequest() testnet.TestRequest {
@@ -22,11 +22,12 @@ type LogsRepository interface {
 
 type LoggregatorLogsRepository struct {
 	config       configuration.Reader
+	tlsConfigs   *net.TLSConfigCache
 	TrustedCerts []tls.Certificate
 }
 
 func NewLoggregatorLogsRepository(config configuration.Reader) LoggregatorLogsRepository {
-	return LoggregatorLogsRepository{config: config}
+	return LoggregatorLogsRepository{config: config, tlsConfigs: new(net.TLSConfigCache)}
 }
 
 func (repo LoggregatorLogsRepository) RecentLogsFor(appGuid string, onConnect func(), logChan chan *logmessage.Message) (err error) {
@@ -64,7 +65,10 @@ func (repo LoggregatorLogsRepository) connectToWebsocket(location string, onConn
 	}
 
 	wsConfig.Header.Add("Authorization", repo.config.AccessToken())
-	wsConfig.TlsConfig = net.NewTLSConfig(repo.TrustedCerts, repo.config.IsSSLDisabled())
+	wsConfig.TlsConfig, err = repo.tlsConfigs.Get(repo.TrustedCerts, repo.config)
+	if err != nil {
+		return
+	}
 
 	ws, err := websocket.DialConfig(wsConfig)
 	if err != nil {
@@ -1,10 +1,12 @@
 package api
 
 import (
+	"bytes"
 	"cf/configuration"
 	"cf/errors"
 	"cf/models"
 	"cf/net"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -23,18 +25,40 @@ func (resource QuotaResource) ToFields() (quota models.QuotaFields) {
 	quota.Guid = resource.Metadata.Guid
 	quota.Name = resource.Entity.Name
 	quota.MemoryLimit = resource.Entity.MemoryLimit
+	quota.InstanceMemoryLimit = resource.Entity.InstanceMemoryLimit
+	quota.RoutesLimit = resource.Entity.RoutesLimit
+	quota.ServicesLimit = resource.Entity.ServicesLimit
+	quota.NonBasicServicesAllowed = resource.Entity.NonBasicServicesAllowed
 	return
 }
 
 type QuotaEntity struct {
-	Name        string
-	MemoryLimit uint64 `json:"memory_limit"`
+	Name                    string `json:"name"`
+	MemoryLimit             uint64 `json:"memory_limit"`
+	InstanceMemoryLimit     int64  `json:"instance_memory_limit"`
+	RoutesLimit             int    `json:"total_routes"`
+	ServicesLimit           int    `json:"total_services"`
+	NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
+}
+
+func NewQuotaEntity(quota models.QuotaFields) QuotaEntity {
+	return QuotaEntity{
+		Name:                    quota.Name,
+		MemoryLimit:             quota.MemoryLimit,
+		InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+		RoutesLimit:             quota.RoutesLimit,
+		ServicesLimit:           quota.ServicesLimit,
+		NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+	}
 }
 
 type QuotaRepository interface {
 	FindAll() (quotas []models.QuotaFields, apiErr error)
 	FindByName(name string) (quota models.QuotaFields, apiErr error)
 	Update(orgGuid, quotaGuid string) (apiErr error)
+	Create(quota models.QuotaFields) (apiErr error)
+	UpdateQuota(quota models.QuotaFields) (apiErr error)
+	Delete(quotaGuid string) (apiErr error)
 }
 
 type CloudControllerQuotaRepository struct {
@@ -89,3 +113,28 @@ func (repo CloudControllerQuotaRepository) Update(orgGuid, quotaGuid string) (ap
 	data := fmt.Sprintf(`{"quota_definition_guid":"%s"}`, quotaGuid)
 	return repo.gateway.UpdateResource(path, repo.config.AccessToken(), strings.NewReader(data))
 }
+
+func (repo CloudControllerQuotaRepository) Create(quota models.QuotaFields) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/quota_definitions", repo.config.ApiEndpoint())
+	body, err := json.Marshal(NewQuotaEntity(quota))
+	if err != nil {
+		apiErr = errors.NewWithError("Could not serialize information", err)
+		return
+	}
+	return repo.gateway.CreateResource(path, repo.config.AccessToken(), bytes.NewReader(body))
+}
+
+func (repo CloudControllerQuotaRepository) UpdateQuota(quota models.QuotaFields) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/quota_definitions/%s", repo.config.ApiEndpoint(), quota.Guid)
+	body, err := json.Marshal(NewQuotaEntity(quota))
+	if err != nil {
+		apiErr = errors.NewWithError("Could not serialize information", err)
+		return
+	}
+	return repo.gateway.UpdateResource(path, repo.config.AccessToken(), bytes.NewReader(body))
+}
+
+func (repo CloudControllerQuotaRepository) Delete(quotaGuid string) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/quota_definitions/%s", repo.config.ApiEndpoint(), quotaGuid)
+	return repo.gateway.DeleteResource(path, repo.config.AccessToken())
+}
@@ -24,7 +24,14 @@ var _ = Describe("CloudControllerQuotaRepository", func() {
 					Body: `{"resources": [
 							{
 							  "metadata": { "guid": "my-quota-guid" },
-							  "entity": { "name": "my-remote-quota", "memory_limit": 1024 }
+							  "entity": {
+							    "name": "my-remote-quota",
+							    "memory_limit": 1024,
+							    "instance_memory_limit": -1,
+							    "total_routes": 10,
+							    "total_services": 5,
+							    "non_basic_services_allowed": true
+							  }
 							}
 						]}`},
 			})
@@ -39,6 +46,10 @@ var _ = Describe("CloudControllerQuotaRepository", func() {
 			expectedQuota.Guid = "my-quota-guid"
 			expectedQuota.Name = "my-remote-quota"
 			expectedQuota.MemoryLimit = 1024
+			expectedQuota.InstanceMemoryLimit = -1
+			expectedQuota.RoutesLimit = 10
+			expectedQuota.ServicesLimit = 5
+			expectedQuota.NonBasicServicesAllowed = true
 			Expect(quota).To(Equal(expectedQuota))
 		})
 
@@ -64,6 +75,83 @@ var _ = Describe("CloudControllerQuotaRepository", func() {
 	})
 })
 
+var _ = Describe("managing quota definitions", func() {
+	var quota models.QuotaFields
+
+	BeforeEach(func() {
+		quota = models.QuotaFields{
+			Guid:                    "my-quota-guid",
+			Name:                    "my-quota",
+			MemoryLimit:             2048,
+			InstanceMemoryLimit:     512,
+			RoutesLimit:             20,
+			ServicesLimit:           -1,
+			NonBasicServicesAllowed: false,
+		}
+	})
+
+	It("creates a quota definition", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "POST",
+			Path:   "/v2/quota_definitions",
+			Matcher: testnet.RequestBodyMatcher(`{
+				"name": "my-quota",
+				"memory_limit": 2048,
+				"instance_memory_limit": 512,
+				"total_routes": 20,
+				"total_services": -1,
+				"non_basic_services_allowed": false
+			}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createQuotaRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.Create(quota)
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+
+	It("updates a quota definition", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "PUT",
+			Path:   "/v2/quota_definitions/my-quota-guid",
+			Matcher: testnet.RequestBodyMatcher(`{
+				"name": "my-quota",
+				"memory_limit": 2048,
+				"instance_memory_limit": 512,
+				"total_routes": 20,
+				"total_services": -1,
+				"non_basic_services_allowed": false
+			}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createQuotaRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.UpdateQuota(quota)
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+
+	It("deletes a quota definition", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "DELETE",
+			Path:     "/v2/quota_definitions/my-quota-guid",
+			Response: testnet.TestResponse{Status: http.StatusNoContent},
+		})
+
+		ts, handler, repo := createQuotaRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.Delete("my-quota-guid")
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+})
+
 func createQuotaRepo(req testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo QuotaRepository) {
 	ts, handler = testnet.NewServer([]testnet.TestRequest{req})
 
@@ -13,6 +13,7 @@ type RepositoryLocator struct {
 	organizationRepo                CloudControllerOrganizationRepository
 	quotaRepo                       CloudControllerQuotaRepository
 	spaceRepo                       CloudControllerSpaceRepository
+	spaceUsageRepo                  CloudControllerSpaceUsageRepository
 	appRepo                         CloudControllerApplicationRepository
 	appBitsRepo                     CloudControllerApplicationBitsRepository
 	appSummaryRepo                  CloudControllerAppSummaryRepository
@@ -30,6 +31,9 @@ type RepositoryLocator struct {
 	logsRepo                        LoggregatorLogsRepository
 	authTokenRepo                   CloudControllerServiceAuthTokenRepository
 	serviceBrokerRepo               CloudControllerServiceBrokerRepository
+	serviceBrokerCatalogRepo        ServiceBrokerAPICatalogRepository
+	servicePlanRepo                 CloudControllerServicePlanRepository
+	servicePlanVisibilityRepo       CloudControllerServicePlanVisibilityRepository
 	userProvidedServiceInstanceRepo CCUserProvidedServiceInstanceRepository
 	buildpackRepo                   CloudControllerBuildpackRepository
 	buildpackBitsRepo               CloudControllerBuildpackBitsRepository
@@ -39,6 +43,7 @@ func NewRepositoryLocator(config configuration.ReadWriter, gatewaysByName map[st
 	authGateway := gatewaysByName["auth"]
 	cloudControllerGateway := gatewaysByName["cloud-controller"]
 	uaaGateway := gatewaysByName["uaa"]
+	serviceBrokerGateway := gatewaysByName["service-broker"]
 	loc.authRepo = NewUAAAuthenticationRepository(authGateway, config)
 
 	// ensure gateway refreshers are set before passing them by value to repositories
@@ -64,8 +69,12 @@ func NewRepositoryLocator(config configuration.ReadWriter, gatewaysByName map[st
 	loc.serviceRepo = NewCloudControllerServiceRepository(config, cloudControllerGateway)
 	loc.serviceBindingRepo = NewCloudControllerServiceBindingRepository(config, cloudControllerGateway)
 	loc.serviceBrokerRepo = NewCloudControllerServiceBrokerRepository(config, cloudControllerGateway)
+	loc.serviceBrokerCatalogRepo = NewServiceBrokerAPICatalogRepository(config, serviceBrokerGateway)
+	loc.servicePlanRepo = NewCloudControllerServicePlanRepository(config, cloudControllerGateway)
+	loc.servicePlanVisibilityRepo = NewCloudControllerServicePlanVisibilityRepository(config, cloudControllerGateway)
 	loc.serviceSummaryRepo = NewCloudControllerServiceSummaryRepository(config, cloudControllerGateway)
 	loc.spaceRepo = NewCloudControllerSpaceRepository(config, cloudControllerGateway)
+	loc.spaceUsageRepo = NewCloudControllerSpaceUsageRepository(config, cloudControllerGateway)
 	loc.userProvidedServiceInstanceRepo = NewCCUserProvidedServiceInstanceRepository(config, cloudControllerGateway)
 	loc.userRepo = NewCloudControllerUserRepository(config, uaaGateway, cloudControllerGateway)
 	loc.buildpackRepo = NewCloudControllerBuildpackRepository(config, cloudControllerGateway)
@@ -98,6 +107,10 @@ func (locator RepositoryLocator) GetSpaceRepository() SpaceRepository {
 	return locator.spaceRepo
 }
 
+func (locator RepositoryLocator) GetSpaceUsageRepository() SpaceUsageRepository {
+	return locator.spaceUsageRepo
+}
+
 func (locator RepositoryLocator) GetApplicationRepository() ApplicationRepository {
 	return locator.appRepo
 }
@@ -166,6 +179,18 @@ func (locator RepositoryLocator) GetServiceBrokerRepository() ServiceBrokerRepos
 	return locator.serviceBrokerRepo
 }
 
+func (locator RepositoryLocator) GetServiceBrokerCatalogRepository() ServiceBrokerCatalogRepository {
+	return locator.serviceBrokerCatalogRepo
+}
+
+func (locator RepositoryLocator) GetServicePlanRepository() ServicePlanRepository {
+	return locator.servicePlanRepo
+}
+
+func (locator RepositoryLocator) GetServicePlanVisibilityRepository() ServicePlanVisibilityRepository {
+	return locator.servicePlanVisibilityRepo
+}
+
 func (locator RepositoryLocator) GetUserProvidedServiceInstanceRepository() UserProvidedServiceInstanceRepository {
 	return locator.userProvidedServiceInstanceRepo
 }
@@ -31,16 +31,21 @@ func (resource RouteResource) ToModel() (route models.Route) {
 }
 
 type RouteEntity struct {
-	Host   string
-	Domain DomainResource
-	Space  SpaceResource
-	Apps   []ApplicationResource
+	Host    string
+	Domain  DomainResource
+	Space   SpaceResource
+	Apps    []ApplicationResource
+	AppsUrl string `json:"apps_url"`
 }
 
 type RouteRepository interface {
 	ListRoutes(cb func(models.Route) bool) (apiErr error)
+	ListRoutesInSpace(spaceGuid string, cb func(models.Route) bool) (apiErr error)
+	ListOrphanedRoutes(cb func(models.Route) bool) (apiErr error)
+	ListOrphanedRoutesInSpace(spaceGuid string, cb func(models.Route) bool) (apiErr error)
 	FindByHost(host string) (route models.Route, apiErr error)
 	FindByHostAndDomain(host, domain string) (route models.Route, apiErr error)
+	IsReserved(host, domain string) (reserved bool, apiErr error)
 	Create(host, domainGuid string) (createdRoute models.Route, apiErr error)
 	CreateInSpace(host, domainGuid, spaceGuid string) (createdRoute models.Route, apiErr error)
 	Bind(routeGuid, appGuid string) (apiErr error)
@@ -62,16 +67,79 @@ func NewCloudControllerRouteRepository(config configuration.Reader, gateway net.
 }
 
 func (repo CloudControllerRouteRepository) ListRoutes(cb func(models.Route) bool) (apiErr error) {
+	return repo.listRoutes("/v2/routes?inline-relations-depth=1", cb)
+}
+
+func (repo CloudControllerRouteRepository) ListRoutesInSpace(spaceGuid string, cb func(models.Route) bool) (apiErr error) {
+	return repo.listRoutes(fmt.Sprintf("/v2/spaces/%s/routes?inline-relations-depth=1", spaceGuid), cb)
+}
+
+func (repo CloudControllerRouteRepository) listRoutes(path string, cb func(models.Route) bool) (apiErr error) {
 	return repo.gateway.ListPaginatedResources(
 		repo.config.ApiEndpoint(),
 		repo.config.AccessToken(),
-		fmt.Sprintf("/v2/routes?inline-relations-depth=1"),
+		path,
 		RouteResource{},
 		func(resource interface{}) bool {
 			return cb(resource.(RouteResource).ToModel())
 		})
 }
 
+// ListOrphanedRoutes lists the routes that are not mapped to any app
+func (repo CloudControllerRouteRepository) ListOrphanedRoutes(cb func(models.Route) bool) (apiErr error) {
+	return repo.listOrphanedRoutes("/v2/routes?inline-relations-depth=1", cb)
+}
+
+func (repo CloudControllerRouteRepository) ListOrphanedRoutesInSpace(spaceGuid string, cb func(models.Route) bool) (apiErr error) {
+	return repo.listOrphanedRoutes(fmt.Sprintf("/v2/spaces/%s/routes?inline-relations-depth=1", spaceGuid), cb)
+}
+
+func (repo CloudControllerRouteRepository) listOrphanedRoutes(path string, cb func(models.Route) bool) (apiErr error) {
+	var appsErr error
+	apiErr = repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		repo.config.AccessToken(),
+		path,
+		RouteResource{},
+		func(resource interface{}) bool {
+			routeResource := resource.(RouteResource)
+
+			var mapped bool
+			mapped, appsErr = repo.isMappedToApps(routeResource)
+			if appsErr != nil {
+				return false
+			}
+			if mapped {
+				return true
+			}
+			return cb(routeResource.ToModel())
+		})
+	if apiErr == nil {
+		apiErr = appsErr
+	}
+	return
+}
+
+// the api leaves out the apps of a route mapped to more apps than it inlines,
+// so a route without them has to be asked for its apps before it counts as orphaned
+func (repo CloudControllerRouteRepository) isMappedToApps(resource RouteResource) (mapped bool, apiErr error) {
+	if resource.Entity.Apps != nil {
+		mapped = len(resource.Entity.Apps) > 0
+		return
+	}
+
+	appsUrl := resource.Entity.AppsUrl
+	if appsUrl == "" {
+		appsUrl = fmt.Sprintf("/v2/routes/%s/apps", resource.Metadata.Guid)
+	}
+
+	apps := new(TotalResults)
+	path := fmt.Sprintf("%s%s?results-per-page=1", repo.config.ApiEndpoint(), appsUrl)
+	apiErr = repo.gateway.GetResource(path, repo.config.AccessToken(), apps)
+	mapped = apps.TotalResults > 0
+	return
+}
+
 func (repo CloudControllerRouteRepository) FindByHost(host string) (route models.Route, apiErr error) {
 	found := false
 	apiErr = repo.gateway.ListPaginatedResources(
@@ -117,6 +185,34 @@ func (repo CloudControllerRouteRepository) FindByHostAndDomain(host, domainName
 	return
 }
 
+// IsReserved tells whether any space has the route, including the spaces the
+// user can't see
+func (repo CloudControllerRouteRepository) IsReserved(host, domainName string) (reserved bool, apiErr error) {
+	domain, apiErr := repo.domainRepo.FindByName(domainName)
+	if apiErr != nil {
+		return
+	}
+
+	path := fmt.Sprintf("%s/v2/routes/reserved/domain/%s/host/%s", repo.config.ApiEndpoint(), domain.Guid, url.QueryEscape(host))
+	request, apiErr := repo.gateway.NewRequest("GET", path, repo.config.AccessToken(), nil)
+	if apiErr != nil {
+		return
+	}
+
+	apiErr = repo.gateway.PerformRequest(request)
+	if apiErr == nil {
+		reserved = true
+		return
+	}
+
+	// older Cloud Controllers don't know the endpoint at all, which is told
+	// apart from a free route by the error code
+	if notFound, ok := apiErr.(errors.HttpNotFoundError); ok && notFound.ErrorCode() != errors.UNKNOWN_REQUEST {
+		apiErr = nil
+	}
+	return
+}
+
 func (repo CloudControllerRouteRepository) Create(host, domainGuid string) (createdRoute models.Route, apiErr error) {
 	return repo.CreateInSpace(host, domainGuid, repo.config.SpaceFields().Guid)
 }
@@ -44,6 +44,113 @@ var _ = Describe("route repository", func() {
 		Expect(apiErr).NotTo(HaveOccurred())
 	})
 
+	It("lists the routes in a space", func() {
+		request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/spaces/space-1-guid/routes?inline-relations-depth=1",
+			Response: orphanedRoutesResponse,
+		})
+
+		ts, handler, repo, _ := createRoutesRepo(request)
+		defer ts.Close()
+
+		routes := []models.Route{}
+		apiErr := repo.ListRoutesInSpace("space-1-guid", func(route models.Route) bool {
+			routes = append(routes, route)
+			return true
+		})
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(routes)).To(Equal(2))
+		Expect(routes[0].Guid).To(Equal("mapped-route-guid"))
+		Expect(routes[0].Apps[0].Name).To(Equal("app-1"))
+		Expect(routes[1].Guid).To(Equal("orphaned-route-guid"))
+	})
+
+	It("lists only the routes that are not mapped to an app", func() {
+		request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/routes?inline-relations-depth=1",
+			Response: orphanedRoutesResponse,
+		})
+
+		ts, handler, repo, _ := createRoutesRepo(request)
+		defer ts.Close()
+
+		routes := []models.Route{}
+		apiErr := repo.ListOrphanedRoutes(func(route models.Route) bool {
+			routes = append(routes, route)
+			return true
+		})
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(routes)).To(Equal(1))
+		Expect(routes[0].Guid).To(Equal("orphaned-route-guid"))
+		Expect(routes[0].Space.Name).To(Equal("space-1"))
+	})
+
+	It("asks for the apps of a route when they were not inlined", func() {
+		routesRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/spaces/space-1-guid/routes?inline-relations-depth=1",
+			Response: notInlinedAppsRoutesResponse,
+		})
+
+		mappedAppsRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/routes/busy-route-guid/apps?results-per-page=1",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"total_results": 120, "resources": []}`},
+		})
+
+		unmappedAppsRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/routes/unknown-route-guid/apps?results-per-page=1",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"total_results": 0, "resources": []}`},
+		})
+
+		ts, handler, repo, _ := createRoutesRepo(routesRequest, mappedAppsRequest, unmappedAppsRequest)
+		defer ts.Close()
+
+		routes := []models.Route{}
+		apiErr := repo.ListOrphanedRoutesInSpace("space-1-guid", func(route models.Route) bool {
+			routes = append(routes, route)
+			return true
+		})
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(routes)).To(Equal(1))
+		Expect(routes[0].Guid).To(Equal("unknown-route-guid"))
+	})
+
+	It("stops listing orphaned routes when the apps of a route can't be fetched", func() {
+		routesRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/routes?inline-relations-depth=1",
+			Response: notInlinedAppsRoutesResponse,
+		})
+
+		appsRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/routes/busy-route-guid/apps?results-per-page=1",
+			Response: testnet.TestResponse{Status: http.StatusInternalServerError},
+		})
+
+		ts, _, repo, _ := createRoutesRepo(routesRequest, appsRequest)
+		defer ts.Close()
+
+		routes := []models.Route{}
+		apiErr := repo.ListOrphanedRoutes(func(route models.Route) bool {
+			routes = append(routes, route)
+			return true
+		})
+
+		Expect(apiErr).To(HaveOccurred())
+		Expect(routes).To(BeEmpty())
+	})
+
 	It("finds routes by host", func() {
 		request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
 			Method:   "GET",
@@ -123,6 +230,49 @@ var _ = Describe("route repository", func() {
 		Expect(apiErr.(errors.ModelNotFoundError)).NotTo(BeNil())
 	})
 
+	Describe("checking if a route is reserved", func() {
+		checkReserved := func(response testnet.TestResponse) (bool, error) {
+			request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/routes/reserved/domain/my-domain-guid/host/my-host",
+				Response: response,
+			})
+
+			ts, handler, repo, domainRepo := createRoutesRepo(request)
+			defer ts.Close()
+
+			domain := models.DomainFields{}
+			domain.Guid = "my-domain-guid"
+			domainRepo.FindByNameDomain = domain
+
+			reserved, apiErr := repo.IsReserved("my-host", "my-domain.com")
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(domainRepo.FindByNameName).To(Equal("my-domain.com"))
+			return reserved, apiErr
+		}
+
+		It("is reserved when the server has the route", func() {
+			reserved, apiErr := checkReserved(testnet.TestResponse{Status: http.StatusNoContent})
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(reserved).To(BeTrue())
+		})
+
+		It("is not reserved when the server does not have the route", func() {
+			reserved, apiErr := checkReserved(testnet.TestResponse{Status: http.StatusNotFound, Body: `{"code": 210002, "description": "The route could not be found"}`})
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(reserved).To(BeFalse())
+		})
+
+		It("returns an error when the server does not know the endpoint", func() {
+			_, apiErr := checkReserved(testnet.TestResponse{Status: http.StatusNotFound, Body: `{"code": 10000, "description": "Unknown request"}`})
+
+			Expect(apiErr).To(HaveOccurred())
+			Expect(apiErr.(errors.HttpError).ErrorCode()).To(Equal(errors.UNKNOWN_REQUEST))
+		})
+	})
+
 	It("creates routes in a given space", func() {
 		request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
 			Method:  "POST",
@@ -254,6 +404,63 @@ var firstPageRoutesResponse = testnet.TestResponse{Status: http.StatusOK, Body:
   ]
 }`}
 
+var orphanedRoutesResponse = testnet.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": {
+        "guid": "mapped-route-guid"
+      },
+      "entity": {
+        "host": "mapped",
+        "domain": { "metadata": { "guid": "domain-1-guid" }, "entity": { "name": "cfapps.io" } },
+        "space": { "metadata": { "guid": "space-1-guid" }, "entity": { "name": "space-1" } },
+        "apps": [
+          { "metadata": { "guid": "app-1-guid" }, "entity": { "name": "app-1" } }
+        ]
+      }
+    },
+    {
+      "metadata": {
+        "guid": "orphaned-route-guid"
+      },
+      "entity": {
+        "host": "orphaned",
+        "domain": { "metadata": { "guid": "domain-1-guid" }, "entity": { "name": "cfapps.io" } },
+        "space": { "metadata": { "guid": "space-1-guid" }, "entity": { "name": "space-1" } },
+        "apps": []
+      }
+    }
+  ]
+}`}
+
+var notInlinedAppsRoutesResponse = testnet.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": {
+        "guid": "busy-route-guid"
+      },
+      "entity": {
+        "host": "busy",
+        "domain": { "metadata": { "guid": "domain-1-guid" }, "entity": { "name": "cfapps.io" } },
+        "space": { "metadata": { "guid": "space-1-guid" }, "entity": { "name": "space-1" } },
+        "apps_url": "/v2/routes/busy-route-guid/apps"
+      }
+    },
+    {
+      "metadata": {
+        "guid": "unknown-route-guid"
+      },
+      "entity": {
+        "host": "unknown",
+        "domain": { "metadata": { "guid": "domain-1-guid" }, "entity": { "name": "cfapps.io" } },
+        "space": { "metadata": { "guid": "space-1-guid" }, "entity": { "name": "space-1" } }
+      }
+    }
+  ]
+}`}
+
 var secondPageRoutesResponse = testnet.TestResponse{Status: http.StatusOK, Body: `
 {
   "resources": [
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"cf/configuration"
+	"cf/net"
+	"encoding/base64"
+	"strings"
+)
+
+const ServiceBrokerApiVersion = "2.3"
+
+type ServiceBrokerCatalogRepository interface {
+	GetCatalog(brokerUrl, username, password string) (catalog []byte, apiErr error)
+}
+
+// fetches catalogs straight from a broker, so no cloud controller is needed
+type ServiceBrokerAPICatalogRepository struct {
+	config  configuration.Reader
+	gateway net.Gateway
+}
+
+func NewServiceBrokerAPICatalogRepository(config configuration.Reader, gateway net.Gateway) (repo ServiceBrokerAPICatalogRepository) {
+	repo.config = config
+	repo.gateway = gateway
+	return
+}
+
+func (repo ServiceBrokerAPICatalogRepository) GetCatalog(brokerUrl, username, password string) (catalog []byte, apiErr error) {
+	path := strings.TrimSuffix(brokerUrl, "/") + "/v2/catalog"
+
+	auth := ""
+	if username != "" || password != "" {
+		auth = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+
+	request, apiErr := repo.gateway.NewRequest("GET", path, auth, nil)
+	if apiErr != nil {
+		return
+	}
+	request.HttpReq.Header.Set("X-Broker-Api-Version", ServiceBrokerApiVersion)
+
+	catalog, _, _, apiErr = repo.gateway.PerformRequestForResponseBytes(request)
+	return
+}
@@ -0,0 +1,49 @@
+package api_test
+
+import (
+	. "cf/api"
+	"cf/net"
+	"encoding/base64"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	testconfig "testhelpers/configuration"
+)
+
+var _ = Describe("Service Broker Catalogs Repo", func() {
+	It("fetches the catalog from the broker with basic auth", func() {
+		var request *http.Request
+		ts := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			request = req
+			writer.Write([]byte(`{"services": []}`))
+		}))
+		defer ts.Close()
+
+		config := testconfig.NewRepository()
+		repo := NewServiceBrokerAPICatalogRepository(config, net.NewServiceBrokerGateway(config))
+
+		catalog, apiErr := repo.GetCatalog(ts.URL+"/", "admin", "secret")
+
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(string(catalog)).To(Equal(`{"services": []}`))
+		Expect(request.Method).To(Equal("GET"))
+		Expect(request.URL.Path).To(Equal("/v2/catalog"))
+		Expect(request.Header.Get("Authorization")).To(Equal("Basic " + base64.StdEncoding.EncodeToString([]byte("admin:secret"))))
+		Expect(request.Header.Get("X-Broker-Api-Version")).To(Equal(ServiceBrokerApiVersion))
+	})
+
+	It("returns an error when the broker refuses the request", func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		config := testconfig.NewRepository()
+		repo := NewServiceBrokerAPICatalogRepository(config, net.NewServiceBrokerGateway(config))
+
+		_, apiErr := repo.GetCatalog(ts.URL, "admin", "wrong")
+
+		Expect(apiErr).To(HaveOccurred())
+	})
+})
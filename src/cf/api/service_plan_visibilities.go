@@ -0,0 +1,67 @@
+package api
+
+import (
+	"cf/configuration"
+	"cf/models"
+	"cf/net"
+	"fmt"
+	"strings"
+)
+
+type ServicePlanVisibilityResource struct {
+	Resource
+	Entity ServicePlanVisibilityEntity
+}
+
+func (resource ServicePlanVisibilityResource) ToFields() (fields models.ServicePlanVisibilityFields) {
+	fields.Guid = resource.Metadata.Guid
+	fields.ServicePlanGuid = resource.Entity.ServicePlanGuid
+	fields.OrganizationGuid = resource.Entity.OrganizationGuid
+	return
+}
+
+type ServicePlanVisibilityEntity struct {
+	ServicePlanGuid  string `json:"service_plan_guid"`
+	OrganizationGuid string `json:"organization_guid"`
+}
+
+type ServicePlanVisibilityRepository interface {
+	List() (visibilities []models.ServicePlanVisibilityFields, apiErr error)
+	Create(planGuid, orgGuid string) (apiErr error)
+	Delete(visibilityGuid string) (apiErr error)
+}
+
+type CloudControllerServicePlanVisibilityRepository struct {
+	config  configuration.Reader
+	gateway net.Gateway
+}
+
+func NewCloudControllerServicePlanVisibilityRepository(config configuration.Reader, gateway net.Gateway) (repo CloudControllerServicePlanVisibilityRepository) {
+	repo.config = config
+	repo.gateway = gateway
+	return
+}
+
+func (repo CloudControllerServicePlanVisibilityRepository) List() (visibilities []models.ServicePlanVisibilityFields, apiErr error) {
+	apiErr = repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		repo.config.AccessToken(),
+		"/v2/service_plan_visibilities",
+		ServicePlanVisibilityResource{},
+		func(resource interface{}) bool {
+			visibilities = append(visibilities, resource.(ServicePlanVisibilityResource).ToFields())
+			return true
+		})
+	return
+}
+
+func (repo CloudControllerServicePlanVisibilityRepository) Create(planGuid, orgGuid string) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/service_plan_visibilities", repo.config.ApiEndpoint())
+	body := fmt.Sprintf(`{"service_plan_guid":"%s","organization_guid":"%s"}`, planGuid, orgGuid)
+	return repo.gateway.CreateResource(path, repo.config.AccessToken(), strings.NewReader(body))
+}
+
+func (repo CloudControllerServicePlanVisibilityRepository) Delete(visibilityGuid string) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/service_plan_visibilities/%s", repo.config.ApiEndpoint(), visibilityGuid)
+	return repo.gateway.DeleteResource(path, repo.config.AccessToken())
+}
@@ -0,0 +1,105 @@
+package api_test
+
+import (
+	. "cf/api"
+	"cf/models"
+	"cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	testapi "testhelpers/api"
+	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
+)
+
+var _ = Describe("Service Plan Visibilities Repo", func() {
+	It("lists the visibilities across all pages", func() {
+		firstRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/service_plan_visibilities",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body: `{
+				  "next_url": "/v2/service_plan_visibilities?page=2",
+				  "resources": [
+					{
+					  "metadata": {"guid": "visibility-1-guid"},
+					  "entity": {"service_plan_guid": "plan-1-guid", "organization_guid": "org-1-guid"}
+					}
+				  ]
+				}`,
+			},
+		})
+
+		secondRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/service_plan_visibilities?page=2",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body: `{
+				  "resources": [
+					{
+					  "metadata": {"guid": "visibility-2-guid"},
+					  "entity": {"service_plan_guid": "plan-2-guid", "organization_guid": "org-2-guid"}
+					}
+				  ]
+				}`,
+			},
+		})
+
+		ts, handler, repo := createServicePlanVisibilityRepo(firstRequest, secondRequest)
+		defer ts.Close()
+
+		visibilities, apiErr := repo.List()
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(visibilities).To(Equal([]models.ServicePlanVisibilityFields{
+			{Guid: "visibility-1-guid", ServicePlanGuid: "plan-1-guid", OrganizationGuid: "org-1-guid"},
+			{Guid: "visibility-2-guid", ServicePlanGuid: "plan-2-guid", OrganizationGuid: "org-2-guid"},
+		}))
+	})
+
+	It("creates a visibility", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "POST",
+			Path:     "/v2/service_plan_visibilities",
+			Matcher:  testnet.RequestBodyMatcher(`{"service_plan_guid":"my-plan-guid","organization_guid":"my-org-guid"}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createServicePlanVisibilityRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.Create("my-plan-guid", "my-org-guid")
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+
+	It("deletes a visibility", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "DELETE",
+			Path:     "/v2/service_plan_visibilities/my-visibility-guid",
+			Response: testnet.TestResponse{Status: http.StatusNoContent},
+		})
+
+		ts, handler, repo := createServicePlanVisibilityRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.Delete("my-visibility-guid")
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+})
+
+func createServicePlanVisibilityRepo(requests ...testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo ServicePlanVisibilityRepository) {
+	ts, handler = testnet.NewServer(requests)
+	configRepo := testconfig.NewRepositoryWithDefaults()
+	configRepo.SetApiEndpoint(ts.URL)
+	gateway := net.NewCloudControllerGateway(configRepo)
+	repo = NewCloudControllerServicePlanVisibilityRepository(configRepo, gateway)
+	return
+}
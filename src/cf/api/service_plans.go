@@ -0,0 +1,29 @@
+package api
+
+import (
+	"cf/configuration"
+	"cf/net"
+	"fmt"
+	"strings"
+)
+
+type ServicePlanRepository interface {
+	Update(planGuid string, public bool) (apiErr error)
+}
+
+type CloudControllerServicePlanRepository struct {
+	config  configuration.Reader
+	gateway net.Gateway
+}
+
+func NewCloudControllerServicePlanRepository(config configuration.Reader, gateway net.Gateway) (repo CloudControllerServicePlanRepository) {
+	repo.config = config
+	repo.gateway = gateway
+	return
+}
+
+func (repo CloudControllerServicePlanRepository) Update(planGuid string, public bool) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/service_plans/%s", repo.config.ApiEndpoint(), planGuid)
+	body := fmt.Sprintf(`{"public":%t}`, public)
+	return repo.gateway.UpdateResource(path, repo.config.AccessToken(), strings.NewReader(body))
+}
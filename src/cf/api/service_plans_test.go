@@ -0,0 +1,58 @@
+package api_test
+
+import (
+	. "cf/api"
+	"cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	testapi "testhelpers/api"
+	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
+)
+
+var _ = Describe("Service Plans Repo", func() {
+	It("makes a service plan public", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "PUT",
+			Path:     "/v2/service_plans/my-plan-guid",
+			Matcher:  testnet.RequestBodyMatcher(`{"public":true}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createServicePlanRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.Update("my-plan-guid", true)
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+
+	It("makes a service plan private", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "PUT",
+			Path:     "/v2/service_plans/my-plan-guid",
+			Matcher:  testnet.RequestBodyMatcher(`{"public":false}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createServicePlanRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.Update("my-plan-guid", false)
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+})
+
+func createServicePlanRepo(requests ...testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo ServicePlanRepository) {
+	ts, handler = testnet.NewServer(requests)
+	configRepo := testconfig.NewRepositoryWithDefaults()
+	configRepo.SetApiEndpoint(ts.URL)
+	gateway := net.NewCloudControllerGateway(configRepo)
+	repo = NewCloudControllerServicePlanRepository(configRepo, gateway)
+	return
+}
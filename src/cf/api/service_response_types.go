@@ -2,6 +2,7 @@ package api
 
 import (
 	"cf/models"
+	"encoding/json"
 	"fmt"
 )
 
@@ -20,6 +21,7 @@ func (resource ServiceOfferingResource) ToFields() (fields models.ServiceOfferin
 	fields.Provider = resource.Entity.Provider
 	fields.Description = resource.Entity.Description
 	fields.Guid = resource.Metadata.Guid
+	fields.BrokerGuid = resource.Entity.ServiceBrokerGuid
 	fields.DocumentationUrl = resource.Entity.DocumentationUrl
 	return
 }
@@ -27,21 +29,19 @@ func (resource ServiceOfferingResource) ToFields() (fields models.ServiceOfferin
 func (resource ServiceOfferingResource) ToModel() (offering models.ServiceOffering) {
 	offering.ServiceOfferingFields = resource.ToFields()
 	for _, p := range resource.Entity.ServicePlans {
-		servicePlan := models.ServicePlanFields{}
-		servicePlan.Name = p.Entity.Name
-		servicePlan.Guid = p.Metadata.Guid
-		offering.Plans = append(offering.Plans, servicePlan)
+		offering.Plans = append(offering.Plans, p.ToFields())
 	}
 	return offering
 }
 
 type ServiceOfferingEntity struct {
-	Label            string
-	Version          string
-	Description      string
-	DocumentationUrl string `json:"documentation_url"`
-	Provider         string
-	ServicePlans     []ServicePlanResource `json:"service_plans"`
+	Label             string
+	Version           string
+	Description       string
+	DocumentationUrl  string `json:"documentation_url"`
+	Provider          string
+	ServiceBrokerGuid string                `json:"service_broker_guid"`
+	ServicePlans      []ServicePlanResource `json:"service_plans"`
 }
 
 type ServicePlanResource struct {
@@ -52,15 +52,55 @@ type ServicePlanResource struct {
 func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields) {
 	fields.Guid = resource.Metadata.Guid
 	fields.Name = resource.Entity.Name
+	fields.Description = resource.Entity.Description
+	fields.Free = resource.Entity.Free
+	fields.Public = resource.Entity.Public
+	fields.Extra = resource.Entity.ToExtra()
 	return
 }
 
 type ServicePlanEntity struct {
 	Name                string
+	Description         string
+	Free                bool
+	Public              bool
+	Extra               string
 	ServiceOfferingGuid string                  `json:"service_guid"`
 	ServiceOffering     ServiceOfferingResource `json:"service"`
 }
 
+// brokers are free to put anything in a plan's extra field, so
+// metadata that does not match the documented format is ignored
+func (entity ServicePlanEntity) ToExtra() (extra models.ServicePlanExtra) {
+	if entity.Extra == "" {
+		return
+	}
+
+	metadata := ServicePlanExtraMetadata{}
+	err := json.Unmarshal([]byte(entity.Extra), &metadata)
+	if err != nil {
+		return
+	}
+
+	extra.DisplayName = metadata.DisplayName
+	extra.Bullets = metadata.Bullets
+	for _, cost := range metadata.Costs {
+		extra.Costs = append(extra.Costs, models.ServicePlanCost{Amount: cost.Amount, Unit: cost.Unit})
+	}
+	return
+}
+
+type ServicePlanExtraMetadata struct {
+	DisplayName string                    `json:"displayName"`
+	Bullets     []string                  `json:"bullets"`
+	Costs       []ServicePlanCostMetadata `json:"costs"`
+}
+
+type ServicePlanCostMetadata struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
 type PaginatedServiceInstanceResources struct {
 	TotalResults int `json:"total_results"`
 	Resources    []ServiceInstanceResource
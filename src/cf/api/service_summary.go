@@ -75,6 +75,7 @@ type ServiceOfferingSummary struct {
 
 type ServiceSummaryRepository interface {
 	GetSummariesInCurrentSpace() (instances []models.ServiceInstance, apiErr error)
+	GetSummariesInSpace(spaceGuid string) (instances []models.ServiceInstance, apiErr error)
 }
 
 type CloudControllerServiceSummaryRepository struct {
@@ -89,7 +90,11 @@ func NewCloudControllerServiceSummaryRepository(config configuration.Reader, gat
 }
 
 func (repo CloudControllerServiceSummaryRepository) GetSummariesInCurrentSpace() (instances []models.ServiceInstance, apiErr error) {
-	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), repo.config.SpaceFields().Guid)
+	return repo.GetSummariesInSpace(repo.config.SpaceFields().Guid)
+}
+
+func (repo CloudControllerServiceSummaryRepository) GetSummariesInSpace(spaceGuid string) (instances []models.ServiceInstance, apiErr error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), spaceGuid)
 	resource := new(ServiceInstancesSummaries)
 
 	apiErr = repo.gateway.GetResource(path, repo.config.AccessToken(), resource)
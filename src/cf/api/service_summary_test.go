@@ -79,6 +79,25 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(instance1.ApplicationNames[0]).To(Equal("app1"))
 		Expect(instance1.ApplicationNames[1]).To(Equal("app2"))
 	})
+
+	It("gets the service instance summaries in a given space", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/spaces/other-space-guid/summary",
+			Response: serviceInstanceSummariesResponse,
+		})
+
+		ts, handler, repo := createServiceSummaryRepo(req)
+		defer ts.Close()
+
+		serviceInstances, apiErr := repo.GetSummariesInSpace("other-space-guid")
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(serviceInstances)).To(Equal(1))
+		Expect(serviceInstances[0].Name).To(Equal("my-service-instance"))
+		Expect(serviceInstances[0].IsUserProvided()).To(BeFalse())
+	})
 })
 
 func createServiceSummaryRepo(req testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo ServiceSummaryRepository) {
@@ -1,10 +1,12 @@
 package api
 
 import (
+	"bytes"
 	"cf/configuration"
 	"cf/errors"
 	"cf/models"
 	"cf/net"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -17,7 +19,8 @@ type ServiceRepository interface {
 	GetAllServiceOfferings() (offerings models.ServiceOfferings, apiErr error)
 	GetServiceOfferingsForSpace(spaceGuid string) (offerings models.ServiceOfferings, apiErr error)
 	FindInstanceByName(name string) (instance models.ServiceInstance, apiErr error)
-	CreateServiceInstance(name, planGuid string) (identicalAlreadyExists bool, apiErr error)
+	CreateServiceInstance(name, planGuid string, params map[string]interface{}) (identicalAlreadyExists bool, apiErr error)
+	UpdateServiceInstance(instanceGuid, planGuid string, params map[string]interface{}) (apiErr error)
 	RenameService(instance models.ServiceInstance, newName string) (apiErr error)
 	DeleteService(instance models.ServiceInstance) (apiErr error)
 	FindServicePlanByDescription(planDescription ServicePlanDescription) (planGuid string, apiErr error)
@@ -122,14 +125,29 @@ func (repo CloudControllerServiceRepository) FindInstanceByName(name string) (in
 	return
 }
 
-func (repo CloudControllerServiceRepository) CreateServiceInstance(name, planGuid string) (identicalAlreadyExists bool, err error) {
+type ServiceInstanceRequest struct {
+	Name      string                 `json:"name,omitempty"`
+	PlanGuid  string                 `json:"service_plan_guid,omitempty"`
+	SpaceGuid string                 `json:"space_guid,omitempty"`
+	Params    map[string]interface{} `json:"parameters,omitempty"`
+	Async     bool                   `json:"async,omitempty"`
+}
+
+func (repo CloudControllerServiceRepository) CreateServiceInstance(name, planGuid string, params map[string]interface{}) (identicalAlreadyExists bool, err error) {
 	path := fmt.Sprintf("%s/v2/service_instances", repo.config.ApiEndpoint())
-	data := fmt.Sprintf(
-		`{"name":"%s","service_plan_guid":"%s","space_guid":"%s", "async": true}`,
-		name, planGuid, repo.config.SpaceFields().Guid,
-	)
+	data, err := json.Marshal(ServiceInstanceRequest{
+		Name:      name,
+		PlanGuid:  planGuid,
+		SpaceGuid: repo.config.SpaceFields().Guid,
+		Params:    params,
+		Async:     true,
+	})
+	if err != nil {
+		err = errors.NewWithError("Could not serialize information", err)
+		return
+	}
 
-	err = repo.gateway.CreateResource(path, repo.config.AccessToken(), strings.NewReader(data))
+	err = repo.gateway.CreateResource(path, repo.config.AccessToken(), bytes.NewReader(data))
 
 	if httpErr, ok := err.(errors.HttpError); ok && httpErr.ErrorCode() == errors.SERVICE_INSTANCE_NAME_TAKEN {
 		serviceInstance, findInstanceErr := repo.FindInstanceByName(name)
@@ -143,6 +161,21 @@ func (repo CloudControllerServiceRepository) CreateServiceInstance(name, planGui
 	return
 }
 
+// the cloud controller gateway polls the job of the update until it finishes
+func (repo CloudControllerServiceRepository) UpdateServiceInstance(instanceGuid, planGuid string, params map[string]interface{}) (apiErr error) {
+	path := fmt.Sprintf("%s/v2/service_instances/%s", repo.config.ApiEndpoint(), instanceGuid)
+	data, err := json.Marshal(ServiceInstanceRequest{
+		PlanGuid: planGuid,
+		Params:   params,
+	})
+	if err != nil {
+		apiErr = errors.NewWithError("Could not serialize information", err)
+		return
+	}
+
+	return repo.gateway.UpdateResourceForResponse(path, repo.config.AccessToken(), bytes.NewReader(data), new(ServiceInstanceResource))
+}
+
 func (repo CloudControllerServiceRepository) RenameService(instance models.ServiceInstance, newName string) (apiErr error) {
 	body := fmt.Sprintf(`{"name":"%s"}`, newName)
 	path := fmt.Sprintf("%s/v2/service_instances/%s", repo.config.ApiEndpoint(), instance.Guid)
@@ -71,7 +71,7 @@ var _ = Describe("Services Repo", func() {
 			testServer, handler, repo := createServiceRepo([]testnet.TestRequest{req})
 			defer testServer.Close()
 
-			identicalAlreadyExists, apiErr := repo.CreateServiceInstance("instance-name", "plan-guid")
+			identicalAlreadyExists, apiErr := repo.CreateServiceInstance("instance-name", "plan-guid", nil)
 			Expect(handler).To(testnet.HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
 			Expect(identicalAlreadyExists).To(Equal(false))
@@ -90,7 +90,7 @@ var _ = Describe("Services Repo", func() {
 			testServer, handler, repo := createServiceRepo([]testnet.TestRequest{errorReq, findServiceInstanceReq, serviceOfferingReq})
 			defer testServer.Close()
 
-			identicalAlreadyExists, apiErr := repo.CreateServiceInstance("my-service", "plan-guid")
+			identicalAlreadyExists, apiErr := repo.CreateServiceInstance("my-service", "plan-guid", nil)
 
 			Expect(handler).To(testnet.HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
@@ -110,7 +110,7 @@ var _ = Describe("Services Repo", func() {
 			testServer, handler, repo := createServiceRepo([]testnet.TestRequest{errorReq, findServiceInstanceReq, serviceOfferingReq})
 			defer testServer.Close()
 
-			identicalAlreadyExists, apiErr := repo.CreateServiceInstance("my-service", "different-plan-guid")
+			identicalAlreadyExists, apiErr := repo.CreateServiceInstance("my-service", "different-plan-guid", nil)
 
 			Expect(handler).To(testnet.HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(BeNil())
@@ -118,6 +118,75 @@ var _ = Describe("Services Repo", func() {
 		})
 	})
 
+	Describe("updating a service instance", func() {
+		It("changes the plan and waits for the job to finish", func() {
+			updateReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:  "PUT",
+				Path:    "/v2/service_instances/instance-guid?async=true",
+				Matcher: testnet.RequestBodyMatcher(`{"service_plan_guid":"new-plan-guid","parameters":{"ram_gb":8}}`),
+				Response: testnet.TestResponse{
+					Status: http.StatusCreated,
+					Body:   `{"metadata": {"guid": "instance-guid", "url": "/v2/jobs/my-job-guid"}}`,
+				}})
+
+			jobReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/jobs/my-job-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusCreated,
+					Body:   `{"entity": {"status": "finished"}}`,
+				}})
+
+			testServer, handler, repo := createServiceRepo([]testnet.TestRequest{updateReq, jobReq})
+			defer testServer.Close()
+
+			apiErr := repo.UpdateServiceInstance("instance-guid", "new-plan-guid", map[string]interface{}{"ram_gb": 8})
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
+
+		It("only sends the parameters when the plan does not change", func() {
+			req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_instances/instance-guid",
+				Matcher:  testnet.RequestBodyMatcher(`{"parameters":{"ram_gb":8}}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			})
+
+			testServer, handler, repo := createServiceRepo([]testnet.TestRequest{req})
+			defer testServer.Close()
+
+			apiErr := repo.UpdateServiceInstance("instance-guid", "", map[string]interface{}{"ram_gb": 8})
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
+
+		It("returns an error when the job fails", func() {
+			updateReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "PUT",
+				Path:   "/v2/service_instances/instance-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusCreated,
+					Body:   `{"metadata": {"guid": "instance-guid", "url": "/v2/jobs/my-job-guid"}}`,
+				}})
+
+			jobReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/jobs/my-job-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusCreated,
+					Body:   `{"entity": {"status": "failed"}}`,
+				}})
+
+			testServer, handler, repo := createServiceRepo([]testnet.TestRequest{updateReq, jobReq})
+			defer testServer.Close()
+
+			apiErr := repo.UpdateServiceInstance("instance-guid", "new-plan-guid", nil)
+			Expect(handler).To(testnet.HaveAllRequestsCalled())
+			Expect(apiErr).To(HaveOccurred())
+		})
+	})
+
 	Describe("finding service instances by name", func() {
 		It("returns the service instance", func() {
 			testServer, handler, repo := createServiceRepo([]testnet.TestRequest{findServiceInstanceReq, serviceOfferingReq})
@@ -760,10 +829,17 @@ var multipleOfferingsResponse = testnet.TestResponse{Status: http.StatusOK, Body
         "provider": "Offering 1 provider",
         "description": "Offering 1 description",
         "version" : "1.0",
+        "service_broker_guid": "offering-1-broker-guid",
         "service_plans": [
             {
                 "metadata": {"guid": "offering-1-plan-1-guid"},
-                "entity": {"name": "Offering 1 Plan 1"}
+                "entity": {
+                    "name": "Offering 1 Plan 1",
+                    "description": "Offering 1 Plan 1 description",
+                    "free": false,
+                    "public": true,
+                    "extra": "{\"displayName\":\"Plan One\",\"bullets\":[\"10 connections\",\"1 GB storage\"],\"costs\":[{\"amount\":{\"usd\":9.99},\"unit\":\"MONTHLY\"}]}"
+                }
             },
             {
                 "metadata": {"guid": "offering-1-plan-2-guid"},
@@ -803,9 +879,22 @@ func expectMultipleServiceOfferings(offerings []models.ServiceOffering) {
 	Expect(firstOffering.Guid).To(Equal("offering-1-guid"))
 	Expect(len(firstOffering.Plans)).To(Equal(2))
 
+	Expect(firstOffering.BrokerGuid).To(Equal("offering-1-broker-guid"))
+
 	plan := firstOffering.Plans[0]
 	Expect(plan.Name).To(Equal("Offering 1 Plan 1"))
 	Expect(plan.Guid).To(Equal("offering-1-plan-1-guid"))
+	Expect(plan.Description).To(Equal("Offering 1 Plan 1 description"))
+	Expect(plan.Free).To(BeFalse())
+	Expect(plan.Public).To(BeTrue())
+	Expect(plan.Extra).To(Equal(models.ServicePlanExtra{
+		DisplayName: "Plan One",
+		Bullets:     []string{"10 connections", "1 GB storage"},
+		Costs: []models.ServicePlanCost{
+			models.ServicePlanCost{Amount: map[string]float64{"usd": 9.99}, Unit: "MONTHLY"},
+		},
+	}))
+	Expect(firstOffering.Plans[1].Extra).To(Equal(models.ServicePlanExtra{}))
 
 	secondOffering := offerings[1]
 	Expect(secondOffering.Label).To(Equal("Offering 2"))
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"cf/configuration"
+	"cf/models"
+	"cf/net"
+	"fmt"
+)
+
+type SpaceUsageSummary struct {
+	Apps     []ApplicationFromSummary
+	Services []ServiceInstanceSummary
+}
+
+type TotalResults struct {
+	TotalResults int `json:"total_results"`
+}
+
+type SpaceUsageRepository interface {
+	GetUsage(space models.SpaceFields) (usage models.SpaceUsage, apiErr error)
+}
+
+type CloudControllerSpaceUsageRepository struct {
+	config  configuration.Reader
+	gateway net.Gateway
+}
+
+func NewCloudControllerSpaceUsageRepository(config configuration.Reader, gateway net.Gateway) (repo CloudControllerSpaceUsageRepository) {
+	repo.config = config
+	repo.gateway = gateway
+	return
+}
+
+func (repo CloudControllerSpaceUsageRepository) GetUsage(space models.SpaceFields) (usage models.SpaceUsage, apiErr error) {
+	usage.Space = space
+
+	summary := new(SpaceUsageSummary)
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), space.Guid)
+	apiErr = repo.gateway.GetResource(path, repo.config.AccessToken(), summary)
+	if apiErr != nil {
+		return
+	}
+
+	for _, resource := range summary.Apps {
+		app := resource.ToFields()
+		usage.AppCount++
+		usage.DiskQuota += app.DiskQuota * uint64(app.InstanceCount)
+		if app.State == "started" {
+			usage.Memory += app.Memory * uint64(app.InstanceCount)
+		}
+	}
+	usage.ServiceCount = len(summary.Services)
+
+	// the summary only lists routes bound to apps, so ask for the total count instead
+	routes := new(TotalResults)
+	path = fmt.Sprintf("%s/v2/spaces/%s/routes?results-per-page=1", repo.config.ApiEndpoint(), space.Guid)
+	apiErr = repo.gateway.GetResource(path, repo.config.AccessToken(), routes)
+	if apiErr != nil {
+		return
+	}
+	usage.RouteCount = routes.TotalResults
+	return
+}
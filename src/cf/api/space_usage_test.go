@@ -0,0 +1,98 @@
+package api_test
+
+import (
+	. "cf/api"
+	"cf/models"
+	"cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	testapi "testhelpers/api"
+	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
+)
+
+var _ = Describe("SpaceUsageRepository", func() {
+	var space models.SpaceFields
+
+	BeforeEach(func() {
+		space = models.SpaceFields{Guid: "space-guid", Name: "my-space"}
+	})
+
+	It("sums the apps, service instances and routes of a space", func() {
+		ts, handler, repo := createSpaceUsageRepo([]testnet.TestRequest{
+			testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/spaces/space-guid/summary",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: spaceUsageSummaryResponse},
+			}),
+			testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/spaces/space-guid/routes?results-per-page=1",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"total_results": 7, "resources": []}`},
+			}),
+		})
+		defer ts.Close()
+
+		usage, apiErr := repo.GetUsage(space)
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+
+		Expect(usage.Space).To(Equal(space))
+		Expect(usage.AppCount).To(Equal(2))
+		Expect(usage.Memory).To(Equal(uint64(256 * 3)))
+		Expect(usage.DiskQuota).To(Equal(uint64(1024*3 + 512)))
+		Expect(usage.ServiceCount).To(Equal(2))
+		Expect(usage.RouteCount).To(Equal(7))
+	})
+
+	It("returns an error when the summary cannot be fetched", func() {
+		ts, _, repo := createSpaceUsageRepo([]testnet.TestRequest{
+			testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/spaces/space-guid/summary",
+				Response: testnet.TestResponse{Status: http.StatusInternalServerError},
+			}),
+		})
+		defer ts.Close()
+
+		_, apiErr := repo.GetUsage(space)
+		Expect(apiErr).To(HaveOccurred())
+	})
+})
+
+var spaceUsageSummaryResponse = `
+{
+  "apps": [
+    {
+      "guid": "app-1-guid",
+      "name": "app1",
+      "memory": 256,
+      "instances": 3,
+      "disk_quota": 1024,
+      "state": "STARTED"
+    },
+    {
+      "guid": "app-2-guid",
+      "name": "app2",
+      "memory": 1024,
+      "instances": 1,
+      "disk_quota": 512,
+      "state": "STOPPED"
+    }
+  ],
+  "services": [
+    {"guid": "service-1-guid", "name": "my-db"},
+    {"guid": "service-2-guid", "name": "my-cache"}
+  ]
+}`
+
+func createSpaceUsageRepo(requests []testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo SpaceUsageRepository) {
+	ts, handler = testnet.NewServer(requests)
+	configRepo := testconfig.NewRepositoryWithDefaults()
+	configRepo.SetApiEndpoint(ts.URL)
+	gateway := net.NewCloudControllerGateway(configRepo)
+	repo = NewCloudControllerSpaceUsageRepository(configRepo, gateway)
+	return
+}
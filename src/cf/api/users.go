@@ -34,6 +34,65 @@ type UserEntity struct {
 	Admin bool
 }
 
+type UserSummaryResource struct {
+	Entity UserSummaryEntity
+}
+
+type UserSummaryEntity struct {
+	Organizations               []OrganizationResource
+	ManagedOrganizations        []OrganizationResource `json:"managed_organizations"`
+	BillingManagedOrganizations []OrganizationResource `json:"billing_managed_organizations"`
+	AuditedOrganizations        []OrganizationResource `json:"audited_organizations"`
+	Spaces                      []SpaceResource
+	ManagedSpaces               []SpaceResource `json:"managed_spaces"`
+	AuditedSpaces               []SpaceResource `json:"audited_spaces"`
+}
+
+func (resource UserSummaryResource) ToModels() (roles []models.UserRole) {
+	// spaces only reference their org when it is inlined, so fall back
+	// to the spaces listed under the orgs the user belongs to
+	orgsBySpaceGuid := map[string]models.OrganizationFields{}
+	for _, org := range resource.Entity.Organizations {
+		for _, space := range org.Entity.Spaces {
+			orgsBySpaceGuid[space.Metadata.Guid] = org.ToFields()
+		}
+	}
+
+	orgRoles := []struct {
+		role string
+		orgs []OrganizationResource
+	}{
+		{models.ORG_USER, resource.Entity.Organizations},
+		{models.ORG_MANAGER, resource.Entity.ManagedOrganizations},
+		{models.BILLING_MANAGER, resource.Entity.BillingManagedOrganizations},
+		{models.ORG_AUDITOR, resource.Entity.AuditedOrganizations},
+	}
+	for _, orgRole := range orgRoles {
+		for _, org := range orgRole.orgs {
+			roles = append(roles, models.UserRole{Organization: org.ToFields(), Role: orgRole.role})
+		}
+	}
+
+	spaceRoles := []struct {
+		role   string
+		spaces []SpaceResource
+	}{
+		{models.SPACE_DEVELOPER, resource.Entity.Spaces},
+		{models.SPACE_MANAGER, resource.Entity.ManagedSpaces},
+		{models.SPACE_AUDITOR, resource.Entity.AuditedSpaces},
+	}
+	for _, spaceRole := range spaceRoles {
+		for _, space := range spaceRole.spaces {
+			org := space.Entity.Organization.ToFields()
+			if org.Name == "" {
+				org = orgsBySpaceGuid[space.Metadata.Guid]
+			}
+			roles = append(roles, models.UserRole{Organization: org, Space: space.ToFields(), Role: spaceRole.role})
+		}
+	}
+	return
+}
+
 var orgRoleToPathMap = map[string]string{
 	models.ORG_USER:        "users",
 	models.ORG_MANAGER:     "managers",
@@ -57,6 +116,7 @@ type UserRepository interface {
 	UnsetOrgRole(userGuid, orgGuid, role string) (apiErr error)
 	SetSpaceRole(userGuid, spaceGuid, orgGuid, role string) (apiErr error)
 	UnsetSpaceRole(userGuid, spaceGuid, role string) (apiErr error)
+	ListRolesForUser(userGuid string) (roles []models.UserRole, apiErr error)
 }
 
 type CloudControllerUserRepository struct {
@@ -266,6 +326,18 @@ func (repo CloudControllerUserRepository) UnsetSpaceRole(userGuid, spaceGuid, ro
 	return repo.ccGateway.DeleteResource(rolePath, repo.config.AccessToken())
 }
 
+func (repo CloudControllerUserRepository) ListRolesForUser(userGuid string) (roles []models.UserRole, apiErr error) {
+	path := fmt.Sprintf("%s/v2/users/%s/summary", repo.config.ApiEndpoint(), userGuid)
+	summary := new(UserSummaryResource)
+	apiErr = repo.ccGateway.GetResource(path, repo.config.AccessToken(), summary)
+	if apiErr != nil {
+		return
+	}
+
+	roles = summary.ToModels()
+	return
+}
+
 func (repo CloudControllerUserRepository) checkSpaceRole(userGuid, spaceGuid, role string) (fullPath string, apiErr error) {
 	rolePath, found := spaceRoleToPathMap[role]
 
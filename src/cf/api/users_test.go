@@ -299,6 +299,64 @@ var _ = Describe("UserRepository", func() {
 		Expect(users[2].Guid).To(Equal("user-3-guid"))
 		Expect(users[2].Username).To(Equal("Super user 3"))
 	})
+
+	It("lists every org and space role of a user from the user summary", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/users/my-user-guid/summary",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body: `
+				{
+					"metadata": {"guid": "my-user-guid"},
+					"entity": {
+						"organizations": [
+							{
+								"metadata": {"guid": "org-1-guid"},
+								"entity": {
+									"name": "org-1",
+									"spaces": [{"metadata": {"guid": "space-1-guid"}, "entity": {"name": "space-1"}}]
+								}
+							}
+						],
+						"managed_organizations": [
+							{"metadata": {"guid": "org-1-guid"}, "entity": {"name": "org-1"}}
+						],
+						"billing_managed_organizations": [],
+						"audited_organizations": [],
+						"spaces": [
+							{"metadata": {"guid": "space-1-guid"}, "entity": {"name": "space-1"}}
+						],
+						"managed_spaces": [],
+						"audited_spaces": [
+							{
+								"metadata": {"guid": "space-2-guid"},
+								"entity": {
+									"name": "space-2",
+									"organization": {"metadata": {"guid": "org-2-guid"}, "entity": {"name": "org-2"}}
+								}
+							}
+						]
+					}
+				}`}})
+
+		cc, handler, repo := createUsersRepoWithoutUAAEndpoints([]testnet.TestRequest{req})
+		defer cc.Close()
+
+		roles, apiErr := repo.ListRolesForUser("my-user-guid")
+
+		Expect(handler).To(testnet.HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+
+		org1 := models.OrganizationFields{Guid: "org-1-guid", Name: "org-1"}
+		org2 := models.OrganizationFields{Guid: "org-2-guid", Name: "org-2"}
+		Expect(roles).To(Equal([]models.UserRole{
+			{Organization: org1, Role: models.ORG_USER},
+			{Organization: org1, Role: models.ORG_MANAGER},
+			{Organization: org1, Space: models.SpaceFields{Guid: "space-1-guid", Name: "space-1"}, Role: models.SPACE_DEVELOPER},
+			{Organization: org2, Space: models.SpaceFields{Guid: "space-2-guid", Name: "space-2"}, Role: models.SPACE_AUDITOR},
+		}))
+	})
 })
 
 func createUsersByRoleEndpoints(rolePath string) (ccReqs []testnet.TestRequest, uaaReqs []testnet.TestRequest) {
@@ -3,6 +3,7 @@ package app
 import (
 	"cf"
 	"cf/commands"
+	"cf/i18n"
 	"cf/terminal"
 	"cf/trace"
 	"fmt"
@@ -13,7 +14,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 	helpCommand := cli.Command{
 		Name:        "help",
 		ShortName:   "h",
-		Description: "Show help",
+		Description: i18n.T("Show help"),
 		Usage:       fmt.Sprintf("%s help [COMMAND]", cf.Name()),
 		Action: func(c *cli.Context) {
 			args := c.Args()
@@ -30,26 +31,42 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 	trace.Logger.Printf("\n%s\n%s\n\n", terminal.HeaderColor("VERSION:"), cf.Version)
 
 	app = cli.NewApp()
-	app.Usage = cf.Usage
+	app.Usage = i18n.T("A command line tool to interact with Cloud Foundry")
 	app.Version = cf.Version
 	app.Action = helpCommand.Action
+	app.Flags = []cli.Flag{
+		cli.BoolFlag{Name: "dry-run", Usage: i18n.T("Print the requests that would change anything instead of sending them")},
+		cli.BoolFlag{Name: "non-interactive", Usage: i18n.T("Fail instead of prompting for input")},
+	}
 	app.Commands = []cli.Command{
 		helpCommand,
 		{
 			Name:        "api",
-			Description: "Set or view target api url",
-			Usage:       fmt.Sprintf("%s api [URL]", cf.Name()),
+			Description: i18n.T("Set or view target api url"),
+			Usage: fmt.Sprintf("%s api [URL] [--ca-cert PATH] [--client-cert PATH --client-key PATH]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s api https://api.example.com --ca-cert internal-ca.pem (%s)", cf.Name(), i18n.T("trust the certificates signed by an internal CA")),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("api", c)
 			},
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "skip-ssl-validation", Usage: "Please don't"},
+				NewStringFlag("ca-cert", i18n.T("Trust the PEM encoded CA certificates in this file, defaults to CF_CA_CERT")),
+				NewStringFlag("client-cert", i18n.T("PEM encoded client certificate, for endpoints requiring mutual TLS")),
+				NewStringFlag("client-key", i18n.T("PEM encoded private key of the client certificate")),
+				cli.BoolFlag{Name: "skip-ssl-validation", Usage: i18n.T("Please don't")},
 			},
 		},
 		{
 			Name:        "app",
-			Description: "Display health and status for app",
-			Usage:       fmt.Sprintf("%s app APP", cf.Name()),
+			Description: i18n.T("Display health and status for app"),
+			Usage: fmt.Sprintf("%s app APP [--watch] [--sort KEY] [--interval SECONDS]\n\n", cf.Name()) +
+				i18n.T("SORT KEYS:") + "\n" +
+				"   index, state, cpu, memory, disk",
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "watch", Usage: i18n.T("Refresh the instance list until interrupted")},
+				NewStringFlag("sort", i18n.T("Sort instances by the given key when watching")),
+				NewIntFlag("interval", i18n.T("Seconds between refreshes when watching (defaults to 5)")),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("app", c)
 			},
@@ -57,7 +74,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "apps",
 			ShortName:   "a",
-			Description: "List all apps in the target space",
+			Description: i18n.T("List all apps in the target space"),
 			Usage:       fmt.Sprintf("%s apps", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("apps", c)
@@ -65,12 +82,17 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "auth",
-			Description: "Authenticate user non-interactively",
-			Usage: fmt.Sprintf("%s auth USERNAME PASSWORD\n\n", cf.Name()) +
-				terminal.WarningColor("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\n") +
-				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s auth name@example.com \"my password\" (use quotes for passwords with a space)\n", cf.Name()) +
-				fmt.Sprintf("   %s auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)", cf.Name()),
+			Description: i18n.T("Authenticate user non-interactively"),
+			Usage: fmt.Sprintf("%s auth USERNAME PASSWORD\n", cf.Name()) +
+				fmt.Sprintf("   %s auth --client-credentials CLIENT_ID CLIENT_SECRET\n\n", cf.Name()) +
+				terminal.WarningColor(i18n.T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")+"\n\n") +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s auth name@example.com \"my password\" (%s)\n", cf.Name(), i18n.T("use quotes for passwords with a space")) +
+				fmt.Sprintf("   %s auth name@example.com \"\\\"password\\\"\" (%s)\n", cf.Name(), i18n.T("escape quotes if used in password")) +
+				fmt.Sprintf("   %s auth --client-credentials my-ci-client s3cr3t (%s)", cf.Name(), i18n.T("authenticate a service account as an OAuth client")),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "client-credentials", Usage: i18n.T("Authenticate as an OAuth client, with its ID and secret, instead of a user")},
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("auth", c)
 			},
@@ -78,7 +100,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "bind-service",
 			ShortName:   "bs",
-			Description: "Bind a service instance to an app",
+			Description: i18n.T("Bind a service instance to an app"),
 			Usage:       fmt.Sprintf("%s bind-service APP SERVICE_INSTANCE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("bind-service", c)
@@ -86,21 +108,31 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "buildpacks",
-			Description: "List all buildpacks",
+			Description: i18n.T("List all buildpacks"),
 			Usage:       fmt.Sprintf("%s buildpacks", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("buildpacks", c)
 			},
 		},
+		{
+			Name:        "check-route",
+			Description: i18n.T("Check whether a route exists and whether it can be used in the targeted space"),
+			Usage: fmt.Sprintf("%s check-route HOST DOMAIN\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s check-route my-app example.com", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("check-route", c)
+			},
+		},
 		{
 			Name:        "create-buildpack",
-			Description: "Create a buildpack",
+			Description: i18n.T("Create a buildpack"),
 			Usage: fmt.Sprintf("%s create-buildpack BUILDPACK PATH POSITION [--enable|--disable]", cf.Name()) +
-				"\n\nTIP:\n" +
-				"   Path should be a zip file, a url to a zip file, or a local directory. Position is an integer, sets priority, and is sorted from lowest to highest.",
+				"\n\n" + i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("Path should be a zip file, a url to a zip file, or a local directory. Position is an integer, sets priority, and is sorted from lowest to highest."),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "enable", Usage: "Enable the buildpack"},
-				cli.BoolFlag{Name: "disable", Usage: "Disable the buildpack"},
+				cli.BoolFlag{Name: "enable", Usage: i18n.T("Enable the buildpack")},
+				cli.BoolFlag{Name: "disable", Usage: i18n.T("Disable the buildpack")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-buildpack", c)
@@ -108,7 +140,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "create-domain",
-			Description: "Create a domain in an org for later use",
+			Description: i18n.T("Create a domain in an org for later use"),
 			Usage:       fmt.Sprintf("%s create-domain ORG DOMAIN", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-domain", c)
@@ -117,18 +149,33 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "create-org",
 			ShortName:   "co",
-			Description: "Create an org",
+			Description: i18n.T("Create an org"),
 			Usage:       fmt.Sprintf("%s create-org ORG", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-org", c)
 			},
 		},
+		{
+			Name:        "create-quota",
+			Description: i18n.T("Define a new resource quota"),
+			Usage:       fmt.Sprintf("%s create-quota QUOTA [-m TOTAL_MEMORY] [-i INSTANCE_MEMORY] [-r ROUTES] [-s SERVICE_INSTANCES] [--allow-paid-service-plans]", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("m", i18n.T("Total amount of memory (e.g. 1024M, 1G, 10G)")),
+				NewStringFlag("i", i18n.T("Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G), -1 means unlimited")),
+				NewIntFlag("r", i18n.T("Total number of routes, -1 means unlimited")),
+				NewIntFlag("s", i18n.T("Total number of service instances, -1 means unlimited")),
+				cli.BoolFlag{Name: "allow-paid-service-plans", Usage: i18n.T("Can provision instances of paid service plans")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("create-quota", c)
+			},
+		},
 		{
 			Name:        "create-route",
-			Description: "Create a url route in a space for later use",
+			Description: i18n.T("Create a url route in a space for later use"),
 			Usage:       fmt.Sprintf("%s create-route SPACE DOMAIN [-n HOSTNAME]", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("n", "Hostname"),
+				NewStringFlag("n", i18n.T("Hostname")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-route", c)
@@ -137,19 +184,24 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "create-service",
 			ShortName:   "cs",
-			Description: "Create a service instance",
-			Usage: fmt.Sprintf("%s create-service SERVICE PLAN SERVICE_INSTANCE\n\n", cf.Name()) +
-				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s create-service cleardb spark clear-db-mine\n\n", cf.Name()) +
-				"TIP:\n" +
-				"   Use '" + cf.Name() + " create-user-provided-service' to make user-provided services available to cf apps",
+			Description: i18n.T("Create a service instance"),
+			Usage: fmt.Sprintf("%s create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s create-service cleardb spark clear-db-mine\n", cf.Name()) +
+				fmt.Sprintf("   %s create-service db-service silver mydb -c '{\"ram_gb\":4}'\n", cf.Name()) +
+				fmt.Sprintf("   %s create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json\n\n", cf.Name()) +
+				i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("Use '%s' to make user-provided services available to cf apps", cf.Name()+" create-user-provided-service"),
+			Flags: []cli.Flag{
+				NewStringFlag("c", i18n.T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file")),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-service", c)
 			},
 		},
 		{
 			Name:        "create-service-auth-token",
-			Description: "Create a service auth token",
+			Description: i18n.T("Create a service auth token"),
 			Usage:       fmt.Sprintf("%s create-service-auth-token LABEL PROVIDER TOKEN", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-service-auth-token", c)
@@ -157,7 +209,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "create-service-broker",
-			Description: "Create a service broker",
+			Description: i18n.T("Create a service broker"),
 			Usage:       fmt.Sprintf("%s create-service-broker SERVICE_BROKER USERNAME PASSWORD URL", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-service-broker", c)
@@ -165,10 +217,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "create-space",
-			Description: "Create a space",
+			Description: i18n.T("Create a space"),
 			Usage:       fmt.Sprintf("%s create-space SPACE [-o ORG]", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("o", "Organization"),
+				NewStringFlag("o", i18n.T("Organization")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-space", c)
@@ -176,7 +228,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "create-user",
-			Description: "Create a new user",
+			Description: i18n.T("Create a new user"),
 			Usage:       fmt.Sprintf("%s create-user USERNAME PASSWORD", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-user", c)
@@ -185,19 +237,19 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "create-user-provided-service",
 			ShortName:   "cups",
-			Description: "Make a user-provided service available to cf apps",
+			Description: i18n.T("Make a user-provided service available to cf apps"),
 			Usage: fmt.Sprintf("%s create-user-provided-service SERVICE_INSTANCE [-p PARAMETERS] [-l SYSLOG-DRAIN-URL]\n", cf.Name()) +
-				"\n   Pass comma separated parameter names to enable interactive mode:\n" +
+				"\n   " + i18n.T("Pass comma separated parameter names to enable interactive mode:") + "\n" +
 				fmt.Sprintf("   %s create-user-provided-service SERVICE_INSTANCE -p \"comma, separated, parameter, names\"\n", cf.Name()) +
-				"\n   Pass parameters as JSON to create a service non-interactively:\n" +
+				"\n   " + i18n.T("Pass parameters as JSON to create a service non-interactively:") + "\n" +
 				fmt.Sprintf("   %s create-user-provided-service SERVICE_INSTANCE -p '{\"name\":\"value\",\"name\":\"value\"}'\n", cf.Name()) +
-				"\nEXAMPLE:\n" +
+				"\n" + i18n.T("EXAMPLE:") + "\n" +
 				fmt.Sprintf("   %s create-user-provided-service oracle-db-mine -p \"host, port, dbname, username, password\"\n", cf.Name()) +
 				fmt.Sprintf("   %s create-user-provided-service oracle-db-mine -p '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'\n", cf.Name()) +
 				fmt.Sprintf("   %s create-user-provided-service my-drain-service -l syslog://example.com\n", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("p", "Parameters"),
-				NewStringFlag("l", "Syslog Drain Url"),
+				NewStringFlag("p", i18n.T("Parameters")),
+				NewStringFlag("l", i18n.T("Syslog Drain Url")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-user-provided-service", c)
@@ -205,14 +257,14 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "curl",
-			Description: "Executes a raw request, content-type set to application/json by default",
+			Description: i18n.T("Executes a raw request, content-type set to application/json by default"),
 			Usage:       fmt.Sprintf("%s curl PATH [-X METHOD] [-H HEADER] [-d DATA] [-i]", cf.Name()),
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: "X", Value: "GET", Usage: "HTTP method (GET,POST,PUT,DELETE,etc)"},
-				NewStringSliceFlag("H", "Custom headers to include in the request, flag can be specified multiple times"),
-				NewStringFlag("d", "HTTP data to include in the request body"),
-				cli.BoolFlag{Name: "i", Usage: "Include response headers in the output"},
-				cli.BoolFlag{Name: "v", Usage: "Enable CF_TRACE output for all requests and responses"},
+				NewStringSliceFlag("H", i18n.T("Custom headers to include in the request, flag can be specified multiple times")),
+				NewStringFlag("d", i18n.T("HTTP data to include in the request body")),
+				cli.BoolFlag{Name: "i", Usage: i18n.T("Include response headers in the output")},
+				cli.BoolFlag{Name: "v", Usage: i18n.T("Enable CF_TRACE output for all requests and responses")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("curl", c)
@@ -221,10 +273,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "delete",
 			ShortName:   "d",
-			Description: "Delete an app",
+			Description: i18n.T("Delete an app"),
 			Usage:       fmt.Sprintf("%s delete APP [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete", c)
@@ -232,10 +284,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-buildpack",
-			Description: "Delete a buildpack",
+			Description: i18n.T("Delete a buildpack"),
 			Usage:       fmt.Sprintf("%s delete-buildpack BUILDPACK [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-buildpack", c)
@@ -243,10 +295,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-domain",
-			Description: "Delete a domain",
+			Description: i18n.T("Delete a domain"),
 			Usage:       fmt.Sprintf("%s delete-domain DOMAIN [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-domain", c)
@@ -254,10 +306,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-shared-domain",
-			Description: "Delete a shared domain",
+			Description: i18n.T("Delete a shared domain"),
 			Usage:       fmt.Sprintf("%s delete-shared-domain DOMAIN [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-shared-domain", c)
@@ -265,22 +317,45 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-org",
-			Description: "Delete an org",
-			Usage:       fmt.Sprintf("%s delete-org ORG [-f]", cf.Name()),
+			Description: i18n.T("Delete an org"),
+			Usage:       fmt.Sprintf("%s delete-org ORG [-f] [--dry-run]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
+				cli.BoolFlag{Name: "dry-run", Usage: i18n.T("List everything that would be deleted without deleting it, implied by the global --dry-run")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-org", c)
 			},
 		},
+		{
+			Name:        "delete-orphaned-routes",
+			Description: i18n.T("Delete the routes in the target space that are not mapped to any app"),
+			Usage:       fmt.Sprintf("%s delete-orphaned-routes [-f]", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("delete-orphaned-routes", c)
+			},
+		},
+		{
+			Name:        "delete-quota",
+			Description: i18n.T("Delete a quota"),
+			Usage:       fmt.Sprintf("%s delete-quota QUOTA [-f]", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("delete-quota", c)
+			},
+		},
 		{
 			Name:        "delete-route",
-			Description: "Delete a route",
+			Description: i18n.T("Delete a route"),
 			Usage:       fmt.Sprintf("%s delete-route DOMAIN [-n HOSTNAME] [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
-				NewStringFlag("n", "Hostname"),
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
+				NewStringFlag("n", i18n.T("Hostname")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-route", c)
@@ -289,10 +364,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "delete-service",
 			ShortName:   "ds",
-			Description: "Delete a service instance",
+			Description: i18n.T("Delete a service instance"),
 			Usage:       fmt.Sprintf("%s delete-service SERVICE_INSTANCE [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-service", c)
@@ -300,10 +375,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-service-auth-token",
-			Description: "Delete a service auth token",
+			Description: i18n.T("Delete a service auth token"),
 			Usage:       fmt.Sprintf("%s delete-service-auth-token LABEL PROVIDER [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-service-auth-token", c)
@@ -311,10 +386,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-service-broker",
-			Description: "Delete a service broker",
+			Description: i18n.T("Delete a service broker"),
 			Usage:       fmt.Sprintf("%s delete-service-broker SERVICE_BROKER [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-service-broker", c)
@@ -322,10 +397,11 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-space",
-			Description: "Delete a space",
-			Usage:       fmt.Sprintf("%s delete-space SPACE [-f]", cf.Name()),
+			Description: i18n.T("Delete a space"),
+			Usage:       fmt.Sprintf("%s delete-space SPACE [-f] [--dry-run]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
+				cli.BoolFlag{Name: "dry-run", Usage: i18n.T("List everything that would be deleted without deleting it, implied by the global --dry-run")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-space", c)
@@ -333,18 +409,48 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "delete-user",
-			Description: "Delete a user",
+			Description: i18n.T("Delete a user"),
 			Usage:       fmt.Sprintf("%s delete-user USERNAME [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("delete-user", c)
 			},
 		},
+		{
+			Name:        "disable-service-access",
+			Description: i18n.T("Disable access to a service or service plan for one or all orgs"),
+			Usage: fmt.Sprintf("%s disable-service-access SERVICE [-p PLAN] [-o ORG]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s disable-service-access cleardb\n", cf.Name()) +
+				fmt.Sprintf("   %s disable-service-access cleardb -p boost -o my-org", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("p", i18n.T("Disable access to a particular service plan")),
+				NewStringFlag("o", i18n.T("Disable access for a particular organization")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("disable-service-access", c)
+			},
+		},
+		{
+			Name:        "enable-service-access",
+			Description: i18n.T("Enable access to a service or service plan for one or all orgs"),
+			Usage: fmt.Sprintf("%s enable-service-access SERVICE [-p PLAN] [-o ORG]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s enable-service-access cleardb\n", cf.Name()) +
+				fmt.Sprintf("   %s enable-service-access cleardb -p boost -o my-org", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("p", i18n.T("Enable access to a particular service plan")),
+				NewStringFlag("o", i18n.T("Enable access for a particular organization")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("enable-service-access", c)
+			},
+		},
 		{
 			Name:        "domains",
-			Description: "List domains in the target org",
+			Description: i18n.T("List domains in the target org"),
 			Usage:       fmt.Sprintf("%s domains", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("domains", c)
@@ -353,7 +459,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "env",
 			ShortName:   "e",
-			Description: "Show all env variables for an app",
+			Description: i18n.T("Show all env variables for an app"),
 			Usage:       fmt.Sprintf("%s env APP", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("env", c)
@@ -361,41 +467,137 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "events",
-			Description: "Show recent app events",
-			Usage:       fmt.Sprintf("%s events APP", cf.Name()),
+			Description: i18n.T("Show recent app events"),
+			Usage: fmt.Sprintf("%s events (APP | --space | --org) [--type TYPE] [--actor USER] [--since DATE] [--until DATE] [--format csv|json]\n\n", cf.Name()) +
+				i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("Event types are crash, create, update and delete, several can be given separated by commas.") + "\n" +
+				"   " + i18n.T("Dates are given as YYYY-MM-DD or as YYYY-MM-DDTHH:MM:SS in local time.") + "\n\n" +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s events --org --type update,delete --since 2014-03-01 --until 2014-03-31 --format csv > march.csv", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "space", Usage: i18n.T("Show events for every app in the targeted space")},
+				cli.BoolFlag{Name: "org", Usage: i18n.T("Show events for every app in the targeted org")},
+				NewStringFlag("type", i18n.T("Only show events of the given types")),
+				NewStringFlag("actor", i18n.T("Only show events caused by the given user")),
+				NewStringFlag("since", i18n.T("Only show events from this date on")),
+				NewStringFlag("until", i18n.T("Only show events up to this date")),
+				NewStringFlag("format", i18n.T("Print events as csv or json instead of a table")),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("events", c)
 			},
 		},
+		{
+			Name:        "export-buildpacks",
+			Description: i18n.T("Save all buildpacks with their settings and bits to a directory"),
+			Usage: fmt.Sprintf("%s export-buildpacks DIR\n\n", cf.Name()) +
+				"   " + i18n.T("Writes a zip file for each buildpack and a buildpacks.json file with\n   their names, positions and enabled/locked flags.") + "\n\n" +
+				i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("Use '%s' to copy the buildpacks to another Cloud Foundry", cf.Name()+" import-buildpacks DIR"),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("export-buildpacks", c)
+			},
+		},
+		{
+			Name:        "export-users",
+			Description: i18n.T("Export the users of an org and their roles as CSV"),
+			Usage: fmt.Sprintf("%s export-users --org ORG\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s export-users --org my-org > users.csv", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("org", i18n.T("Org to export")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("export-users", c)
+			},
+		},
 		{
 			Name:        "files",
 			ShortName:   "f",
-			Description: "Print out a list of files in a directory or the contents of a specific file",
-			Usage:       fmt.Sprintf("%s files APP [PATH]", cf.Name()),
+			Description: i18n.T("Print out a list of files in a directory or the contents of a specific file"),
+			Usage: fmt.Sprintf("%s files APP [PATH] [--instance INDEX] [--all-instances | --download DIR | --tail]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s files my-app logs/ --instance 3 (%s)\n", cf.Name(), i18n.T("list the logs directory of the fourth instance")) +
+				fmt.Sprintf("   %s files my-app logs/ --download out/ (%s)\n", cf.Name(), i18n.T("recursively download the logs directory")) +
+				fmt.Sprintf("   %s files my-app logs/stdout.log --tail (%s)\n", cf.Name(), i18n.T("follow a log file as it grows")) +
+				fmt.Sprintf("   %s files my-app logs/stderr.log --all-instances (%s)", cf.Name(), i18n.T("show a file on every instance")),
+			Flags: []cli.Flag{
+				NewIntFlag("instance", i18n.T("Index of the app instance (defaults to 0)")),
+				cli.BoolFlag{Name: "all-instances", Usage: i18n.T("Show the path on every instance, prefixing each line with the instance index")},
+				NewStringFlag("download", i18n.T("Recursively download the path to a local directory")),
+				cli.BoolFlag{Name: "tail", Usage: i18n.T("Follow a file as it grows")},
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("files", c)
 			},
 		},
+		{
+			Name:        "history",
+			Description: i18n.T("Show the commands run from this machine"),
+			Usage: fmt.Sprintf("%s history [--since SINCE] [--failed]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s history --since 2h\n", cf.Name()) +
+				fmt.Sprintf("   %s history --since 2014-05-01 --failed", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("since", i18n.T("Only show commands started since a duration ago (e.g. 2h, 3d) or a date (e.g. 2014-05-01)")),
+				cli.BoolFlag{Name: "failed", Usage: i18n.T("Only show commands that failed")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("history", c)
+			},
+		},
+		{
+			Name:        "import-buildpacks",
+			Description: i18n.T("Create or update buildpacks from a directory written by export-buildpacks"),
+			Usage: fmt.Sprintf("%s import-buildpacks DIR\n\n", cf.Name()) +
+				"   " + i18n.T("Buildpacks that already exist are updated, others are created.") + "\n" +
+				"   " + i18n.T("Buildpacks that are not in DIR are left unchanged."),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("import-buildpacks", c)
+			},
+		},
+		{
+			Name:        "import-users",
+			Description: i18n.T("Create users and assign their org and space roles from a CSV file"),
+			Usage: fmt.Sprintf("%s import-users FILE [--dry-run]\n\n", cf.Name()) +
+				i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("Each row has the columns username,password,org,org_role,space,space_role.") + "\n" +
+				"   " + i18n.T("Users that already exist are not created again, rows for them can leave the password empty.") + "\n" +
+				"   " + i18n.T("Org roles are OrgUser, OrgManager, BillingManager and OrgAuditor.") + "\n" +
+				"   " + i18n.T("Space roles are SpaceManager, SpaceDeveloper and SpaceAuditor.") + "\n\n" +
+				i18n.T("EXAMPLE:") + "\n" +
+				"   username,password,org,org_role,space,space_role\n" +
+				"   alice@example.com,s3cret,my-org,OrgManager,,\n" +
+				"   alice@example.com,,my-org,,development,SpaceDeveloper",
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "dry-run", Usage: i18n.T("Check the file and show what would be done without making changes, implied by the global --dry-run")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("import-users", c)
+			},
+		},
 		{
 			Name:        "login",
 			ShortName:   "l",
-			Description: "Log user in",
-			Usage: fmt.Sprintf("%s login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE]\n\n", cf.Name()) +
-				terminal.WarningColor("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\n") +
-				"EXAMPLE:\n" +
-				fmt.Sprintf("   %s login (omit username and password to login interactively -- %s will prompt for both)\n", cf.Name(), cf.Name()) +
-				fmt.Sprintf("   %s login -u name@example.com -p pa55woRD (specify username and password as arguments)\n", cf.Name()) +
-				fmt.Sprintf("   %s login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n", cf.Name()) +
-				fmt.Sprintf("   %s login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)", cf.Name()),
+			Description: i18n.T("Log user in"),
+			Usage: fmt.Sprintf("%s login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso]\n\n", cf.Name()) +
+				terminal.WarningColor(i18n.T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")+"\n\n") +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s login (%s)\n", cf.Name(), i18n.T("omit username and password to login interactively -- %s will prompt for both", cf.Name())) +
+				fmt.Sprintf("   %s login -u name@example.com -p pa55woRD (%s)\n", cf.Name(), i18n.T("specify username and password as arguments")) +
+				fmt.Sprintf("   %s login -u name@example.com -p \"my password\" (%s)\n", cf.Name(), i18n.T("use quotes for passwords with a space")) +
+				fmt.Sprintf("   %s login -u name@example.com -p \"\\\"password\\\"\" (%s)\n", cf.Name(), i18n.T("escape quotes if used in password")) +
+				fmt.Sprintf("   %s login --sso (%s)", cf.Name(), i18n.T("log in with a one-time passcode from your single sign-on provider")),
 			Flags: []cli.Flag{
 				StringFlagWithNoDefault{cli.StringFlag{
 					Name: "a", Usage: "API endpoint (e.g. https://api.example.com)",
 				}},
-				NewStringFlag("u", "Username"),
-				NewStringFlag("p", "Password"),
-				NewStringFlag("o", "Org"),
-				NewStringFlag("s", "Space"),
-				cli.BoolFlag{Name: "skip-ssl-validation", Usage: "Please don't"},
+				NewStringFlag("u", i18n.T("Username")),
+				NewStringFlag("p", i18n.T("Password")),
+				NewStringFlag("o", i18n.T("Org")),
+				NewStringFlag("s", i18n.T("Space")),
+				cli.BoolFlag{Name: "skip-ssl-validation", Usage: i18n.T("Please don't")},
+				cli.BoolFlag{Name: "sso", Usage: i18n.T("Log in with a one-time passcode")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("login", c)
@@ -404,7 +606,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "logout",
 			ShortName:   "lo",
-			Description: "Log user out",
+			Description: i18n.T("Log user out"),
 			Usage:       fmt.Sprintf("%s logout", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("logout", c)
@@ -412,10 +614,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "logs",
-			Description: "Tail or show recent logs for an app",
+			Description: i18n.T("Tail or show recent logs for an app"),
 			Usage:       fmt.Sprintf("%s logs APP", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "recent", Usage: "Dump recent logs instead of tailing"},
+				cli.BoolFlag{Name: "recent", Usage: i18n.T("Dump recent logs instead of tailing")},
 			},
 
 			Action: func(c *cli.Context) {
@@ -425,26 +627,45 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "marketplace",
 			ShortName:   "m",
-			Description: "List available offerings in the marketplace",
-			Usage:       fmt.Sprintf("%s marketplace", cf.Name()),
+			Description: i18n.T("List available offerings in the marketplace"),
+			Usage: fmt.Sprintf("%s marketplace [-s SERVICE] [--search TERM] [--broker BROKER] [--provider PROVIDER]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s marketplace -s cleardb\n", cf.Name()) +
+				fmt.Sprintf("   %s marketplace --search redis", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("s", i18n.T("Show plan details for a particular service offering")),
+				NewStringFlag("search", i18n.T("Only show offerings whose name, description, provider or plans contain the term")),
+				NewStringFlag("broker", i18n.T("Only show offerings from the given service broker")),
+				NewStringFlag("provider", i18n.T("Only show offerings from the given provider")),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("marketplace", c)
 			},
 		},
 		{
 			Name:        "map-route",
-			Description: "Add a url route to an app",
+			Description: i18n.T("Add a url route to an app"),
 			Usage:       fmt.Sprintf("%s map-route APP DOMAIN [-n HOSTNAME]", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("n", "Hostname"),
+				NewStringFlag("n", i18n.T("Hostname")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("map-route", c)
 			},
 		},
+		{
+			Name:        "oauth-token",
+			Description: i18n.T("Print a fresh OAuth token, e.g. for use with other tools"),
+			Usage: fmt.Sprintf("%s oauth-token\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   curl -H \"Authorization: $(%s oauth-token)\" https://api.example.com/v2/info", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("oauth-token", c)
+			},
+		},
 		{
 			Name:        "org",
-			Description: "Show org info",
+			Description: i18n.T("Show org info"),
 			Usage:       fmt.Sprintf("%s org ORG", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("org", c)
@@ -452,10 +673,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "org-users",
-			Description: "Show org users by role",
+			Description: i18n.T("Show org users by role"),
 			Usage:       fmt.Sprintf("%s org-users ORG", cf.Name()),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "a", Usage: "List all users in the org"},
+				cli.BoolFlag{Name: "a", Usage: i18n.T("List all users in the org")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("org-users", c)
@@ -464,7 +685,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "orgs",
 			ShortName:   "o",
-			Description: "List all orgs",
+			Description: i18n.T("List all orgs"),
 			Usage:       fmt.Sprintf("%s orgs", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("orgs", c)
@@ -473,7 +694,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "passwd",
 			ShortName:   "pw",
-			Description: "Change user password",
+			Description: i18n.T("Change user password"),
 			Usage:       fmt.Sprintf("%s passwd", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("passwd", c)
@@ -481,13 +702,13 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "purge-service-offering",
-			Description: "Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker",
+			Description: i18n.T("Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"),
 			Usage: fmt.Sprintf("%s purge-service-offering SERVICE [-p PROVIDER]", cf.Name()) +
-				"\n\nWARNING:\n" +
-				"This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.",
+				"\n\n" + i18n.T("WARNING:") + "\n" +
+				i18n.T("This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup."),
 			Flags: []cli.Flag{
-				NewStringFlag("p", "Provider"),
-				cli.BoolFlag{Name: "f", Usage: "Force deletion without confirmation"},
+				NewStringFlag("p", i18n.T("Provider")),
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force deletion without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("purge-service-offering", c)
@@ -496,37 +717,46 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "push",
 			ShortName:   "p",
-			Description: "Push a new app or sync changes to an existing app",
-			Usage: "Push a single app (with or without a manifest):\n" +
+			Description: i18n.T("Push a new app or sync changes to an existing app"),
+			Usage: i18n.T("Push a single app (with or without a manifest):") + "\n" +
 				fmt.Sprintf("   %s push APP [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH]\n", cf.Name()) +
 				"   [-i NUM_INSTANCES] [-m MEMORY] [-n HOST] [-p PATH] [-s STACK] [-t TIMEOUT]\n" +
-				"   [--no-hostname] [--no-manifest] [--no-route] [--no-start]" +
-				"\n\n   Push multiple apps with a manifest:\n" +
+				"   [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--prune-routes]" +
+				"\n\n   " + i18n.T("Push multiple apps with a manifest:") + "\n" +
 				fmt.Sprintf("   %s push [-f MANIFEST_PATH]\n", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("b", "Custom buildpack by name (e.g. my-buildpack) or GIT URL (e.g. https://github.com/heroku/heroku-buildpack-play.git)"),
-				NewStringFlag("c", "Startup command, set to null to reset to default start command"),
-				NewStringFlag("d", "Domain (e.g. example.com)"),
-				NewStringFlag("f", "Path to manifest"),
-				NewStringFlag("i", "Number of instances"),
-				NewStringFlag("m", "Memory limit (e.g. 256M, 1024M, 1G)"),
-				NewStringFlag("n", "Hostname (e.g. my-subdomain)"),
-				NewStringFlag("p", "Path of app directory or zip file"),
-				NewStringFlag("s", "Stack to use"),
-				NewStringFlag("t", "Start timeout in seconds"),
-				cli.BoolFlag{Name: "no-hostname", Usage: "Map the root domain to this app"},
-				cli.BoolFlag{Name: "no-manifest", Usage: "Ignore manifest file"},
-				cli.BoolFlag{Name: "no-route", Usage: "Do not map a route to this app"},
-				cli.BoolFlag{Name: "no-start", Usage: "Do not start an app after pushing"},
-				cli.BoolFlag{Name: "random-route", Usage: "Create a random route for this app"},
+				NewStringFlag("b", i18n.T("Custom buildpack by name (e.g. my-buildpack) or GIT URL (e.g. https://github.com/heroku/heroku-buildpack-play.git)")),
+				NewStringFlag("c", i18n.T("Startup command, set to null to reset to default start command")),
+				NewStringFlag("d", i18n.T("Domain (e.g. example.com)")),
+				NewStringFlag("f", i18n.T("Path to manifest")),
+				NewStringFlag("i", i18n.T("Number of instances")),
+				NewStringFlag("m", i18n.T("Memory limit (e.g. 256M, 1024M, 1G)")),
+				NewStringFlag("n", i18n.T("Hostname (e.g. my-subdomain)")),
+				NewStringFlag("p", i18n.T("Path of app directory or zip file")),
+				NewStringFlag("s", i18n.T("Stack to use")),
+				NewStringFlag("t", i18n.T("Start timeout in seconds")),
+				cli.BoolFlag{Name: "no-hostname", Usage: i18n.T("Map the root domain to this app")},
+				cli.BoolFlag{Name: "no-manifest", Usage: i18n.T("Ignore manifest file")},
+				cli.BoolFlag{Name: "no-route", Usage: i18n.T("Do not map a route to this app")},
+				cli.BoolFlag{Name: "no-start", Usage: i18n.T("Do not start an app after pushing")},
+				cli.BoolFlag{Name: "prune-routes", Usage: i18n.T("Unmap routes that are no longer listed for this app")},
+				cli.BoolFlag{Name: "random-route", Usage: i18n.T("Create a random route for this app")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("push", c)
 			},
 		},
+		{
+			Name:        "quota",
+			Description: i18n.T("Show quota info"),
+			Usage:       fmt.Sprintf("%s quota QUOTA", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("quota", c)
+			},
+		},
 		{
 			Name:        "quotas",
-			Description: "List available usage quotas ",
+			Description: i18n.T("List available usage quotas "),
 			Usage:       fmt.Sprintf("%s quotas", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("quotas", c)
@@ -534,7 +764,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "rename",
-			Description: "Rename an app",
+			Description: i18n.T("Rename an app"),
 			Usage:       fmt.Sprintf("%s rename APP NEW_APP", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename", c)
@@ -542,7 +772,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "rename-org",
-			Description: "Rename an org",
+			Description: i18n.T("Rename an org"),
 			Usage:       fmt.Sprintf("%s rename-org ORG NEW_ORG", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-org", c)
@@ -550,7 +780,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "rename-service",
-			Description: "Rename a service instance",
+			Description: i18n.T("Rename a service instance"),
 			Usage:       fmt.Sprintf("%s rename-service SERVICE_INSTANCE NEW_SERVICE_INSTANCE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-service", c)
@@ -558,7 +788,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "rename-service-broker",
-			Description: "Rename a service broker",
+			Description: i18n.T("Rename a service broker"),
 			Usage:       fmt.Sprintf("%s rename-service-broker SERVICE_BROKER NEW_SERVICE_BROKER", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-service-broker", c)
@@ -566,39 +796,67 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "rename-space",
-			Description: "Rename a space",
+			Description: i18n.T("Rename a space"),
 			Usage:       fmt.Sprintf("%s rename-space SPACE NEW_SPACE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("rename-space", c)
 			},
 		},
+		{
+			Name:        "reorder-buildpacks",
+			Description: i18n.T("Set the priority order of all buildpacks at once"),
+			Usage: fmt.Sprintf("%s reorder-buildpacks BUILDPACK...\n\n", cf.Name()) +
+				"   " + i18n.T("Every buildpack has to be listed, the first one gets position 1.") + "\n\n" +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s reorder-buildpacks java_buildpack ruby_buildpack nodejs_buildpack", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("reorder-buildpacks", c)
+			},
+		},
 		{
 			Name:        "restart",
 			ShortName:   "rs",
-			Description: "Restart an app",
-			Usage:       fmt.Sprintf("%s restart APP", cf.Name()),
+			Description: i18n.T("Restart an app"),
+			Usage: fmt.Sprintf("%s restart APP [--rolling [--batch-size N]]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s restart my-app --rolling --batch-size 2 (%s)", cf.Name(), i18n.T("restart two instances at a time")),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "rolling", Usage: i18n.T("Restart instances in batches, waiting for each batch to be running before the next")},
+				NewIntFlag("batch-size", i18n.T("Number of instances to restart at a time with --rolling (defaults to 1)")),
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("restart", c)
 			},
 		},
+		{
+			Name:        "restart-app-instance",
+			Description: i18n.T("Restart a single instance of an app"),
+			Usage:       fmt.Sprintf("%s restart-app-instance APP INDEX", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("restart-app-instance", c)
+			},
+		},
 		{
 			Name:        "routes",
 			ShortName:   "r",
-			Description: "List all routes",
-			Usage:       fmt.Sprintf("%s routes", cf.Name()),
+			Description: i18n.T("List all routes"),
+			Usage:       fmt.Sprintf("%s routes [--orphaned]", cf.Name()),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "orphaned", Usage: i18n.T("Only list routes that are not mapped to any app")},
+			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("routes", c)
 			},
 		},
 		{
 			Name:        "scale",
-			Description: "Change or view the instance count, disk space limit, and memory limit for an app",
+			Description: i18n.T("Change or view the instance count, disk space limit, and memory limit for an app"),
 			Usage:       fmt.Sprintf("%s scale APP [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]", cf.Name()),
 			Flags: []cli.Flag{
-				NewIntFlagWithValue("i", "Number of instances", -1),
-				NewStringFlag("k", "Disk limit (e.g. 256M, 1024M, 1G)"),
-				NewStringFlag("m", "Memory limit (e.g. 256M, 1024M, 1G)"),
-				cli.BoolFlag{Name: "f", Usage: "Force restart of app without prompt"},
+				NewIntFlagWithValue("i", i18n.T("Number of instances"), -1),
+				NewStringFlag("k", i18n.T("Disk limit (e.g. 256M, 1024M, 1G)")),
+				NewStringFlag("m", i18n.T("Memory limit (e.g. 256M, 1024M, 1G)")),
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force restart of app without prompt")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("scale", c)
@@ -606,15 +864,23 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "service",
-			Description: "Show service instance info",
+			Description: i18n.T("Show service instance info"),
 			Usage:       fmt.Sprintf("%s service SERVICE_INSTANCE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service", c)
 			},
 		},
+		{
+			Name:        "service-access",
+			Description: i18n.T("List service access settings"),
+			Usage:       fmt.Sprintf("%s service-access", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("service-access", c)
+			},
+		},
 		{
 			Name:        "service-auth-tokens",
-			Description: "List service auth tokens",
+			Description: i18n.T("List service auth tokens"),
 			Usage:       fmt.Sprintf("%s service-auth-tokens", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service-auth-tokens", c)
@@ -622,7 +888,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "service-brokers",
-			Description: "List service brokers",
+			Description: i18n.T("List service brokers"),
 			Usage:       fmt.Sprintf("%s service-brokers", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("service-brokers", c)
@@ -631,7 +897,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "services",
 			ShortName:   "s",
-			Description: "List all services in the target space",
+			Description: i18n.T("List all services in the target space"),
 			Usage:       fmt.Sprintf("%s services", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("services", c)
@@ -639,20 +905,17 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "migrate-service-instances",
-			Description: "Migrate service instances from one service plan to another",
-			Usage: fmt.Sprintf(
-				"%s migrate-service-instances v1_SERVICE v1_PROVIDER v1_PLAN v2_SERVICE v2_PLAN\n\n"+
-					"WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and"+
+			Description: i18n.T("Migrate service instances from one service plan to another"),
+			Usage: fmt.Sprintf("%s migrate-service-instances v1_SERVICE v1_PROVIDER v1_PLAN v2_SERVICE v2_PLAN\n\n", cf.Name()) +
+				i18n.T("WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and"+
 					" resources for service instances will not be altered. The primary use case for this operation is"+
 					" to replace a service broker which implements the v1 Service Broker API with a broker which"+
 					" implements the v2 API by remapping service instances from v1 plans to v2 plans.  We recommend"+
 					" making the v1 plan private or shutting down the v1 broker to prevent additional instances from"+
 					" being created. Once service instances have been migrated, the v1 services and plans can be"+
-					" removed from Cloud Foundry.",
-				cf.Name(),
-			),
+					" removed from Cloud Foundry."),
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: "f", Usage: "Force migration without confirmation"},
+				cli.BoolFlag{Name: "f", Usage: i18n.T("Force migration without confirmation")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("migrate-service-instances", c)
@@ -661,7 +924,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "set-env",
 			ShortName:   "se",
-			Description: "Set an env variable for an app",
+			Description: i18n.T("Set an env variable for an app"),
 			Usage:       fmt.Sprintf("%s set-env APP NAME VALUE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-env", c)
@@ -669,41 +932,41 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "set-org-role",
-			Description: "Assign an org role to a user",
+			Description: i18n.T("Assign an org role to a user"),
 			Usage: fmt.Sprintf("%s set-org-role USERNAME ORG ROLE\n\n", cf.Name()) +
-				"ROLES:\n" +
-				"   OrgManager - Invite and manage users, select and change plans, and set spending limits\n" +
-				"   BillingManager - Create and manage the billing account and payment info\n" +
-				"   OrgAuditor - Read-only access to org info and reports\n",
+				i18n.T("ROLES:") + "\n" +
+				"   OrgManager - " + i18n.T("Invite and manage users, select and change plans, and set spending limits") + "\n" +
+				"   BillingManager - " + i18n.T("Create and manage the billing account and payment info") + "\n" +
+				"   OrgAuditor - " + i18n.T("Read-only access to org info and reports") + "\n",
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-org-role", c)
 			},
 		},
 		{
 			Name:        "set-quota",
-			Description: "Define the quota for an org",
+			Description: i18n.T("Define the quota for an org"),
 			Usage: fmt.Sprintf("%s set-quota ORG QUOTA\n\n", cf.Name()) +
-				"TIP:\n" +
-				fmt.Sprintf("   View allowable quotas with '%s quotas'", cf.Name()),
+				i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("View allowable quotas with '%s'", cf.Name()+" quotas"),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-quota", c)
 			},
 		},
 		{
 			Name:        "set-space-role",
-			Description: "Assign a space role to a user",
+			Description: i18n.T("Assign a space role to a user"),
 			Usage: fmt.Sprintf("%s set-space-role USERNAME ORG SPACE ROLE\n\n", cf.Name()) +
-				"ROLES:\n" +
-				"   SpaceManager - Invite and manage users, and enable features for a given space\n" +
-				"   SpaceDeveloper - Create and manage apps and services, and see logs and reports\n" +
-				"   SpaceAuditor - View logs, reports, and settings on this space\n",
+				i18n.T("ROLES:") + "\n" +
+				"   SpaceManager - " + i18n.T("Invite and manage users, and enable features for a given space") + "\n" +
+				"   SpaceDeveloper - " + i18n.T("Create and manage apps and services, and see logs and reports") + "\n" +
+				"   SpaceAuditor - " + i18n.T("View logs, reports, and settings on this space") + "\n",
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("set-space-role", c)
 			},
 		},
 		{
 			Name:        "create-shared-domain",
-			Description: "Create a domain that can be used by all orgs (admin-only)",
+			Description: i18n.T("Create a domain that can be used by all orgs (admin-only)"),
 			Usage:       fmt.Sprintf("%s create-shared-domain DOMAIN", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("create-shared-domain", c)
@@ -711,7 +974,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "space",
-			Description: "Show space info",
+			Description: i18n.T("Show space info"),
 			Usage:       fmt.Sprintf("%s space SPACE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("space", c)
@@ -719,7 +982,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "space-users",
-			Description: "Show space users by role",
+			Description: i18n.T("Show space users by role"),
 			Usage:       fmt.Sprintf("%s space-users ORG SPACE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("space-users", c)
@@ -727,7 +990,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "spaces",
-			Description: "List all spaces in an org",
+			Description: i18n.T("List all spaces in an org"),
 			Usage:       fmt.Sprintf("%s spaces", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("spaces", c)
@@ -735,16 +998,33 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "stacks",
-			Description: "List all stacks",
+			Description: i18n.T("List all stacks"),
 			Usage:       fmt.Sprintf("%s stacks", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("stacks", c)
 			},
 		},
+		{
+			Name:        "stage-local",
+			Description: i18n.T("Run a buildpack against an app on this machine to debug staging"),
+			Usage: fmt.Sprintf("%s stage-local [-b BUILDPACK] [-p PATH]\n\n", cf.Name()) +
+				"   " + i18n.T("BUILDPACK can be a directory, a zip file or a URL. When it is not given,\n   the buildpack from the app's manifest is used.") + "\n\n" +
+				"   " + i18n.T("The files ignored by .cfignore are left out, as they are when pushing.") + "\n" +
+				"   " + i18n.T("Buildpacks can only be run on Linux.") + "\n\n" +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s stage-local -b ../my-buildpack -p ./my-app", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("b", i18n.T("Buildpack directory, zip file or URL")),
+				NewStringFlag("p", i18n.T("Path to the app directory (default is the current directory)")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("stage-local", c)
+			},
+		},
 		{
 			Name:        "start",
 			ShortName:   "st",
-			Description: "Start an app",
+			Description: i18n.T("Start an app"),
 			Usage:       fmt.Sprintf("%s start APP", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("start", c)
@@ -753,7 +1033,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "stop",
 			ShortName:   "sp",
-			Description: "Stop an app",
+			Description: i18n.T("Stop an app"),
 			Usage:       fmt.Sprintf("%s stop APP", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("stop", c)
@@ -762,20 +1042,34 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "target",
 			ShortName:   "t",
-			Description: "Set or view the targeted org or space",
+			Description: i18n.T("Set or view the targeted org or space"),
 			Usage:       fmt.Sprintf("%s target [-o ORG] [-s SPACE]", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("o", "organization"),
-				NewStringFlag("s", "space"),
+				NewStringFlag("o", i18n.T("organization")),
+				NewStringFlag("s", i18n.T("space")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("target", c)
 			},
 		},
+		{
+			Name:        "top",
+			Description: i18n.T("Display a refreshing dashboard of all apps in the target space"),
+			Usage: fmt.Sprintf("%s top [--sort KEY] [--interval SECONDS]\n\n", cf.Name()) +
+				i18n.T("SORT KEYS:") + "\n" +
+				"   name, state, cpu, memory, disk",
+			Flags: []cli.Flag{
+				NewStringFlag("sort", i18n.T("Sort apps by the given key")),
+				NewIntFlag("interval", i18n.T("Seconds between refreshes (defaults to 5)")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("top", c)
+			},
+		},
 		{
 			Name:        "unbind-service",
 			ShortName:   "us",
-			Description: "Unbind a service instance from an app",
+			Description: i18n.T("Unbind a service instance from an app"),
 			Usage:       fmt.Sprintf("%s unbind-service APP SERVICE_INSTANCE", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unbind-service", c)
@@ -783,10 +1077,10 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "unmap-route",
-			Description: "Remove a url route from an app",
+			Description: i18n.T("Remove a url route from an app"),
 			Usage:       fmt.Sprintf("%s unmap-route APP DOMAIN [-n HOSTNAME]", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("n", "Hostname"),
+				NewStringFlag("n", i18n.T("Hostname")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unmap-route", c)
@@ -794,7 +1088,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "unset-env",
-			Description: "Remove an env variable",
+			Description: i18n.T("Remove an env variable"),
 			Usage:       fmt.Sprintf("%s unset-env APP NAME", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unset-env", c)
@@ -802,47 +1096,81 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "unset-org-role",
-			Description: "Remove an org role from a user",
+			Description: i18n.T("Remove an org role from a user"),
 			Usage: fmt.Sprintf("%s unset-org-role USERNAME ORG ROLE\n\n", cf.Name()) +
-				"ROLES:\n" +
-				"   OrgManager - Invite and manage users, select and change plans, and set spending limits\n" +
-				"   BillingManager - Create and manage the billing account and payment info\n" +
-				"   OrgAuditor - Read-only access to org info and reports\n",
+				i18n.T("ROLES:") + "\n" +
+				"   OrgManager - " + i18n.T("Invite and manage users, select and change plans, and set spending limits") + "\n" +
+				"   BillingManager - " + i18n.T("Create and manage the billing account and payment info") + "\n" +
+				"   OrgAuditor - " + i18n.T("Read-only access to org info and reports") + "\n",
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unset-org-role", c)
 			},
 		},
 		{
 			Name:        "unset-space-role",
-			Description: "Remove a space role from a user",
+			Description: i18n.T("Remove a space role from a user"),
 			Usage: fmt.Sprintf("%s unset-space-role USERNAME ORG SPACE ROLE\n\n", cf.Name()) +
-				"ROLES:\n" +
-				"   SpaceManager - Invite and manage users, and enable features for a given space\n" +
-				"   SpaceDeveloper - Create and manage apps and services, and see logs and reports\n" +
-				"   SpaceAuditor - View logs, reports, and settings on this space\n",
+				i18n.T("ROLES:") + "\n" +
+				"   SpaceManager - " + i18n.T("Invite and manage users, and enable features for a given space") + "\n" +
+				"   SpaceDeveloper - " + i18n.T("Create and manage apps and services, and see logs and reports") + "\n" +
+				"   SpaceAuditor - " + i18n.T("View logs, reports, and settings on this space") + "\n",
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("unset-space-role", c)
 			},
 		},
 		{
 			Name:        "update-buildpack",
-			Description: "Update a buildpack",
+			Description: i18n.T("Update a buildpack"),
 			Usage:       fmt.Sprintf("%s update-buildpack BUILDPACK [-p PATH] [-i POSITION] [--enable|--disable] [--lock|--unlock]", cf.Name()),
 			Flags: []cli.Flag{
-				NewIntFlag("i", "Buildpack position among other buildpacks"),
-				NewStringFlag("p", "Path to directory or zip file"),
-				cli.BoolFlag{Name: "enable", Usage: "Enable the buildpack"},
-				cli.BoolFlag{Name: "disable", Usage: "Disable the buildpack"},
-				cli.BoolFlag{Name: "lock", Usage: "Lock the buildpack"},
-				cli.BoolFlag{Name: "unlock", Usage: "Unlock the buildpack"},
+				NewIntFlag("i", i18n.T("Buildpack position among other buildpacks")),
+				NewStringFlag("p", i18n.T("Path to directory or zip file")),
+				cli.BoolFlag{Name: "enable", Usage: i18n.T("Enable the buildpack")},
+				cli.BoolFlag{Name: "disable", Usage: i18n.T("Disable the buildpack")},
+				cli.BoolFlag{Name: "lock", Usage: i18n.T("Lock the buildpack")},
+				cli.BoolFlag{Name: "unlock", Usage: i18n.T("Unlock the buildpack")},
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-buildpack", c)
 			},
 		},
+		{
+			Name:        "update-quota",
+			Description: i18n.T("Update an existing resource quota"),
+			Usage:       fmt.Sprintf("%s update-quota QUOTA [-m TOTAL_MEMORY] [-i INSTANCE_MEMORY] [-n NEW_NAME] [-r ROUTES] [-s SERVICE_INSTANCES] [--allow-paid-service-plans | --disallow-paid-service-plans]", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("m", i18n.T("Total amount of memory (e.g. 1024M, 1G, 10G)")),
+				NewStringFlag("i", i18n.T("Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G), -1 means unlimited")),
+				NewIntFlag("r", i18n.T("Total number of routes, -1 means unlimited")),
+				NewIntFlag("s", i18n.T("Total number of service instances, -1 means unlimited")),
+				cli.BoolFlag{Name: "allow-paid-service-plans", Usage: i18n.T("Can provision instances of paid service plans")},
+				cli.BoolFlag{Name: "disallow-paid-service-plans", Usage: i18n.T("Cannot provision instances of paid service plans")},
+				NewStringFlag("n", i18n.T("New name")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("update-quota", c)
+			},
+		},
+		{
+			Name:        "update-service",
+			Description: i18n.T("Change the plan or the configuration parameters of a service instance"),
+			Usage: fmt.Sprintf("%s update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s update-service mydb -p gold\n", cf.Name()) +
+				fmt.Sprintf("   %s update-service mydb -c '{\"ram_gb\":8}'\n\n", cf.Name()) +
+				i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("Not every service broker supports plan changes, the broker may refuse the update."),
+			Flags: []cli.Flag{
+				NewStringFlag("p", i18n.T("Change the service plan of the instance")),
+				NewStringFlag("c", i18n.T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("update-service", c)
+			},
+		},
 		{
 			Name:        "update-service-broker",
-			Description: "Update a service broker",
+			Description: i18n.T("Update a service broker"),
 			Usage:       fmt.Sprintf("%s update-service-broker SERVICE_BROKER USERNAME PASSWORD URL", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-service-broker", c)
@@ -850,7 +1178,7 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		},
 		{
 			Name:        "update-service-auth-token",
-			Description: "Update a service auth token",
+			Description: i18n.T("Update a service auth token"),
 			Usage:       fmt.Sprintf("%s update-service-auth-token LABEL PROVIDER TOKEN", cf.Name()),
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-service-auth-token", c)
@@ -859,19 +1187,79 @@ func NewApp(cmdRunner commands.Runner) (app *cli.App, err error) {
 		{
 			Name:        "update-user-provided-service",
 			ShortName:   "uups",
-			Description: "Update user-provided service name value pairs",
+			Description: i18n.T("Update user-provided service name value pairs"),
 			Usage: fmt.Sprintf("%s update-user-provided-service SERVICE_INSTANCE [-p PARAMETERS] [-l SYSLOG-DRAIN-URL]'\n\n", cf.Name()) +
-				"EXAMPLE:\n" +
+				i18n.T("EXAMPLE:") + "\n" +
 				fmt.Sprintf("   %s update-user-provided-service oracle-db-mine -p '{\"username\":\"admin\",\"password\":\"pa55woRD\"}'\n", cf.Name()) +
 				fmt.Sprintf("   %s update-user-provided-service my-drain-service -l syslog://example.com\n", cf.Name()),
 			Flags: []cli.Flag{
-				NewStringFlag("p", "Parameters"),
-				NewStringFlag("l", "Syslog Drain Url"),
+				NewStringFlag("p", i18n.T("Parameters")),
+				NewStringFlag("l", i18n.T("Syslog Drain Url")),
 			},
 			Action: func(c *cli.Context) {
 				cmdRunner.RunCmdByName("update-user-provided-service", c)
 			},
 		},
+		{
+			Name:        "usage",
+			Description: i18n.T("Show resource usage of an org compared to its quota"),
+			Usage: fmt.Sprintf("%s usage [--org ORG | --all-orgs]\n\n", cf.Name()) +
+				"   " + i18n.T("Memory counts the started apps only, the same way the quota is enforced.") + "\n" +
+				"   " + i18n.T("Orgs using %d%% or more of a limit are flagged.", 80),
+			Flags: []cli.Flag{
+				NewStringFlag("org", i18n.T("Show usage for the given org instead of the targeted org")),
+				cli.BoolFlag{Name: "all-orgs", Usage: i18n.T("Show usage for every org")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("usage", c)
+			},
+		},
+		{
+			Name:        "user-roles",
+			Description: i18n.T("Show every org and space role of a user"),
+			Usage: fmt.Sprintf("%s user-roles (USERNAME | --all-users)\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s user-roles alice@example.com\n", cf.Name()) +
+				fmt.Sprintf("   %s user-roles --all-users > access.csv (%s)", cf.Name(), i18n.T("export the roles of every user as CSV")),
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "all-users", Usage: i18n.T("Export the roles of every user in every org as CSV")},
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("user-roles", c)
+			},
+		},
+		{
+			Name:        "validate-buildpack",
+			Description: i18n.T("Check a buildpack directory, zip file or URL for problems before uploading it"),
+			Usage: fmt.Sprintf("%s validate-buildpack PATH|URL\n\n", cf.Name()) +
+				i18n.T("TIP:") + "\n" +
+				"   " + i18n.T("%s and %s run the same checks before uploading", cf.Name()+" create-buildpack", cf.Name()+" update-buildpack"),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("validate-buildpack", c)
+			},
+		},
+		{
+			Name:        "validate-service-broker",
+			Description: i18n.T("Check the catalog of a service broker before registering it"),
+			Usage: fmt.Sprintf("%s validate-service-broker URL [-u USERNAME] [-p PASSWORD]\n\n", cf.Name()) +
+				i18n.T("EXAMPLE:") + "\n" +
+				fmt.Sprintf("   %s validate-service-broker http://localhost:9292 -u admin -p secret", cf.Name()),
+			Flags: []cli.Flag{
+				NewStringFlag("u", i18n.T("Username for the broker's basic auth")),
+				NewStringFlag("p", i18n.T("Password for the broker's basic auth")),
+			},
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("validate-service-broker", c)
+			},
+		},
+		{
+			Name:        "whoami",
+			Description: i18n.T("Show who you are logged in as, with your scopes and when your session expires"),
+			Usage:       fmt.Sprintf("%s whoami", cf.Name()),
+			Action: func(c *cli.Context) {
+				cmdRunner.RunCmdByName("whoami", c)
+			},
+		},
 	}
 	return
 }
@@ -17,18 +17,19 @@ import (
 )
 
 var expectedCommandNames = []string{
-	"api", "app", "apps", "auth", "bind-service", "buildpacks", "create-buildpack",
-	"create-domain", "create-org", "create-route", "create-service", "create-service-auth-token",
+	"api", "app", "apps", "auth", "bind-service", "buildpacks", "check-route", "create-buildpack",
+	"create-domain", "create-org", "create-quota", "create-route", "create-service", "create-service-auth-token",
 	"create-service-broker", "create-space", "create-user", "create-user-provided-service", "curl",
-	"delete", "delete-buildpack", "delete-domain", "delete-shared-domain", "delete-org", "delete-route",
+	"delete", "delete-buildpack", "delete-domain", "delete-shared-domain", "delete-org", "delete-orphaned-routes", "delete-quota", "delete-route",
 	"delete-service", "delete-service-auth-token", "delete-service-broker", "delete-space", "delete-user",
-	"domains", "env", "events", "files", "login", "logout", "logs", "marketplace", "map-route", "org",
-	"org-users", "orgs", "passwd", "purge-service-offering", "push", "quotas", "rename", "rename-org",
-	"rename-service", "rename-service-broker", "rename-space", "restart", "routes", "scale",
-	"service", "service-auth-tokens", "service-brokers", "services", "set-env", "set-org-role", "set-quota",
-	"set-space-role", "create-shared-domain", "space", "space-users", "spaces", "stacks", "start", "stop",
-	"target", "unbind-service", "unmap-route", "unset-env", "unset-org-role", "unset-space-role",
-	"update-buildpack", "update-service-broker", "update-service-auth-token", "update-user-provided-service",
+	"disable-service-access", "domains", "enable-service-access", "env", "events", "export-buildpacks", "export-users", "files", "history", "import-buildpacks", "import-users", "login", "logout", "logs", "marketplace", "map-route", "oauth-token", "org",
+	"org-users", "orgs", "passwd", "purge-service-offering", "push", "quota", "quotas", "rename", "rename-org",
+	"rename-service", "rename-service-broker", "rename-space", "reorder-buildpacks", "restart", "restart-app-instance", "routes", "scale",
+	"service", "service-access", "service-auth-tokens", "service-brokers", "services", "set-env", "set-org-role", "set-quota",
+	"set-space-role", "create-shared-domain", "space", "space-users", "spaces", "stacks", "stage-local", "start", "stop",
+	"target", "top", "unbind-service", "unmap-route", "unset-env", "unset-org-role", "unset-space-role",
+	"update-buildpack", "update-quota", "update-service", "update-service-broker", "update-service-auth-token", "update-user-provided-service",
+	"usage", "user-roles", "validate-buildpack", "validate-service-broker", "whoami",
 }
 
 var _ = Describe("App", func() {
@@ -40,6 +41,7 @@ var _ = Describe("App", func() {
 		repoLocator := api.NewRepositoryLocator(config, map[string]net.Gateway{
 			"auth":             net.NewUAAGateway(config),
 			"cloud-controller": net.NewCloudControllerGateway(config),
+			"service-broker":   net.NewServiceBrokerGateway(config),
 			"uaa":              net.NewUAAGateway(config),
 		})
 
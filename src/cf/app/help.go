@@ -1,47 +1,109 @@
 package app
 
 import (
+	"cf/i18n"
 	"cf/terminal"
+	"fmt"
 	"github.com/codegangsta/cli"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"text/template"
 )
 
-var appHelpTemplate = `{{.Title "NAME:"}}
+var appHelpTemplate = `{{.Titles.Name}}
    {{.Name}} - {{.Usage}}
 
-{{.Title "USAGE:"}}
+{{.Titles.Usage}}
    [environment variables] {{.Name}} [global options] command [arguments...] [command options]
 
-{{.Title "VERSION:"}}
+{{.Titles.Version}}
    {{.Version}}
    {{range .Commands}}
-{{.SubTitle .Name}}{{range .CommandSubGroups}}
+{{.SubTitle}}{{range .CommandSubGroups}}
 {{range .}}   {{.Name}} {{.Description}}
 {{end}}{{end}}{{end}}
-{{.Title "ENVIRONMENT VARIABLES"}}
-   CF_COLOR=false                     Do not colorize output
-   CF_HOME=path/to/dir/               Override path to default config directory
-   CF_STAGING_TIMEOUT=15              Max wait time for buildpack staging, in minutes
-   CF_STARTUP_TIMEOUT=5               Max wait time for app instance startup, in minutes
-   CF_TRACE=true                      Print API request diagnostics to stdout
-   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file
-   HTTP_PROXY=proxy.example.com:8080  Enable HTTP proxying for API requests
+{{.Titles.EnvironmentVariables}}
+{{range .EnvironmentVariables}}   {{.}}
+{{end}}
+{{.Titles.GlobalOptions}}
+{{range .GlobalOptions}}   {{.}}
+{{end}}
+{{.Titles.ExitStatus}}
+{{range .ExitStatuses}}   {{.}}
+{{end}}`
 
-{{.Title "GLOBAL OPTIONS"}}
-   --version, -v                      Print the version
-   --help, -h                         Show help
-`
+// wide enough for the longest environment variable example
+const helpColumnWidth = 35
+
+type helpTitles struct {
+	Name                 string
+	Usage                string
+	Version              string
+	EnvironmentVariables string
+	GlobalOptions        string
+	ExitStatus           string
+}
+
+func newHelpTitles() helpTitles {
+	return helpTitles{
+		Name:                 terminal.HeaderColor(i18n.T("NAME:")),
+		Usage:                terminal.HeaderColor(i18n.T("USAGE:")),
+		Version:              terminal.HeaderColor(i18n.T("VERSION:")),
+		EnvironmentVariables: terminal.HeaderColor(i18n.T("ENVIRONMENT VARIABLES")),
+		GlobalOptions:        terminal.HeaderColor(i18n.T("GLOBAL OPTIONS")),
+		ExitStatus:           terminal.HeaderColor(i18n.T("EXIT STATUS")),
+	}
+}
+
+func helpRow(name, description string) string {
+	return fmt.Sprintf("%-*s%s", helpColumnWidth, name, description)
+}
+
+func environmentVariableRows() []string {
+	return []string{
+		helpRow("CF_CA_CERT=path/to/ca.pem", i18n.T("Trust the CA certificates in this file when setting the api endpoint")),
+		helpRow("CF_COLOR=false", i18n.T("Do not colorize output")),
+		helpRow("CF_HOME=path/to/dir/", i18n.T("Override path to default config directory")),
+		helpRow("CF_LOCALE=ja_JP", i18n.T("Language of messages, overrides LANG (en_US, ja_JP or pt_BR)")),
+		helpRow("CF_NONINTERACTIVE=true", i18n.T("Fail instead of prompting for input")),
+		helpRow("CF_STAGING_TIMEOUT=15", i18n.T("Max wait time for buildpack staging, in minutes")),
+		helpRow("CF_STARTUP_TIMEOUT=5", i18n.T("Max wait time for app instance startup, in minutes")),
+		helpRow("CF_TRACE=true", i18n.T("Print API request diagnostics to stdout")),
+		helpRow("CF_TRACE=path/to/trace.log", i18n.T("Append API request diagnostics to a log file")),
+		helpRow("HTTP_PROXY=proxy.example.com:8080", i18n.T("Enable HTTP proxying for API requests")),
+	}
+}
+
+func globalOptionRows() []string {
+	return []string{
+		helpRow("--dry-run", i18n.T("Print the requests that would change anything instead of sending them")),
+		helpRow("--non-interactive", i18n.T("Fail instead of prompting for input")),
+		helpRow("--version, -v", i18n.T("Print the version")),
+		helpRow("--help, -h", i18n.T("Show help")),
+	}
+}
+
+func exitStatusRows() []string {
+	return []string{
+		helpRow(strconv.Itoa(terminal.EXIT_OK), i18n.T("Success")),
+		helpRow(strconv.Itoa(terminal.EXIT_FAILURE), i18n.T("Any failure not listed below")),
+		helpRow(strconv.Itoa(terminal.EXIT_USAGE), i18n.T("Incorrect usage, or input needed in non-interactive mode")),
+		helpRow(strconv.Itoa(terminal.EXIT_AUTH_FAILURE), i18n.T("Not logged in, bad credentials or an expired session")),
+		helpRow(strconv.Itoa(terminal.EXIT_NOT_FOUND), i18n.T("The app, space, service or other resource does not exist")),
+		helpRow(strconv.Itoa(terminal.EXIT_SERVER_ERROR), i18n.T("The server failed or could not be reached")),
+		helpRow(strconv.Itoa(terminal.EXIT_TIMEOUT), i18n.T("The server, staging or an async job took too long")),
+	}
+}
 
 type groupedCommands struct {
 	Name             string
 	CommandSubGroups [][]cmdPresenter
 }
 
-func (c groupedCommands) SubTitle(name string) string {
-	return terminal.HeaderColor(name + ":")
+func (c groupedCommands) SubTitle() string {
+	return terminal.HeaderColor(c.Name + ":")
 }
 
 type cmdPresenter struct {
@@ -71,11 +133,11 @@ func presentCmdName(cmd cli.Command) (name string) {
 
 type appPresenter struct {
 	cli.App
-	Commands []groupedCommands
-}
-
-func (p appPresenter) Title(name string) string {
-	return terminal.HeaderColor(name)
+	Titles               helpTitles
+	Commands             []groupedCommands
+	EnvironmentVariables []string
+	GlobalOptions        []string
+	ExitStatuses         []string
 }
 
 func getMaxCmdNameLength(app *cli.App) (length int) {
@@ -96,27 +158,33 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 	presenter.Version = app.Version
 	presenter.Name = app.Name
 	presenter.Flags = app.Flags
+	presenter.Titles = newHelpTitles()
+	presenter.EnvironmentVariables = environmentVariableRows()
+	presenter.GlobalOptions = globalOptionRows()
+	presenter.ExitStatuses = exitStatusRows()
 
 	presenter.Commands = []groupedCommands{
 		{
-			Name: "GETTING STARTED",
+			Name: i18n.T("GETTING STARTED"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "login"),
 					newCmdPresenter(app, maxNameLen, "logout"),
 					newCmdPresenter(app, maxNameLen, "passwd"),
 					newCmdPresenter(app, maxNameLen, "target"),
+					newCmdPresenter(app, maxNameLen, "whoami"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "api"),
 					newCmdPresenter(app, maxNameLen, "auth"),
 				},
 			},
 		}, {
-			Name: "APPS",
+			Name: i18n.T("APPS"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "apps"),
 					newCmdPresenter(app, maxNameLen, "app"),
+					newCmdPresenter(app, maxNameLen, "top"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "push"),
 					newCmdPresenter(app, maxNameLen, "scale"),
@@ -126,10 +194,12 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					newCmdPresenter(app, maxNameLen, "start"),
 					newCmdPresenter(app, maxNameLen, "stop"),
 					newCmdPresenter(app, maxNameLen, "restart"),
+					newCmdPresenter(app, maxNameLen, "restart-app-instance"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "events"),
 					newCmdPresenter(app, maxNameLen, "files"),
 					newCmdPresenter(app, maxNameLen, "logs"),
+					newCmdPresenter(app, maxNameLen, "stage-local"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "env"),
 					newCmdPresenter(app, maxNameLen, "set-env"),
@@ -139,7 +209,7 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				},
 			},
 		}, {
-			Name: "SERVICES",
+			Name: i18n.T("SERVICES"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "marketplace"),
@@ -147,6 +217,7 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					newCmdPresenter(app, maxNameLen, "service"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "create-service"),
+					newCmdPresenter(app, maxNameLen, "update-service"),
 					newCmdPresenter(app, maxNameLen, "delete-service"),
 					newCmdPresenter(app, maxNameLen, "rename-service"),
 				}, {
@@ -158,7 +229,7 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				},
 			},
 		}, {
-			Name: "ORGS",
+			Name: i18n.T("ORGS"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "orgs"),
@@ -170,7 +241,7 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				},
 			},
 		}, {
-			Name: "SPACES",
+			Name: i18n.T("SPACES"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "spaces"),
@@ -182,7 +253,7 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				},
 			},
 		}, {
-			Name: "DOMAINS",
+			Name: i18n.T("DOMAINS"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "domains"),
@@ -193,7 +264,7 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				},
 			},
 		}, {
-			Name: "ROUTES",
+			Name: i18n.T("ROUTES"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "routes"),
@@ -201,24 +272,34 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					newCmdPresenter(app, maxNameLen, "map-route"),
 					newCmdPresenter(app, maxNameLen, "unmap-route"),
 					newCmdPresenter(app, maxNameLen, "delete-route"),
+					newCmdPresenter(app, maxNameLen, "delete-orphaned-routes"),
+					newCmdPresenter(app, maxNameLen, "check-route"),
 				},
 			},
 		}, {
-			Name: "BUILDPACKS",
+			Name: i18n.T("BUILDPACKS"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "buildpacks"),
 					newCmdPresenter(app, maxNameLen, "create-buildpack"),
 					newCmdPresenter(app, maxNameLen, "update-buildpack"),
 					newCmdPresenter(app, maxNameLen, "delete-buildpack"),
+					newCmdPresenter(app, maxNameLen, "reorder-buildpacks"),
+					newCmdPresenter(app, maxNameLen, "export-buildpacks"),
+					newCmdPresenter(app, maxNameLen, "import-buildpacks"),
+					newCmdPresenter(app, maxNameLen, "validate-buildpack"),
 				},
 			},
 		}, {
-			Name: "USER ADMIN",
+			Name: i18n.T("USER ADMIN"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "create-user"),
 					newCmdPresenter(app, maxNameLen, "delete-user"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "import-users"),
+					newCmdPresenter(app, maxNameLen, "export-users"),
+					newCmdPresenter(app, maxNameLen, "user-roles"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "org-users"),
 					newCmdPresenter(app, maxNameLen, "set-org-role"),
@@ -230,15 +311,22 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				},
 			},
 		}, {
-			Name: "ORG ADMIN",
+			Name: i18n.T("ORG ADMIN"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "quotas"),
+					newCmdPresenter(app, maxNameLen, "quota"),
 					newCmdPresenter(app, maxNameLen, "set-quota"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "create-quota"),
+					newCmdPresenter(app, maxNameLen, "update-quota"),
+					newCmdPresenter(app, maxNameLen, "delete-quota"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "usage"),
 				},
 			},
 		}, {
-			Name: "SERVICE ADMIN",
+			Name: i18n.T("SERVICE ADMIN"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "service-auth-tokens"),
@@ -251,16 +339,23 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					newCmdPresenter(app, maxNameLen, "update-service-broker"),
 					newCmdPresenter(app, maxNameLen, "delete-service-broker"),
 					newCmdPresenter(app, maxNameLen, "rename-service-broker"),
+					newCmdPresenter(app, maxNameLen, "validate-service-broker"),
+				}, {
+					newCmdPresenter(app, maxNameLen, "service-access"),
+					newCmdPresenter(app, maxNameLen, "enable-service-access"),
+					newCmdPresenter(app, maxNameLen, "disable-service-access"),
 				}, {
 					newCmdPresenter(app, maxNameLen, "migrate-service-instances"),
 					newCmdPresenter(app, maxNameLen, "purge-service-offering"),
 				},
 			},
 		}, {
-			Name: "ADVANCED",
+			Name: i18n.T("ADVANCED"),
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					newCmdPresenter(app, maxNameLen, "curl"),
+					newCmdPresenter(app, maxNameLen, "history"),
+					newCmdPresenter(app, maxNameLen, "oauth-token"),
 				},
 			},
 		},
@@ -268,12 +363,14 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 	return
 }
 
-func showAppHelp(helpTemplate string, appToPrint interface{}) {
-	app := appToPrint.(*cli.App)
-	presenter := newAppPresenter(app)
+// also prints the help of a single command, which the cli hands over as is
+func showAppHelp(helpTemplate string, toPrint interface{}) {
+	if app, ok := toPrint.(*cli.App); ok {
+		toPrint = newAppPresenter(app)
+	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
 	t := template.Must(template.New("help").Parse(helpTemplate))
-	t.Execute(w, presenter)
+	t.Execute(w, toPrint)
 	w.Flush()
 }
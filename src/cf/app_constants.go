@@ -5,10 +5,7 @@ import (
 	"path/filepath"
 )
 
-const (
-	Version = "BUILT_FROM_SOURCE"
-	Usage   = "A command line tool to interact with Cloud Foundry"
-)
+const Version = "BUILT_FROM_SOURCE"
 
 func Name() string {
 	return filepath.Base(os.Args[0])
@@ -0,0 +1,279 @@
+package broker_catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// plan and service names are typed on the command line, so the broker API
+// asks for them to be lowercase without spaces
+var cliFriendlyName = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*$`)
+
+type CatalogErrors []error
+
+func (errs CatalogErrors) Empty() bool {
+	return len(errs) == 0
+}
+
+func (errs CatalogErrors) Error() (errorMessage string) {
+	for _, err := range errs {
+		errorMessage = fmt.Sprintf("%s%s\n", errorMessage, err)
+	}
+	return
+}
+
+type Report struct {
+	ServiceCount int
+	PlanCount    int
+	Errors       CatalogErrors
+}
+
+type validator struct {
+	report     Report
+	guidsInUse map[string]string
+}
+
+// Validate checks a catalog returned by a broker's /v2/catalog endpoint
+// against the v2 service broker API and reports every problem it finds,
+// not just the first one
+func Validate(data []byte) Report {
+	v := &validator{guidsInUse: map[string]string{}}
+
+	var catalog interface{}
+	err := json.Unmarshal(data, &catalog)
+	if err != nil {
+		v.addError("catalog", "is not valid JSON: %s", err.Error())
+		return v.report
+	}
+
+	catalogMap, ok := catalog.(map[string]interface{})
+	if !ok {
+		v.addError("catalog", "must be an object, got %s", jsonType(catalog))
+		return v.report
+	}
+
+	services, ok := v.field(catalogMap, "catalog", "services", "array", true)
+	if !ok {
+		return v.report
+	}
+
+	serviceNames := map[string]bool{}
+	for index, service := range services.([]interface{}) {
+		path := fmt.Sprintf("services[%d]", index)
+		serviceMap, ok := service.(map[string]interface{})
+		if !ok {
+			v.addError(path, "must be an object, got %s", jsonType(service))
+			continue
+		}
+
+		v.report.ServiceCount++
+		name := v.validateService(path, serviceMap)
+		if name == "" {
+			continue
+		}
+		if serviceNames[name] {
+			v.addError(path+".name", "%s is used by more than one service", name)
+		}
+		serviceNames[name] = true
+	}
+	return v.report
+}
+
+func (v *validator) validateService(path string, service map[string]interface{}) (name string) {
+	v.validateGuid(service, path)
+	name = v.validateName(service, path)
+	v.field(service, path, "description", "string", true)
+	v.field(service, path, "bindable", "boolean", true)
+	v.field(service, path, "plan_updateable", "boolean", false)
+	v.validateStrings(service, path, "tags")
+	v.validateStrings(service, path, "requires")
+
+	metadata, ok := v.field(service, path, "metadata", "object", false)
+	if ok && metadata != nil {
+		metadataPath := path + ".metadata"
+		for _, key := range []string{"displayName", "imageUrl", "longDescription", "providerDisplayName", "documentationUrl", "supportUrl"} {
+			v.field(metadata.(map[string]interface{}), metadataPath, key, "string", false)
+		}
+	}
+
+	dashboardClient, ok := v.field(service, path, "dashboard_client", "object", false)
+	if ok && dashboardClient != nil {
+		clientPath := path + ".dashboard_client"
+		for _, key := range []string{"id", "secret", "redirect_uri"} {
+			v.field(dashboardClient.(map[string]interface{}), clientPath, key, "string", true)
+		}
+	}
+
+	plans, ok := v.field(service, path, "plans", "array", true)
+	if !ok {
+		return
+	}
+	if len(plans.([]interface{})) == 0 {
+		v.addError(path+".plans", "must contain at least one plan")
+	}
+
+	planNames := map[string]bool{}
+	for index, plan := range plans.([]interface{}) {
+		planPath := fmt.Sprintf("%s.plans[%d]", path, index)
+		planMap, ok := plan.(map[string]interface{})
+		if !ok {
+			v.addError(planPath, "must be an object, got %s", jsonType(plan))
+			continue
+		}
+
+		v.report.PlanCount++
+		planName := v.validatePlan(planPath, planMap)
+		if planName == "" {
+			continue
+		}
+		if planNames[planName] {
+			v.addError(planPath+".name", "%s is used by more than one plan of this service", planName)
+		}
+		planNames[planName] = true
+	}
+	return
+}
+
+func (v *validator) validatePlan(path string, plan map[string]interface{}) (name string) {
+	v.validateGuid(plan, path)
+	name = v.validateName(plan, path)
+	v.field(plan, path, "description", "string", true)
+	v.field(plan, path, "free", "boolean", false)
+
+	metadata, ok := v.field(plan, path, "metadata", "object", false)
+	if !ok || metadata == nil {
+		return
+	}
+
+	metadataMap := metadata.(map[string]interface{})
+	metadataPath := path + ".metadata"
+	v.field(metadataMap, metadataPath, "displayName", "string", false)
+	v.validateStrings(metadataMap, metadataPath, "bullets")
+
+	costs, ok := v.field(metadataMap, metadataPath, "costs", "array", false)
+	if !ok || costs == nil {
+		return
+	}
+
+	for index, cost := range costs.([]interface{}) {
+		costPath := fmt.Sprintf("%s.costs[%d]", metadataPath, index)
+		costMap, ok := cost.(map[string]interface{})
+		if !ok {
+			v.addError(costPath, "must be an object, got %s", jsonType(cost))
+			continue
+		}
+
+		v.field(costMap, costPath, "unit", "string", true)
+		amount, ok := v.field(costMap, costPath, "amount", "object", true)
+		if !ok {
+			continue
+		}
+		amountMap := amount.(map[string]interface{})
+		currencies := []string{}
+		for currency := range amountMap {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			if jsonType(amountMap[currency]) != "number" {
+				v.addError(fmt.Sprintf("%s.amount.%s", costPath, currency), "must be a number, got %s", jsonType(amountMap[currency]))
+			}
+		}
+	}
+	return
+}
+
+func (v *validator) validateGuid(object map[string]interface{}, path string) {
+	guid, ok := v.field(object, path, "id", "string", true)
+	if !ok {
+		return
+	}
+
+	if guid == "" {
+		v.addError(path+".id", "must not be empty")
+		return
+	}
+
+	if firstPath, found := v.guidsInUse[guid.(string)]; found {
+		v.addError(path+".id", "%s is already used by %s", guid, firstPath)
+		return
+	}
+	v.guidsInUse[guid.(string)] = path
+}
+
+func (v *validator) validateName(object map[string]interface{}, path string) (name string) {
+	value, ok := v.field(object, path, "name", "string", true)
+	if !ok {
+		return
+	}
+
+	name = value.(string)
+	if !cliFriendlyName.MatchString(name) {
+		v.addError(path+".name", "%q must be lowercase letters, digits, '-', '_' or '.' with no spaces", name)
+	}
+	return
+}
+
+func (v *validator) validateStrings(object map[string]interface{}, path, key string) {
+	values, ok := v.field(object, path, key, "array", false)
+	if !ok || values == nil {
+		return
+	}
+
+	for index, value := range values.([]interface{}) {
+		if jsonType(value) != "string" {
+			v.addError(fmt.Sprintf("%s.%s[%d]", path, key, index), "must be a string, got %s", jsonType(value))
+		}
+	}
+}
+
+// returns the value of a field when it is present and has the expected type
+func (v *validator) field(object map[string]interface{}, path, key, expectedType string, required bool) (value interface{}, ok bool) {
+	value, found := object[key]
+	if !found || value == nil {
+		if required {
+			v.addError(path+"."+key, "is required")
+		}
+		return nil, !required
+	}
+
+	if jsonType(value) != expectedType {
+		v.addError(path+"."+key, "must be %s %s, got %s", article(expectedType), expectedType, jsonType(value))
+		return nil, false
+	}
+	return value, true
+}
+
+func (v *validator) addError(path, format string, args ...interface{}) {
+	v.report.Errors = append(v.report.Errors, errors.New(path+": "+fmt.Sprintf(format, args...)))
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "unknown"
+}
+
+func article(jsonType string) string {
+	switch jsonType {
+	case "array", "object":
+		return "an"
+	}
+	return "a"
+}
@@ -0,0 +1,13 @@
+package broker_catalog_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestBrokerCatalog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Broker Catalog Suite")
+}
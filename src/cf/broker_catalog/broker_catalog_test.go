@@ -0,0 +1,121 @@
+package broker_catalog_test
+
+import (
+	. "cf/broker_catalog"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func errorMessages(report Report) (messages []string) {
+	for _, err := range report.Errors {
+		messages = append(messages, err.Error())
+	}
+	return
+}
+
+var _ = Describe("Validate", func() {
+	It("accepts a valid catalog", func() {
+		report := Validate([]byte(`{
+			"services": [{
+				"id": "service-guid",
+				"name": "cleardb",
+				"description": "MySQL as a service",
+				"bindable": true,
+				"tags": ["mysql", "relational"],
+				"metadata": {"displayName": "ClearDB"},
+				"dashboard_client": {"id": "client", "secret": "s3cret", "redirect_uri": "http://example.com"},
+				"plans": [{
+					"id": "spark-guid",
+					"name": "spark",
+					"description": "Free plan",
+					"free": true
+				}, {
+					"id": "boost-guid",
+					"name": "boost",
+					"description": "Paid plan",
+					"metadata": {
+						"displayName": "Boost",
+						"bullets": ["20 connections"],
+						"costs": [{"amount": {"usd": 10.0}, "unit": "MONTHLY"}]
+					}
+				}]
+			}]
+		}`))
+
+		Expect(report.Errors.Empty()).To(BeTrue())
+		Expect(report.ServiceCount).To(Equal(1))
+		Expect(report.PlanCount).To(Equal(2))
+	})
+
+	It("reports invalid JSON", func() {
+		report := Validate([]byte(`{"services": [`))
+		Expect(errorMessages(report)).To(HaveLen(1))
+		Expect(errorMessages(report)[0]).To(ContainSubstring("catalog: is not valid JSON"))
+	})
+
+	It("requires a list of services", func() {
+		report := Validate([]byte(`{}`))
+		Expect(errorMessages(report)).To(Equal([]string{"catalog.services: is required"}))
+
+		report = Validate([]byte(`{"services": {}}`))
+		Expect(errorMessages(report)).To(Equal([]string{"catalog.services: must be an array, got object"}))
+	})
+
+	It("reports every problem in the catalog", func() {
+		report := Validate([]byte(`{
+			"services": [{
+				"id": "duplicate-guid",
+				"name": "My Service",
+				"bindable": "yes",
+				"tags": ["ok", 3],
+				"plans": [{
+					"id": "duplicate-guid",
+					"name": "small",
+					"description": "Small",
+					"metadata": {"bullets": "not a list", "costs": [{"amount": {"usd": "ten"}}]}
+				}, {
+					"id": "plan-2-guid",
+					"name": "small",
+					"description": 7
+				}]
+			}, {
+				"id": "other-guid",
+				"name": "other",
+				"description": "Other",
+				"bindable": false,
+				"plans": []
+			}]
+		}`))
+
+		Expect(errorMessages(report)).To(Equal([]string{
+			`services[0].name: "My Service" must be lowercase letters, digits, '-', '_' or '.' with no spaces`,
+			"services[0].description: is required",
+			"services[0].bindable: must be a boolean, got string",
+			"services[0].tags[1]: must be a string, got number",
+			"services[0].plans[0].id: duplicate-guid is already used by services[0]",
+			"services[0].plans[0].metadata.bullets: must be an array, got string",
+			"services[0].plans[0].metadata.costs[0].unit: is required",
+			"services[0].plans[0].metadata.costs[0].amount.usd: must be a number, got string",
+			"services[0].plans[1].description: must be a string, got number",
+			"services[0].plans[1].name: small is used by more than one plan of this service",
+			"services[1].plans: must contain at least one plan",
+		}))
+		Expect(report.ServiceCount).To(Equal(2))
+		Expect(report.PlanCount).To(Equal(2))
+	})
+
+	It("requires service names to be unique", func() {
+		report := Validate([]byte(`{
+			"services": [
+				{"id": "guid-1", "name": "mysql", "description": "one", "bindable": true,
+				 "plans": [{"id": "plan-1", "name": "small", "description": "small"}]},
+				{"id": "guid-2", "name": "mysql", "description": "two", "bindable": true,
+				 "plans": [{"id": "plan-2", "name": "small", "description": "small"}]}
+			]
+		}`))
+
+		Expect(errorMessages(report)).To(Equal([]string{
+			"services[1].name: mysql is used by more than one service",
+		}))
+	})
+})
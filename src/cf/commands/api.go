@@ -5,10 +5,14 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
+	"cf/net"
 	"cf/requirements"
 	"cf/terminal"
 	"fmt"
 	"github.com/codegangsta/cli"
+	"io/ioutil"
+	"os"
 	"strings"
 )
 
@@ -26,16 +30,20 @@ func NewApi(ui terminal.UI, config configuration.ReadWriter, endpointRepo api.En
 }
 
 func (cmd Api) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if (c.String("client-cert") == "") != (c.String("client-key") == "") {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "api")
+	}
 	return
 }
 
 func (cmd Api) Run(c *cli.Context) {
 	if len(c.Args()) == 0 {
 		if cmd.config.ApiEndpoint() == "" {
-			cmd.ui.Say(fmt.Sprintf("No api endpoint set. Use '%s' to set an endpoint", terminal.CommandColor(cf.Name()+" api")))
+			cmd.ui.Say(i18n.T("No api endpoint set. Use '%s' to set an endpoint", terminal.CommandColor(cf.Name()+" api")))
 		} else {
 			cmd.ui.Say(
-				"API endpoint: %s (API version: %s)",
+				i18n.T("API endpoint: %s (API version: %s)"),
 				terminal.EntityNameColor(cmd.config.ApiEndpoint()),
 				terminal.EntityNameColor(cmd.config.ApiVersion()),
 			)
@@ -45,7 +53,13 @@ func (cmd Api) Run(c *cli.Context) {
 
 	endpoint := c.Args()[0]
 
-	cmd.ui.Say("Setting api endpoint to %s...", terminal.EntityNameColor(endpoint))
+	cmd.ui.Say(i18n.T("Setting api endpoint to %s..."), terminal.EntityNameColor(endpoint))
+	caCertPath := c.String("ca-cert")
+	if caCertPath == "" {
+		caCertPath = os.Getenv("CF_CA_CERT")
+	}
+	cmd.setCACert(caCertPath)
+	cmd.setClientCertificate(c.String("client-cert"), c.String("client-key"))
 	cmd.setApiEndpoint(endpoint, c.Bool("skip-ssl-validation"))
 	cmd.ui.Ok()
 
@@ -64,18 +78,89 @@ func (cmd Api) setApiEndpoint(endpoint string, skipSSL bool) {
 	if err != nil {
 		cmd.config.SetApiEndpoint("")
 		cmd.config.SetSSLDisabled(false)
+		cmd.config.SetSSLCACert("")
+		cmd.config.SetSSLClientCertificate("", "")
 
 		switch typedErr := err.(type) {
 		case *errors.InvalidSSLCert:
+			caCertCommand := terminal.CommandColor(fmt.Sprintf("%s api --ca-cert PATH", cf.Name()))
 			cfApiCommand := terminal.CommandColor(fmt.Sprintf("%s api --skip-ssl-validation", cf.Name()))
-			tipMessage := fmt.Sprintf("TIP: Use '%s' to continue with an insecure API endpoint", cfApiCommand)
-			cmd.ui.Failed("Invalid SSL Cert for %s\n%s", typedErr.URL, tipMessage)
+			tipMessage := i18n.T("TIP: Use '%s' to trust a custom certificate authority, or '%s' to continue with an insecure API endpoint", caCertCommand, cfApiCommand)
+			cmd.ui.Failed(i18n.T("Invalid SSL Cert for %s\n%s"), typedErr.URL, tipMessage)
 		default:
-			cmd.ui.Failed(typedErr.Error())
+			cmd.ui.FailWithError(typedErr)
 		}
 	}
 
 	if !strings.HasPrefix(endpoint, "https://") {
-		cmd.ui.Say(terminal.WarningColor("Warning: Insecure http API endpoint detected: secure https API endpoints are recommended\n"))
+		cmd.ui.Say("%s\n", terminal.WarningColor(i18n.T("Warning: Insecure http API endpoint detected: secure https API endpoints are recommended")))
+	}
+}
+
+// the certificates are stored in the config rather than their paths, so the
+// files don't have to stay around; targeting an api without them forgets them
+func (cmd Api) setCACert(caCertPath string) {
+	caCert := cmd.readCertificateFile(i18n.T("Could not read CA certificate file %s\n%s"), caCertPath)
+	if caCert != "" {
+		err := net.ValidateCACert(caCert)
+		if err != nil {
+			cmd.ui.FailWithError(err)
+			return
+		}
+	}
+
+	cmd.config.SetSSLCACert(caCert)
+}
+
+func (cmd Api) setClientCertificate(clientCertPath, clientKeyPath string) {
+	clientCert := cmd.readCertificateFile(i18n.T("Could not read client certificate file %s\n%s"), clientCertPath)
+	clientKey := cmd.readCertificateFile(i18n.T("Could not read client key file %s\n%s"), clientKeyPath)
+	if clientCert != "" {
+		_, err := net.NewClientCertificate(clientCert, clientKey)
+		if err != nil {
+			cmd.ui.FailWithError(err)
+			return
+		}
+	}
+
+	cmd.config.SetSSLClientCertificate(clientCert, clientKey)
+}
+
+// login has no certificate flags, so logging in to another api forgets the
+// certificates of the old one; CF_CA_CERT is trusted either way
+func (cmd Api) setLoginCertificates(endpoint string) {
+	caCertPath := os.Getenv("CF_CA_CERT")
+
+	if !sameEndpoint(endpoint, cmd.config.ApiEndpoint()) {
+		cmd.setCACert(caCertPath)
+		cmd.setClientCertificate("", "")
+		return
+	}
+
+	if caCertPath != "" {
+		cmd.setCACert(caCertPath)
+	}
+}
+
+// the endpoint given to login may leave out the scheme the config has
+func sameEndpoint(endpoint, configuredEndpoint string) bool {
+	trim := func(endpoint string) string {
+		endpoint = strings.TrimSuffix(endpoint, "/")
+		endpoint = strings.TrimPrefix(endpoint, "https://")
+		return strings.TrimPrefix(endpoint, "http://")
+	}
+	return trim(endpoint) == trim(configuredEndpoint)
+}
+
+func (cmd Api) readCertificateFile(failureMessage, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		cmd.ui.Failed(failureMessage, path, err.Error())
+		return ""
 	}
+	return string(contents)
 }
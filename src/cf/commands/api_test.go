@@ -9,10 +9,14 @@ import (
 	"github.com/codegangsta/cli"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
 	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
 	testreq "testhelpers/requirements"
 	testterm "testhelpers/terminal"
 )
@@ -156,6 +160,100 @@ var _ = Describe("api command", func() {
 			})
 		})
 
+		Describe("trusting a custom certificate authority", func() {
+			var (
+				dir           string
+				caCertPath    string
+				certPEM       string
+				keyPEM        string
+				clientKeyPath string
+			)
+
+			BeforeEach(func() {
+				var err error
+				dir, err = ioutil.TempDir("", "api-certs")
+				Expect(err).NotTo(HaveOccurred())
+
+				certPEM, keyPEM = testnet.MakeTLSCertPEM()
+				caCertPath = filepath.Join(dir, "ca.pem")
+				clientKeyPath = filepath.Join(dir, "client-key.pem")
+				Expect(ioutil.WriteFile(caCertPath, []byte(certPEM), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(clientKeyPath, []byte(keyPEM), 0600)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(dir)
+				os.Setenv("CF_CA_CERT", "")
+			})
+
+			It("stores the CA certificates from --ca-cert in the config", func() {
+				callApi([]string{"--ca-cert", caCertPath, "https://example.com"}, config, endpointRepo)
+
+				Expect(endpointRepo.UpdateEndpointReceived).To(Equal("https://example.com"))
+				Expect(config.SSLCACert()).To(Equal(certPEM))
+			})
+
+			It("reads the CA certificates from CF_CA_CERT when --ca-cert is not given", func() {
+				os.Setenv("CF_CA_CERT", caCertPath)
+				callApi([]string{"https://example.com"}, config, endpointRepo)
+
+				Expect(config.SSLCACert()).To(Equal(certPEM))
+			})
+
+			It("forgets the CA certificates when targeting an api without them", func() {
+				config.SetSSLCACert(certPEM)
+				config.SetSSLClientCertificate(certPEM, keyPEM)
+				callApi([]string{"https://example.com"}, config, endpointRepo)
+
+				Expect(config.SSLCACert()).To(BeEmpty())
+				Expect(config.SSLClientCert()).To(BeEmpty())
+				Expect(config.SSLClientKey()).To(BeEmpty())
+			})
+
+			It("fails when the CA certificate file does not exist", func() {
+				ui := callApi([]string{"--ca-cert", filepath.Join(dir, "missing.pem"), "https://example.com"}, config, endpointRepo)
+
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"FAILED"},
+					{"Could not read CA certificate file", "missing.pem"},
+				})
+				Expect(endpointRepo.UpdateEndpointReceived).To(BeEmpty())
+			})
+
+			It("fails when the file does not contain certificates", func() {
+				ui := callApi([]string{"--ca-cert", clientKeyPath, "https://example.com"}, config, endpointRepo)
+
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"FAILED"},
+					{"Invalid CA certificate"},
+				})
+				Expect(config.SSLCACert()).To(BeEmpty())
+			})
+
+			It("stores the client certificate and key in the config", func() {
+				callApi([]string{"--client-cert", caCertPath, "--client-key", clientKeyPath, "https://example.com"}, config, endpointRepo)
+
+				Expect(config.SSLClientCert()).To(Equal(certPEM))
+				Expect(config.SSLClientKey()).To(Equal(keyPEM))
+			})
+
+			It("fails with usage when given a client certificate without its key", func() {
+				ui := callApi([]string{"--client-cert", caCertPath, "https://example.com"}, config, endpointRepo)
+
+				Expect(ui.FailedWithUsage).To(BeTrue())
+			})
+
+			It("forgets the certificates when the endpoint cannot be set", func() {
+				endpointRepo.UpdateEndpointError = errors.NewInvalidSSLCert("https://example.com", "unknown authority")
+				ui := callApi([]string{"--ca-cert", caCertPath, "https://example.com"}, config, endpointRepo)
+
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"TIP", "--ca-cert"},
+				})
+				Expect(config.SSLCACert()).To(BeEmpty())
+			})
+		})
+
 		Describe("unencrypted http endpoints", func() {
 			It("warns the user", func() {
 				ui = callApi([]string{"http://example.com"}, config, endpointRepo)
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -40,7 +41,7 @@ func (cmd *DeleteApp) Run(c *cli.Context) {
 
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete %s?%s",
+			i18n.T("Really delete %s?%s"),
 			terminal.EntityNameColor(appName),
 			terminal.PromptColor(">"),
 		)
@@ -49,7 +50,7 @@ func (cmd *DeleteApp) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Deleting app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(appName),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -62,16 +63,16 @@ func (cmd *DeleteApp) Run(c *cli.Context) {
 	case nil: // no error
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn("App %s does not exist.", appName)
+		cmd.ui.Warn(i18n.T("App %s does not exist."), appName)
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	apiErr = cmd.appRepo.Delete(app.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -2,6 +2,7 @@ package application
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -40,7 +41,7 @@ func (cmd *Env) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 func (cmd *Env) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
 
-	cmd.ui.Say("Getting env variables for app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Getting env variables for app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -52,7 +53,7 @@ func (cmd *Env) Run(c *cli.Context) {
 	cmd.ui.Say("")
 
 	if len(envVars) == 0 {
-		cmd.ui.Say("No env variables exist")
+		cmd.ui.Say(i18n.T("No env variables exist"))
 		return
 	}
 	for key, value := range envVars {
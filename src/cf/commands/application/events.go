@@ -1,20 +1,40 @@
 package application
 
 import (
+	"bytes"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/codegangsta/cli"
+	"strings"
+	"time"
 )
 
+var eventTypes = []string{"crash", "create", "update", "delete"}
+
+var eventTimeFormats = []string{
+	"2006-01-02",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+}
+
 type Events struct {
 	ui         terminal.UI
 	config     configuration.Reader
 	appReq     requirements.ApplicationRequirement
 	eventsRepo api.AppEventsRepository
+
+	types  []string
+	since  time.Time
+	until  time.Time
+	format string
 }
 
 func NewEvents(ui terminal.UI, config configuration.Reader, eventsRepo api.AppEventsRepository) (cmd *Events) {
@@ -26,51 +46,292 @@ func NewEvents(ui terminal.UI, config configuration.Reader, eventsRepo api.AppEv
 }
 
 func (cmd *Events) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
-	if len(c.Args()) != 1 {
+	aggregated := c.Bool("space") || c.Bool("org")
+	if (c.Bool("space") && c.Bool("org")) || (aggregated && len(c.Args()) != 0) || (!aggregated && len(c.Args()) != 1) {
 		err = errors.New("Incorrect Usage")
 		cmd.ui.FailWithUsage(c, "events")
 		return
 	}
 
-	cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
+	err = cmd.parseFilters(c)
+	if err != nil {
+		cmd.ui.FailWithUsage(c, "events")
+		return
+	}
 
 	reqs = []requirements.Requirement{
 		reqFactory.NewLoginRequirement(),
-		reqFactory.NewTargetedSpaceRequirement(),
-		cmd.appReq,
+	}
+
+	switch {
+	case c.Bool("org"):
+		reqs = append(reqs, reqFactory.NewTargetedOrgRequirement())
+	case c.Bool("space"):
+		reqs = append(reqs, reqFactory.NewTargetedSpaceRequirement())
+	default:
+		cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
+		reqs = append(reqs, reqFactory.NewTargetedSpaceRequirement(), cmd.appReq)
 	}
 	return
 }
 
-func (cmd *Events) Run(c *cli.Context) {
-	app := cmd.appReq.GetApplication()
+func (cmd *Events) parseFilters(c *cli.Context) (err error) {
+	cmd.types = []string{}
+	if c.String("type") != "" {
+		for _, eventType := range strings.Split(c.String("type"), ",") {
+			eventType = strings.ToLower(strings.TrimSpace(eventType))
+			if !isKnownEventType(eventType) {
+				err = errors.New("Incorrect Usage")
+				return
+			}
+			cmd.types = append(cmd.types, eventType)
+		}
+	}
 
-	cmd.ui.Say("Getting events for app %s in org %s / space %s as %s...\n",
-		terminal.EntityNameColor(app.Name),
-		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
-		terminal.EntityNameColor(cmd.config.Username()),
-	)
+	cmd.since, err = parseEventTime(c.String("since"))
+	if err != nil {
+		return
+	}
+
+	cmd.until, err = parseEventTime(c.String("until"))
+	if err != nil {
+		return
+	}
 
-	table := cmd.ui.Table([]string{"time", "event", "description"})
-	noEvents := true
+	// a date without a time covers the whole day
+	if len(c.String("until")) == len("2006-01-02") {
+		cmd.until = cmd.until.Add(24*time.Hour - time.Nanosecond)
+	}
 
-	apiErr := cmd.eventsRepo.ListEvents(app.Guid, func(event models.EventFields) bool {
-		table.Print([][]string{{
-			event.Timestamp.Local().Format(TIMESTAMP_FORMAT),
-			event.Name,
-			event.Description,
-		}})
-		noEvents = false
+	cmd.format = strings.ToLower(c.String("format"))
+	if cmd.format != "" && cmd.format != "csv" && cmd.format != "json" {
+		err = errors.New("Incorrect Usage")
+	}
+	return
+}
+
+func (cmd *Events) Run(c *cli.Context) {
+	events := []models.EventFields{}
+	collect := func(event models.EventFields) bool {
+		if !cmd.until.IsZero() && event.Timestamp.After(cmd.until) {
+			return false
+		}
+		if cmd.matches(event, c.String("actor")) {
+			events = append(events, event)
+		}
 		return true
-	})
+	}
+
+	var apiErr error
+	var scope string
+
+	switch {
+	case c.Bool("org"):
+		scope = i18n.T("org %s", terminal.EntityNameColor(cmd.config.OrganizationFields().Name))
+		cmd.sayGettingEvents(scope)
+		apiErr = cmd.eventsRepo.ListEventsInOrg(cmd.config.OrganizationFields().Guid, cmd.since, collect)
+	case c.Bool("space"):
+		scope = i18n.T("org %s / space %s",
+			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			terminal.EntityNameColor(cmd.config.SpaceFields().Name))
+		cmd.sayGettingEvents(scope)
+		apiErr = cmd.eventsRepo.ListEventsInSpace(cmd.config.SpaceFields().Guid, cmd.since, collect)
+	default:
+		app := cmd.appReq.GetApplication()
+		scope = i18n.T("app %s", terminal.EntityNameColor(app.Name))
+		cmd.sayGettingEvents(i18n.T("app %s in org %s / space %s",
+			terminal.EntityNameColor(app.Name),
+			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			terminal.EntityNameColor(cmd.config.SpaceFields().Name)))
+		apiErr = cmd.eventsRepo.ListEvents(app.Guid, collect)
+	}
 
 	if apiErr != nil {
-		cmd.ui.Failed("Failed fetching events.\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Failed fetching events.\n%s"), apiErr.Error())
+		return
+	}
+
+	switch cmd.format {
+	case "csv":
+		cmd.printCSV(events)
+	case "json":
+		cmd.printJSON(events)
+	default:
+		cmd.printTable(events, scope, c.Bool("space") || c.Bool("org"))
+	}
+}
+
+func (cmd *Events) sayGettingEvents(scope string) {
+	if cmd.format != "" {
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Getting events for %s as %s...\n"), scope, terminal.EntityNameColor(cmd.config.Username()))
+}
+
+func (cmd *Events) printTable(events []models.EventFields, scope string, aggregated bool) {
+	if len(events) == 0 {
+		cmd.ui.Say(i18n.T("No events for %s"), scope)
+		return
+	}
+
+	headers := []string{i18n.T("time"), i18n.T("event"), i18n.T("actor"), i18n.T("description")}
+	if aggregated {
+		headers = []string{i18n.T("time"), i18n.T("target"), i18n.T("event"), i18n.T("actor"), i18n.T("description")}
+	}
+
+	table := cmd.ui.Table(headers)
+	rows := [][]string{}
+	for _, event := range events {
+		row := []string{event.Timestamp.Local().Format(TIMESTAMP_FORMAT), event.Name, eventActor(event), eventDescription(event)}
+		if aggregated {
+			row = append([]string{row[0], event.ActeeName}, row[1:]...)
+		}
+		rows = append(rows, row)
+	}
+	table.Print(rows)
+}
+
+func (cmd *Events) printCSV(events []models.EventFields) {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	writer.Write([]string{"time", "target", "event", "actor", "description"})
+	for _, event := range events {
+		writer.Write([]string{
+			event.Timestamp.UTC().Format(time.RFC3339),
+			event.ActeeName,
+			event.Name,
+			eventActor(event),
+			eventDescription(event),
+		})
+	}
+	writer.Flush()
+
+	cmd.ui.Say("%s", strings.TrimSuffix(buffer.String(), "\n"))
+}
+
+type eventExport struct {
+	Time        string `json:"time"`
+	Target      string `json:"target"`
+	Event       string `json:"event"`
+	Actor       string `json:"actor"`
+	Description string `json:"description"`
+}
+
+func (cmd *Events) printJSON(events []models.EventFields) {
+	exports := []eventExport{}
+	for _, event := range events {
+		exports = append(exports, eventExport{
+			Time:        event.Timestamp.UTC().Format(time.RFC3339),
+			Target:      event.ActeeName,
+			Event:       event.Name,
+			Actor:       eventActor(event),
+			Description: eventDescription(event),
+		})
+	}
+
+	output, err := json.MarshalIndent(exports, "", "  ")
+	if err != nil {
+		cmd.ui.FailWithError(err)
 		return
 	}
-	if noEvents {
-		cmd.ui.Say("No events for app %s", terminal.EntityNameColor(app.Name))
+
+	cmd.ui.Say("%s", output)
+}
+
+func (cmd *Events) matches(event models.EventFields, actor string) bool {
+	if !cmd.since.IsZero() && event.Timestamp.Before(cmd.since) {
+		return false
+	}
+
+	if actor != "" && actor != event.ActorName && actor != event.Actor {
+		return false
+	}
+
+	if len(cmd.types) == 0 {
+		return true
+	}
+
+	for _, eventType := range cmd.types {
+		if eventHasType(event, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// event names look like "audit.app.delete-request" or "app.crash"
+func eventHasType(event models.EventFields, eventType string) bool {
+	if eventType == "crash" {
+		return event.IsCrash()
+	}
+
+	nameParts := strings.Split(event.Name, ".")
+	return strings.HasPrefix(nameParts[len(nameParts)-1], eventType)
+}
+
+func eventActor(event models.EventFields) string {
+	if event.ActorName != "" {
+		return event.ActorName
+	}
+	return event.Actor
+}
+
+func eventDescription(event models.EventFields) string {
+	if !event.IsCrash() {
+		return event.Description
+	}
+
+	explanation := explainExitStatus(event.ExitStatus)
+	if explanation == "" {
+		return event.Description
+	}
+	return fmt.Sprintf("%s (%s)", event.Description, explanation)
+}
+
+func explainExitStatus(status int) string {
+	switch {
+	case status == 0:
+		return i18n.T("the app exited on its own")
+	case status == 126:
+		return i18n.T("the start command is not executable")
+	case status == 127:
+		return i18n.T("the start command was not found")
+	case status == 128+9:
+		return i18n.T("killed by SIGKILL, usually because the app exceeded its memory limit")
+	case status == 128+15:
+		return i18n.T("terminated by SIGTERM")
+	case status == 128+11:
+		return i18n.T("segmentation fault")
+	case status == 128+6:
+		return i18n.T("aborted by SIGABRT")
+	case status > 128 && status < 160:
+		return i18n.T("killed by signal %d", status-128)
+	case status > 0:
+		return i18n.T("the app exited with an error")
+	}
+	return ""
+}
+
+func isKnownEventType(eventType string) bool {
+	for _, known := range eventTypes {
+		if eventType == known {
+			return true
+		}
+	}
+	return false
+}
+
+func parseEventTime(value string) (parsed time.Time, err error) {
+	if value == "" {
 		return
 	}
+
+	for _, format := range eventTimeFormats {
+		parsed, err = time.ParseInLocation(format, value, time.Local)
+		if err == nil {
+			return
+		}
+	}
+	return
 }
@@ -5,6 +5,7 @@ import (
 	"cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"strings"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
@@ -46,12 +47,14 @@ var _ = Describe("Testing with ginkgo", func() {
 		event1.Name = "app crashed"
 		event1.Timestamp = timestamp
 		event1.Description = "reason: app instance exited, exit_status: 78"
+		event1.ExitStatus = 78
 
 		event2 := models.EventFields{}
 		event2.Guid = "event-guid-2"
 		event2.Name = "app crashed"
 		event2.Timestamp = timestamp
 		event2.Description = "reason: app instance was stopped, exit_status: 77"
+		event2.ExitStatus = 77
 
 		eventsRepo.Events = []models.EventFields{
 			event1,
@@ -83,6 +86,126 @@ var _ = Describe("Testing with ginkgo", func() {
 	})
 })
 
+var _ = Describe("events for a space or an org", func() {
+	var (
+		reqFactory *testreq.FakeReqFactory
+		eventsRepo *testapi.FakeAppEventsRepo
+	)
+
+	BeforeEach(func() {
+		reqFactory, eventsRepo = getEventsDependencies()
+		reqFactory.TargetedOrgSuccess = true
+
+		eventsRepo.Events = []models.EventFields{
+			{
+				Name:        "audit.app.create",
+				Timestamp:   time.Date(2014, 3, 1, 10, 0, 0, 0, time.UTC),
+				Description: "instances: 1",
+				ActorName:   "alice",
+				ActeeName:   "app-1",
+			},
+			{
+				Name:            "app.crash",
+				Timestamp:       time.Date(2014, 3, 2, 10, 0, 0, 0, time.UTC),
+				Description:     "index: 0, reason: CRASHED, exit_status: 137",
+				Actor:           "app-1-guid",
+				ActeeName:       "app-1",
+				ExitStatus:      137,
+				ExitDescription: "out of memory",
+			},
+			{
+				Name:        "audit.app.delete-request",
+				Timestamp:   time.Date(2014, 3, 3, 10, 0, 0, 0, time.UTC),
+				Description: "recursive: true",
+				ActorName:   "bob",
+				ActeeName:   "app-2",
+			},
+			{
+				Name:        "audit.app.update",
+				Timestamp:   time.Date(2014, 4, 1, 10, 0, 0, 0, time.UTC),
+				Description: "state: STOPPED",
+				ActorName:   "alice",
+				ActeeName:   "app-2",
+			},
+		}
+	})
+
+	It("fails with usage when given an app together with --space or --org", func() {
+		ui := callEvents([]string{"--space", "my-app"}, reqFactory, eventsRepo)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = callEvents([]string{"--space", "--org"}, reqFactory, eventsRepo)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("fails with usage when given an unknown type, date or format", func() {
+		ui := callEvents([]string{"--space", "--type", "scale"}, reqFactory, eventsRepo)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = callEvents([]string{"--space", "--since", "last tuesday"}, reqFactory, eventsRepo)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = callEvents([]string{"--space", "--format", "xml"}, reqFactory, eventsRepo)
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires a targeted org with --org", func() {
+		reqFactory.TargetedOrgSuccess = false
+		callEvents([]string{"--org"}, reqFactory, eventsRepo)
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("lists events for every app in the space, decoding crashes", func() {
+		ui := callEvents([]string{"--space"}, reqFactory, eventsRepo)
+
+		Expect(eventsRepo.SpaceGuid).To(Equal("my-space-guid"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting events for org", "my-org", "space", "my-space", "my-user"},
+			{"time", "target", "event", "actor", "description"},
+			{"app-1", "audit.app.create", "alice", "instances: 1"},
+			{"app-1", "app.crash", "app-1-guid", "exit_status: 137", "killed by SIGKILL"},
+			{"app-2", "audit.app.delete-request", "bob"},
+			{"app-2", "audit.app.update", "alice"},
+		})
+	})
+
+	It("filters events in the org by type, actor and time range", func() {
+		ui := callEvents([]string{"--org", "--type", "create,delete,update", "--actor", "alice", "--since", "2014-03-01", "--until", "2014-03-31"}, reqFactory, eventsRepo)
+
+		Expect(eventsRepo.OrganizationGuid).To(Equal("my-org-guid"))
+		Expect(eventsRepo.Since).To(Equal(time.Date(2014, 3, 1, 0, 0, 0, 0, time.Local)))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"app-1", "audit.app.create", "alice"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"app.crash"},
+			{"bob"},
+			{"state: STOPPED"},
+		})
+	})
+
+	It("exports events as csv", func() {
+		ui := callEvents([]string{"--space", "--type", "crash", "--format", "csv"}, reqFactory, eventsRepo)
+
+		Expect(ui.Outputs).To(Equal([]string{
+			"time,target,event,actor,description",
+			"2014-03-02T10:00:00Z,app-1,app.crash,app-1-guid,\"index: 0, reason: CRASHED, exit_status: 137 (killed by SIGKILL, usually because the app exceeded its memory limit)\"",
+		}))
+	})
+
+	It("exports events as json", func() {
+		ui := callEvents([]string{"--space", "--actor", "bob", "--format", "json"}, reqFactory, eventsRepo)
+
+		Expect(strings.Join(ui.Outputs, "\n")).To(MatchJSON(`[{
+			"time": "2014-03-03T10:00:00Z",
+			"target": "app-2",
+			"event": "audit.app.delete-request",
+			"actor": "bob",
+			"description": "recursive: true"
+		}]`))
+	})
+})
+
 func getEventsDependencies() (reqFactory *testreq.FakeReqFactory, eventsRepo *testapi.FakeAppEventsRepo) {
 	reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
 	eventsRepo = &testapi.FakeAppEventsRepo{}
@@ -3,17 +3,30 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
+	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
+	"fileutils"
+	"fmt"
 	"github.com/codegangsta/cli"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
+const DefaultTailPollInterval = 2 * time.Second
+
 type Files struct {
 	ui           terminal.UI
 	config       configuration.Reader
 	appFilesRepo api.AppFilesRepository
 	appReq       requirements.ApplicationRequirement
+
+	TailPollInterval time.Duration
 }
 
 func NewFiles(ui terminal.UI, config configuration.Reader, appFilesRepo api.AppFilesRepository) (cmd *Files) {
@@ -21,6 +34,7 @@ func NewFiles(ui terminal.UI, config configuration.Reader, appFilesRepo api.AppF
 	cmd.ui = ui
 	cmd.config = config
 	cmd.appFilesRepo = appFilesRepo
+	cmd.TailPollInterval = DefaultTailPollInterval
 	return
 }
 
@@ -31,6 +45,19 @@ func (cmd *Files) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 		return
 	}
 
+	modeCount := 0
+	for _, mode := range []bool{c.Bool("all-instances"), c.String("download") != "", c.Bool("tail")} {
+		if mode {
+			modeCount++
+		}
+	}
+
+	if c.Int("instance") < 0 || modeCount > 1 || (c.Bool("all-instances") && c.Int("instance") != 0) {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "files")
+		return
+	}
+
 	cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
 
 	reqs = []requirements.Requirement{
@@ -44,21 +71,40 @@ func (cmd *Files) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 func (cmd *Files) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
 
-	cmd.ui.Say("Getting files for app %s in org %s / space %s as %s...",
+	path := "/"
+	if len(c.Args()) > 1 {
+		path = c.Args()[1]
+	}
+
+	instance := c.Int("instance")
+	if instance > 0 && instance >= app.InstanceCount {
+		cmd.ui.Failed(i18n.T("Instance %d of app %s does not exist. The app has %d instance(s)."), instance, app.Name, app.InstanceCount)
+		return
+	}
+
+	switch {
+	case c.Bool("all-instances"):
+		cmd.listFilesOnAllInstances(app, path)
+	case c.String("download") != "":
+		cmd.downloadFiles(app, instance, path, c.String("download"))
+	case c.Bool("tail"):
+		cmd.tailFile(app, instance, path)
+	default:
+		cmd.listFiles(app, instance, path)
+	}
+}
+
+func (cmd *Files) listFiles(app models.Application, instance int, path string) {
+	cmd.ui.Say(i18n.T("Getting files for app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
-	path := "/"
-	if len(c.Args()) > 1 {
-		path = c.Args()[1]
-	}
-
-	list, apiErr := cmd.appFilesRepo.ListFiles(app.Guid, path)
+	list, apiErr := cmd.appFilesRepo.ListFiles(app.Guid, instance, path)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -66,3 +112,192 @@ func (cmd *Files) Run(c *cli.Context) {
 	cmd.ui.Say("")
 	cmd.ui.Say("%s", list)
 }
+
+func (cmd *Files) listFilesOnAllInstances(app models.Application, path string) {
+	cmd.ui.Say(i18n.T("Getting files for all instances of app %s in org %s / space %s as %s..."),
+		terminal.EntityNameColor(app.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	for index := 0; index < app.InstanceCount; index++ {
+		prefix := terminal.HeaderColor(fmt.Sprintf("#%d", index))
+
+		list, apiErr := cmd.appFilesRepo.ListFiles(app.Guid, index, path)
+		if apiErr != nil {
+			cmd.ui.Warn("%s %s", fmt.Sprintf("#%d", index), apiErr.Error())
+			continue
+		}
+
+		for _, line := range strings.Split(strings.TrimRight(list, "\n"), "\n") {
+			cmd.ui.Say("%s   %s", prefix, line)
+		}
+	}
+}
+
+func (cmd *Files) downloadFiles(app models.Application, instance int, remotePath, localDir string) {
+	cmd.ui.Say(i18n.T("Downloading %s from instance %d of app %s in org %s / space %s as %s..."),
+		terminal.EntityNameColor(remotePath),
+		instance,
+		terminal.EntityNameColor(app.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	isDir, err := cmd.isDirectory(app.Guid, instance, remotePath)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	var fileCount int
+	if isDir {
+		fileCount, err = cmd.downloadDirectory(app.Guid, instance, strings.TrimSuffix(remotePath, "/")+"/", localDir)
+	} else {
+		err = cmd.downloadFile(app.Guid, instance, remotePath, filepath.Join(localDir, path.Base(remotePath)))
+		fileCount = 1
+	}
+
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	cmd.ui.Say(i18n.T("Downloaded %d file(s) to %s"), fileCount, terminal.EntityNameColor(localDir))
+}
+
+func (cmd *Files) isDirectory(appGuid string, instance int, remotePath string) (isDir bool, err error) {
+	trimmedPath := strings.Trim(remotePath, "/")
+	if trimmedPath == "" || strings.HasSuffix(remotePath, "/") {
+		isDir = true
+		return
+	}
+
+	parentListing, err := cmd.appFilesRepo.ListFiles(appGuid, instance, path.Dir("/"+trimmedPath))
+	if err != nil {
+		return
+	}
+
+	for _, entry := range parseFileListing(parentListing) {
+		if entry == path.Base(trimmedPath)+"/" {
+			isDir = true
+			return
+		}
+	}
+	return
+}
+
+func (cmd *Files) downloadDirectory(appGuid string, instance int, remoteDir, localDir string) (fileCount int, err error) {
+	err = os.MkdirAll(localDir, os.ModeDir|os.ModeTemporary|os.ModePerm)
+	if err != nil {
+		return
+	}
+
+	listing, err := cmd.appFilesRepo.ListFiles(appGuid, instance, remoteDir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range parseFileListing(listing) {
+		remotePath := path.Join(remoteDir, entry)
+
+		var localPath string
+		localPath, err = localEntryPath(localDir, entry)
+		if err != nil {
+			return
+		}
+
+		if strings.HasSuffix(entry, "/") {
+			var count int
+			count, err = cmd.downloadDirectory(appGuid, instance, remotePath+"/", localPath)
+			fileCount += count
+		} else {
+			err = cmd.downloadFile(appGuid, instance, remotePath, localPath)
+			fileCount++
+		}
+
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// the listing comes from the instance, so an entry that is not a plain
+// name, like ../../.ssh/x or an absolute path, could write anywhere
+func localEntryPath(localDir, entry string) (localPath string, err error) {
+	name := strings.TrimSuffix(entry, "/")
+	localPath = filepath.Join(localDir, name)
+
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) ||
+		!strings.HasPrefix(localPath, filepath.Clean(localDir)+string(filepath.Separator)) {
+		err = errors.New(i18n.T("Refusing to download %s, it is not a file or directory name", entry))
+	}
+	return
+}
+
+func (cmd *Files) downloadFile(appGuid string, instance int, remotePath, localPath string) (err error) {
+	contents, err := cmd.appFilesRepo.ListFiles(appGuid, instance, remotePath)
+	if err != nil {
+		return
+	}
+
+	return fileutils.CopyReaderToPath(strings.NewReader(contents), localPath)
+}
+
+func (cmd *Files) tailFile(app models.Application, instance int, path string) {
+	cmd.ui.Say(i18n.T("Tailing %s on instance %d of app %s in org %s / space %s as %s..."),
+		terminal.EntityNameColor(path),
+		instance,
+		terminal.EntityNameColor(app.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+	cmd.ui.Say("")
+
+	var offset int64
+	partialLine := ""
+
+	for {
+		contents, apiErr := cmd.appFilesRepo.ReadFileFrom(app.Guid, instance, path, offset)
+		if apiErr != nil {
+			cmd.ui.FailWithError(apiErr)
+			return
+		}
+
+		offset += int64(len(contents))
+		lines := strings.Split(partialLine+contents, "\n")
+		partialLine = lines[len(lines)-1]
+
+		for _, line := range lines[:len(lines)-1] {
+			cmd.ui.Say("%s", line)
+		}
+
+		cmd.ui.Wait(cmd.TailPollInterval)
+	}
+}
+
+// directory listings have one entry per line: the name, followed by its size.
+// Names of directories end with a slash.
+func parseFileListing(listing string) (entries []string) {
+	for _, line := range strings.Split(listing, "\n") {
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 0:
+			continue
+		case 1:
+			entries = append(entries, fields[0])
+		default:
+			entries = append(entries, strings.Join(fields[:len(fields)-1], " "))
+		}
+	}
+	return
+}
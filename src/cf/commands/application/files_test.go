@@ -2,9 +2,13 @@ package application_test
 
 import (
 	. "cf/commands/application"
+	"cf/errors"
 	"cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
@@ -59,6 +63,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		})
 
 		Expect(appFilesRepo.AppGuid).To(Equal("my-app-guid"))
+		Expect(appFilesRepo.Instance).To(Equal(0))
 		Expect(appFilesRepo.Path).To(Equal("/foo"))
 	})
 	It("TestListingFilesWithTemplateTokens", func() {
@@ -76,6 +81,209 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"%s %d %i"},
 		})
 	})
+
+	Describe("selecting instances", func() {
+		var (
+			app          models.Application
+			reqFactory   *testreq.FakeReqFactory
+			appFilesRepo *testapi.FakeAppFilesRepo
+		)
+
+		BeforeEach(func() {
+			app = models.Application{}
+			app.Name = "my-found-app"
+			app.Guid = "my-app-guid"
+			app.InstanceCount = 3
+
+			reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true, Application: app}
+			appFilesRepo = &testapi.FakeAppFilesRepo{FileList: "file 1\nfile 2"}
+		})
+
+		It("lists files on the given instance", func() {
+			callFiles([]string{"--instance", "2", "my-app", "/foo"}, reqFactory, appFilesRepo)
+
+			Expect(appFilesRepo.Instance).To(Equal(2))
+			Expect(appFilesRepo.Path).To(Equal("/foo"))
+		})
+
+		It("fails when the instance does not exist", func() {
+			ui := callFiles([]string{"--instance", "3", "my-app", "/foo"}, reqFactory, appFilesRepo)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"Instance 3", "my-found-app", "does not exist"},
+			})
+			Expect(appFilesRepo.Paths).To(BeEmpty())
+		})
+
+		It("fails with usage when given a negative instance", func() {
+			ui := callFiles([]string{"--instance", "-1", "my-app", "/foo"}, reqFactory, appFilesRepo)
+
+			Expect(ui.FailedWithUsage).To(BeTrue())
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+
+		It("fails with usage when given more than one mode", func() {
+			ui := callFiles([]string{"--tail", "--all-instances", "my-app", "/foo"}, reqFactory, appFilesRepo)
+
+			Expect(ui.FailedWithUsage).To(BeTrue())
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+
+		It("shows the path on every instance, prefixed with the instance index", func() {
+			ui := callFiles([]string{"--all-instances", "my-app", "/foo"}, reqFactory, appFilesRepo)
+
+			Expect(appFilesRepo.Instances).To(Equal([]int{0, 1, 2}))
+			Expect(appFilesRepo.Paths).To(Equal([]string{"/foo", "/foo", "/foo"}))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Getting files for all instances", "my-found-app", "my-org", "my-space", "my-user"},
+				{"OK"},
+				{"#0", "file 1"},
+				{"#0", "file 2"},
+				{"#1", "file 1"},
+				{"#1", "file 2"},
+				{"#2", "file 1"},
+				{"#2", "file 2"},
+			})
+		})
+	})
+
+	Describe("downloading files", func() {
+		var (
+			reqFactory   *testreq.FakeReqFactory
+			appFilesRepo *testapi.FakeAppFilesRepo
+			downloadDir  string
+		)
+
+		BeforeEach(func() {
+			app := models.Application{}
+			app.Name = "my-found-app"
+			app.Guid = "my-app-guid"
+			app.InstanceCount = 2
+			reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true, Application: app}
+
+			var err error
+			downloadDir, err = ioutil.TempDir("", "files-download")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(downloadDir)
+		})
+
+		It("recursively downloads a directory tree", func() {
+			appFilesRepo = &testapi.FakeAppFilesRepo{FilesList: map[string]string{
+				"/":                           "logs/                 -\napp/                  -\n",
+				"logs/":                       "stdout.log            1.2K\ncrash dumps/          -\n",
+				"logs/crash dumps/":           "heap.hprof            64M\n",
+				"logs/stdout.log":             "some log lines",
+				"logs/crash dumps/heap.hprof": "heap dump bytes",
+			}}
+
+			ui := callFiles([]string{"--instance", "1", "--download", downloadDir, "my-app", "logs/"}, reqFactory, appFilesRepo)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Downloading", "logs/", "instance 1", "my-found-app"},
+				{"OK"},
+				{"Downloaded 2 file(s)", downloadDir},
+			})
+
+			Expect(appFilesRepo.Instances).To(Equal([]int{1, 1, 1, 1}))
+
+			contents, err := ioutil.ReadFile(filepath.Join(downloadDir, "stdout.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some log lines"))
+
+			contents, err = ioutil.ReadFile(filepath.Join(downloadDir, "crash dumps", "heap.hprof"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("heap dump bytes"))
+		})
+
+		It("detects directories given without a trailing slash", func() {
+			appFilesRepo = &testapi.FakeAppFilesRepo{FilesList: map[string]string{
+				"/":               "logs/                 -\n",
+				"logs/":           "stdout.log            1.2K\n",
+				"logs/stdout.log": "some log lines",
+			}}
+
+			callFiles([]string{"--download", downloadDir, "my-app", "logs"}, reqFactory, appFilesRepo)
+
+			contents, err := ioutil.ReadFile(filepath.Join(downloadDir, "stdout.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some log lines"))
+		})
+
+		It("refuses entries that would be written outside the directory", func() {
+			for _, entry := range []string{"../../.ssh/authorized_keys", "/etc/cron.d/x", "../", "..\\evil"} {
+				appFilesRepo = &testapi.FakeAppFilesRepo{FilesList: map[string]string{
+					"logs/":           entry + "            1.2K\nstdout.log            1.2K\n",
+					"logs/" + entry:   "pwned",
+					"logs/stdout.log": "some log lines",
+				}}
+
+				ui := callFiles([]string{"--download", downloadDir, "my-app", "logs/"}, reqFactory, appFilesRepo)
+
+				testassert.SliceContains(ui.Outputs, testassert.Lines{
+					{"FAILED"},
+					{"Refusing to download", entry},
+				})
+			}
+
+			_, err := os.Stat(filepath.Join(downloadDir, "..", "..", ".ssh", "authorized_keys"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			files, err := ioutil.ReadDir(downloadDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+
+		It("downloads a single file into the directory", func() {
+			appFilesRepo = &testapi.FakeAppFilesRepo{FilesList: map[string]string{
+				"/logs":           "stdout.log            1.2K\n",
+				"logs/stdout.log": "some log lines",
+			}}
+
+			ui := callFiles([]string{"--download", downloadDir, "my-app", "logs/stdout.log"}, reqFactory, appFilesRepo)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Downloaded 1 file(s)"},
+			})
+
+			contents, err := ioutil.ReadFile(filepath.Join(downloadDir, "stdout.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some log lines"))
+		})
+	})
+
+	It("tails a file by polling for new bytes", func() {
+		app := models.Application{}
+		app.Name = "my-found-app"
+		app.Guid = "my-app-guid"
+		app.InstanceCount = 2
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true, Application: app}
+
+		appFilesRepo := &testapi.FakeAppFilesRepo{
+			ReadFileFromResponses: []string{"line 1\nline", " 2\n", "", "line 3\n"},
+			ReadFileFromError:     errors.New("instance went away"),
+		}
+
+		ui := &testterm.FakeUI{}
+		ctxt := testcmd.NewContext("files", []string{"--instance", "1", "--tail", "my-app", "logs/stdout.log"})
+		cmd := NewFiles(ui, testconfig.NewRepositoryWithDefaults(), appFilesRepo)
+		cmd.TailPollInterval = 0
+		testcmd.RunCommand(cmd, ctxt, reqFactory)
+
+		Expect(appFilesRepo.Instance).To(Equal(1))
+		Expect(appFilesRepo.Path).To(Equal("logs/stdout.log"))
+		Expect(appFilesRepo.ReadFileFromOffsets).To(Equal([]int64{0, 11, 14, 14, 21}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Tailing", "logs/stdout.log", "instance 1", "my-found-app"},
+			{"line 1"},
+			{"line 2"},
+			{"line 3"},
+			{"FAILED"},
+			{"instance went away"},
+		})
+	})
 })
 
 func callFiles(args []string, reqFactory *testreq.FakeReqFactory, appFilesRepo *testapi.FakeAppFilesRepo) (ui *testterm.FakeUI) {
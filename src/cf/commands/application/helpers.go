@@ -43,8 +43,11 @@ func simpleLogMessageOutput(msg *logmessage.Message) (msgText string) {
 }
 
 func LogMessageOutput(msg *logmessage.Message) string {
-	logHeader, coloredLogHeader := extractLogHeader(msg)
-	logMsg := msg.GetLogMessage()
+	return logMessageOutput(msg.GetLogMessage())
+}
+
+func logMessageOutput(logMsg *logmessage.LogMessage) string {
+	logHeader, coloredLogHeader := extractLogHeader(logMsg)
 	logContent := extractLogContent(logMsg, logHeader)
 
 	return fmt.Sprintf("%s%s", coloredLogHeader, logContent)
@@ -57,8 +60,7 @@ func max(a, b int) int {
 	return b
 }
 
-func extractLogHeader(msg *logmessage.Message) (logHeader, coloredLogHeader string) {
-	logMsg := msg.GetLogMessage()
+func extractLogHeader(logMsg *logmessage.LogMessage) (logHeader, coloredLogHeader string) {
 	sourceName := logMsg.GetSourceName()
 	sourceID := logMsg.GetSourceId()
 	t := time.Unix(0, logMsg.GetTimestamp())
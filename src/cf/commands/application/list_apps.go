@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/formatters"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -32,7 +33,7 @@ func (cmd ListApps) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 }
 
 func (cmd ListApps) Run(c *cli.Context) {
-	cmd.ui.Say("Getting apps in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Getting apps in org %s / space %s as %s..."),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -41,7 +42,7 @@ func (cmd ListApps) Run(c *cli.Context) {
 	apps, apiErr := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -49,12 +50,12 @@ func (cmd ListApps) Run(c *cli.Context) {
 	cmd.ui.Say("")
 
 	if len(apps) == 0 {
-		cmd.ui.Say("No apps found")
+		cmd.ui.Say(i18n.T("No apps found"))
 		return
 	}
 
 	table := [][]string{
-		[]string{"name", "requested state", "instances", "memory", "disk", "urls"},
+		[]string{i18n.T("name"), i18n.T("requested state"), i18n.T("instances"), i18n.T("memory"), i18n.T("disk"), i18n.T("urls")},
 	}
 
 	for _, appSummary := range apps {
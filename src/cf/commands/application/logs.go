@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -63,7 +64,7 @@ func (cmd *Logs) Run(c *cli.Context) {
 
 func (cmd *Logs) recentLogsFor(app models.Application, logChan chan *logmessage.Message, errChan chan error) {
 	onConnect := func() {
-		cmd.ui.Say("Connected, dumping recent logs for app %s in org %s / space %s as %s...\n",
+		cmd.ui.Say(i18n.T("Connected, dumping recent logs for app %s in org %s / space %s as %s...\n"),
 			terminal.EntityNameColor(app.Name),
 			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 			terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -79,7 +80,7 @@ func (cmd *Logs) recentLogsFor(app models.Application, logChan chan *logmessage.
 
 func (cmd *Logs) tailLogsFor(app models.Application, logChan chan *logmessage.Message, errChan chan error) {
 	onConnect := func() {
-		cmd.ui.Say("Connected, tailing logs for app %s in org %s / space %s as %s...\n",
+		cmd.ui.Say(i18n.T("Connected, tailing logs for app %s in org %s / space %s as %s...\n"),
 			terminal.EntityNameColor(app.Name),
 			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 			terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -104,9 +105,9 @@ func (cmd *Logs) displayLogMessages(logChan chan *logmessage.Message, errChan ch
 			switch err.(type) {
 			case nil:
 			case *errors.InvalidSSLCert:
-				cmd.ui.Failed(err.Error() + "\nTIP: use the --skip-ssl-validation to suppress this error")
+				cmd.ui.Failed("%s\n%s", err.Error(), i18n.T("TIP: use the --skip-ssl-validation to suppress this error"))
 			default:
-				cmd.ui.Failed(err.Error())
+				cmd.ui.FailWithError(err)
 			}
 
 		case msg, ok := <-logChan:
@@ -6,11 +6,11 @@ import (
 	"cf/configuration"
 	"cf/errors"
 	"cf/formatters"
+	"cf/i18n"
 	"cf/manifest"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
-	"fmt"
 	"github.com/codegangsta/cli"
 	"os"
 	"regexp"
@@ -76,11 +76,11 @@ func (cmd *Push) Run(c *cli.Context) {
 
 		cmd.bindAppToRoute(app, appParams, c)
 
-		cmd.ui.Say("Uploading %s...", terminal.EntityNameColor(app.Name))
+		cmd.ui.Say(i18n.T("Uploading %s..."), terminal.EntityNameColor(app.Name))
 
 		apiErr := cmd.appBitsRepo.UploadApp(app.Guid, *appParams.Path, cmd.describeUploadOperation)
 		if apiErr != nil {
-			cmd.ui.Failed(fmt.Sprintf("Error uploading application.\n%s", apiErr.Error()))
+			cmd.ui.Failed(i18n.T("Error uploading application.\n%s", apiErr.Error()))
 			return
 		}
 		cmd.ui.Ok()
@@ -98,11 +98,11 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) {
 		serviceInstance, err := cmd.serviceRepo.FindInstanceByName(serviceName)
 
 		if err != nil {
-			cmd.ui.Failed("Could not find service %s to bind to %s", serviceName, app.Name)
+			cmd.ui.Failed(i18n.T("Could not find service %s to bind to %s"), serviceName, app.Name)
 			return
 		}
 
-		cmd.ui.Say("Binding service %s to %s in org %s / space %s as %s", serviceName, app.Name, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name, cmd.config.Username())
+		cmd.ui.Say(i18n.T("Binding service %s to %s in org %s / space %s as %s"), serviceName, app.Name, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name, cmd.config.Username())
 		err = cmd.binder.BindApplication(app, serviceInstance)
 
 		if err, ok := err.(errors.HttpError); ok && err.ErrorCode() == service.AppAlreadyBoundErrorCode {
@@ -110,7 +110,7 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) {
 		}
 
 		if err != nil {
-			cmd.ui.Failed("Could not find to service %s\nError: %s", serviceName, err)
+			cmd.ui.Failed(i18n.T("Could not find to service %s\nError: %s"), serviceName, err)
 		}
 
 		cmd.ui.Ok()
@@ -119,7 +119,7 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) {
 
 func (cmd *Push) describeUploadOperation(path string, zipFileBytes, fileCount uint64) {
 	humanReadableBytes := formatters.ByteSize(zipFileBytes)
-	cmd.ui.Say("Uploading from: %s\n%s, %d files", path, humanReadableBytes, fileCount)
+	cmd.ui.Say(i18n.T("Uploading from: %s\n%s, %d files"), path, humanReadableBytes, fileCount)
 }
 
 func (cmd *Push) fetchStackGuid(appParams *models.AppParams) {
@@ -128,11 +128,11 @@ func (cmd *Push) fetchStackGuid(appParams *models.AppParams) {
 	}
 
 	stackName := *appParams.StackName
-	cmd.ui.Say("Using stack %s...", terminal.EntityNameColor(stackName))
+	cmd.ui.Say(i18n.T("Using stack %s..."), terminal.EntityNameColor(stackName))
 
 	stack, apiErr := cmd.stackRepo.FindByName(stackName)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -142,52 +142,148 @@ func (cmd *Push) fetchStackGuid(appParams *models.AppParams) {
 
 func (cmd *Push) bindAppToRoute(app models.Application, params models.AppParams, c *cli.Context) {
 	if params.NoRoute {
-		cmd.ui.Say("App %s is a worker, skipping route creation", terminal.EntityNameColor(app.Name))
+		cmd.ui.Say(i18n.T("App %s is a worker, skipping route creation"), terminal.EntityNameColor(app.Name))
 		return
 	}
 
 	routeFlagsPresent := c.String("n") != "" || c.String("d") != "" || c.Bool("no-hostname")
-	if len(app.Routes) > 0 && !routeFlagsPresent {
+	manifestRoutesPresent := params.Hosts != nil || params.Domains != nil || params.Routes != nil
+	if len(app.Routes) > 0 && !routeFlagsPresent && !manifestRoutesPresent && !c.Bool("prune-routes") {
 		return
 	}
 
-	domain := cmd.findDomain(params)
-	hostname := cmd.hostnameForApp(params, c)
+	boundRoutes := []models.Route{}
+	for _, spec := range cmd.routesForApp(params, c) {
+		route := cmd.findOrCreateRoute(spec.host, spec.domain)
+		cmd.bindRoute(app, route, spec.host, spec.domain)
+		boundRoutes = append(boundRoutes, route)
+	}
+
+	if c.Bool("prune-routes") {
+		cmd.pruneRoutes(app, boundRoutes)
+	}
+}
+
+type routeSpec struct {
+	host   string
+	domain models.DomainFields
+}
+
+// every host is combined with every domain; a manifest with only a list
+// of routes does not get the default route as well
+func (cmd *Push) routesForApp(params models.AppParams, c *cli.Context) (specs []routeSpec) {
+	seen := map[string]bool{}
+	addRoute := func(host string, domain models.DomainFields) {
+		url := domain.UrlForHost(host)
+		if seen[url] {
+			return
+		}
+		seen[url] = true
+		specs = append(specs, routeSpec{host: host, domain: domain})
+	}
+
+	useHostsAndDomains := params.Routes == nil || params.Host != nil || params.Hosts != nil ||
+		params.Domain != nil || params.Domains != nil || params.UseRandomHostname || c.Bool("no-hostname")
 
+	if useHostsAndDomains {
+		hostnames := []string{cmd.hostnameForApp(params, c)}
+		if params.Hosts != nil && c.String("n") == "" && !c.Bool("no-hostname") {
+			hostnames = *params.Hosts
+		}
+
+		domains := []models.DomainFields{}
+		if params.Domains != nil && c.String("d") == "" {
+			for _, domainName := range *params.Domains {
+				domains = append(domains, cmd.findDomainByName(domainName))
+			}
+		} else {
+			domains = append(domains, cmd.findDomain(params))
+		}
+
+		for _, domain := range domains {
+			for _, hostname := range hostnames {
+				addRoute(hostname, domain)
+			}
+		}
+	}
+
+	if params.Routes != nil {
+		for _, url := range *params.Routes {
+			host, domain := cmd.findDomainForURL(url)
+			addRoute(host, domain)
+		}
+	}
+	return
+}
+
+func (cmd *Push) findOrCreateRoute(hostname string, domain models.DomainFields) (route models.Route) {
 	route, apiErr := cmd.routeRepo.FindByHostAndDomain(hostname, domain.Name)
 
 	switch apiErr.(type) {
 	case nil:
-		cmd.ui.Say("Using route %s", terminal.EntityNameColor(route.URL()))
+		cmd.ui.Say(i18n.T("Using route %s"), terminal.EntityNameColor(route.URL()))
 	case errors.ModelNotFoundError:
-		cmd.ui.Say("Creating route %s...", terminal.EntityNameColor(domain.UrlForHost(hostname)))
+		cmd.ui.Say(i18n.T("Creating route %s..."), terminal.EntityNameColor(domain.UrlForHost(hostname)))
 
 		route, apiErr = cmd.routeRepo.Create(hostname, domain.Guid)
 		if apiErr != nil {
-			cmd.ui.Failed(apiErr.Error())
+			cmd.ui.FailWithError(apiErr)
 		}
 
 		cmd.ui.Ok()
 		cmd.ui.Say("")
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 	}
+	return
+}
 
-	if !app.HasRoute(route) {
-		cmd.ui.Say("Binding %s to %s...", terminal.EntityNameColor(domain.UrlForHost(hostname)), terminal.EntityNameColor(app.Name))
+func (cmd *Push) bindRoute(app models.Application, route models.Route, hostname string, domain models.DomainFields) {
+	if app.HasRoute(route) {
+		return
+	}
 
-		apiErr = cmd.routeRepo.Bind(route.Guid, app.Guid)
-		switch apiErr := apiErr.(type) {
-		case nil:
-			cmd.ui.Ok()
-			cmd.ui.Say("")
-			return
-		case errors.HttpError:
-			if apiErr.ErrorCode() == errors.INVALID_RELATION {
-				cmd.ui.Failed("The route %s is already in use.\nTIP: Change the hostname with -n HOSTNAME or use --random-route to generate a new route and then push again.", route.URL())
+	cmd.ui.Say(i18n.T("Binding %s to %s..."), terminal.EntityNameColor(domain.UrlForHost(hostname)), terminal.EntityNameColor(app.Name))
+
+	apiErr := cmd.routeRepo.Bind(route.Guid, app.Guid)
+	switch apiErr := apiErr.(type) {
+	case nil:
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+		return
+	case errors.HttpError:
+		if apiErr.ErrorCode() == errors.INVALID_RELATION {
+			cmd.ui.Failed(i18n.T("The route %s is already in use.\nTIP: Change the hostname with -n HOSTNAME or use --random-route to generate a new route and then push again."), route.URL())
+		}
+	}
+	cmd.ui.FailWithError(apiErr)
+}
+
+// routes are only unmapped, so they can be mapped again or cleaned up
+// with delete-orphaned-routes
+func (cmd *Push) pruneRoutes(app models.Application, boundRoutes []models.Route) {
+	for _, appRoute := range app.Routes {
+		keep := false
+		for _, route := range boundRoutes {
+			if route.Guid == appRoute.Guid {
+				keep = true
+				break
 			}
 		}
-		cmd.ui.Failed(apiErr.Error())
+		if keep {
+			continue
+		}
+
+		cmd.ui.Say(i18n.T("Unbinding %s from %s..."), terminal.EntityNameColor(appRoute.URL()), terminal.EntityNameColor(app.Name))
+
+		apiErr := cmd.routeRepo.Unbind(appRoute.Guid, app.Guid)
+		if apiErr != nil {
+			cmd.ui.FailWithError(apiErr)
+			return
+		}
+
+		cmd.ui.Ok()
+		cmd.ui.Say("")
 	}
 }
 
@@ -237,20 +333,52 @@ func (cmd *Push) restart(app models.Application, params models.AppParams, c *cli
 func (cmd *Push) findDomain(appParams models.AppParams) (domain models.DomainFields) {
 	var err error
 	if appParams.Domain != nil {
-		domain, err = cmd.domainRepo.FindByNameInOrg(*appParams.Domain, cmd.config.OrganizationFields().Guid)
-		if err != nil {
-			cmd.ui.Failed(err.Error())
-		}
+		domain = cmd.findDomainByName(*appParams.Domain)
 	} else {
 		domain, err = cmd.findDefaultDomain()
 		if err != nil {
-			cmd.ui.Failed(err.Error())
+			cmd.ui.FailWithError(err)
 		}
 		if domain.Guid == "" {
-			cmd.ui.Failed("No default domain exists")
+			cmd.ui.Failed(i18n.T("No default domain exists"))
+		}
+	}
+
+	return
+}
+
+func (cmd *Push) findDomainByName(domainName string) (domain models.DomainFields) {
+	domain, err := cmd.domainRepo.FindByNameInOrg(domainName, cmd.config.OrganizationFields().Guid)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+	}
+	return
+}
+
+// hostnames can't contain dots, so a route URL is either a domain
+// or a hostname followed by a domain
+func (cmd *Push) findDomainForURL(url string) (hostname string, domain models.DomainFields) {
+	orgGuid := cmd.config.OrganizationFields().Guid
+
+	domain, err := cmd.domainRepo.FindByNameInOrg(url, orgGuid)
+	if err == nil {
+		return
+	}
+
+	parts := strings.SplitN(url, ".", 2)
+	if len(parts) == 2 {
+		domain, err = cmd.domainRepo.FindByNameInOrg(parts[1], orgGuid)
+		if err == nil {
+			hostname = parts[0]
+			return
 		}
 	}
 
+	if _, ok := err.(errors.ModelNotFoundError); ok {
+		cmd.ui.Failed(i18n.T("No domain found for route %s"), url)
+	} else {
+		cmd.ui.FailWithError(err)
+	}
 	return
 }
 
@@ -273,7 +401,7 @@ func (cmd *Push) findDefaultDomain() (domain models.DomainFields, err error) {
 	}
 
 	if !foundIt {
-		err = errors.New("Could not find a default domain")
+		err = errors.New(i18n.T("Could not find a default domain"))
 		return
 	}
 
@@ -282,7 +410,7 @@ func (cmd *Push) findDefaultDomain() (domain models.DomainFields, err error) {
 
 func (cmd *Push) createOrUpdateApp(appParams models.AppParams) (app models.Application) {
 	if appParams.Name == nil {
-		cmd.ui.Failed("Error: No name found for app")
+		cmd.ui.Failed(i18n.T("Error: No name found for app"))
 	}
 
 	app, apiErr := cmd.appRepo.Read(*appParams.Name)
@@ -293,11 +421,11 @@ func (cmd *Push) createOrUpdateApp(appParams models.AppParams) (app models.Appli
 	case errors.ModelNotFoundError:
 		app, apiErr = cmd.createApp(appParams)
 		if apiErr != nil {
-			cmd.ui.Failed(apiErr.Error())
+			cmd.ui.FailWithError(apiErr)
 			return
 		}
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -308,7 +436,7 @@ func (cmd *Push) createApp(appParams models.AppParams) (app models.Application,
 	spaceGuid := cmd.config.SpaceFields().Guid
 	appParams.SpaceGuid = &spaceGuid
 
-	cmd.ui.Say("Creating app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Creating app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(*appParams.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -317,7 +445,7 @@ func (cmd *Push) createApp(appParams models.AppParams) (app models.Application,
 
 	app, apiErr = cmd.appRepo.Create(appParams)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -328,7 +456,7 @@ func (cmd *Push) createApp(appParams models.AppParams) (app models.Application,
 }
 
 func (cmd *Push) updateApp(app models.Application, appParams models.AppParams) (updatedApp models.Application) {
-	cmd.ui.Say("Updating app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Updating app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -346,7 +474,7 @@ func (cmd *Push) updateApp(app models.Application, appParams models.AppParams) (
 	var apiErr error
 	updatedApp, apiErr = cmd.appRepo.Update(app.Guid, appParams)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -361,18 +489,18 @@ func (cmd *Push) findAndValidateAppsToPush(c *cli.Context) (appSet []models.AppP
 
 	contextParams, err := newAppParamsFromContext(c)
 	if err != nil {
-		cmd.ui.Failed("Error: %s", err)
+		cmd.ui.Failed(i18n.T("Error: %s"), err)
 		return
 	}
 
 	if contextParams.Name == nil && len(apps) > 1 && !contextParams.Equals(&models.AppParams{}) {
-		cmd.ui.Failed("%s", "Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file.")
+		cmd.ui.Failed("%s", i18n.T("Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file."))
 		return
 	}
 
 	appSet, err = cmd.createAppSetFromContextAndManifest(c, contextParams, apps)
 	if err != nil {
-		cmd.ui.Failed("Error: %s", err)
+		cmd.ui.Failed(i18n.T("Error: %s"), err)
 	}
 
 	return
@@ -390,7 +518,7 @@ func (cmd *Push) instantiateManifest(c *cli.Context) []models.AppParams {
 		var err error
 		path, err = os.Getwd()
 		if err != nil {
-			cmd.ui.Failed("Could not determine the current working directory!", err)
+			cmd.ui.Failed(i18n.T("Could not determine the current working directory!"), err)
 		}
 	}
 
@@ -400,7 +528,7 @@ func (cmd *Push) instantiateManifest(c *cli.Context) []models.AppParams {
 		if m.Path == "" && c.String("f") == "" {
 			return []models.AppParams{}
 		} else {
-			cmd.ui.Failed("Error reading manifest file:\n%s", errs)
+			cmd.ui.Failed(i18n.T("Error reading manifest file:\n%s"), errs)
 		}
 	}
 
@@ -409,11 +537,11 @@ func (cmd *Push) instantiateManifest(c *cli.Context) []models.AppParams {
 		if m.Path == "" && c.String("f") == "" {
 			return []models.AppParams{}
 		} else {
-			cmd.ui.Failed("Error reading manifest file:\n%s", errs)
+			cmd.ui.Failed(i18n.T("Error reading manifest file:\n%s"), errs)
 		}
 	}
 
-	cmd.ui.Say("Using manifest file %s\n", terminal.EntityNameColor(m.Path))
+	cmd.ui.Say(i18n.T("Using manifest file %s\n"), terminal.EntityNameColor(m.Path))
 	return apps
 }
 
@@ -424,7 +552,7 @@ func (cmd *Push) createAppSetFromContextAndManifest(c *cli.Context, contextParam
 			app, err = findAppWithNameInManifest(*contextParams.Name, manifestApps)
 
 			if err != nil {
-				cmd.ui.Failed(fmt.Sprintf("Could not find app named '%s' in manifest", *contextParams.Name))
+				cmd.ui.Failed(i18n.T("Could not find app named '%s' in manifest", *contextParams.Name))
 				return
 			}
 
@@ -451,7 +579,7 @@ func (cmd *Push) createAppSetFromContextAndManifest(c *cli.Context, contextParam
 
 func addApp(apps *[]models.AppParams, app models.AppParams) (err error) {
 	if app.Name == nil {
-		err = errors.New("app name is a required field")
+		err = errors.New(i18n.T("app name is a required field"))
 	}
 	if app.Path == nil {
 		cwd, _ := os.Getwd()
@@ -469,7 +597,7 @@ func findAppWithNameInManifest(name string, manifestApps []models.AppParams) (ap
 		}
 	}
 
-	err = errors.New("Could not find named app in manifest")
+	err = errors.New(i18n.T("Could not find named app in manifest"))
 	return
 }
 
@@ -487,7 +615,7 @@ func newAppParamsFromContext(c *cli.Context) (appParams models.AppParams, err er
 		var memory uint64
 		memory, err = formatters.ToMegabytes(c.String("m"))
 		if err != nil {
-			err = errors.New(fmt.Sprintf("Invalid memory param: %s\n%s", c.String("m"), err))
+			err = errors.New(i18n.T("Invalid memory param: %s\n%s", c.String("m"), err))
 			return
 		}
 		appParams.Memory = &memory
@@ -520,7 +648,7 @@ func newAppParamsFromContext(c *cli.Context) (appParams models.AppParams, err er
 		var instances int
 		instances, err = strconv.Atoi(c.String("i"))
 		if err != nil {
-			err = errors.New(fmt.Sprintf("Invalid instances param: %s\n%s", c.String("i"), err))
+			err = errors.New(i18n.T("Invalid instances param: %s\n%s", c.String("i"), err))
 			return
 		}
 		appParams.InstanceCount = &instances
@@ -535,7 +663,7 @@ func newAppParamsFromContext(c *cli.Context) (appParams models.AppParams, err er
 		var timeout int
 		timeout, err = strconv.Atoi(c.String("t"))
 		if err != nil {
-			err = errors.New(fmt.Sprintf("Invalid timeout param: %s\n%s", c.String("t"), err))
+			err = errors.New(i18n.T("Invalid timeout param: %s\n%s", c.String("t"), err))
 			return
 		}
 
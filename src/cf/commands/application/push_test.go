@@ -808,6 +808,132 @@ var _ = Describe("Push Command", func() {
 		})
 	})
 
+	Describe("multiple routes in the manifest", func() {
+		BeforeEach(func() {
+			domainRepo.FindByNameInOrgDomains = map[string]models.DomainFields{
+				"example.com": models.DomainFields{Name: "example.com", Guid: "example-domain-guid"},
+				"example.org": models.DomainFields{Name: "example.org", Guid: "org-domain-guid"},
+			}
+			routeRepo.FindByHostAndDomainErr = true
+			appRepo.ReadNotFound = true
+		})
+
+		It("creates and binds a route for every host and domain", func() {
+			manifestRepo.ReadManifestReturns.Manifest = manifestWithRoutes(map[interface{}]interface{}{
+				"hosts":   []interface{}{"app-one", "app-two"},
+				"domains": []interface{}{"example.com", "example.org"},
+			})
+
+			callPush()
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Creating route", "app-one.example.com"},
+				{"Binding", "app-one.example.com"},
+				{"Creating route", "app-two.example.com"},
+				{"Binding", "app-two.example.com"},
+				{"Creating route", "app-one.example.org"},
+				{"Binding", "app-one.example.org"},
+				{"Creating route", "app-two.example.org"},
+				{"Binding", "app-two.example.org"},
+			})
+			Expect(routeRepo.CreatedHosts).To(Equal([]string{"app-one", "app-two", "app-one", "app-two"}))
+			Expect(routeRepo.CreatedDomainGuids).To(Equal([]string{
+				"example-domain-guid", "example-domain-guid", "org-domain-guid", "org-domain-guid",
+			}))
+			Expect(len(routeRepo.BoundRouteGuids)).To(Equal(4))
+		})
+
+		It("creates and binds every route in the routes list", func() {
+			manifestRepo.ReadManifestReturns.Manifest = manifestWithRoutes(map[interface{}]interface{}{
+				"routes": []interface{}{"www.example.com", "example.org", "www.example.com"},
+			})
+
+			callPush()
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Creating route", "www.example.com"},
+				{"Binding", "www.example.com"},
+				{"Creating route", "example.org"},
+				{"Binding", "example.org"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"manifest-app-name.example.com"},
+			})
+			Expect(routeRepo.CreatedHosts).To(Equal([]string{"www", ""}))
+			Expect(routeRepo.CreatedDomainGuids).To(Equal([]string{"example-domain-guid", "org-domain-guid"}))
+		})
+
+		It("fails when a route in the routes list has no matching domain", func() {
+			manifestRepo.ReadManifestReturns.Manifest = manifestWithRoutes(map[interface{}]interface{}{
+				"routes": []interface{}{"www.unknown.net"},
+			})
+
+			callPush()
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"No domain found for route www.unknown.net"},
+			})
+			Expect(routeRepo.CreatedHosts).To(BeEmpty())
+		})
+
+		It("unbinds routes no longer listed when --prune-routes is given", func() {
+			keptRoute := models.RouteSummary{Guid: "kept-route-guid", Host: "www"}
+			keptRoute.Domain = models.DomainFields{Name: "example.com"}
+			staleRoute := models.RouteSummary{Guid: "stale-route-guid", Host: "old"}
+			staleRoute.Domain = models.DomainFields{Name: "example.com"}
+
+			existingApp := models.Application{}
+			existingApp.Name = "manifest-app-name"
+			existingApp.Guid = "existing-app-guid"
+			existingApp.Routes = []models.RouteSummary{keptRoute, staleRoute}
+
+			appRepo.ReadNotFound = false
+			appRepo.ReadApp = existingApp
+			appRepo.UpdateAppResult = existingApp
+			routeRepo.FindByHostAndDomainErr = false
+			routeRepo.FindByHostAndDomainRoute = models.Route{RouteSummary: keptRoute}
+
+			manifestRepo.ReadManifestReturns.Manifest = manifestWithRoutes(map[interface{}]interface{}{
+				"routes": []interface{}{"www.example.com"},
+			})
+
+			callPush("--prune-routes")
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Using route", "www.example.com"},
+				{"Unbinding", "old.example.com", "manifest-app-name"},
+				{"OK"},
+			})
+			Expect(routeRepo.BoundRouteGuids).To(BeEmpty())
+			Expect(routeRepo.UnboundRouteGuids).To(Equal([]string{"stale-route-guid"}))
+			Expect(routeRepo.UnboundAppGuid).To(Equal("existing-app-guid"))
+		})
+
+		It("leaves routes that are no longer listed bound without --prune-routes", func() {
+			staleRoute := models.RouteSummary{Guid: "stale-route-guid", Host: "old"}
+			staleRoute.Domain = models.DomainFields{Name: "example.com"}
+
+			existingApp := models.Application{}
+			existingApp.Name = "manifest-app-name"
+			existingApp.Guid = "existing-app-guid"
+			existingApp.Routes = []models.RouteSummary{staleRoute}
+
+			appRepo.ReadNotFound = false
+			appRepo.ReadApp = existingApp
+			appRepo.UpdateAppResult = existingApp
+
+			manifestRepo.ReadManifestReturns.Manifest = manifestWithRoutes(map[interface{}]interface{}{
+				"routes": []interface{}{"www.example.com"},
+			})
+
+			callPush()
+
+			Expect(routeRepo.CreatedHosts).To(Equal([]string{"www"}))
+			Expect(routeRepo.UnboundRouteGuids).To(BeEmpty())
+		})
+	})
+
 	It("displays information about the files being uploaded", func() {
 		appRepo.ReadNotFound = true
 		appBitsRepo.CallbackPath = "path/to/app"
@@ -900,6 +1026,22 @@ func singleAppManifest() *manifest.Manifest {
 	}
 }
 
+func manifestWithRoutes(routes map[interface{}]interface{}) *manifest.Manifest {
+	app := map[interface{}]interface{}{
+		"name": "manifest-app-name",
+	}
+	for key, value := range routes {
+		app[key] = value
+	}
+
+	return &manifest.Manifest{
+		Path: "manifest.yml",
+		Data: generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{generic.NewMap(app)},
+		}),
+	}
+}
+
 func manifestWithServicesAndEnv() *manifest.Manifest {
 	return &manifest.Manifest{
 		Data: generic.NewMap(map[interface{}]interface{}{
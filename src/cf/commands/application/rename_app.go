@@ -3,6 +3,7 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -43,7 +44,7 @@ func (cmd *RenameApp) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
 	newName := c.Args()[1]
 
-	cmd.ui.Say("Renaming app %s to %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Renaming app %s to %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(newName),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -55,7 +56,7 @@ func (cmd *RenameApp) Run(c *cli.Context) {
 
 	_, apiErr := cmd.appRepo.Update(app.Guid, params)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 	cmd.ui.Ok()
@@ -1,34 +1,50 @@
 package application
 
 import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
+	"fmt"
 	"github.com/codegangsta/cli"
+	"strings"
+	"time"
 )
 
 type Restart struct {
-	ui      terminal.UI
-	starter ApplicationStarter
-	stopper ApplicationStopper
-	appReq  requirements.ApplicationRequirement
+	ui               terminal.UI
+	config           configuration.Reader
+	starter          ApplicationStarter
+	stopper          ApplicationStopper
+	appInstancesRepo api.AppInstancesRepository
+	appReq           requirements.ApplicationRequirement
+
+	StartupTimeout time.Duration
+	PingerThrottle time.Duration
 }
 
 type ApplicationRestarter interface {
 	ApplicationRestart(app models.Application)
 }
 
-func NewRestart(ui terminal.UI, starter ApplicationStarter, stopper ApplicationStopper) (cmd *Restart) {
+func NewRestart(ui terminal.UI, config configuration.Reader, starter ApplicationStarter, stopper ApplicationStopper, appInstancesRepo api.AppInstancesRepository) (cmd *Restart) {
 	cmd = new(Restart)
 	cmd.ui = ui
+	cmd.config = config
 	cmd.starter = starter
 	cmd.stopper = stopper
+	cmd.appInstancesRepo = appInstancesRepo
+	cmd.StartupTimeout = DefaultStartupTimeout
+	cmd.PingerThrottle = DefaultPingerThrottle
 	return
 }
 
 func (cmd *Restart) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
-	if len(c.Args()) == 0 {
+	if len(c.Args()) == 0 || c.Int("batch-size") < 0 || (c.IsSet("batch-size") && !c.Bool("rolling")) {
 		err = errors.New("Incorrect Usage")
 		cmd.ui.FailWithUsage(c, "restart")
 		return
@@ -46,13 +62,23 @@ func (cmd *Restart) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 
 func (cmd *Restart) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
+
+	if c.Bool("rolling") {
+		batchSize := c.Int("batch-size")
+		if batchSize == 0 {
+			batchSize = 1
+		}
+		cmd.RollingRestart(app, batchSize)
+		return
+	}
+
 	cmd.ApplicationRestart(app)
 }
 
 func (cmd *Restart) ApplicationRestart(app models.Application) {
 	stoppedApp, err := cmd.stopper.ApplicationStop(app)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
 		return
 	}
 
@@ -60,7 +86,125 @@ func (cmd *Restart) ApplicationRestart(app models.Application) {
 
 	_, err = cmd.starter.ApplicationStart(stoppedApp)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
+		return
+	}
+}
+
+func (cmd *Restart) RollingRestart(app models.Application, batchSize int) {
+	cmd.ui.Say(i18n.T("Restarting app %s in org %s / space %s as %s, %d instance(s) at a time..."),
+		terminal.EntityNameColor(app.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+		batchSize,
+	)
+
+	if app.State != "started" {
+		cmd.ui.Failed(i18n.T("App %s is not started. Use '%s' to start it."), app.Name, terminal.CommandColor(fmt.Sprintf("%s start %s", cf.Name(), app.Name)))
 		return
 	}
+
+	instances, apiErr := cmd.appInstancesRepo.GetInstances(app.Guid)
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	if len(instances) < 2 {
+		cmd.ui.Warn(i18n.T("App %s has only one instance, it will be unavailable while it restarts."), app.Name)
+	}
+
+	for start := 0; start < len(instances); start += batchSize {
+		end := start + batchSize
+		if end > len(instances) {
+			end = len(instances)
+		}
+
+		batch := []int{}
+		for index := start; index < end; index++ {
+			batch = append(batch, index)
+		}
+
+		cmd.ui.Say("")
+		cmd.ui.Say(i18n.T("Restarting %s..."), formatInstanceIndexes(batch))
+
+		for _, index := range batch {
+			apiErr = cmd.appInstancesRepo.DeleteInstance(app.Guid, index)
+			if apiErr != nil {
+				cmd.ui.FailWithError(apiErr)
+				return
+			}
+		}
+
+		err := cmd.waitForRestartedInstances(app, batch, instances)
+		if err != nil {
+			cmd.ui.Failed(i18n.T("%s\n\nRolling restart aborted, instances after %s were not restarted.\n\nTIP: use '%s' for more information"),
+				err.Error(),
+				formatInstanceIndexes(batch),
+				terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name)),
+			)
+			return
+		}
+
+		cmd.ui.Ok()
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say("%s", terminal.HeaderColor(i18n.T("App restarted")))
+}
+
+// instances restarted by the health manager come back with a new "since" time,
+// which tells them apart from instances that have not been stopped yet
+func (cmd *Restart) waitForRestartedInstances(app models.Application, batch []int, previous []models.AppInstanceFields) (err error) {
+	startupStartTime := time.Now()
+
+	for {
+		if time.Since(startupStartTime) > cmd.StartupTimeout {
+			err = errors.New(i18n.T("Timed out waiting for %s to start", formatInstanceIndexes(batch)))
+			return
+		}
+
+		cmd.ui.Wait(cmd.PingerThrottle)
+
+		instances, apiErr := cmd.appInstancesRepo.GetInstances(app.Guid)
+		if apiErr != nil {
+			continue
+		}
+
+		runningCount := 0
+		for _, index := range batch {
+			if index >= len(instances) {
+				continue
+			}
+
+			instance := instances[index]
+			if instance.State == models.InstanceFlapping {
+				err = errors.New(i18n.T("Instance #%d is crashing", index))
+				return
+			}
+
+			if instance.State == models.InstanceRunning && !instance.Since.Equal(previous[index].Since) {
+				runningCount++
+			}
+		}
+
+		cmd.ui.Say(i18n.T("%d of %d instances in batch running"), runningCount, len(batch))
+
+		if runningCount == len(batch) {
+			return
+		}
+	}
+}
+
+func formatInstanceIndexes(indexes []int) string {
+	names := []string{}
+	for _, index := range indexes {
+		names = append(names, fmt.Sprintf("#%d", index))
+	}
+
+	if len(indexes) > 1 {
+		return i18n.T("instances %s", strings.Join(names, ", "))
+	}
+	return i18n.T("instance %s", strings.Join(names, ", "))
 }
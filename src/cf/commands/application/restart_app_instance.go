@@ -0,0 +1,77 @@
+package application
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strconv"
+)
+
+type RestartAppInstance struct {
+	ui               terminal.UI
+	config           configuration.Reader
+	appInstancesRepo api.AppInstancesRepository
+	appReq           requirements.ApplicationRequirement
+	index            int
+}
+
+func NewRestartAppInstance(ui terminal.UI, config configuration.Reader, appInstancesRepo api.AppInstancesRepository) (cmd *RestartAppInstance) {
+	cmd = new(RestartAppInstance)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.appInstancesRepo = appInstancesRepo
+	return
+}
+
+func (cmd *RestartAppInstance) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "restart-app-instance")
+		return
+	}
+
+	cmd.index, err = strconv.Atoi(c.Args()[1])
+	if err != nil || cmd.index < 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "restart-app-instance")
+		return
+	}
+
+	cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+	return
+}
+
+func (cmd *RestartAppInstance) Run(c *cli.Context) {
+	app := cmd.appReq.GetApplication()
+
+	cmd.ui.Say(i18n.T("Restarting instance %d of app %s in org %s / space %s as %s..."),
+		cmd.index,
+		terminal.EntityNameColor(app.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	if cmd.index >= app.InstanceCount {
+		cmd.ui.Failed(i18n.T("Instance %d of app %s does not exist. The app has %d instance(s)."), cmd.index, app.Name, app.InstanceCount)
+		return
+	}
+
+	apiErr := cmd.appInstancesRepo.DeleteInstance(app.Guid, cmd.index)
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Ok()
+}
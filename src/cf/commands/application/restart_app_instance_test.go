@@ -0,0 +1,82 @@
+package application_test
+
+import (
+	. "cf/commands/application"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("restart-app-instance command", func() {
+	var (
+		app              models.Application
+		reqFactory       *testreq.FakeReqFactory
+		appInstancesRepo *testapi.FakeAppInstancesRepo
+	)
+
+	BeforeEach(func() {
+		app = models.Application{}
+		app.Name = "my-app"
+		app.Guid = "my-app-guid"
+		app.InstanceCount = 2
+		reqFactory = &testreq.FakeReqFactory{Application: app, LoginSuccess: true, TargetedSpaceSuccess: true}
+		appInstancesRepo = &testapi.FakeAppInstancesRepo{}
+	})
+
+	callRestartAppInstance := func(args []string) (ui *testterm.FakeUI) {
+		ui = new(testterm.FakeUI)
+		cmd := NewRestartAppInstance(ui, testconfig.NewRepositoryWithDefaults(), appInstancesRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("restart-app-instance", args), reqFactory)
+		return
+	}
+
+	It("fails with usage when not given an app and a numeric index", func() {
+		ui := callRestartAppInstance([]string{"my-app"})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = callRestartAppInstance([]string{"my-app", "first"})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = callRestartAppInstance([]string{"my-app", "1"})
+		Expect(ui.FailedWithUsage).To(BeFalse())
+	})
+
+	It("requires the user to be logged in and have a targeted space", func() {
+		reqFactory.LoginSuccess = false
+		callRestartAppInstance([]string{"my-app", "1"})
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+
+		reqFactory.LoginSuccess = true
+		reqFactory.TargetedSpaceSuccess = false
+		callRestartAppInstance([]string{"my-app", "1"})
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("restarts the instance", func() {
+		ui := callRestartAppInstance([]string{"my-app", "1"})
+
+		Expect(reqFactory.ApplicationName).To(Equal("my-app"))
+		Expect(appInstancesRepo.DeleteInstanceAppGuid).To(Equal("my-app-guid"))
+		Expect(appInstancesRepo.DeletedInstances).To(Equal([]int{1}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Restarting instance 1 of app", "my-app", "my-org", "my-space", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("fails when the instance does not exist", func() {
+		ui := callRestartAppInstance([]string{"my-app", "2"})
+
+		Expect(appInstancesRepo.DeletedInstances).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Instance 2 of app my-app does not exist"},
+		})
+	})
+})
@@ -5,16 +5,30 @@ import (
 	"cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
 	testreq "testhelpers/requirements"
 	testterm "testhelpers/terminal"
+	"time"
 )
 
 func callRestart(args []string, reqFactory *testreq.FakeReqFactory, starter ApplicationStarter, stopper ApplicationStopper) (ui *testterm.FakeUI) {
 	ui = new(testterm.FakeUI)
 	ctxt := testcmd.NewContext("restart", args)
 
-	cmd := NewRestart(ui, starter, stopper)
+	cmd := NewRestart(ui, testconfig.NewRepositoryWithDefaults(), starter, stopper, &testapi.FakeAppInstancesRepo{})
+	testcmd.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
+
+func callRollingRestart(args []string, reqFactory *testreq.FakeReqFactory, appInstancesRepo *testapi.FakeAppInstancesRepo) (ui *testterm.FakeUI) {
+	ui = new(testterm.FakeUI)
+	ctxt := testcmd.NewContext("restart", args)
+
+	cmd := NewRestart(ui, testconfig.NewRepositoryWithDefaults(), &testcmd.FakeAppStarter{}, &testcmd.FakeAppStopper{}, appInstancesRepo)
+	cmd.PingerThrottle = 0
 	testcmd.RunCommand(cmd, ctxt, reqFactory)
 	return
 }
@@ -63,4 +77,95 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(stopper.AppToStop).To(Equal(app))
 		Expect(starter.AppToStart).To(Equal(app))
 	})
+
+	It("fails with usage when given a batch size without --rolling", func() {
+		reqFactory := &testreq.FakeReqFactory{}
+		ui := callRestart([]string{"--batch-size", "2", "my-app"}, reqFactory, &testcmd.FakeAppStarter{}, &testcmd.FakeAppStopper{})
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	Describe("rolling restarts", func() {
+		var (
+			app          models.Application
+			reqFactory   *testreq.FakeReqFactory
+			before       time.Time
+			after        time.Time
+			runningSince func(since time.Time) models.AppInstanceFields
+		)
+
+		BeforeEach(func() {
+			app = models.Application{}
+			app.Name = "my-app"
+			app.Guid = "my-app-guid"
+			app.State = "started"
+			app.InstanceCount = 3
+			reqFactory = &testreq.FakeReqFactory{Application: app, LoginSuccess: true, TargetedSpaceSuccess: true}
+
+			before = time.Unix(1000, 0)
+			after = time.Unix(2000, 0)
+			runningSince = func(since time.Time) models.AppInstanceFields {
+				return models.AppInstanceFields{State: models.InstanceRunning, Since: since}
+			}
+		})
+
+		It("restarts instances in batches, waiting for each batch to run again", func() {
+			starting := models.AppInstanceFields{State: models.InstanceStarting, Since: after}
+			appInstancesRepo := &testapi.FakeAppInstancesRepo{
+				GetInstancesResponses: [][]models.AppInstanceFields{
+					{runningSince(before), runningSince(before), runningSince(before)},
+					{runningSince(before), starting, runningSince(before)},
+					{runningSince(after), runningSince(after), runningSince(before)},
+					{runningSince(after), runningSince(after), runningSince(after)},
+				},
+			}
+
+			ui := callRollingRestart([]string{"--rolling", "--batch-size", "2", "my-app"}, reqFactory, appInstancesRepo)
+
+			Expect(appInstancesRepo.DeleteInstanceAppGuid).To(Equal("my-app-guid"))
+			Expect(appInstancesRepo.DeletedInstances).To(Equal([]int{0, 1, 2}))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Restarting app", "my-app", "my-org", "my-space", "my-user", "2 instance(s) at a time"},
+				{"Restarting instances #0, #1"},
+				{"0 of 2 instances in batch running"},
+				{"2 of 2 instances in batch running"},
+				{"OK"},
+				{"Restarting instance #2"},
+				{"1 of 1 instances in batch running"},
+				{"App restarted"},
+			})
+		})
+
+		It("aborts when a restarted instance is crashing", func() {
+			appInstancesRepo := &testapi.FakeAppInstancesRepo{
+				GetInstancesResponses: [][]models.AppInstanceFields{
+					{runningSince(before), runningSince(before), runningSince(before)},
+					{{State: models.InstanceFlapping, Since: after}, runningSince(before), runningSince(before)},
+				},
+			}
+
+			ui := callRollingRestart([]string{"--rolling", "my-app"}, reqFactory, appInstancesRepo)
+
+			Expect(appInstancesRepo.DeletedInstances).To(Equal([]int{0}))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Restarting instance #0"},
+				{"FAILED"},
+				{"Instance #0 is crashing"},
+				{"Rolling restart aborted"},
+			})
+		})
+
+		It("fails when the app is not started", func() {
+			app.State = "stopped"
+			reqFactory.Application = app
+			appInstancesRepo := &testapi.FakeAppInstancesRepo{}
+
+			ui := callRollingRestart([]string{"--rolling", "my-app"}, reqFactory, appInstancesRepo)
+
+			Expect(appInstancesRepo.DeletedInstances).To(BeEmpty())
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"my-app", "is not started"},
+			})
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/formatters"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -50,7 +51,7 @@ var bytesInAMegabyte uint64 = 1024 * 1024
 func (cmd *Scale) Run(c *cli.Context) {
 	currentApp := cmd.appReq.GetApplication()
 	if !anyFlagsSet(c) {
-		cmd.ui.Say("Showing current scale of app %s in org %s / space %s as %s...",
+		cmd.ui.Say(i18n.T("Showing current scale of app %s in org %s / space %s as %s..."),
 			terminal.EntityNameColor(currentApp.Name),
 			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 			terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -59,9 +60,9 @@ func (cmd *Scale) Run(c *cli.Context) {
 		cmd.ui.Ok()
 		cmd.ui.Say("")
 
-		cmd.ui.Say("%s %s", terminal.HeaderColor("memory:"), formatters.ByteSize(currentApp.Memory*bytesInAMegabyte))
-		cmd.ui.Say("%s %s", terminal.HeaderColor("disk:"), formatters.ByteSize(currentApp.DiskQuota*bytesInAMegabyte))
-		cmd.ui.Say("%s %d", terminal.HeaderColor("instances:"), currentApp.InstanceCount)
+		cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("memory:")), formatters.ByteSize(currentApp.Memory*bytesInAMegabyte))
+		cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("disk:")), formatters.ByteSize(currentApp.DiskQuota*bytesInAMegabyte))
+		cmd.ui.Say("%s %d", terminal.HeaderColor(i18n.T("instances:")), currentApp.InstanceCount)
 
 		return
 	}
@@ -72,7 +73,7 @@ func (cmd *Scale) Run(c *cli.Context) {
 	if c.String("m") != "" {
 		memory, err := formatters.ToMegabytes(c.String("m"))
 		if err != nil {
-			cmd.ui.Say("Invalid value for memory")
+			cmd.ui.Say(i18n.T("Invalid value for memory"))
 			cmd.ui.FailWithUsage(c, "scale")
 			return
 		}
@@ -83,7 +84,7 @@ func (cmd *Scale) Run(c *cli.Context) {
 	if c.String("k") != "" {
 		diskQuota, err := formatters.ToMegabytes(c.String("k"))
 		if err != nil {
-			cmd.ui.Say("Invalid value for disk")
+			cmd.ui.Say(i18n.T("Invalid value for disk"))
 			cmd.ui.FailWithUsage(c, "scale")
 			return
 		}
@@ -100,7 +101,7 @@ func (cmd *Scale) Run(c *cli.Context) {
 		return
 	}
 
-	cmd.ui.Say("Scaling app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Scaling app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(currentApp.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -109,7 +110,7 @@ func (cmd *Scale) Run(c *cli.Context) {
 
 	updatedApp, apiErr := cmd.appRepo.Update(currentApp.Guid, params)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -124,7 +125,7 @@ func (cmd *Scale) confirmRestart(context *cli.Context, appName string) bool {
 	if context.Bool("f") {
 		return true
 	} else {
-		result := cmd.ui.Confirm("This will cause the app to restage. Are you sure you want to scale %s?", terminal.EntityNameColor(appName))
+		result := cmd.ui.Confirm(i18n.T("This will cause the app to restage. Are you sure you want to scale %s?"), terminal.EntityNameColor(appName))
 		cmd.ui.Say("")
 		return result
 	}
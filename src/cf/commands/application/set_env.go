@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -47,7 +48,7 @@ func (cmd *SetEnv) Run(c *cli.Context) {
 	varValue := c.Args()[2]
 	app := cmd.appReq.GetApplication()
 
-	cmd.ui.Say("Setting env variable '%s' to '%s' for app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Setting env variable '%s' to '%s' for app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(varName),
 		terminal.EntityNameColor(varValue),
 		terminal.EntityNameColor(app.Name),
@@ -65,10 +66,10 @@ func (cmd *SetEnv) Run(c *cli.Context) {
 	_, apiErr := cmd.appRepo.Update(app.Guid, models.AppParams{EnvironmentVars: &envParams})
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("TIP: Use '%s' to ensure your env variable changes take effect", terminal.CommandColor(cf.Name()+" push"))
+	cmd.ui.Say(i18n.T("TIP: Use '%s' to ensure your env variable changes take effect"), terminal.CommandColor(cf.Name()+" push"))
 }
@@ -5,6 +5,7 @@ import (
 	"cf/configuration"
 	"cf/errors"
 	"cf/formatters"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -19,6 +20,8 @@ type ShowApp struct {
 	appSummaryRepo   api.AppSummaryRepository
 	appInstancesRepo api.AppInstancesRepository
 	appReq           requirements.ApplicationRequirement
+
+	Watcher *Top
 }
 
 type ApplicationDisplayer interface {
@@ -31,11 +34,12 @@ func NewShowApp(ui terminal.UI, config configuration.Reader, appSummaryRepo api.
 	cmd.config = config
 	cmd.appSummaryRepo = appSummaryRepo
 	cmd.appInstancesRepo = appInstancesRepo
+	cmd.Watcher = NewTop(ui, config, appSummaryRepo, appInstancesRepo)
 	return
 }
 
 func (cmd *ShowApp) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
-	if len(c.Args()) < 1 {
+	if len(c.Args()) < 1 || !isValidSortKey(c.String("sort"), instanceSortKeys) || c.Int("interval") < 0 {
 		err = errors.New("Incorrect Usage")
 		cmd.ui.FailWithUsage(c, "app")
 		return
@@ -53,12 +57,19 @@ func (cmd *ShowApp) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 
 func (cmd *ShowApp) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
+
+	if c.Bool("watch") {
+		cmd.Watcher.SetInterval(c.Int("interval"))
+		cmd.Watcher.WatchApp(app, c.String("sort"))
+		return
+	}
+
 	cmd.ShowApp(app)
 }
 
 func (cmd *ShowApp) ShowApp(app models.Application) {
 
-	cmd.ui.Say("Showing health and status for app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Showing health and status for app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -75,36 +86,36 @@ func (cmd *ShowApp) ShowApp(app models.Application) {
 	}
 
 	if apiErr != nil && !appIsStopped {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	var instances []models.AppInstanceFields
 	instances, apiErr = cmd.appInstancesRepo.GetInstances(app.Guid)
 	if apiErr != nil && !appIsStopped {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("\n%s %s", terminal.HeaderColor("requested state:"), coloredAppState(appSummary.ApplicationFields))
-	cmd.ui.Say("%s %s", terminal.HeaderColor("instances:"), coloredAppInstances(appSummary.ApplicationFields))
-	cmd.ui.Say("%s %s x %d instances", terminal.HeaderColor("usage:"), formatters.ByteSize(appSummary.Memory*formatters.MEGABYTE), appSummary.InstanceCount)
+	cmd.ui.Say("\n%s %s", terminal.HeaderColor(i18n.T("requested state:")), coloredAppState(appSummary.ApplicationFields))
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("instances:")), coloredAppInstances(appSummary.ApplicationFields))
+	cmd.ui.Say(i18n.T("%s %s x %d instances"), terminal.HeaderColor(i18n.T("usage:")), formatters.ByteSize(appSummary.Memory*formatters.MEGABYTE), appSummary.InstanceCount)
 
 	var urls []string
 	for _, route := range appSummary.RouteSummaries {
 		urls = append(urls, route.URL())
 	}
 
-	cmd.ui.Say("%s %s\n", terminal.HeaderColor("urls:"), strings.Join(urls, ", "))
+	cmd.ui.Say("%s %s\n", terminal.HeaderColor(i18n.T("urls:")), strings.Join(urls, ", "))
 
 	if appIsStopped {
-		cmd.ui.Say("There are no running instances of this app.")
+		cmd.ui.Say(i18n.T("There are no running instances of this app."))
 		return
 	}
 
 	table := [][]string{
-		[]string{"", "state", "since", "cpu", "memory", "disk"},
+		[]string{"", i18n.T("state"), i18n.T("since"), i18n.T("cpu"), i18n.T("memory"), i18n.T("disk")},
 	}
 
 	for index, instance := range instances {
@@ -113,8 +124,8 @@ func (cmd *ShowApp) ShowApp(app models.Application) {
 			coloredInstanceState(instance),
 			instance.Since.Format("2006-01-02 03:04:05 PM"),
 			fmt.Sprintf("%.1f%%", instance.CpuUsage*100),
-			fmt.Sprintf("%s of %s", formatters.ByteSize(instance.MemUsage), formatters.ByteSize(instance.MemQuota)),
-			fmt.Sprintf("%s of %s", formatters.ByteSize(instance.DiskUsage), formatters.ByteSize(instance.DiskQuota)),
+			i18n.T("%s of %s", formatters.ByteSize(instance.MemUsage), formatters.ByteSize(instance.MemQuota)),
+			i18n.T("%s of %s", formatters.ByteSize(instance.DiskUsage), formatters.ByteSize(instance.DiskQuota)),
 		})
 	}
 
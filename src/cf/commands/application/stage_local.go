@@ -0,0 +1,128 @@
+package application
+
+import (
+	"cf"
+	"cf/api"
+	"cf/i18n"
+	"cf/manifest"
+	"cf/requirements"
+	"cf/staging"
+	"cf/terminal"
+	"code.google.com/p/gogoprotobuf/proto"
+	"errors"
+	"fileutils"
+	"github.com/cloudfoundry/loggregatorlib/logmessage"
+	"github.com/codegangsta/cli"
+	"os"
+	"time"
+)
+
+type StageLocal struct {
+	ui                terminal.UI
+	manifestRepo      manifest.ManifestRepository
+	buildpackBitsRepo api.BuildpackBitsRepository
+	stager            staging.Stager
+}
+
+func NewStageLocal(ui terminal.UI, manifestRepo manifest.ManifestRepository, buildpackBitsRepo api.BuildpackBitsRepository, stager staging.Stager) (cmd *StageLocal) {
+	cmd = new(StageLocal)
+	cmd.ui = ui
+	cmd.manifestRepo = manifestRepo
+	cmd.buildpackBitsRepo = buildpackBitsRepo
+	cmd.stager = stager
+	return
+}
+
+// staging happens on this machine, so neither a targeted API nor a login is required
+func (cmd *StageLocal) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "stage-local")
+		return
+	}
+	return
+}
+
+func (cmd *StageLocal) Run(c *cli.Context) {
+	appDir := c.String("p")
+	if appDir == "" {
+		var err error
+		appDir, err = os.Getwd()
+		if err != nil {
+			cmd.ui.Failed(i18n.T("Error finding the current directory: %s"), err.Error())
+			return
+		}
+	}
+
+	buildpackLocation := c.String("b")
+	if buildpackLocation == "" {
+		buildpackLocation = cmd.buildpackFromManifest(appDir)
+	}
+	if buildpackLocation == "" {
+		cmd.ui.Failed(i18n.T("No buildpack given.\nTIP: Use '%s stage-local -b BUILDPACK' or set a buildpack in the app's manifest."), cf.Name())
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Staging app in %s locally with buildpack %s..."),
+		terminal.EntityNameColor(appDir),
+		terminal.EntityNameColor(buildpackLocation),
+	)
+
+	var result staging.Result
+	var stagingErr error
+	fileutils.TempDir("stage-local-buildpack", func(buildpackDir string, err error) {
+		if err != nil {
+			stagingErr = err
+			return
+		}
+
+		stagingErr = cmd.buildpackBitsRepo.ExtractBuildpack(buildpackLocation, buildpackDir)
+		if stagingErr != nil {
+			return
+		}
+
+		result, stagingErr = cmd.stager.Stage(appDir, buildpackDir, cmd.sayStagingLog)
+	})
+
+	if stagingErr != nil {
+		cmd.ui.FailWithError(stagingErr)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("detected buildpack:")), result.DetectedBuildpack)
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("start command:")), result.StartCommand)
+	cmd.ui.Say("")
+	cmd.ui.Say("%s", terminal.HeaderColor(i18n.T("release:")))
+	cmd.ui.Say("%s", result.ReleaseYAML)
+}
+
+// a manifest is optional, it is only read to find the buildpack of a single app
+func (cmd *StageLocal) buildpackFromManifest(appDir string) string {
+	m, errs := cmd.manifestRepo.ReadManifest(appDir)
+	if !errs.Empty() {
+		return ""
+	}
+
+	apps, errs := m.Applications()
+	if !errs.Empty() || len(apps) != 1 || apps[0].BuildpackUrl == nil {
+		return ""
+	}
+	return *apps[0].BuildpackUrl
+}
+
+// buildpack output is shown the same way 'cf logs' shows staging logs
+func (cmd *StageLocal) sayStagingLog(line string, isStderr bool) {
+	messageType := logmessage.LogMessage_OUT
+	if isStderr {
+		messageType = logmessage.LogMessage_ERR
+	}
+
+	cmd.ui.Say("%s", logMessageOutput(&logmessage.LogMessage{
+		Message:     []byte(line),
+		MessageType: &messageType,
+		SourceName:  proto.String(LogMessageTypeStaging),
+		Timestamp:   proto.Int64(time.Now().UnixNano()),
+	}))
+}
@@ -0,0 +1,135 @@
+package application_test
+
+import (
+	. "cf/commands/application"
+	"cf/errors"
+	"cf/manifest"
+	"cf/staging"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"os"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testmanifest "testhelpers/manifest"
+	testreq "testhelpers/requirements"
+	teststaging "testhelpers/staging"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("stage-local command", func() {
+	var (
+		ui           *testterm.FakeUI
+		reqFactory   *testreq.FakeReqFactory
+		manifestRepo *testmanifest.FakeManifestRepository
+		bitsRepo     *testapi.FakeBuildpackBitsRepository
+		stager       *teststaging.FakeStager
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{}
+		manifestRepo = &testmanifest.FakeManifestRepository{}
+		manifestRepo.ReadManifestReturns.Errors = manifest.ManifestErrors{errors.New("no manifest")}
+		bitsRepo = &testapi.FakeBuildpackBitsRepository{}
+		stager = &teststaging.FakeStager{
+			Result: staging.Result{
+				DetectedBuildpack: "Ruby/Rack",
+				ReleaseYAML:       "---\ndefault_process_types:\n  web: bundle exec rackup",
+				StartCommand:      "bundle exec rackup",
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewStageLocal(ui, manifestRepo, bitsRepo, stager)
+		testcmd.RunCommand(cmd, testcmd.NewContext("stage-local", args), reqFactory)
+	}
+
+	It("fails with usage when given arguments", func() {
+		runCommand("my-app")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("does not need a targeted API or a login", func() {
+		runCommand("-b", "my-buildpack.zip")
+		Expect(testcmd.CommandDidPassRequirements).To(BeTrue())
+	})
+
+	It("stages the app directory with the extracted buildpack", func() {
+		stager.LogLines = []string{"-----> Installing dependencies"}
+		stager.StderrLines = []string{"warning: no Gemfile.lock"}
+
+		runCommand("-b", "https://example.com/buildpack.zip", "-p", "/path/to/app")
+
+		Expect(bitsRepo.ExtractBuildpackPath).To(Equal("https://example.com/buildpack.zip"))
+		Expect(bitsRepo.ExtractBuildpackDestDir).NotTo(BeEmpty())
+		Expect(stager.BuildpackDir).To(Equal(bitsRepo.ExtractBuildpackDestDir))
+		Expect(stager.AppDir).To(Equal("/path/to/app"))
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Staging app in", "/path/to/app", "https://example.com/buildpack.zip"},
+			{"[STG]", "OUT -----> Installing dependencies"},
+			{"[STG]", "ERR warning: no Gemfile.lock"},
+			{"OK"},
+			{"detected buildpack:", "Ruby/Rack"},
+			{"start command:", "bundle exec rackup"},
+			{"release:"},
+			{"web: bundle exec rackup"},
+		})
+	})
+
+	It("stages the current directory by default", func() {
+		runCommand("-b", "my-buildpack")
+
+		cwd, _ := os.Getwd()
+		Expect(stager.AppDir).To(Equal(cwd))
+	})
+
+	It("uses the buildpack from the app's manifest when -b is not given", func() {
+		manifestRepo.ReadManifestReturns.Errors = nil
+		manifestRepo.ReadManifestReturns.Manifest = singleAppManifest()
+
+		runCommand("-p", "/path/to/app")
+
+		Expect(manifestRepo.ReadManifestArgs.Path).To(Equal("/path/to/app"))
+		Expect(bitsRepo.ExtractBuildpackPath).To(Equal("some-buildpack"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{{"OK"}})
+	})
+
+	It("fails when no buildpack is given", func() {
+		runCommand("-p", "/path/to/app")
+
+		Expect(bitsRepo.ExtractBuildpackPath).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"No buildpack given"},
+		})
+	})
+
+	It("fails when the buildpack cannot be extracted", func() {
+		bitsRepo.ExtractBuildpackErr = errors.New("Couldn't extract buildpack")
+
+		runCommand("-b", "my-buildpack")
+
+		Expect(stager.AppDir).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Couldn't extract buildpack"},
+		})
+	})
+
+	It("fails after streaming the logs when staging fails", func() {
+		stager.LogLines = []string{"-----> Compiling"}
+		stager.StageErr = errors.New("Buildpack compilation step failed: exit status 1")
+
+		runCommand("-b", "my-buildpack")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"[STG]", "-----> Compiling"},
+			{"FAILED"},
+			{"Buildpack compilation step failed"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{{"OK"}})
+	})
+})
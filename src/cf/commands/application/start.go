@@ -5,6 +5,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -58,7 +59,7 @@ func NewStart(ui terminal.UI, config configuration.Reader, appDisplayer Applicat
 	if os.Getenv("CF_STAGING_TIMEOUT") != "" {
 		duration, err := strconv.ParseInt(os.Getenv("CF_STAGING_TIMEOUT"), 10, 64)
 		if err != nil {
-			cmd.ui.Failed("invalid value for env var CF_STAGING_TIMEOUT\n%s", err)
+			cmd.ui.Failed(i18n.T("invalid value for env var CF_STAGING_TIMEOUT\n%s"), err)
 		}
 		cmd.StagingTimeout = time.Duration(duration) * time.Minute
 	} else {
@@ -68,7 +69,7 @@ func NewStart(ui terminal.UI, config configuration.Reader, appDisplayer Applicat
 	if os.Getenv("CF_STARTUP_TIMEOUT") != "" {
 		duration, err := strconv.ParseInt(os.Getenv("CF_STARTUP_TIMEOUT"), 10, 64)
 		if err != nil {
-			cmd.ui.Failed("invalid value for env var CF_STARTUP_TIMEOUT\n%s", err)
+			cmd.ui.Failed(i18n.T("invalid value for env var CF_STARTUP_TIMEOUT\n%s"), err)
 		}
 		cmd.StartupTimeout = time.Duration(duration) * time.Minute
 	} else {
@@ -97,7 +98,7 @@ func (cmd *Start) Run(c *cli.Context) {
 
 func (cmd *Start) ApplicationStart(app models.Application) (updatedApp models.Application, err error) {
 	if app.State == "started" {
-		cmd.ui.Say(terminal.WarningColor("App " + app.Name + " is already started"))
+		cmd.ui.Say("%s", terminal.WarningColor(i18n.T("App %s is already started", app.Name)))
 		return
 	}
 
@@ -110,7 +111,7 @@ func (cmd *Start) ApplicationStart(app models.Application) (updatedApp models.Ap
 
 	<-loggingStartedChan
 
-	cmd.ui.Say("Starting app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Starting app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -120,7 +121,7 @@ func (cmd *Start) ApplicationStart(app models.Application) (updatedApp models.Ap
 	state := "STARTED"
 	updatedApp, apiErr := cmd.appRepo.Update(app.Guid, models.AppParams{State: &state})
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -132,7 +133,7 @@ func (cmd *Start) ApplicationStart(app models.Application) (updatedApp models.Ap
 	cmd.ui.Say("")
 
 	cmd.waitForOneRunningInstance(updatedApp)
-	cmd.ui.Say(terminal.HeaderColor("\nApp started\n"))
+	cmd.ui.Say("\n%s\n", terminal.HeaderColor(i18n.T("App started")))
 
 	cmd.appDisplayer.ShowApp(updatedApp)
 	return
@@ -153,7 +154,7 @@ func (cmd Start) tailStagingLogs(app models.Application, startChan chan bool, st
 
 		err := cmd.logRepo.TailLogsFor(app.Guid, onConnect, logChan, stopChan, 1)
 		if err != nil {
-			cmd.ui.Warn("Warning: error tailing logs")
+			cmd.ui.Warn(i18n.T("Warning: error tailing logs"))
 			cmd.ui.Say("%s", err)
 			startChan <- true
 		}
@@ -167,7 +168,7 @@ func (cmd Start) displayLogMessages(logChan chan *logmessage.Message) {
 		if msg.GetLogMessage().GetSourceName() != "STG" {
 			continue
 		}
-		cmd.ui.Say(simpleLogMessageOutput(msg))
+		cmd.ui.Say("%s", simpleLogMessageOutput(msg))
 	}
 }
 
@@ -178,7 +179,7 @@ func (cmd Start) waitForInstancesToStage(app models.Application) {
 	for err != nil && time.Since(stagingStartTime) < cmd.StagingTimeout {
 		if err, ok := err.(errors.HttpError); ok && err.ErrorCode() != errors.APP_NOT_STAGED {
 			cmd.ui.Say("")
-			cmd.ui.Failed(fmt.Sprintf("%s\n\nTIP: use '%s' for more information",
+			cmd.ui.Failed(i18n.T("%s\n\nTIP: use '%s' for more information",
 				err.Error(),
 				terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
 			return
@@ -195,7 +196,7 @@ func (cmd Start) waitForOneRunningInstance(app models.Application) {
 
 	for runningCount == 0 {
 		if time.Since(startupStartTime) > cmd.StartupTimeout {
-			cmd.ui.Failed(fmt.Sprintf("Start app timeout\n\nTIP: use '%s' for more information", terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
+			cmd.ui.FailWithError(errors.NewTimeoutError(i18n.T("Start app timeout\n\nTIP: use '%s' for more information"), terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
 			return
 		}
 
@@ -221,28 +222,28 @@ func (cmd Start) waitForOneRunningInstance(app models.Application) {
 			}
 		}
 
-		cmd.ui.Say(instancesDetails(startingCount, downCount, runningCount, flappingCount, totalCount))
+		cmd.ui.Say("%s", instancesDetails(startingCount, downCount, runningCount, flappingCount, totalCount))
 
 		if flappingCount > 0 {
-			cmd.ui.Failed(fmt.Sprintf("Start unsuccessful\n\nTIP: use '%s' for more information", terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
+			cmd.ui.Failed(i18n.T("Start unsuccessful\n\nTIP: use '%s' for more information", terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))))
 			return
 		}
 	}
 }
 
 func instancesDetails(startingCount, downCount, runningCount, flappingCount, totalCount int) string {
-	details := []string{fmt.Sprintf("%d of %d instances running", runningCount, totalCount)}
+	details := []string{i18n.T("%d of %d instances running", runningCount, totalCount)}
 
 	if startingCount > 0 {
-		details = append(details, fmt.Sprintf("%d starting", startingCount))
+		details = append(details, i18n.T("%d starting", startingCount))
 	}
 
 	if downCount > 0 {
-		details = append(details, fmt.Sprintf("%d down", downCount))
+		details = append(details, i18n.T("%d down", downCount))
 	}
 
 	if flappingCount > 0 {
-		details = append(details, fmt.Sprintf("%d failing", flappingCount))
+		details = append(details, i18n.T("%d failing", flappingCount))
 	}
 
 	return strings.Join(details, ", ")
@@ -3,6 +3,7 @@ package application
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -46,11 +47,11 @@ func (cmd *Stop) GetRequirements(reqFactory requirements.Factory, c *cli.Context
 func (cmd *Stop) ApplicationStop(app models.Application) (updatedApp models.Application, err error) {
 	if app.State == "stopped" {
 		updatedApp = app
-		cmd.ui.Say(terminal.WarningColor("App " + app.Name + " is already stopped"))
+		cmd.ui.Say("%s", terminal.WarningColor(i18n.T("App %s is already stopped", app.Name)))
 		return
 	}
 
-	cmd.ui.Say("Stopping app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Stopping app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -61,7 +62,7 @@ func (cmd *Stop) ApplicationStop(app models.Application) (updatedApp models.Appl
 	updatedApp, apiErr := cmd.appRepo.Update(app.Guid, models.AppParams{State: &state})
 	if apiErr != nil {
 		err = errors.New(apiErr.Error())
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
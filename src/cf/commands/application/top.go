@@ -0,0 +1,375 @@
+package application
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/formatters"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultRefreshInterval = 5 * time.Second
+	sparklineLength        = 20
+)
+
+var (
+	spaceSortKeys    = []string{"name", "state", "cpu", "memory", "disk"}
+	instanceSortKeys = []string{"index", "state", "cpu", "memory", "disk"}
+)
+
+type Top struct {
+	ui               terminal.UI
+	config           configuration.Reader
+	appSummaryRepo   api.AppSummaryRepository
+	appInstancesRepo api.AppInstancesRepository
+
+	RefreshInterval time.Duration
+	MaxRefreshes    int // zero keeps refreshing until interrupted
+
+	cpuHistory    map[string][]float64
+	memoryHistory map[string][]float64
+}
+
+func NewTop(ui terminal.UI, config configuration.Reader, appSummaryRepo api.AppSummaryRepository, appInstancesRepo api.AppInstancesRepository) (cmd *Top) {
+	cmd = new(Top)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.appSummaryRepo = appSummaryRepo
+	cmd.appInstancesRepo = appInstancesRepo
+	cmd.RefreshInterval = DefaultRefreshInterval
+	cmd.cpuHistory = map[string][]float64{}
+	cmd.memoryHistory = map[string][]float64{}
+	return
+}
+
+func (cmd *Top) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 || !isValidSortKey(c.String("sort"), spaceSortKeys) || c.Int("interval") < 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "top")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewTargetedSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *Top) Run(c *cli.Context) {
+	cmd.SetInterval(c.Int("interval"))
+	cmd.WatchSpace(c.String("sort"))
+}
+
+func (cmd *Top) SetInterval(seconds int) {
+	if seconds > 0 {
+		cmd.RefreshInterval = time.Duration(seconds) * time.Second
+	}
+}
+
+func (cmd *Top) WatchSpace(sortKey string) {
+	if sortKey == "" {
+		sortKey = "name"
+	}
+	cmd.watch(func() bool {
+		return cmd.showSpace(sortKey)
+	})
+}
+
+func (cmd *Top) WatchApp(app models.Application, sortKey string) {
+	if sortKey == "" {
+		sortKey = "index"
+	}
+	cmd.watch(func() bool {
+		return cmd.showApp(app, sortKey)
+	})
+}
+
+func (cmd *Top) watch(refresh func() bool) {
+	for count := 0; cmd.MaxRefreshes == 0 || count < cmd.MaxRefreshes; count++ {
+		if count > 0 {
+			cmd.ui.Wait(cmd.RefreshInterval)
+		}
+
+		cmd.ui.ClearScreen()
+		if !refresh() {
+			return
+		}
+	}
+}
+
+func (cmd *Top) showSpace(sortKey string) bool {
+	cmd.ui.Say(i18n.T("Watching apps in org %s / space %s as %s..."),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	apps, apiErr := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return false
+	}
+
+	cmd.ui.Say("%s\n", cmd.refreshInfo(sortKey))
+
+	if len(apps) == 0 {
+		cmd.ui.Say(i18n.T("No apps found"))
+		return true
+	}
+
+	rows := []dashboardRow{}
+	for _, app := range apps {
+		row := dashboardRow{key: app.Guid, name: app.Name, state: coloredAppState(app.ApplicationFields)}
+
+		unhealthy := 0
+		if app.State != "stopped" {
+			instances, apiErr := cmd.appInstancesRepo.GetInstances(app.Guid)
+			if apiErr != nil && !isNotRunningError(apiErr) {
+				// one app's instances failing to load shouldn't pass for an idle app
+				row.err = apiErr
+				row.unhealthy = true
+				rows = append(rows, row)
+				continue
+			}
+			for _, instance := range instances {
+				row.add(instance)
+				if instance.State == models.InstanceFlapping || instance.State == models.InstanceDown {
+					unhealthy++
+				}
+			}
+			if len(instances) > 0 {
+				row.cpu = row.cpu / float64(len(instances))
+			}
+		}
+
+		row.instances = coloredAppInstances(app.ApplicationFields)
+		if unhealthy > 0 {
+			row.instances = terminal.CrashedColor(i18n.T("%d/%d (%d crashing)", app.RunningInstances, app.InstanceCount, unhealthy))
+			row.unhealthy = true
+		}
+
+		cmd.recordHistory(row)
+		rows = append(rows, row)
+	}
+
+	sortDashboardRows(rows, sortKey)
+
+	table := cmd.ui.Table([]string{i18n.T("name"), i18n.T("state"), i18n.T("instances"), i18n.T("cpu"), "", i18n.T("memory"), "", i18n.T("disk")})
+	tableRows := [][]string{}
+	for _, row := range rows {
+		if row.err != nil {
+			tableRows = append(tableRows, []string{
+				row.name,
+				row.state,
+				terminal.FailureColor(i18n.T("error: %s", row.err.Error())),
+				"", "", "", "", "",
+			})
+			continue
+		}
+
+		tableRows = append(tableRows, []string{
+			row.name,
+			row.state,
+			row.instances,
+			fmt.Sprintf("%.1f%%", row.cpu*100),
+			terminal.Sparkline(cmd.cpuHistory[row.key], 1),
+			i18n.T("%s of %s", formatters.ByteSize(row.memUsage), formatters.ByteSize(row.memQuota)),
+			terminal.Sparkline(cmd.memoryHistory[row.key], 1),
+			i18n.T("%s of %s", formatters.ByteSize(row.diskUsage), formatters.ByteSize(row.diskQuota)),
+		})
+	}
+	table.Print(tableRows)
+	return true
+}
+
+func (cmd *Top) showApp(app models.Application, sortKey string) bool {
+	cmd.ui.Say(i18n.T("Watching app %s in org %s / space %s as %s..."),
+		terminal.EntityNameColor(app.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	appSummary, apiErr := cmd.appSummaryRepo.GetSummary(app.Guid)
+
+	appIsStopped := (appSummary.State == "stopped") || isNotRunningError(apiErr)
+
+	if apiErr != nil && !appIsStopped {
+		cmd.ui.FailWithError(apiErr)
+		return false
+	}
+
+	instances, apiErr := cmd.appInstancesRepo.GetInstances(app.Guid)
+	if apiErr != nil && !appIsStopped {
+		cmd.ui.FailWithError(apiErr)
+		return false
+	}
+
+	cmd.ui.Say("%s", cmd.refreshInfo(sortKey))
+	cmd.ui.Say("\n%s %s", terminal.HeaderColor(i18n.T("requested state:")), coloredAppState(appSummary.ApplicationFields))
+	cmd.ui.Say("%s %s\n", terminal.HeaderColor(i18n.T("instances:")), coloredAppInstances(appSummary.ApplicationFields))
+
+	if appIsStopped {
+		cmd.ui.Say(i18n.T("There are no running instances of this app."))
+		return true
+	}
+
+	rows := []dashboardRow{}
+	unhealthy := 0
+	for index, instance := range instances {
+		row := dashboardRow{
+			key:   fmt.Sprintf("%s/%d", app.Guid, index),
+			name:  fmt.Sprintf("#%d", index),
+			state: coloredInstanceState(instance),
+			since: instance.Since.Format("2006-01-02 03:04:05 PM"),
+		}
+		row.add(instance)
+
+		if instance.State == models.InstanceFlapping || instance.State == models.InstanceDown {
+			row.unhealthy = true
+			unhealthy++
+		}
+
+		cmd.recordHistory(row)
+		rows = append(rows, row)
+	}
+
+	sortDashboardRows(rows, sortKey)
+
+	table := cmd.ui.Table([]string{"", i18n.T("state"), i18n.T("since"), i18n.T("cpu"), "", i18n.T("memory"), "", i18n.T("disk")})
+	tableRows := [][]string{}
+	for _, row := range rows {
+		tableRows = append(tableRows, []string{
+			row.name,
+			row.state,
+			row.since,
+			fmt.Sprintf("%.1f%%", row.cpu*100),
+			terminal.Sparkline(cmd.cpuHistory[row.key], 1),
+			i18n.T("%s of %s", formatters.ByteSize(row.memUsage), formatters.ByteSize(row.memQuota)),
+			terminal.Sparkline(cmd.memoryHistory[row.key], 1),
+			i18n.T("%s of %s", formatters.ByteSize(row.diskUsage), formatters.ByteSize(row.diskQuota)),
+		})
+	}
+	table.Print(tableRows)
+
+	if unhealthy > 0 {
+		cmd.ui.Say("")
+		cmd.ui.Say("%s", terminal.CrashedColor(i18n.T("%d of %d instances are crashing or down", unhealthy, len(instances))))
+	}
+	return true
+}
+
+func (cmd *Top) refreshInfo(sortKey string) string {
+	return i18n.T("%s %s, %s every %s, press Ctrl-C to quit",
+		terminal.HeaderColor(i18n.T("sorted by")), strings.ToLower(sortKey),
+		terminal.HeaderColor(i18n.T("refreshing")), cmd.RefreshInterval,
+	)
+}
+
+func isNotRunningError(apiErr error) bool {
+	err, ok := apiErr.(errors.HttpError)
+	return ok && (err.ErrorCode() == errors.APP_STOPPED || err.ErrorCode() == errors.APP_NOT_STAGED)
+}
+
+func (cmd *Top) recordHistory(row dashboardRow) {
+	cmd.cpuHistory[row.key] = appendSample(cmd.cpuHistory[row.key], row.cpu)
+
+	memory := 0.0
+	if row.memQuota > 0 {
+		memory = float64(row.memUsage) / float64(row.memQuota)
+	}
+	cmd.memoryHistory[row.key] = appendSample(cmd.memoryHistory[row.key], memory)
+}
+
+func appendSample(history []float64, sample float64) []float64 {
+	history = append(history, sample)
+	if len(history) > sparklineLength {
+		history = history[len(history)-sparklineLength:]
+	}
+	return history
+}
+
+func isValidSortKey(sortKey string, validKeys []string) bool {
+	if sortKey == "" {
+		return true
+	}
+
+	for _, key := range validKeys {
+		if strings.ToLower(sortKey) == key {
+			return true
+		}
+	}
+	return false
+}
+
+type dashboardRow struct {
+	key       string
+	name      string
+	state     string
+	since     string
+	instances string
+	unhealthy bool
+	err       error
+	cpu       float64
+	memUsage  uint64
+	memQuota  uint64
+	diskUsage uint64
+	diskQuota uint64
+}
+
+func (row *dashboardRow) add(instance models.AppInstanceFields) {
+	row.cpu += instance.CpuUsage
+	row.memUsage += instance.MemUsage
+	row.memQuota += instance.MemQuota
+	row.diskUsage += instance.DiskUsage
+	row.diskQuota += instance.DiskQuota
+}
+
+type dashboardRows struct {
+	rows []dashboardRow
+	less func(a, b dashboardRow) bool
+}
+
+func (s dashboardRows) Len() int {
+	return len(s.rows)
+}
+
+func (s dashboardRows) Less(i, j int) bool {
+	return s.less(s.rows[i], s.rows[j])
+}
+
+func (s dashboardRows) Swap(i, j int) {
+	s.rows[i], s.rows[j] = s.rows[j], s.rows[i]
+}
+
+// unhealthy rows sort first by state; usage columns sort the busiest first
+func sortDashboardRows(rows []dashboardRow, sortKey string) {
+	var less func(a, b dashboardRow) bool
+
+	switch strings.ToLower(sortKey) {
+	case "name":
+		less = func(a, b dashboardRow) bool { return a.name < b.name }
+	case "state":
+		less = func(a, b dashboardRow) bool { return a.unhealthy && !b.unhealthy }
+	case "cpu":
+		less = func(a, b dashboardRow) bool { return a.cpu > b.cpu }
+	case "memory":
+		less = func(a, b dashboardRow) bool { return a.memUsage > b.memUsage }
+	case "disk":
+		less = func(a, b dashboardRow) bool { return a.diskUsage > b.diskUsage }
+	default:
+		return
+	}
+
+	sort.Stable(dashboardRows{rows: rows, less: less})
+}
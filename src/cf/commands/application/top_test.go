@@ -0,0 +1,185 @@
+package application_test
+
+import (
+	. "cf/commands/application"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"time"
+)
+
+var _ = Describe("top command", func() {
+	var (
+		ui               *testterm.FakeUI
+		reqFactory       *testreq.FakeReqFactory
+		appSummaryRepo   *testapi.FakeAppSummaryRepo
+		appInstancesRepo *testapi.FakeAppInstancesRepo
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+		appSummaryRepo = &testapi.FakeAppSummaryRepo{}
+		appInstancesRepo = &testapi.FakeAppInstancesRepo{}
+	})
+
+	runTop := func(refreshes int, args ...string) {
+		cmd := NewTop(ui, testconfig.NewRepositoryWithDefaults(), appSummaryRepo, appInstancesRepo)
+		cmd.RefreshInterval = 0
+		cmd.MaxRefreshes = refreshes
+		testcmd.RunCommand(cmd, testcmd.NewContext("top", args), reqFactory)
+	}
+
+	It("requires the user to be logged in and have a targeted space", func() {
+		reqFactory.LoginSuccess = false
+		runTop(1)
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+
+		reqFactory.LoginSuccess = true
+		reqFactory.TargetedSpaceSuccess = false
+		runTop(1)
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("fails with usage when given an unknown sort key", func() {
+		runTop(1, "--sort", "color")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	Context("when there are apps in the space", func() {
+		BeforeEach(func() {
+			app1 := models.AppSummary{}
+			app1.Name = "app-b"
+			app1.Guid = "app-b-guid"
+			app1.State = "started"
+			app1.InstanceCount = 2
+			app1.RunningInstances = 1
+
+			app2 := models.AppSummary{}
+			app2.Name = "app-a"
+			app2.Guid = "app-a-guid"
+			app2.State = "started"
+			app2.InstanceCount = 1
+			app2.RunningInstances = 1
+
+			appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.AppSummary{app1, app2}
+
+			appInstancesRepo.GetInstancesResponses = [][]models.AppInstanceFields{
+				{
+					{State: models.InstanceRunning, CpuUsage: 0.5, MemUsage: 64 * 1024 * 1024, MemQuota: 128 * 1024 * 1024},
+					{State: models.InstanceFlapping},
+				},
+				{
+					{State: models.InstanceRunning, CpuUsage: 0.75, MemUsage: 32 * 1024 * 1024, MemQuota: 64 * 1024 * 1024},
+				},
+				{
+					{State: models.InstanceRunning, CpuUsage: 1.0, MemUsage: 128 * 1024 * 1024, MemQuota: 128 * 1024 * 1024},
+					{State: models.InstanceFlapping},
+				},
+				{
+					{State: models.InstanceRunning, CpuUsage: 0.0, MemUsage: 0, MemQuota: 64 * 1024 * 1024},
+				},
+			}
+		})
+
+		It("shows every app sorted by name, highlighting crashing instances", func() {
+			runTop(1)
+
+			Expect(ui.ClearScreenCount).To(Equal(1))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Watching apps in org", "my-org", "my-space", "my-user"},
+				{"sorted by", "name"},
+				{"name", "state", "instances", "cpu", "memory", "disk"},
+				{"app-a", "started", "1/1", "75.0%", "32M of 64M"},
+				{"app-b", "started", "1/2 (1 crashing)", "25.0%", "64M of 128M"},
+			})
+		})
+
+		It("shows the error of an app whose instances can't be fetched", func() {
+			appInstancesRepo.GetInstancesErrorCodes = []string{"", "10001"}
+			runTop(1)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"app-a", "started", "error:", "Error staging app"},
+				{"app-b", "started", "1/2 (1 crashing)", "25.0%", "64M of 128M"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"app-a", "0.0%"},
+			})
+		})
+
+		It("shows an app that isn't staged yet without instances", func() {
+			appInstancesRepo.GetInstancesErrorCodes = []string{"", errors.APP_NOT_STAGED}
+			runTop(1)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"app-a", "started", "1/1"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"error:"},
+			})
+		})
+
+		It("sorts by cpu usage", func() {
+			runTop(1, "--sort", "cpu")
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"app-a", "75.0%"},
+				{"app-b", "25.0%"},
+			})
+		})
+
+		It("keeps a sparkline history between refreshes", func() {
+			runTop(2)
+
+			Expect(ui.ClearScreenCount).To(Equal(2))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"app-a", "0.0%", "▆▁", "of 64M", "▄▁"},
+				{"app-b", "50.0%", "▂▄", "128M of 128M", "▄█"},
+			})
+		})
+	})
+
+	It("watches the instances of a single app with app --watch", func() {
+		app := models.Application{}
+		app.Name = "my-app"
+		app.Guid = "my-app-guid"
+		reqFactory.Application = app
+
+		appSummary := models.AppSummary{}
+		appSummary.State = "started"
+		appSummary.InstanceCount = 2
+		appSummary.RunningInstances = 1
+		appSummaryRepo.GetSummarySummary = appSummary
+
+		since := time.Date(2012, time.January, 2, 15, 4, 5, 0, time.UTC)
+		appInstancesRepo.GetInstancesResponses = [][]models.AppInstanceFields{
+			{
+				{State: models.InstanceRunning, Since: since, CpuUsage: 0.1, DiskUsage: 1024 * 1024, DiskQuota: 2 * 1024 * 1024},
+				{State: models.InstanceDown, Since: since, CpuUsage: 0.9, DiskUsage: 512 * 1024, DiskQuota: 2 * 1024 * 1024},
+			},
+		}
+
+		cmd := NewShowApp(ui, testconfig.NewRepositoryWithDefaults(), appSummaryRepo, appInstancesRepo)
+		cmd.Watcher.MaxRefreshes = 1
+		testcmd.RunCommand(cmd, testcmd.NewContext("app", []string{"--watch", "--sort", "cpu", "my-app"}), reqFactory)
+
+		Expect(appSummaryRepo.GetSummaryAppGuid).To(Equal("my-app-guid"))
+		Expect(appInstancesRepo.GetInstancesAppGuid).To(Equal("my-app-guid"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Watching app", "my-app", "my-org", "my-space", "my-user"},
+			{"sorted by", "cpu"},
+			{"#1", "down", "2012-01-02 03:04:05 PM", "90.0%", "512K of 2M"},
+			{"#0", "running", "2012-01-02 03:04:05 PM", "10.0%", "1M of 2M"},
+			{"1 of 2 instances are crashing or down"},
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -46,7 +47,7 @@ func (cmd *UnsetEnv) Run(c *cli.Context) {
 	varName := c.Args()[1]
 	app := cmd.appReq.GetApplication()
 
-	cmd.ui.Say("Removing env variable %s from app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Removing env variable %s from app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(varName),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -58,7 +59,7 @@ func (cmd *UnsetEnv) Run(c *cli.Context) {
 
 	if _, ok := envParams[varName]; !ok {
 		cmd.ui.Ok()
-		cmd.ui.Warn("Env variable %s was not set.", varName)
+		cmd.ui.Warn(i18n.T("Env variable %s was not set."), varName)
 		return
 	}
 
@@ -66,10 +67,10 @@ func (cmd *UnsetEnv) Run(c *cli.Context) {
 
 	_, apiErr := cmd.appRepo.Update(app.Guid, models.AppParams{EnvironmentVars: &envParams})
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("TIP: Use '%s' to ensure your env variable changes take effect", terminal.CommandColor(cf.Name()+" push"))
+	cmd.ui.Say(i18n.T("TIP: Use '%s' to ensure your env variable changes take effect"), terminal.CommandColor(cf.Name()+" push"))
 }
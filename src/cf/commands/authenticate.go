@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -37,19 +38,24 @@ func (cmd Authenticate) GetRequirements(reqFactory requirements.Factory, c *cli.
 func (cmd Authenticate) Run(c *cli.Context) {
 	cmd.config.ClearSession()
 
-	cmd.ui.Say("API endpoint: %s", terminal.EntityNameColor(cmd.config.ApiEndpoint()))
-	cmd.ui.Say("Authenticating...")
-
-	apiErr := cmd.authenticator.Authenticate(map[string]string{
-		"username": c.Args()[0],
-		"password": c.Args()[1],
-	})
+	cmd.ui.Say(i18n.T("API endpoint: %s"), terminal.EntityNameColor(cmd.config.ApiEndpoint()))
+	cmd.ui.Say(i18n.T("Authenticating..."))
+
+	var apiErr error
+	if c.Bool("client-credentials") {
+		apiErr = cmd.authenticator.AuthenticateWithClientCredentials(c.Args()[0], c.Args()[1])
+	} else {
+		apiErr = cmd.authenticator.Authenticate(map[string]string{
+			"username": c.Args()[0],
+			"password": c.Args()[1],
+		})
+	}
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("Use '%s' to view or set your target org and space", terminal.CommandColor(cf.Name()+" target"))
+	cmd.ui.Say(i18n.T("Use '%s' to view or set your target org and space"), terminal.CommandColor(cf.Name()+" target"))
 	return
 }
@@ -76,6 +76,20 @@ var _ = Describe("auth command", func() {
 			}))
 		})
 
+		It("authenticates as a client with --client-credentials", func() {
+			context := testcmd.NewContext("auth", []string{"--client-credentials", "my-robot", "robot-secret"})
+			testcmd.RunCommand(cmd, context, reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Authenticating..."},
+				{"OK"},
+			})
+			Expect(repo.AuthenticateArgs.Credentials).To(BeEmpty())
+			Expect(repo.AuthenticateWithClientCredentialsArgs.ClientId).To(Equal("my-robot"))
+			Expect(repo.AuthenticateWithClientCredentialsArgs.ClientSecret).To(Equal("robot-secret"))
+			Expect(config.UAAOAuthClient()).To(Equal("my-robot"))
+		})
+
 		Describe("when authentication fails", func() {
 			BeforeEach(func() {
 				repo.AuthError = true
@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -39,30 +40,43 @@ func (cmd CreateBuildpack) Run(c *cli.Context) {
 
 	buildpackName := c.Args()[0]
 
-	cmd.ui.Say("Creating buildpack %s...", terminal.EntityNameColor(buildpackName))
+	cmd.ui.Say(i18n.T("Creating buildpack %s..."), terminal.EntityNameColor(buildpackName))
+
+	cmd.buildpackBitsRepo.FetchBuildpack(c.Args()[1], func(dir string, apiErr error) {
+		if apiErr != nil {
+			cmd.ui.FailWithError(apiErr)
+			return
+		}
+
+		cmd.createAndUpload(buildpackName, dir, c)
+	})
+}
+
+func (cmd CreateBuildpack) createAndUpload(buildpackName, dir string, c *cli.Context) {
+	if !checkBuildpackBits(cmd.ui, cmd.buildpackBitsRepo, dir) {
+		return
+	}
 
 	buildpack, err := cmd.createBuildpack(buildpackName, c)
 
 	if err != nil {
 		if err, ok := err.(errors.HttpError); ok && err.ErrorCode() == errors.BUILDPACK_EXISTS {
 			cmd.ui.Ok()
-			cmd.ui.Warn("Buildpack %s already exists", buildpackName)
-			cmd.ui.Say("TIP: use '%s' to update this buildpack", terminal.CommandColor(cf.Name()+" update-buildpack"))
+			cmd.ui.Warn(i18n.T("Buildpack %s already exists"), buildpackName)
+			cmd.ui.Say(i18n.T("TIP: use '%s' to update this buildpack"), terminal.CommandColor(cf.Name()+" update-buildpack"))
 		} else {
-			cmd.ui.Failed(err.Error())
+			cmd.ui.FailWithError(err)
 		}
 		return
 	}
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
-	cmd.ui.Say("Uploading buildpack %s...", terminal.EntityNameColor(buildpackName))
-
-	dir := c.Args()[1]
+	cmd.ui.Say(i18n.T("Uploading buildpack %s..."), terminal.EntityNameColor(buildpackName))
 
 	err = cmd.buildpackBitsRepo.UploadBuildpack(buildpack, dir)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
 		return
 	}
 
@@ -72,14 +86,14 @@ func (cmd CreateBuildpack) Run(c *cli.Context) {
 func (cmd CreateBuildpack) createBuildpack(buildpackName string, c *cli.Context) (buildpack models.Buildpack, apiErr error) {
 	position, err := strconv.Atoi(c.Args()[2])
 	if err != nil {
-		apiErr = errors.NewWithFmt("Invalid position. %s", err.Error())
+		apiErr = errors.NewWithFmt(i18n.T("Invalid position. %s"), err.Error())
 		return
 	}
 
 	enabled := c.Bool("enable")
 	disabled := c.Bool("disable")
 	if enabled && disabled {
-		apiErr = errors.New("Cannot specify both enabled and disabled.")
+		apiErr = errors.New(i18n.T("Cannot specify both enabled and disabled."))
 		return
 	}
 
@@ -2,6 +2,7 @@ package buildpack_test
 
 import (
 	. "cf/commands/buildpack"
+	"cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
@@ -102,4 +103,30 @@ var _ = Describe("create-buildpack command", func() {
 			{"FAILED"},
 		})
 	})
+
+	It("checks the buildpack before creating it", func() {
+		bitsRepo.InspectBuildpackReport = models.BuildpackReport{Problems: []string{"bin/release is missing"}}
+		context := testcmd.NewContext("create-buildpack", []string{"my-buildpack", "my-dir", "5"})
+		testcmd.RunCommand(cmd, context, reqFactory)
+
+		Expect(bitsRepo.InspectBuildpackPath).To(Equal("my-dir"))
+		Expect(repo.CreateBuildpack.Name).To(Equal(""))
+		Expect(bitsRepo.UploadBuildpackPath).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Creating buildpack", "my-buildpack"},
+			{"bin/release is missing"},
+			{"FAILED"},
+			{"Buildpack my-dir is not valid"},
+		})
+	})
+
+	It("checks and uploads the same download of a buildpack URL", func() {
+		bitsRepo.FetchBuildpackLocalPath = "/tmp/downloaded/buildpack.zip"
+		context := testcmd.NewContext("create-buildpack", []string{"my-buildpack", "https://example.com/buildpack.zip", "5"})
+		testcmd.RunCommand(cmd, context, reqFactory)
+
+		Expect(bitsRepo.FetchedBuildpackLocations).To(Equal([]string{"https://example.com/buildpack.zip"}))
+		Expect(bitsRepo.InspectBuildpackPath).To(Equal("/tmp/downloaded/buildpack.zip"))
+		Expect(bitsRepo.UploadBuildpackPath).To(Equal("/tmp/downloaded/buildpack.zip"))
+	})
 })
@@ -3,6 +3,7 @@ package buildpack
 import (
 	"cf/api"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -42,13 +43,13 @@ func (cmd *DeleteBuildpack) Run(c *cli.Context) {
 	force := c.Bool("f")
 
 	if !force {
-		answer := cmd.ui.Confirm("Are you sure you want to delete the buildpack %s ?", terminal.EntityNameColor(buildpackName))
+		answer := cmd.ui.Confirm(i18n.T("Are you sure you want to delete the buildpack %s ?"), terminal.EntityNameColor(buildpackName))
 		if !answer {
 			return
 		}
 	}
 
-	cmd.ui.Say("Deleting buildpack %s...", terminal.EntityNameColor(buildpackName))
+	cmd.ui.Say(i18n.T("Deleting buildpack %s..."), terminal.EntityNameColor(buildpackName))
 
 	buildpack, apiErr := cmd.buildpackRepo.FindByName(buildpackName)
 
@@ -56,17 +57,17 @@ func (cmd *DeleteBuildpack) Run(c *cli.Context) {
 	case nil: //do nothing
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn("Buildpack %s does not exist.", buildpackName)
+		cmd.ui.Warn(i18n.T("Buildpack %s does not exist."), buildpackName)
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 
 	}
 
 	apiErr = cmd.buildpackRepo.Delete(buildpack.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed("Error deleting buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error deleting buildpack %s\n%s"), terminal.EntityNameColor(buildpack.Name), apiErr.Error())
 		return
 	}
 
@@ -0,0 +1,113 @@
+package buildpack
+
+import (
+	"cf/api"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"encoding/json"
+	"errors"
+	"github.com/codegangsta/cli"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// the file export-buildpacks writes next to the buildpack zip files,
+// and import-buildpacks reads
+const buildpackSetFileName = "buildpacks.json"
+
+type buildpackSet struct {
+	Buildpacks []buildpackSetEntry `json:"buildpacks"`
+}
+
+type buildpackSetEntry struct {
+	Name     string `json:"name"`
+	Position *int   `json:"position,omitempty"`
+	Enabled  *bool  `json:"enabled,omitempty"`
+	Locked   *bool  `json:"locked,omitempty"`
+	File     string `json:"file,omitempty"`
+}
+
+type ExportBuildpacks struct {
+	ui                terminal.UI
+	buildpackRepo     api.BuildpackRepository
+	buildpackBitsRepo api.BuildpackBitsRepository
+}
+
+func NewExportBuildpacks(ui terminal.UI, buildpackRepo api.BuildpackRepository, buildpackBitsRepo api.BuildpackBitsRepository) (cmd ExportBuildpacks) {
+	cmd.ui = ui
+	cmd.buildpackRepo = buildpackRepo
+	cmd.buildpackBitsRepo = buildpackBitsRepo
+	return
+}
+
+func (cmd ExportBuildpacks) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "export-buildpacks")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd ExportBuildpacks) Run(c *cli.Context) {
+	dir := c.Args()[0]
+
+	cmd.ui.Say(i18n.T("Exporting buildpacks to %s..."), terminal.EntityNameColor(dir))
+
+	buildpacks, apiErr := listBuildpacksByPosition(cmd.buildpackRepo)
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching buildpacks.\n%s"), apiErr.Error())
+		return
+	}
+
+	err := os.MkdirAll(dir, os.ModeDir|os.ModePerm)
+	if err != nil {
+		cmd.ui.Failed(i18n.T("Error creating directory %s: %s"), dir, err.Error())
+		return
+	}
+
+	set := buildpackSet{Buildpacks: []buildpackSetEntry{}}
+	for _, buildpack := range buildpacks {
+		entry := buildpackSetEntry{
+			Name:     buildpack.Name,
+			Position: buildpack.Position,
+			Enabled:  buildpack.Enabled,
+			Locked:   buildpack.Locked,
+		}
+
+		// buildpacks created without uploading any bits have no file to download
+		if buildpack.Filename != "" {
+			entry.File = buildpack.Name + ".zip"
+			cmd.ui.Say(i18n.T("  downloading %s"), terminal.EntityNameColor(buildpack.Name))
+
+			apiErr = cmd.buildpackBitsRepo.DownloadBuildpack(buildpack, filepath.Join(dir, entry.File))
+			if apiErr != nil {
+				cmd.ui.Failed(i18n.T("Failed downloading buildpack %s.\n%s"), buildpack.Name, apiErr.Error())
+				return
+			}
+		}
+
+		set.Buildpacks = append(set.Buildpacks, entry)
+	}
+
+	data, err := json.MarshalIndent(set, "", "  ")
+	if err != nil {
+		cmd.ui.Failed(i18n.T("Error writing %s: %s"), buildpackSetFileName, err.Error())
+		return
+	}
+
+	err = ioutil.WriteFile(filepath.Join(dir, buildpackSetFileName), data, 0644)
+	if err != nil {
+		cmd.ui.Failed(i18n.T("Error writing %s: %s"), buildpackSetFileName, err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(i18n.T("Exported %d buildpacks"), len(set.Buildpacks))
+}
@@ -0,0 +1,101 @@
+package buildpack_test
+
+import (
+	. "cf/commands/buildpack"
+	"cf/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("export-buildpacks command", func() {
+	var (
+		reqFactory *testreq.FakeReqFactory
+		repo       *testapi.FakeBuildpackRepository
+		bitsRepo   *testapi.FakeBuildpackBitsRepository
+		ui         *testterm.FakeUI
+		dir        string
+	)
+
+	BeforeEach(func() {
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		bitsRepo = &testapi.FakeBuildpackBitsRepository{}
+		ui = &testterm.FakeUI{}
+
+		enabled, locked := true, true
+		buildpacks := buildpacksInOrder("ruby", "empty")
+		buildpacks[0].Enabled = &enabled
+		buildpacks[0].Locked = &locked
+		buildpacks[0].Filename = "ruby_buildpack-v1.zip"
+		repo = &testapi.FakeBuildpackRepository{Buildpacks: buildpacks}
+
+		var err error
+		dir, err = ioutil.TempDir("", "export-buildpacks")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewExportBuildpacks(ui, repo, bitsRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("export-buildpacks", args), reqFactory)
+	}
+
+	It("fails with usage when no directory is given", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand(dir)
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("writes the buildpack settings and bits to the directory", func() {
+		exportDir := filepath.Join(dir, "export")
+		runCommand(exportDir)
+
+		Expect(bitsRepo.DownloadedBuildpackGuids).To(Equal([]string{"ruby-guid"}))
+
+		bits, err := ioutil.ReadFile(filepath.Join(exportDir, "ruby.zip"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(bits)).To(Equal("ruby-bits"))
+
+		settings, err := ioutil.ReadFile(filepath.Join(exportDir, "buildpacks.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings).To(MatchJSON(`{
+			"buildpacks": [
+				{"name": "ruby", "position": 1, "enabled": true, "locked": true, "file": "ruby.zip"},
+				{"name": "empty", "position": 2}
+			]
+		}`))
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Exporting buildpacks to", exportDir},
+			{"OK"},
+			{"Exported 2 buildpacks"},
+		})
+	})
+
+	It("fails when downloading a buildpack fails", func() {
+		bitsRepo.DownloadBuildpackErr = errors.New("download failed")
+		runCommand(dir)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Failed downloading buildpack ruby"},
+		})
+		_, err := os.Stat(filepath.Join(dir, "buildpacks.json"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+})
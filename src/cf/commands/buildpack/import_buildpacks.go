@@ -0,0 +1,160 @@
+package buildpack
+
+import (
+	"cf/api"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"encoding/json"
+	"errors"
+	"github.com/codegangsta/cli"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+)
+
+type ImportBuildpacks struct {
+	ui                terminal.UI
+	buildpackRepo     api.BuildpackRepository
+	buildpackBitsRepo api.BuildpackBitsRepository
+}
+
+func NewImportBuildpacks(ui terminal.UI, buildpackRepo api.BuildpackRepository, buildpackBitsRepo api.BuildpackBitsRepository) (cmd ImportBuildpacks) {
+	cmd.ui = ui
+	cmd.buildpackRepo = buildpackRepo
+	cmd.buildpackBitsRepo = buildpackBitsRepo
+	return
+}
+
+func (cmd ImportBuildpacks) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "import-buildpacks")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd ImportBuildpacks) Run(c *cli.Context) {
+	dir := c.Args()[0]
+
+	cmd.ui.Say(i18n.T("Importing buildpacks from %s..."), terminal.EntityNameColor(dir))
+
+	set, err := readBuildpackSet(dir)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	existing, apiErr := listBuildpacksByPosition(cmd.buildpackRepo)
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching buildpacks.\n%s"), apiErr.Error())
+		return
+	}
+
+	existingByName := map[string]models.Buildpack{}
+	for _, buildpack := range existing {
+		existingByName[buildpack.Name] = buildpack
+	}
+
+	// going through the set in position order leaves the imported
+	// buildpacks in the same relative order they were exported in
+	sort.Stable(buildpackSetEntriesByPosition(set.Buildpacks))
+
+	for _, entry := range set.Buildpacks {
+		buildpack, found := existingByName[entry.Name]
+		if found {
+			cmd.ui.Say(i18n.T("  updating %s"), terminal.EntityNameColor(entry.Name))
+		} else {
+			cmd.ui.Say(i18n.T("  creating %s"), terminal.EntityNameColor(entry.Name))
+		}
+
+		apiErr = cmd.importBuildpack(dir, entry, buildpack, found)
+		if apiErr != nil {
+			cmd.ui.Failed(i18n.T("Failed importing buildpack %s.\n%s"), entry.Name, apiErr.Error())
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(i18n.T("Imported %d buildpacks"), len(set.Buildpacks))
+}
+
+// a locked buildpack does not accept bits, so it is only locked
+// once its bits have been uploaded
+func (cmd ImportBuildpacks) importBuildpack(dir string, entry buildpackSetEntry, buildpack models.Buildpack, exists bool) (apiErr error) {
+	locked := entry.Locked
+	if entry.File != "" && locked != nil && *locked {
+		unlocked := false
+		locked = &unlocked
+	}
+
+	if exists {
+		buildpack.Position = entry.Position
+		buildpack.Enabled = entry.Enabled
+		buildpack.Locked = locked
+		buildpack, apiErr = cmd.buildpackRepo.Update(buildpack)
+	} else {
+		buildpack, apiErr = cmd.buildpackRepo.Create(entry.Name, entry.Position, entry.Enabled, locked)
+	}
+	if apiErr != nil || entry.File == "" {
+		return
+	}
+
+	apiErr = cmd.buildpackBitsRepo.UploadBuildpack(buildpack, filepath.Join(dir, entry.File))
+	if apiErr != nil {
+		return
+	}
+
+	if entry.Locked != nil && *entry.Locked {
+		buildpack.Locked = entry.Locked
+		_, apiErr = cmd.buildpackRepo.Update(buildpack)
+	}
+	return
+}
+
+func readBuildpackSet(dir string) (set buildpackSet, err error) {
+	path := filepath.Join(dir, buildpackSetFileName)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		err = errors.New(i18n.T("Error reading %s: %s", path, err.Error()))
+		return
+	}
+
+	err = json.Unmarshal(data, &set)
+	if err != nil {
+		err = errors.New(i18n.T("Error parsing %s: %s", path, err.Error()))
+		return
+	}
+
+	for _, entry := range set.Buildpacks {
+		if entry.Name == "" {
+			err = errors.New(i18n.T("Error parsing %s: every buildpack needs a name", path))
+			return
+		}
+	}
+	return
+}
+
+type buildpackSetEntriesByPosition []buildpackSetEntry
+
+func (entries buildpackSetEntriesByPosition) Len() int {
+	return len(entries)
+}
+
+func (entries buildpackSetEntriesByPosition) Less(i, j int) bool {
+	a, b := entries[i].Position, entries[j].Position
+	if a == nil || b == nil {
+		return a != nil
+	}
+	return *a < *b
+}
+
+func (entries buildpackSetEntriesByPosition) Swap(i, j int) {
+	entries[i], entries[j] = entries[j], entries[i]
+}
@@ -0,0 +1,146 @@
+package buildpack_test
+
+import (
+	. "cf/commands/buildpack"
+	"cf/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("import-buildpacks command", func() {
+	var (
+		reqFactory *testreq.FakeReqFactory
+		repo       *testapi.FakeBuildpackRepository
+		bitsRepo   *testapi.FakeBuildpackBitsRepository
+		ui         *testterm.FakeUI
+		dir        string
+	)
+
+	writeSettings := func(settings string) {
+		err := ioutil.WriteFile(filepath.Join(dir, "buildpacks.json"), []byte(settings), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		repo = &testapi.FakeBuildpackRepository{Buildpacks: buildpacksInOrder("java")}
+		bitsRepo = &testapi.FakeBuildpackBitsRepository{}
+		ui = &testterm.FakeUI{}
+
+		var err error
+		dir, err = ioutil.TempDir("", "import-buildpacks")
+		Expect(err).NotTo(HaveOccurred())
+
+		writeSettings(`{
+			"buildpacks": [
+				{"name": "java", "position": 2, "enabled": false, "file": "java.zip"},
+				{"name": "ruby", "position": 1, "enabled": true, "locked": true, "file": "ruby.zip"},
+				{"name": "empty", "position": 3}
+			]
+		}`)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewImportBuildpacks(ui, repo, bitsRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("import-buildpacks", args), reqFactory)
+	}
+
+	It("fails with usage when no directory is given", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand(dir)
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("creates missing buildpacks and updates existing ones in position order", func() {
+		runCommand(dir)
+
+		Expect(repo.CreatedBuildpacks).To(HaveLen(2))
+		ruby := repo.CreatedBuildpacks[0]
+		Expect(ruby.Name).To(Equal("ruby"))
+		Expect(*ruby.Position).To(Equal(1))
+		Expect(*ruby.Enabled).To(BeTrue())
+		Expect(*ruby.Locked).To(BeFalse())
+
+		empty := repo.CreatedBuildpacks[1]
+		Expect(empty.Name).To(Equal("empty"))
+		Expect(*empty.Position).To(Equal(3))
+
+		Expect(bitsRepo.UploadedBuildpackPaths).To(Equal([]string{
+			filepath.Join(dir, "ruby.zip"),
+			filepath.Join(dir, "java.zip"),
+		}))
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Importing buildpacks from", dir},
+			{"creating", "ruby"},
+			{"updating", "java"},
+			{"creating", "empty"},
+			{"OK"},
+			{"Imported 3 buildpacks"},
+		})
+	})
+
+	It("locks a buildpack only after uploading its bits", func() {
+		runCommand(dir)
+
+		Expect(repo.UpdatedBuildpacks).To(HaveLen(2))
+		lockedRuby := repo.UpdatedBuildpacks[0]
+		Expect(lockedRuby.Name).To(Equal("ruby"))
+		Expect(*lockedRuby.Locked).To(BeTrue())
+
+		java := repo.UpdatedBuildpacks[1]
+		Expect(java.Name).To(Equal("java"))
+		Expect(java.Guid).To(Equal("java-guid"))
+		Expect(*java.Position).To(Equal(2))
+		Expect(*java.Enabled).To(BeFalse())
+	})
+
+	It("fails when the settings file is missing", func() {
+		os.Remove(filepath.Join(dir, "buildpacks.json"))
+		runCommand(dir)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Error reading", "buildpacks.json"},
+		})
+	})
+
+	It("fails when a buildpack has no name", func() {
+		writeSettings(`{"buildpacks": [{"position": 1}]}`)
+		runCommand(dir)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"every buildpack needs a name"},
+		})
+	})
+
+	It("stops at the first buildpack that cannot be imported", func() {
+		bitsRepo.UploadBuildpackApiResponse = errors.New("upload failed")
+		runCommand(dir)
+
+		Expect(repo.CreatedBuildpacks).To(HaveLen(1))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Failed importing buildpack ruby"},
+			{"upload failed"},
+		})
+	})
+})
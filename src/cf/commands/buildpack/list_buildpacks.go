@@ -2,6 +2,7 @@ package buildpack
 
 import (
 	"cf/api"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -28,9 +29,9 @@ func (cmd ListBuildpacks) GetRequirements(reqFactory requirements.Factory, c *cl
 }
 
 func (cmd ListBuildpacks) Run(c *cli.Context) {
-	cmd.ui.Say("Getting buildpacks...\n")
+	cmd.ui.Say(i18n.T("Getting buildpacks...\n"))
 
-	table := cmd.ui.Table([]string{"buildpack", "position", "enabled", "locked", "filename"})
+	table := cmd.ui.Table([]string{i18n.T("buildpack"), i18n.T("position"), i18n.T("enabled"), i18n.T("locked"), i18n.T("filename")})
 	noBuildpacks := true
 
 	apiErr := cmd.buildpackRepo.ListBuildpacks(func(buildpack models.Buildpack) bool {
@@ -58,11 +59,11 @@ func (cmd ListBuildpacks) Run(c *cli.Context) {
 	})
 
 	if apiErr != nil {
-		cmd.ui.Failed("Failed fetching buildpacks.\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Failed fetching buildpacks.\n%s"), apiErr.Error())
 		return
 	}
 
 	if noBuildpacks {
-		cmd.ui.Say("No buildpacks found")
+		cmd.ui.Say(i18n.T("No buildpacks found"))
 	}
 }
@@ -0,0 +1,187 @@
+package buildpack
+
+import (
+	"cf/api"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+)
+
+type ReorderBuildpacks struct {
+	ui            terminal.UI
+	buildpackRepo api.BuildpackRepository
+}
+
+func NewReorderBuildpacks(ui terminal.UI, buildpackRepo api.BuildpackRepository) (cmd ReorderBuildpacks) {
+	cmd.ui = ui
+	cmd.buildpackRepo = buildpackRepo
+	return
+}
+
+func (cmd ReorderBuildpacks) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) == 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "reorder-buildpacks")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd ReorderBuildpacks) Run(c *cli.Context) {
+	order := c.Args()
+
+	cmd.ui.Say(i18n.T("Reordering buildpacks..."))
+
+	buildpacks, apiErr := listBuildpacksByPosition(cmd.buildpackRepo)
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching buildpacks.\n%s"), apiErr.Error())
+		return
+	}
+
+	err := checkCompleteOrder(buildpacks, order)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	originalOrder := buildpackNames(buildpacks)
+	failedName, apiErr := applyBuildpackOrder(cmd.buildpackRepo, buildpacks, order)
+	if apiErr != nil {
+		message := i18n.T("Failed moving buildpack %s.\n%s", failedName, apiErr.Error())
+		if _, restoreErr := applyBuildpackOrder(cmd.buildpackRepo, buildpacks, originalOrder); restoreErr != nil {
+			message = i18n.T("%s\nThe original order could not be restored: %s", message, restoreErr.Error())
+		} else {
+			message = i18n.T("%s\nThe original order was restored.", message)
+		}
+		cmd.ui.Failed("%s", message)
+		return
+	}
+
+	err = verifyBuildpackOrder(cmd.buildpackRepo, order)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	table := cmd.ui.Table([]string{i18n.T("position"), i18n.T("buildpack")})
+	rows := [][]string{}
+	for index, name := range order {
+		rows = append(rows, []string{fmt.Sprintf("%d", index+1), name})
+	}
+	table.Print(rows)
+}
+
+// the order has to name every buildpack exactly once, otherwise the position
+// of the buildpacks left out would depend on how the cloud controller shifts them
+func checkCompleteOrder(buildpacks []models.Buildpack, order []string) error {
+	known := map[string]bool{}
+	for _, buildpack := range buildpacks {
+		known[buildpack.Name] = true
+	}
+
+	seen := map[string]bool{}
+	for _, name := range order {
+		if !known[name] {
+			return errors.New(i18n.T("Buildpack %s not found", name))
+		}
+		if seen[name] {
+			return errors.New(i18n.T("Buildpack %s is listed more than once", name))
+		}
+		seen[name] = true
+	}
+
+	missing := []string{}
+	for _, buildpack := range buildpacks {
+		if !seen[buildpack.Name] {
+			missing = append(missing, buildpack.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.New(i18n.T("The order must include every buildpack. Missing: %s", strings.Join(missing, ", ")))
+	}
+	return nil
+}
+
+// moving the buildpacks to positions 1, 2, 3... in turn leaves them in the
+// requested order, however the cloud controller shifts the rest
+func applyBuildpackOrder(buildpackRepo api.BuildpackRepository, buildpacks []models.Buildpack, order []string) (failedName string, apiErr error) {
+	byName := map[string]models.Buildpack{}
+	for _, buildpack := range buildpacks {
+		byName[buildpack.Name] = buildpack
+	}
+
+	for index, name := range order {
+		buildpack := byName[name]
+		position := index + 1
+		buildpack.Position = &position
+
+		_, apiErr = buildpackRepo.Update(buildpack)
+		if apiErr != nil {
+			failedName = name
+			return
+		}
+	}
+	return
+}
+
+func verifyBuildpackOrder(buildpackRepo api.BuildpackRepository, order []string) error {
+	buildpacks, apiErr := listBuildpacksByPosition(buildpackRepo)
+	if apiErr != nil {
+		return errors.New(i18n.T("Failed verifying the buildpack order.\n%s", apiErr.Error()))
+	}
+
+	actualOrder := buildpackNames(buildpacks)
+	if strings.Join(actualOrder, " ") != strings.Join(order, " ") {
+		return errors.New(i18n.T("The buildpack order could not be verified.\nExpected: %s\nActual:   %s",
+			strings.Join(order, ", "), strings.Join(actualOrder, ", ")))
+	}
+	return nil
+}
+
+func listBuildpacksByPosition(buildpackRepo api.BuildpackRepository) (buildpacks []models.Buildpack, apiErr error) {
+	apiErr = buildpackRepo.ListBuildpacks(func(buildpack models.Buildpack) bool {
+		buildpacks = append(buildpacks, buildpack)
+		return true
+	})
+	sort.Stable(buildpacksByPosition(buildpacks))
+	return
+}
+
+func buildpackNames(buildpacks []models.Buildpack) (names []string) {
+	for _, buildpack := range buildpacks {
+		names = append(names, buildpack.Name)
+	}
+	return
+}
+
+// buildpacks without a position are sorted last
+type buildpacksByPosition []models.Buildpack
+
+func (buildpacks buildpacksByPosition) Len() int {
+	return len(buildpacks)
+}
+
+func (buildpacks buildpacksByPosition) Less(i, j int) bool {
+	a, b := buildpacks[i].Position, buildpacks[j].Position
+	if a == nil || b == nil {
+		return a != nil
+	}
+	return *a < *b
+}
+
+func (buildpacks buildpacksByPosition) Swap(i, j int) {
+	buildpacks[i], buildpacks[j] = buildpacks[j], buildpacks[i]
+}
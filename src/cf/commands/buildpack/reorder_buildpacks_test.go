@@ -0,0 +1,117 @@
+package buildpack_test
+
+import (
+	. "cf/commands/buildpack"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+func buildpacksInOrder(names ...string) (buildpacks []models.Buildpack) {
+	for index, name := range names {
+		position := index + 1
+		buildpacks = append(buildpacks, models.Buildpack{Name: name, Guid: name + "-guid", Position: &position})
+	}
+	return
+}
+
+var _ = Describe("reorder-buildpacks command", func() {
+	var (
+		reqFactory *testreq.FakeReqFactory
+		repo       *testapi.FakeBuildpackRepository
+		ui         *testterm.FakeUI
+	)
+
+	BeforeEach(func() {
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		repo = &testapi.FakeBuildpackRepository{Buildpacks: buildpacksInOrder("ruby", "java", "go")}
+		ui = &testterm.FakeUI{}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewReorderBuildpacks(ui, repo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("reorder-buildpacks", args), reqFactory)
+	}
+
+	currentOrder := func() (names []string) {
+		for _, buildpack := range repo.Buildpacks {
+			names = append(names, buildpack.Name)
+		}
+		return
+	}
+
+	It("fails with usage when no buildpacks are given", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand("go", "ruby", "java")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("moves every buildpack to its position in the given order", func() {
+		runCommand("go", "ruby", "java")
+
+		Expect(currentOrder()).To(Equal([]string{"go", "ruby", "java"}))
+		Expect(repo.UpdatedBuildpacks).To(HaveLen(3))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Reordering buildpacks"},
+			{"OK"},
+			{"1", "go"},
+			{"2", "ruby"},
+			{"3", "java"},
+		})
+	})
+
+	It("fails when a buildpack is left out of the order", func() {
+		runCommand("go", "ruby")
+
+		Expect(repo.UpdatedBuildpacks).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"must include every buildpack", "java"},
+		})
+	})
+
+	It("fails when a buildpack does not exist", func() {
+		runCommand("go", "ruby", "java", "php")
+
+		Expect(repo.UpdatedBuildpacks).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Buildpack php not found"},
+		})
+	})
+
+	It("fails when a buildpack is listed twice", func() {
+		runCommand("go", "ruby", "go", "java")
+
+		Expect(repo.UpdatedBuildpacks).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Buildpack go is listed more than once"},
+		})
+	})
+
+	It("restores the original order when moving a buildpack fails", func() {
+		repo.UpdateErrs = map[string]error{"java": errors.New("server error")}
+
+		runCommand("go", "java", "ruby")
+
+		Expect(currentOrder()).To(Equal([]string{"ruby", "java", "go"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Failed moving buildpack java"},
+			{"server error"},
+			{"original order was restored"},
+		})
+	})
+})
@@ -2,6 +2,8 @@ package buildpack
 
 import (
 	"cf/api"
+	"cf/i18n"
+	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -44,7 +46,7 @@ func (cmd *UpdateBuildpack) GetRequirements(reqFactory requirements.Factory, c *
 func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	buildpack := cmd.buildpackReq.GetBuildpack()
 
-	cmd.ui.Say("Updating buildpack %s...", terminal.EntityNameColor(buildpack.Name))
+	cmd.ui.Say(i18n.T("Updating buildpack %s..."), terminal.EntityNameColor(buildpack.Name))
 
 	updateBuildpack := false
 
@@ -58,7 +60,7 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	enabled := c.Bool("enable")
 	disabled := c.Bool("disable")
 	if enabled && disabled {
-		cmd.ui.Failed("Cannot specify both enabled and disabled options.")
+		cmd.ui.Failed(i18n.T("Cannot specify both enabled and disabled options."))
 		return
 	}
 
@@ -75,13 +77,13 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	lock := c.Bool("lock")
 	unlock := c.Bool("unlock")
 	if lock && unlock {
-		cmd.ui.Failed("Cannot specify both lock and unlock options.")
+		cmd.ui.Failed(i18n.T("Cannot specify both lock and unlock options."))
 		return
 	}
 
 	dir := c.String("p")
 	if dir != "" && (lock || unlock) {
-		cmd.ui.Failed("Cannot specify buildpack bits and lock/unlock.")
+		cmd.ui.Failed(i18n.T("Cannot specify buildpack bits and lock/unlock."))
 	}
 
 	if lock {
@@ -94,10 +96,30 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 		updateBuildpack = true
 	}
 
+	if dir == "" {
+		cmd.update(buildpack, updateBuildpack, "")
+		return
+	}
+
+	cmd.buildpackBitsRepo.FetchBuildpack(dir, func(localPath string, apiErr error) {
+		if apiErr != nil {
+			cmd.ui.FailWithError(apiErr)
+			return
+		}
+
+		cmd.update(buildpack, updateBuildpack, localPath)
+	})
+}
+
+func (cmd *UpdateBuildpack) update(buildpack models.Buildpack, updateBuildpack bool, dir string) {
+	if dir != "" && !checkBuildpackBits(cmd.ui, cmd.buildpackBitsRepo, dir) {
+		return
+	}
+
 	if updateBuildpack {
 		buildpack, apiErr := cmd.buildpackRepo.Update(buildpack)
 		if apiErr != nil {
-			cmd.ui.Failed("Error updating buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiErr.Error())
+			cmd.ui.Failed(i18n.T("Error updating buildpack %s\n%s"), terminal.EntityNameColor(buildpack.Name), apiErr.Error())
 			return
 		}
 	}
@@ -105,7 +127,7 @@ func (cmd *UpdateBuildpack) Run(c *cli.Context) {
 	if dir != "" {
 		apiErr := cmd.buildpackBitsRepo.UploadBuildpack(buildpack, dir)
 		if apiErr != nil {
-			cmd.ui.Failed("Error uploading buildpack %s\n%s", terminal.EntityNameColor(buildpack.Name), apiErr.Error())
+			cmd.ui.Failed(i18n.T("Error uploading buildpack %s\n%s"), terminal.EntityNameColor(buildpack.Name), apiErr.Error())
 			return
 		}
 	}
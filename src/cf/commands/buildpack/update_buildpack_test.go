@@ -2,6 +2,7 @@ package buildpack_test
 
 import (
 	. "cf/commands/buildpack"
+	"cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
@@ -138,6 +139,33 @@ var _ = Describe("Testing with ginkgo", func() {
 			{"FAILED"},
 		})
 	})
+	It("checks the buildpack bits before updating anything", func() {
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, BuildpackSuccess: true}
+		repo, bitsRepo := getRepositories()
+		bitsRepo.InspectBuildpackReport = models.BuildpackReport{Problems: []string{"bin/compile is not executable"}}
+
+		ui := callUpdateBuildpack([]string{"-p", "buildpack.zip", "-i", "3", "my-buildpack"}, reqFactory, repo, bitsRepo)
+
+		Expect(bitsRepo.InspectBuildpackPath).To(Equal("buildpack.zip"))
+		Expect(repo.UpdateBuildpack.Position).To(BeNil())
+		Expect(bitsRepo.UploadBuildpackPath).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Updating buildpack", "my-buildpack"},
+			{"bin/compile is not executable"},
+			{"FAILED"},
+		})
+	})
+	It("checks and uploads the same download of a buildpack URL", func() {
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, BuildpackSuccess: true}
+		repo, bitsRepo := getRepositories()
+		bitsRepo.FetchBuildpackLocalPath = "/tmp/downloaded/buildpack.zip"
+
+		callUpdateBuildpack([]string{"-p", "https://example.com/buildpack.zip", "my-buildpack"}, reqFactory, repo, bitsRepo)
+
+		Expect(bitsRepo.FetchedBuildpackLocations).To(Equal([]string{"https://example.com/buildpack.zip"}))
+		Expect(bitsRepo.InspectBuildpackPath).To(Equal("/tmp/downloaded/buildpack.zip"))
+		Expect(bitsRepo.UploadBuildpackPath).To(Equal("/tmp/downloaded/buildpack.zip"))
+	})
 	It("TestUpdateBuildpackLock", func() {
 
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true, BuildpackSuccess: true}
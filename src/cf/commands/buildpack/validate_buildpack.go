@@ -0,0 +1,84 @@
+package buildpack
+
+import (
+	"cf/api"
+	"cf/errors"
+	"cf/formatters"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"strconv"
+)
+
+type ValidateBuildpack struct {
+	ui                terminal.UI
+	buildpackBitsRepo api.BuildpackBitsRepository
+}
+
+func NewValidateBuildpack(ui terminal.UI, buildpackBitsRepo api.BuildpackBitsRepository) (cmd ValidateBuildpack) {
+	cmd.ui = ui
+	cmd.buildpackBitsRepo = buildpackBitsRepo
+	return
+}
+
+// buildpacks are checked locally, so neither a targeted API nor a login is required
+func (cmd ValidateBuildpack) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "validate-buildpack")
+		return
+	}
+	return
+}
+
+func (cmd ValidateBuildpack) Run(c *cli.Context) {
+	location := c.Args()[0]
+
+	cmd.ui.Say(i18n.T("Validating buildpack %s..."), terminal.EntityNameColor(location))
+
+	report, apiErr := cmd.buildpackBitsRepo.InspectBuildpack(location)
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("layout:")), report.Layout)
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("files:")), strconv.Itoa(report.Files))
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("size:")), formatters.ByteSize(uint64(report.Size)))
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("sha1:")), report.Checksum)
+	cmd.ui.Say("")
+
+	if !report.IsValid() {
+		sayBuildpackProblems(cmd.ui, report.Problems)
+		cmd.ui.Failed(i18n.T("Buildpack %s is not valid"), location)
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+// runs the validate-buildpack checks before create-buildpack and
+// update-buildpack change anything on the server
+func checkBuildpackBits(ui terminal.UI, buildpackBitsRepo api.BuildpackBitsRepository, location string) bool {
+	report, apiErr := buildpackBitsRepo.InspectBuildpack(location)
+	if apiErr != nil {
+		ui.FailWithError(apiErr)
+		return false
+	}
+
+	if !report.IsValid() {
+		sayBuildpackProblems(ui, report.Problems)
+		ui.Failed(i18n.T("Buildpack %s is not valid"), location)
+		return false
+	}
+	return true
+}
+
+func sayBuildpackProblems(ui terminal.UI, problems []string) {
+	for _, problem := range problems {
+		ui.Say("  %s", terminal.FailureColor(problem))
+	}
+	ui.Say("")
+}
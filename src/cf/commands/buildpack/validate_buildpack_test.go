@@ -0,0 +1,87 @@
+package buildpack_test
+
+import (
+	. "cf/commands/buildpack"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("validate-buildpack command", func() {
+	var (
+		reqFactory *testreq.FakeReqFactory
+		bitsRepo   *testapi.FakeBuildpackBitsRepository
+		ui         *testterm.FakeUI
+	)
+
+	BeforeEach(func() {
+		reqFactory = &testreq.FakeReqFactory{}
+		bitsRepo = &testapi.FakeBuildpackBitsRepository{}
+		ui = &testterm.FakeUI{}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewValidateBuildpack(ui, bitsRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("validate-buildpack", args), reqFactory)
+	}
+
+	It("fails with usage when no path is given", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("does not need a targeted API or a login", func() {
+		runCommand("my-buildpack.zip")
+		Expect(testcmd.CommandDidPassRequirements).To(BeTrue())
+	})
+
+	It("reports the layout, size and checksum of a valid buildpack", func() {
+		bitsRepo.InspectBuildpackReport = models.BuildpackReport{
+			Layout:   "buildpack at the root of the archive",
+			Files:    4,
+			Size:     2048,
+			Checksum: "abc123",
+		}
+		runCommand("my-buildpack.zip")
+
+		Expect(bitsRepo.InspectBuildpackPath).To(Equal("my-buildpack.zip"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Validating buildpack", "my-buildpack.zip"},
+			{"layout:", "buildpack at the root of the archive"},
+			{"files:", "4"},
+			{"size:", "2K"},
+			{"sha1:", "abc123"},
+			{"OK"},
+		})
+	})
+
+	It("lists the problems of an invalid buildpack", func() {
+		bitsRepo.InspectBuildpackReport = models.BuildpackReport{
+			Problems: []string{"bin/detect is not executable", "bin/release is missing"},
+		}
+		runCommand("my-buildpack")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"bin/detect is not executable"},
+			{"bin/release is missing"},
+			{"FAILED"},
+			{"Buildpack my-buildpack is not valid"},
+		})
+	})
+
+	It("fails when the buildpack cannot be read", func() {
+		bitsRepo.InspectBuildpackErr = errors.New("Error opening buildpack file")
+		runCommand("nope")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Error opening buildpack file"},
+		})
+	})
+})
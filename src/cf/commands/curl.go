@@ -3,6 +3,7 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"cf/trace"
@@ -53,7 +54,7 @@ func (cmd *Curl) Run(c *cli.Context) {
 
 	respHeader, respBody, apiErr := cmd.curlRepo.Request(method, path, reqHeader, body)
 	if apiErr != nil {
-		cmd.ui.Failed("Error creating request:\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error creating request:\n%s"), apiErr.Error())
 		return
 	}
 
@@ -3,6 +3,7 @@ package domain
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -43,7 +44,7 @@ func (cmd *CreateDomain) Run(c *cli.Context) {
 	domainName := c.Args()[1]
 	owningOrg := cmd.orgReq.GetOrganization()
 
-	cmd.ui.Say("Creating domain %s for org %s as %s...",
+	cmd.ui.Say(i18n.T("Creating domain %s for org %s as %s..."),
 		terminal.EntityNameColor(domainName),
 		terminal.EntityNameColor(owningOrg.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -51,7 +52,7 @@ func (cmd *CreateDomain) Run(c *cli.Context) {
 
 	_, apiErr := cmd.domainRepo.Create(domainName, owningOrg.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -3,6 +3,7 @@ package domain
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -40,14 +41,14 @@ func (cmd *CreateSharedDomain) GetRequirements(reqFactory requirements.Factory,
 func (cmd *CreateSharedDomain) Run(c *cli.Context) {
 	domainName := c.Args()[0]
 
-	cmd.ui.Say("Creating shared domain %s as %s...",
+	cmd.ui.Say(i18n.T("Creating shared domain %s as %s..."),
 		terminal.EntityNameColor(domainName),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
 	apiErr := cmd.domainRepo.CreateSharedDomain(domainName)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
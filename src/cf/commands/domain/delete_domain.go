@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -46,7 +47,7 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 	domainName := c.Args()[0]
 	force := c.Bool("f")
 
-	cmd.ui.Say("Deleting domain %s as %s...",
+	cmd.ui.Say(i18n.T("Deleting domain %s as %s..."),
 		terminal.EntityNameColor(domainName),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -57,15 +58,15 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 	case nil: //do nothing
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn(apiErr.Error())
+		cmd.ui.Warn("%s", apiErr.Error())
 		return
 	default:
-		cmd.ui.Failed("Error finding domain %s\n%s", domainName, apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error finding domain %s\n%s"), domainName, apiErr.Error())
 		return
 	}
 
 	if !force {
-		answer := cmd.ui.Confirm("Are you sure you want to delete the domain %s and all of its associations?", domainName)
+		answer := cmd.ui.Confirm(i18n.T("Are you sure you want to delete the domain %s and all of its associations?"), domainName)
 
 		if !answer {
 			return
@@ -74,7 +75,7 @@ func (cmd *DeleteDomain) Run(c *cli.Context) {
 
 	apiErr = cmd.domainRepo.Delete(domain.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed("Error deleting domain %s\n%s", domainName, apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error deleting domain %s\n%s"), domainName, apiErr.Error())
 		return
 	}
 
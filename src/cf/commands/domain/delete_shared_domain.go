@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -46,7 +47,7 @@ func (cmd *DeleteSharedDomain) Run(c *cli.Context) {
 	domainName := c.Args()[0]
 	force := c.Bool("f")
 
-	cmd.ui.Say("Deleting domain %s as %s...",
+	cmd.ui.Say(i18n.T("Deleting domain %s as %s..."),
 		terminal.EntityNameColor(domainName),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -56,18 +57,18 @@ func (cmd *DeleteSharedDomain) Run(c *cli.Context) {
 	case nil:
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn(apiErr.Error())
+		cmd.ui.Warn("%s", apiErr.Error())
 		return
 	default:
-		cmd.ui.Failed("Error finding domain %s\n%s", domainName, apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error finding domain %s\n%s"), domainName, apiErr.Error())
 		return
 	}
 
 	if !force {
 		answer := cmd.ui.Confirm(
-			`This domain is shared across all orgs.
+			i18n.T(`This domain is shared across all orgs.
 Deleting it will remove all associated routes, and will make any app with this domain unreachable.
-Are you sure you want to delete the domain %s? `, domainName)
+Are you sure you want to delete the domain %s? `), domainName)
 
 		if !answer {
 			return
@@ -76,7 +77,7 @@ Are you sure you want to delete the domain %s? `, domainName)
 
 	apiErr = cmd.domainRepo.DeleteSharedDomain(domain.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed("Error deleting domain %s\n%s", domainName, apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error deleting domain %s\n%s"), domainName, apiErr.Error())
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -43,13 +44,13 @@ func (cmd *ListDomains) GetRequirements(reqFactory requirements.Factory, c *cli.
 func (cmd *ListDomains) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganizationFields()
 
-	cmd.ui.Say("Getting domains in org %s as %s...",
+	cmd.ui.Say(i18n.T("Getting domains in org %s as %s..."),
 		terminal.EntityNameColor(org.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
 	noDomains := true
-	table := cmd.ui.Table([]string{"name                              ", "status"})
+	table := cmd.ui.Table([]string{i18n.T("name"), i18n.T("status")})
 
 	apiErr := cmd.domainRepo.ListSharedDomains(domainsCallback(table, &noDomains))
 
@@ -57,18 +58,18 @@ func (cmd *ListDomains) Run(c *cli.Context) {
 	case nil:
 	case errors.HttpNotFoundError:
 	default:
-		cmd.ui.Failed("Failed fetching shared domains.\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Failed fetching shared domains.\n%s"), apiErr.Error())
 		return
 	}
 
 	apiErr = cmd.domainRepo.ListDomainsForOrg(org.Guid, domainsCallback(table, &noDomains))
 	if apiErr != nil {
-		cmd.ui.Failed("Failed fetching private domains.\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Failed fetching private domains.\n%s"), apiErr.Error())
 		return
 	}
 
 	if noDomains {
-		cmd.ui.Say("No domains found")
+		cmd.ui.Say(i18n.T("No domains found"))
 	}
 }
 
@@ -82,8 +83,8 @@ func domainsCallback(table terminal.Table, noDomains *bool) func(models.DomainFi
 
 func domainStatusString(domain models.DomainFields) string {
 	if domain.Shared {
-		return "shared"
+		return i18n.T("shared")
 	} else {
-		return "owned"
+		return i18n.T("owned")
 	}
 }
@@ -8,12 +8,15 @@ import (
 	"cf/commands/organization"
 	"cf/commands/route"
 	"cf/commands/service"
+	"cf/commands/serviceaccess"
 	"cf/commands/serviceauthtoken"
 	"cf/commands/servicebroker"
 	"cf/commands/space"
 	"cf/commands/user"
 	"cf/configuration"
+	"cf/journal"
 	"cf/manifest"
+	"cf/staging"
 	"cf/terminal"
 	"errors"
 	"words"
@@ -32,8 +35,10 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 
 	factory.cmdsByName["api"] = NewApi(ui, config, repoLocator.GetEndpointRepository())
 	factory.cmdsByName["apps"] = application.NewListApps(ui, config, repoLocator.GetAppSummaryRepository())
+	factory.cmdsByName["top"] = application.NewTop(ui, config, repoLocator.GetAppSummaryRepository(), repoLocator.GetAppInstancesRepository())
 	factory.cmdsByName["auth"] = NewAuthenticate(ui, config, repoLocator.GetAuthenticationRepository())
 	factory.cmdsByName["buildpacks"] = buildpack.NewListBuildpacks(ui, repoLocator.GetBuildpackRepository())
+	factory.cmdsByName["check-route"] = route.NewCheckRoute(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["create-buildpack"] = buildpack.NewCreateBuildpack(ui, repoLocator.GetBuildpackRepository(), repoLocator.GetBuildpackBitsRepository())
 	factory.cmdsByName["create-domain"] = domain.NewCreateDomain(ui, config, repoLocator.GetDomainRepository())
 	factory.cmdsByName["create-org"] = organization.NewCreateOrg(ui, config, repoLocator.GetOrganizationRepository())
@@ -47,34 +52,51 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["delete-buildpack"] = buildpack.NewDeleteBuildpack(ui, repoLocator.GetBuildpackRepository())
 	factory.cmdsByName["delete-domain"] = domain.NewDeleteDomain(ui, config, repoLocator.GetDomainRepository())
 	factory.cmdsByName["delete-shared-domain"] = domain.NewDeleteSharedDomain(ui, config, repoLocator.GetDomainRepository())
-	factory.cmdsByName["delete-org"] = organization.NewDeleteOrg(ui, config, repoLocator.GetOrganizationRepository())
+	factory.cmdsByName["delete-org"] = organization.NewDeleteOrg(ui, config, repoLocator.GetOrganizationRepository(), repoLocator.GetAppSummaryRepository(), repoLocator.GetServiceSummaryRepository(), repoLocator.GetRouteRepository())
+	factory.cmdsByName["delete-orphaned-routes"] = route.NewDeleteOrphanedRoutes(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["delete-route"] = route.NewDeleteRoute(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["delete-service"] = service.NewDeleteService(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["delete-service-auth-token"] = serviceauthtoken.NewDeleteServiceAuthToken(ui, config, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["delete-service-broker"] = servicebroker.NewDeleteServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
-	factory.cmdsByName["delete-space"] = space.NewDeleteSpace(ui, config, repoLocator.GetSpaceRepository())
+	factory.cmdsByName["delete-space"] = space.NewDeleteSpace(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetAppSummaryRepository(), repoLocator.GetServiceSummaryRepository(), repoLocator.GetRouteRepository())
 	factory.cmdsByName["delete-user"] = user.NewDeleteUser(ui, config, repoLocator.GetUserRepository())
+	factory.cmdsByName["disable-service-access"] = serviceaccess.NewDisableServiceAccess(ui, config, repoLocator.GetServiceRepository(), repoLocator.GetServicePlanRepository(), repoLocator.GetServicePlanVisibilityRepository())
 	factory.cmdsByName["domains"] = domain.NewListDomains(ui, config, repoLocator.GetDomainRepository())
+	factory.cmdsByName["enable-service-access"] = serviceaccess.NewEnableServiceAccess(ui, config, repoLocator.GetServiceRepository(), repoLocator.GetServicePlanRepository(), repoLocator.GetServicePlanVisibilityRepository())
 	factory.cmdsByName["env"] = application.NewEnv(ui, config)
 	factory.cmdsByName["events"] = application.NewEvents(ui, config, repoLocator.GetAppEventsRepository())
+	factory.cmdsByName["export-buildpacks"] = buildpack.NewExportBuildpacks(ui, repoLocator.GetBuildpackRepository(), repoLocator.GetBuildpackBitsRepository())
+	factory.cmdsByName["export-users"] = user.NewExportUsers(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["files"] = application.NewFiles(ui, config, repoLocator.GetAppFilesRepository())
+	factory.cmdsByName["history"] = NewHistory(ui, journal.NewDiskJournal(journal.DefaultFilePath()))
+	factory.cmdsByName["import-buildpacks"] = buildpack.NewImportBuildpacks(ui, repoLocator.GetBuildpackRepository(), repoLocator.GetBuildpackBitsRepository())
+	factory.cmdsByName["import-users"] = user.NewImportUsers(ui, config, repoLocator.GetUserRepository(), repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["login"] = NewLogin(ui, config, repoLocator.GetAuthenticationRepository(), repoLocator.GetEndpointRepository(), repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository())
 	factory.cmdsByName["logout"] = NewLogout(ui, config)
 	factory.cmdsByName["logs"] = application.NewLogs(ui, config, repoLocator.GetLogsRepository())
-	factory.cmdsByName["marketplace"] = service.NewMarketplaceServices(ui, config, repoLocator.GetServiceRepository())
+	factory.cmdsByName["marketplace"] = service.NewMarketplaceServices(ui, config, repoLocator.GetServiceRepository(), repoLocator.GetServiceBrokerRepository())
+	factory.cmdsByName["oauth-token"] = NewOAuthToken(ui, repoLocator.GetAuthenticationRepository())
 	factory.cmdsByName["org"] = organization.NewShowOrg(ui, config)
 	factory.cmdsByName["org-users"] = user.NewOrgUsers(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["orgs"] = organization.NewListOrgs(ui, config, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["passwd"] = NewPassword(ui, repoLocator.GetPasswordRepository(), config)
 	factory.cmdsByName["purge-service-offering"] = service.NewPurgeServiceOffering(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["quotas"] = organization.NewListQuotas(ui, config, repoLocator.GetQuotaRepository())
+	factory.cmdsByName["quota"] = organization.NewShowQuota(ui, config, repoLocator.GetQuotaRepository())
+	factory.cmdsByName["create-quota"] = organization.NewCreateQuota(ui, config, repoLocator.GetQuotaRepository())
+	factory.cmdsByName["update-quota"] = organization.NewUpdateQuota(ui, config, repoLocator.GetQuotaRepository())
+	factory.cmdsByName["delete-quota"] = organization.NewDeleteQuota(ui, config, repoLocator.GetQuotaRepository())
+	factory.cmdsByName["usage"] = organization.NewUsage(ui, config, repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceUsageRepository())
+	factory.cmdsByName["user-roles"] = user.NewUserRoles(ui, config, repoLocator.GetUserRepository(), repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["rename"] = application.NewRenameApp(ui, config, repoLocator.GetApplicationRepository())
 	factory.cmdsByName["rename-org"] = organization.NewRenameOrg(ui, config, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["rename-service"] = service.NewRenameService(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["rename-service-broker"] = servicebroker.NewRenameServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["rename-space"] = space.NewRenameSpace(ui, config, repoLocator.GetSpaceRepository())
+	factory.cmdsByName["reorder-buildpacks"] = buildpack.NewReorderBuildpacks(ui, repoLocator.GetBuildpackRepository())
 	factory.cmdsByName["routes"] = route.NewListRoutes(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["service"] = service.NewShowService(ui)
+	factory.cmdsByName["service-access"] = serviceaccess.NewServiceAccess(ui, config, repoLocator.GetServiceRepository(), repoLocator.GetServicePlanVisibilityRepository(), repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["service-auth-tokens"] = serviceauthtoken.NewListServiceAuthTokens(ui, config, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["service-brokers"] = servicebroker.NewListServiceBrokers(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["services"] = service.NewListServices(ui, config, repoLocator.GetServiceSummaryRepository())
@@ -87,15 +109,20 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["space-users"] = user.NewSpaceUsers(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetUserRepository())
 	factory.cmdsByName["spaces"] = space.NewListSpaces(ui, config, repoLocator.GetSpaceRepository())
 	factory.cmdsByName["stacks"] = NewListStacks(ui, config, repoLocator.GetStackRepository())
+	factory.cmdsByName["stage-local"] = application.NewStageLocal(ui, manifestRepo, repoLocator.GetBuildpackBitsRepository(), staging.NewLocalStager())
 	factory.cmdsByName["target"] = NewTarget(ui, config, repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository())
 	factory.cmdsByName["unbind-service"] = service.NewUnbindService(ui, config, repoLocator.GetServiceBindingRepository())
 	factory.cmdsByName["unset-env"] = application.NewUnsetEnv(ui, config, repoLocator.GetApplicationRepository())
 	factory.cmdsByName["unset-org-role"] = user.NewUnsetOrgRole(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["unset-space-role"] = user.NewUnsetSpaceRole(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetUserRepository())
 	factory.cmdsByName["update-buildpack"] = buildpack.NewUpdateBuildpack(ui, repoLocator.GetBuildpackRepository(), repoLocator.GetBuildpackBitsRepository())
+	factory.cmdsByName["update-service"] = service.NewUpdateService(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["update-service-broker"] = servicebroker.NewUpdateServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["update-service-auth-token"] = serviceauthtoken.NewUpdateServiceAuthToken(ui, config, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["update-user-provided-service"] = service.NewUpdateUserProvidedService(ui, config, repoLocator.GetUserProvidedServiceInstanceRepository())
+	factory.cmdsByName["validate-buildpack"] = buildpack.NewValidateBuildpack(ui, repoLocator.GetBuildpackBitsRepository())
+	factory.cmdsByName["validate-service-broker"] = servicebroker.NewValidateServiceBroker(ui, repoLocator.GetServiceBrokerCatalogRepository())
+	factory.cmdsByName["whoami"] = NewWhoami(ui, config)
 
 	createRoute := route.NewCreateRoute(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["create-route"] = createRoute
@@ -105,7 +132,7 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	displayApp := application.NewShowApp(ui, config, repoLocator.GetAppSummaryRepository(), repoLocator.GetAppInstancesRepository())
 	start := application.NewStart(ui, config, displayApp, repoLocator.GetApplicationRepository(), repoLocator.GetAppInstancesRepository(), repoLocator.GetLogsRepository())
 	stop := application.NewStop(ui, config, repoLocator.GetApplicationRepository())
-	restart := application.NewRestart(ui, start, stop)
+	restart := application.NewRestart(ui, config, start, stop, repoLocator.GetAppInstancesRepository())
 	bind := service.NewBindService(ui, config, repoLocator.GetServiceBindingRepository())
 
 	factory.cmdsByName["app"] = displayApp
@@ -113,6 +140,7 @@ func NewFactory(ui terminal.UI, config configuration.ReadWriter, manifestRepo ma
 	factory.cmdsByName["start"] = start
 	factory.cmdsByName["stop"] = stop
 	factory.cmdsByName["restart"] = restart
+	factory.cmdsByName["restart-app-instance"] = application.NewRestartAppInstance(ui, config, repoLocator.GetAppInstancesRepository())
 	factory.cmdsByName["push"] = application.NewPush(ui, config, manifestRepo, start, stop, bind, repoLocator.GetApplicationRepository(), repoLocator.GetDomainRepository(), repoLocator.GetRouteRepository(), repoLocator.GetStackRepository(), repoLocator.GetServiceRepository(), repoLocator.GetApplicationBitsRepository(), words.NewWordGenerator())
 	factory.cmdsByName["scale"] = application.NewScale(ui, config, restart, repoLocator.GetApplicationRepository())
 
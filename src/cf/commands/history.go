@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"cf"
+	"cf/i18n"
+	"cf/journal"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type History struct {
+	ui      terminal.UI
+	journal journal.Journal
+}
+
+func NewHistory(ui terminal.UI, commandJournal journal.Journal) (cmd *History) {
+	cmd = new(History)
+	cmd.ui = ui
+	cmd.journal = commandJournal
+	return
+}
+
+func (cmd *History) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "history")
+		return
+	}
+	return
+}
+
+func (cmd *History) Run(c *cli.Context) {
+	since := time.Time{}
+	if c.String("since") != "" {
+		var err error
+		since, err = parseSince(c.String("since"), time.Now())
+		if err != nil {
+			cmd.ui.FailWithError(err)
+			return
+		}
+	}
+
+	cmd.ui.Say(i18n.T("Getting command history..."))
+
+	entries, err := cmd.journal.Entries()
+	if err != nil {
+		cmd.ui.Failed(i18n.T("Error reading the command history: %s"), err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	rows := [][]string{}
+	for _, entry := range entries {
+		if entry.StartedAt.Before(since) || (c.Bool("failed") && !entry.Failed()) {
+			continue
+		}
+
+		rows = append(rows, []string{
+			entry.StartedAt.Local().Format(time.RFC3339),
+			entryStatus(entry),
+			entry.User,
+			entry.Target,
+			entry.Org,
+			entry.Space,
+			fmt.Sprintf("%s %s", cf.Name(), strings.Join(entry.Args, " ")),
+		})
+
+		// the changes a command made are listed right below it
+		for _, apiCall := range entry.ApiCalls {
+			rows = append(rows, []string{"", "", "", "", "", "", "  " + apiCall})
+		}
+	}
+
+	if len(rows) == 0 {
+		cmd.ui.Say(i18n.T("No commands found"))
+		return
+	}
+
+	table := cmd.ui.Table([]string{i18n.T("started"), i18n.T("status"), i18n.T("user"), i18n.T("api"), i18n.T("org"), i18n.T("space"), i18n.T("command")})
+	table.Print(rows)
+}
+
+// under --dry-run the requests were only printed, never sent
+func entryStatus(entry journal.Entry) (status string) {
+	status = i18n.T("ok")
+	if entry.Failed() {
+		status = terminal.FailureColor(i18n.T("failed (%d)", entry.ExitStatus))
+	}
+	if entry.DryRun {
+		status = i18n.T("%s (dry run)", status)
+	}
+	return
+}
+
+// since is either a duration back from now, where d counts whole days,
+// or a point in time
+func parseSince(since string, now time.Time) (time.Time, error) {
+	if strings.HasSuffix(since, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(since, "d"))
+		if err == nil {
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+
+	duration, err := time.ParseDuration(since)
+	if err == nil {
+		return now.Add(-duration), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		sinceTime, err := time.ParseInLocation(layout, since, time.Local)
+		if err == nil {
+			return sinceTime, nil
+		}
+	}
+
+	return time.Time{}, errors.New(i18n.T("Invalid value for --since: %s\nUse a duration like 2h or 3d, or a date like 2014-05-01", since))
+}
@@ -0,0 +1,156 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/journal"
+	"errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testjournal "testhelpers/journal"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"time"
+)
+
+var _ = Describe("history command", func() {
+	var (
+		ui             *testterm.FakeUI
+		commandJournal *testjournal.FakeJournal
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+
+		now := time.Now()
+		commandJournal = &testjournal.FakeJournal{
+			EntriesEntries: []journal.Entry{
+				{
+					Command:    "create-space",
+					Args:       []string{"create-space", "old-space"},
+					User:       "my-user",
+					Target:     "https://api.example.com",
+					Org:        "my-org",
+					StartedAt:  now.Add(-72 * time.Hour),
+					ExitStatus: 0,
+					ApiCalls:   []string{"POST https://api.example.com/v2/spaces"},
+				},
+				{
+					Command:    "delete-space",
+					Args:       []string{"delete-space", "my-space", "-f"},
+					User:       "my-user",
+					Target:     "https://api.example.com",
+					Org:        "my-org",
+					Space:      "my-space",
+					StartedAt:  now.Add(-30 * time.Minute),
+					ExitStatus: 1,
+				},
+				{
+					Command:    "delete-space",
+					Args:       []string{"--dry-run", "delete-space", "other-space", "-f"},
+					User:       "my-user",
+					Target:     "https://api.example.com",
+					Org:        "my-org",
+					StartedAt:  now.Add(-20 * time.Minute),
+					ExitStatus: 0,
+					DryRun:     true,
+				},
+				{
+					Command:   "apps",
+					Args:      []string{"apps"},
+					User:      "other-user",
+					Target:    "https://api.example.com",
+					Org:       "my-org",
+					Space:     "my-space",
+					StartedAt: now.Add(-10 * time.Minute),
+				},
+			},
+		}
+	})
+
+	runHistory := func(args ...string) {
+		cmd := NewHistory(ui, commandJournal)
+		testcmd.RunCommand(cmd, testcmd.NewContext("history", args), &testreq.FakeReqFactory{})
+	}
+
+	It("fails with usage when given arguments", func() {
+		runHistory("foo")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("lists every command with the changes it made", func() {
+		runHistory()
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting command history"},
+			{"OK"},
+			{"started", "status", "user", "api", "org", "space", "command"},
+			{"ok", "my-user", "https://api.example.com", "my-org", "create-space old-space"},
+			{"POST https://api.example.com/v2/spaces"},
+			{"failed (1)", "my-user", "my-space", "delete-space my-space -f"},
+			{"ok (dry run)", "my-user", "--dry-run delete-space other-space -f"},
+			{"ok", "other-user", "apps"},
+		})
+	})
+
+	It("only lists failed commands with --failed", func() {
+		runHistory("--failed")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"failed (1)", "delete-space my-space -f"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"create-space old-space"},
+			{"other-user"},
+		})
+	})
+
+	It("only lists commands started since a duration ago", func() {
+		runHistory("--since", "1h")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"delete-space my-space -f"},
+			{"apps"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"create-space old-space"},
+		})
+	})
+
+	It("accepts a number of days for --since", func() {
+		runHistory("--since", "4d")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"create-space old-space"},
+		})
+	})
+
+	It("accepts a date for --since", func() {
+		runHistory("--since", time.Now().Add(24*time.Hour).Format("2006-01-02"))
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"No commands found"},
+		})
+	})
+
+	It("fails when --since can't be parsed", func() {
+		runHistory("--since", "yesterday")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Invalid value for --since: yesterday"},
+		})
+	})
+
+	It("fails when the journal can't be read", func() {
+		commandJournal.EntriesErr = errors.New("permission denied")
+
+		runHistory()
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Error reading the command history: permission denied"},
+		})
+	})
+})
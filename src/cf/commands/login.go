@@ -3,9 +3,11 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
+	"errors"
 	"github.com/codegangsta/cli"
 	"strconv"
 )
@@ -39,6 +41,11 @@ func NewLogin(ui terminal.UI,
 }
 
 func (cmd Login) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if c.Bool("sso") && (c.String("u") != "" || c.String("p") != "") {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "login")
+		return
+	}
 	return
 }
 
@@ -46,7 +53,9 @@ func (cmd Login) Run(c *cli.Context) {
 	cmd.config.ClearSession()
 
 	endpoint, skipSSL := cmd.decideEndpoint(c)
-	NewApi(cmd.ui, cmd.config, cmd.endpointRepo).setApiEndpoint(endpoint, skipSSL)
+	apiCmd := NewApi(cmd.ui, cmd.config, cmd.endpointRepo)
+	apiCmd.setLoginCertificates(endpoint)
+	apiCmd.setApiEndpoint(endpoint, skipSSL)
 
 	defer func() {
 		cmd.ui.Say("")
@@ -71,9 +80,9 @@ func (cmd Login) decideEndpoint(c *cli.Context) (string, bool) {
 	}
 
 	if endpoint == "" {
-		endpoint = cmd.ui.Ask("API endpoint%s", terminal.PromptColor(">"))
+		endpoint = cmd.ui.Ask(i18n.T("API endpoint%s"), terminal.PromptColor(">"))
 	} else {
-		cmd.ui.Say("API endpoint: %s", terminal.EntityNameColor(endpoint))
+		cmd.ui.Say(i18n.T("API endpoint: %s"), terminal.EntityNameColor(endpoint))
 	}
 
 	return endpoint, skipSSL
@@ -82,12 +91,20 @@ func (cmd Login) decideEndpoint(c *cli.Context) (string, bool) {
 func (cmd Login) authenticate(c *cli.Context) {
 	prompts, err := cmd.authenticator.GetLoginPromptsAndSaveUAAServerURL()
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
+	}
+
+	if c.Bool("sso") {
+		cmd.authenticateWithPasscode(prompts)
+		return
 	}
+
 	passwordKeys := []string{}
 	credentials := make(map[string]string)
 	for key, prompt := range prompts {
-		if prompt.Type == configuration.AuthPromptTypePassword {
+		if key == "passcode" {
+			continue
+		} else if prompt.Type == configuration.AuthPromptTypePassword {
 			passwordKeys = append(passwordKeys, key)
 		} else if key == "username" && c.String("u") != "" {
 			credentials[key] = c.String("u")
@@ -108,7 +125,7 @@ func (cmd Login) authenticate(c *cli.Context) {
 			credentials[key] = value
 		}
 
-		cmd.ui.Say("Authenticating...")
+		cmd.ui.Say(i18n.T("Authenticating..."))
 		err = cmd.authenticator.Authenticate(credentials)
 
 		if err == nil {
@@ -117,12 +134,38 @@ func (cmd Login) authenticate(c *cli.Context) {
 			break
 		}
 
-		cmd.ui.Say(err.Error())
+		cmd.ui.Say("%s", err.Error())
 	}
 
 	if err != nil {
-		cmd.ui.Failed("Unable to authenticate.")
+		cmd.ui.Failed(i18n.T("Unable to authenticate."))
+	}
+}
+
+// the prompt for the passcode tells where to get one
+func (cmd Login) authenticateWithPasscode(prompts map[string]configuration.AuthPrompt) {
+	prompt, found := prompts["passcode"]
+	if !found {
+		cmd.ui.Failed(i18n.T("Single sign-on is not supported by %s"), cmd.config.AuthenticationEndpoint())
 	}
+
+	var err error
+	for i := 0; i < maxLoginTries; i++ {
+		passcode := cmd.ui.AskForPassword("%s%s", prompt.DisplayName, terminal.PromptColor(">"))
+
+		cmd.ui.Say(i18n.T("Authenticating..."))
+		err = cmd.authenticator.Authenticate(map[string]string{"passcode": passcode})
+
+		if err == nil {
+			cmd.ui.Ok()
+			cmd.ui.Say("")
+			return
+		}
+
+		cmd.ui.Say("%s", err.Error())
+	}
+
+	cmd.ui.Failed(i18n.T("Unable to authenticate."))
 }
 
 func (cmd Login) setOrganization(c *cli.Context) (isOrgSet bool) {
@@ -135,7 +178,7 @@ func (cmd Login) setOrganization(c *cli.Context) (isOrgSet bool) {
 			return len(availableOrgs) < maxChoices
 		})
 		if apiErr != nil {
-			cmd.ui.Failed("Error finding avilable orgs\n%s", apiErr.Error())
+			cmd.ui.Failed(i18n.T("Error finding avilable orgs\n%s"), apiErr.Error())
 		}
 
 		if len(availableOrgs) == 1 {
@@ -152,7 +195,7 @@ func (cmd Login) setOrganization(c *cli.Context) (isOrgSet bool) {
 
 	org, err := cmd.orgRepo.FindByName(orgName)
 	if err != nil {
-		cmd.ui.Failed("Error finding org %s\n%s", terminal.EntityNameColor(orgName), err.Error())
+		cmd.ui.Failed(i18n.T("Error finding org %s\n%s"), terminal.EntityNameColor(orgName), err.Error())
 	}
 
 	cmd.targetOrganization(org)
@@ -165,12 +208,12 @@ func (cmd Login) promptForOrgName(orgs []models.Organization) string {
 		orgNames = append(orgNames, org.Name)
 	}
 
-	return cmd.promptForName(orgNames, "Select an org (or press enter to skip):", "Org")
+	return cmd.promptForName(orgNames, i18n.T("Select an org (or press enter to skip):"), i18n.T("Org"))
 }
 
 func (cmd Login) targetOrganization(org models.Organization) {
 	cmd.config.SetOrganizationFields(org.OrganizationFields)
-	cmd.ui.Say("Targeted org %s\n", terminal.EntityNameColor(org.Name))
+	cmd.ui.Say(i18n.T("Targeted org %s\n"), terminal.EntityNameColor(org.Name))
 }
 
 func (cmd Login) setSpace(c *cli.Context) {
@@ -183,7 +226,7 @@ func (cmd Login) setSpace(c *cli.Context) {
 			return (len(availableSpaces) < maxChoices)
 		})
 		if err != nil {
-			cmd.ui.Failed("Error finding available spaces\n%s", err.Error())
+			cmd.ui.Failed(i18n.T("Error finding available spaces\n%s"), err.Error())
 		}
 
 		// Target only space if possible
@@ -201,7 +244,7 @@ func (cmd Login) setSpace(c *cli.Context) {
 
 	space, err := cmd.spaceRepo.FindByName(spaceName)
 	if err != nil {
-		cmd.ui.Failed("Error finding space %s\n%s", terminal.EntityNameColor(spaceName), err.Error())
+		cmd.ui.Failed(i18n.T("Error finding space %s\n%s"), terminal.EntityNameColor(spaceName), err.Error())
 	}
 
 	cmd.targetSpace(space)
@@ -213,12 +256,12 @@ func (cmd Login) promptForSpaceName(spaces []models.Space) string {
 		spaceNames = append(spaceNames, space.Name)
 	}
 
-	return cmd.promptForName(spaceNames, "Select a space (or press enter to skip):", "Space")
+	return cmd.promptForName(spaceNames, i18n.T("Select a space (or press enter to skip):"), i18n.T("Space"))
 }
 
 func (cmd Login) targetSpace(space models.Space) {
 	cmd.config.SetSpaceFields(space.SpaceFields)
-	cmd.ui.Say("Targeted space %s\n", terminal.EntityNameColor(space.Name))
+	cmd.ui.Say(i18n.T("Targeted space %s\n"), terminal.EntityNameColor(space.Name))
 }
 
 func (cmd Login) promptForName(names []string, listPrompt, itemPrompt string) string {
@@ -228,7 +271,7 @@ func (cmd Login) promptForName(names []string, listPrompt, itemPrompt string) st
 		var err error
 
 		// list header
-		cmd.ui.Say(listPrompt)
+		cmd.ui.Say("%s", listPrompt)
 
 		// only display list if it is shorter than maxChoices
 		if len(names) < maxChoices {
@@ -236,7 +279,7 @@ func (cmd Login) promptForName(names []string, listPrompt, itemPrompt string) st
 				cmd.ui.Say("%d. %s", i+1, name)
 			}
 		} else {
-			cmd.ui.Say("There are too many options to display, please type in the name.")
+			cmd.ui.Say(i18n.T("There are too many options to display, please type in the name."))
 		}
 
 		nameString = cmd.ui.Ask("%s%s", itemPrompt, terminal.PromptColor(">"))
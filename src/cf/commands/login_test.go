@@ -7,11 +7,15 @@ import (
 	"cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
 	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
 	testterm "testhelpers/terminal"
 )
 
@@ -359,6 +363,69 @@ var _ = Describe("Login Command", func() {
 		})
 	})
 
+	Describe("single sign-on", func() {
+		BeforeEach(func() {
+			authRepo.GetLoginPromptsReturns.Prompts["passcode"] = configuration.AuthPrompt{
+				DisplayName: "One Time Code (Get one at https://login.example.com/passcode)",
+				Type:        configuration.AuthPromptTypePassword,
+			}
+		})
+
+		It("asks for a passcode, showing where to get one", func() {
+			Flags = []string{"--sso"}
+			ui.Inputs = []string{"api.example.com", "the-passcode"}
+
+			l := NewLogin(ui, Config, authRepo, endpointRepo, orgRepo, spaceRepo)
+			testcmd.RunCommand(l, testcmd.NewContext("login", Flags), nil)
+
+			testassert.SliceContains(ui.PasswordPrompts, testassert.Lines{
+				{"One Time Code (Get one at https://login.example.com/passcode)>"},
+			})
+			testassert.SliceDoesNotContain(ui.Prompts, testassert.Lines{
+				{"Username>"},
+			})
+			Expect(authRepo.AuthenticateArgs.Credentials).To(Equal([]map[string]string{
+				{"passcode": "the-passcode"},
+			}))
+			Expect(Config.AccessToken()).To(Equal("my_access_token"))
+		})
+
+		It("does not ask for a passcode without --sso", func() {
+			ui.Inputs = []string{"api.example.com", "the-username", "the-password"}
+
+			l := NewLogin(ui, Config, authRepo, endpointRepo, orgRepo, spaceRepo)
+			testcmd.RunCommand(l, testcmd.NewContext("login", Flags), nil)
+
+			testassert.SliceDoesNotContain(ui.PasswordPrompts, testassert.Lines{
+				{"One Time Code"},
+			})
+		})
+
+		It("fails when the login server offers no passcode", func() {
+			delete(authRepo.GetLoginPromptsReturns.Prompts, "passcode")
+			Flags = []string{"--sso"}
+			ui.Inputs = []string{"api.example.com"}
+
+			l := NewLogin(ui, Config, authRepo, endpointRepo, orgRepo, spaceRepo)
+			testcmd.RunCommand(l, testcmd.NewContext("login", Flags), nil)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"Single sign-on is not supported"},
+			})
+			Expect(authRepo.AuthenticateArgs.Credentials).To(BeEmpty())
+		})
+
+		It("fails with usage when given a username or password too", func() {
+			Flags = []string{"--sso", "-u", "my-user"}
+
+			l := NewLogin(ui, Config, authRepo, endpointRepo, orgRepo, spaceRepo)
+			testcmd.RunCommand(l, testcmd.NewContext("login", Flags), nil)
+
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
 	Describe("updates to the config", func() {
 		var l Login
 
@@ -484,6 +551,56 @@ var _ = Describe("Login Command", func() {
 				})
 			})
 
+			Describe("certificates", func() {
+				var dir string
+
+				BeforeEach(func() {
+					var err error
+					dir, err = ioutil.TempDir("", "login-certs")
+					Expect(err).NotTo(HaveOccurred())
+
+					Config.SetSSLCACert("old-ca-cert")
+					Config.SetSSLClientCertificate("old-client-cert", "old-client-key")
+				})
+
+				AfterEach(func() {
+					os.RemoveAll(dir)
+					os.Setenv("CF_CA_CERT", "")
+				})
+
+				It("forgets the certificates of the old api", func() {
+					Expect(Config.SSLCACert()).To(BeEmpty())
+					Expect(Config.SSLClientCert()).To(BeEmpty())
+					Expect(Config.SSLClientKey()).To(BeEmpty())
+				})
+
+				Describe("when CF_CA_CERT is set", func() {
+					var certPEM string
+
+					BeforeEach(func() {
+						certPEM, _ = testnet.MakeTLSCertPEM()
+						caCertPath := filepath.Join(dir, "ca.pem")
+						Expect(ioutil.WriteFile(caCertPath, []byte(certPEM), 0600)).To(Succeed())
+						os.Setenv("CF_CA_CERT", caCertPath)
+					})
+
+					It("trusts the CA certificates from it", func() {
+						Expect(Config.SSLCACert()).To(Equal(certPEM))
+					})
+				})
+
+				Describe("when logging in to the same api again", func() {
+					BeforeEach(func() {
+						Flags = []string{"-a", "https://api.the-old-endpoint.com", "-u", "the-user-name", "-p", "the-password"}
+					})
+
+					It("keeps the certificates", func() {
+						Expect(Config.SSLCACert()).To(Equal("old-ca-cert"))
+						Expect(Config.SSLClientCert()).To(Equal("old-client-cert"))
+					})
+				})
+			})
+
 			Describe("when there is an invalid SSL cert", func() {
 				BeforeEach(func() {
 					endpointRepo.UpdateEndpointError = errors.NewInvalidSSLCert("https://bobs-burgers.com", "SELF SIGNED SADNESS")
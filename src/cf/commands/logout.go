@@ -2,6 +2,7 @@ package commands
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -23,7 +24,7 @@ func (cmd Logout) GetRequirements(reqFactory requirements.Factory, c *cli.Contex
 }
 
 func (cmd Logout) Run(c *cli.Context) {
-	cmd.ui.Say("Logging out...")
+	cmd.ui.Say(i18n.T("Logging out..."))
 	cmd.config.ClearSession()
 	cmd.ui.Ok()
 }
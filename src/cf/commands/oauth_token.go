@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type OAuthToken struct {
+	ui            terminal.UI
+	authenticator api.AuthenticationRepository
+}
+
+func NewOAuthToken(ui terminal.UI, authenticator api.AuthenticationRepository) (cmd *OAuthToken) {
+	cmd = new(OAuthToken)
+	cmd.ui = ui
+	cmd.authenticator = authenticator
+	return
+}
+
+func (cmd *OAuthToken) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "oauth-token")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+// the token is refreshed first, so other tools get all of its lifetime;
+// it is the only output, so $(cf oauth-token) can be used as a header
+func (cmd *OAuthToken) Run(c *cli.Context) {
+	token, apiErr := cmd.authenticator.RefreshAuthToken()
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Say("%s", token)
+}
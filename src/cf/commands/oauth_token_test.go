@@ -0,0 +1,61 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("oauth-token command", func() {
+	var (
+		ui         *testterm.FakeUI
+		cmd        *OAuthToken
+		authRepo   *testapi.FakeAuthenticationRepository
+		reqFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		authRepo = &testapi.FakeAuthenticationRepository{Config: testconfig.NewRepositoryWithDefaults()}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		cmd = NewOAuthToken(ui, authRepo)
+	})
+
+	Describe("requirements", func() {
+		It("fails with usage when given arguments", func() {
+			testcmd.RunCommand(cmd, testcmd.NewContext("oauth-token", []string{"extra"}), reqFactory)
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails if the user is not logged in", func() {
+			reqFactory.LoginSuccess = false
+			testcmd.RunCommand(cmd, testcmd.NewContext("oauth-token", []string{}), reqFactory)
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+	})
+
+	It("refreshes the token and prints only the token", func() {
+		authRepo.RefreshAuthTokenReturns.Token = "bearer a-fresh-token%2F"
+		testcmd.RunCommand(cmd, testcmd.NewContext("oauth-token", []string{}), reqFactory)
+
+		Expect(authRepo.RefreshAuthTokenCalled).To(BeTrue())
+		Expect(ui.Outputs).To(Equal([]string{"bearer a-fresh-token%2F"}))
+	})
+
+	It("fails when the token cannot be refreshed", func() {
+		authRepo.RefreshAuthTokenReturns.Error = errors.NewTokenRefreshError(errors.New("invalid refresh token"))
+		testcmd.RunCommand(cmd, testcmd.NewContext("oauth-token", []string{}), reqFactory)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"please log in again", "invalid refresh token"},
+		})
+	})
+})
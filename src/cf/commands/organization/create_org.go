@@ -5,6 +5,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -39,7 +40,7 @@ func (cmd CreateOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Con
 func (cmd CreateOrg) Run(c *cli.Context) {
 	name := c.Args()[0]
 
-	cmd.ui.Say("Creating org %s as %s...",
+	cmd.ui.Say(i18n.T("Creating org %s as %s..."),
 		terminal.EntityNameColor(name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -47,13 +48,13 @@ func (cmd CreateOrg) Run(c *cli.Context) {
 	if err != nil {
 		if apiErr, ok := err.(errors.HttpError); ok && apiErr.ErrorCode() == errors.ORG_EXISTS {
 			cmd.ui.Ok()
-			cmd.ui.Warn("Org %s already exists", name)
+			cmd.ui.Warn(i18n.T("Org %s already exists"), name)
 			return
 		} else {
-			cmd.ui.Failed(err.Error())
+			cmd.ui.FailWithError(err)
 		}
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("\nTIP: Use '%s' to target new org", terminal.CommandColor(cf.Name()+" target -o "+name))
+	cmd.ui.Say(i18n.T("\nTIP: Use '%s' to target new org"), terminal.CommandColor(cf.Name()+" target -o "+name))
 }
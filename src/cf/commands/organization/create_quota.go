@@ -0,0 +1,74 @@
+package organization
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type CreateQuota struct {
+	ui        terminal.UI
+	config    configuration.Reader
+	quotaRepo api.QuotaRepository
+}
+
+func NewCreateQuota(ui terminal.UI, config configuration.Reader, quotaRepo api.QuotaRepository) (cmd *CreateQuota) {
+	cmd = new(CreateQuota)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *CreateQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *CreateQuota) Run(c *cli.Context) {
+	quotaName := c.Args()[0]
+
+	cmd.ui.Say(i18n.T("Creating quota %s as %s..."),
+		terminal.EntityNameColor(quotaName),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	quota := models.QuotaFields{
+		Name:                quotaName,
+		InstanceMemoryLimit: models.UnlimitedQuota,
+	}
+
+	err := applyQuotaFlags(&quota, c)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	err = cmd.quotaRepo.Create(quota)
+	if err != nil {
+		if err, ok := err.(errors.HttpError); ok && err.ErrorCode() == errors.QUOTA_NAME_TAKEN {
+			cmd.ui.Ok()
+			cmd.ui.Warn(i18n.T("Quota %s already exists"), quotaName)
+			cmd.ui.Say(i18n.T("TIP: use '%s' to change its limits"), terminal.CommandColor(cf.Name()+" update-quota"))
+			return
+		}
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,90 @@
+package organization_test
+
+import (
+	. "cf/commands/organization"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("create-quota command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+		quotaRepo  *testapi.FakeQuotaRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		quotaRepo = &testapi.FakeQuotaRepository{}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewCreateQuota(ui, testconfig.NewRepositoryWithDefaults(), quotaRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("create-quota", args), reqFactory)
+	}
+
+	It("fails with usage when not given a name", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand("my-quota")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("creates a quota with unlimited instance memory by default", func() {
+		runCommand("my-quota")
+
+		Expect(quotaRepo.CreateQuota).To(Equal(models.QuotaFields{
+			Name:                "my-quota",
+			InstanceMemoryLimit: -1,
+		}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Creating quota", "my-quota", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("creates a quota with every limit given", func() {
+		runCommand("-m", "10G", "-i", "512M", "-r", "100", "-s", "-1", "--allow-paid-service-plans", "my-quota")
+
+		Expect(quotaRepo.CreateQuota).To(Equal(models.QuotaFields{
+			Name:                    "my-quota",
+			MemoryLimit:             10240,
+			InstanceMemoryLimit:     512,
+			RoutesLimit:             100,
+			ServicesLimit:           -1,
+			NonBasicServicesAllowed: true,
+		}))
+	})
+
+	It("fails when given an invalid memory limit", func() {
+		runCommand("-m", "lots", "my-quota")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Could not parse byte quantity", "lots"},
+		})
+	})
+
+	It("warns when the quota already exists", func() {
+		quotaRepo.CreateQuotaErr = errors.NewHttpError(400, errors.QUOTA_NAME_TAKEN, "name taken")
+		runCommand("my-quota")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"my-quota", "already exists"},
+		})
+	})
+})
@@ -4,24 +4,33 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
+	"cf/net"
 	"cf/requirements"
+	"cf/spacecontents"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
 )
 
 type DeleteOrg struct {
-	ui      terminal.UI
-	config  configuration.ReadWriter
-	orgRepo api.OrganizationRepository
-	orgReq  requirements.OrganizationRequirement
+	ui                 terminal.UI
+	config             configuration.ReadWriter
+	orgRepo            api.OrganizationRepository
+	appSummaryRepo     api.AppSummaryRepository
+	serviceSummaryRepo api.ServiceSummaryRepository
+	routeRepo          api.RouteRepository
+	orgReq             requirements.OrganizationRequirement
 }
 
-func NewDeleteOrg(ui terminal.UI, config configuration.ReadWriter, sR api.OrganizationRepository) (cmd *DeleteOrg) {
+func NewDeleteOrg(ui terminal.UI, config configuration.ReadWriter, sR api.OrganizationRepository, appSummaryRepo api.AppSummaryRepository, serviceSummaryRepo api.ServiceSummaryRepository, routeRepo api.RouteRepository) (cmd *DeleteOrg) {
 	cmd = new(DeleteOrg)
 	cmd.ui = ui
 	cmd.config = config
 	cmd.orgRepo = sR
+	cmd.appSummaryRepo = appSummaryRepo
+	cmd.serviceSummaryRepo = serviceSummaryRepo
+	cmd.routeRepo = routeRepo
 	return
 }
 
@@ -39,10 +48,38 @@ func (cmd *DeleteOrg) Run(c *cli.Context) {
 	orgName := c.Args()[0]
 
 	force := c.Bool("f")
+	dryRun := c.Bool("dry-run") || net.IsDryRunEnabled()
+
+	org, apiErr := cmd.orgRepo.FindByName(orgName)
+
+	switch apiErr.(type) {
+	case nil:
+	case errors.ModelNotFoundError:
+		cmd.sayDeleting(orgName)
+		cmd.ui.Ok()
+		cmd.ui.Warn(i18n.T("Org %s does not exist."), orgName)
+		return
+	default:
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	if dryRun || !force {
+		apiErr = cmd.printOrgContents(org)
+		if apiErr != nil {
+			cmd.ui.Failed(i18n.T("Failed fetching the contents of org %s.\n%s"), orgName, apiErr.Error())
+			return
+		}
+	}
+
+	if dryRun {
+		cmd.ui.Say(i18n.T("Dry run, org %s was not deleted"), terminal.EntityNameColor(orgName))
+		return
+	}
 
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete org %s and everything associated with it?%s",
+			i18n.T("Really delete org %s and everything associated with it?%s"),
 			terminal.EntityNameColor(orgName),
 			terminal.PromptColor(">"),
 		)
@@ -52,27 +89,11 @@ func (cmd *DeleteOrg) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting org %s as %s...",
-		terminal.EntityNameColor(orgName),
-		terminal.EntityNameColor(cmd.config.Username()),
-	)
-
-	org, apiErr := cmd.orgRepo.FindByName(orgName)
-
-	switch apiErr.(type) {
-	case nil:
-	case errors.ModelNotFoundError:
-		cmd.ui.Ok()
-		cmd.ui.Warn("Org %s does not exist.", orgName)
-		return
-	default:
-		cmd.ui.Failed(apiErr.Error())
-		return
-	}
+	cmd.sayDeleting(orgName)
 
 	apiErr = cmd.orgRepo.Delete(org.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -84,3 +105,38 @@ func (cmd *DeleteOrg) Run(c *cli.Context) {
 	cmd.ui.Ok()
 	return
 }
+
+func (cmd *DeleteOrg) sayDeleting(orgName string) {
+	cmd.ui.Say(i18n.T("Deleting org %s as %s..."),
+		terminal.EntityNameColor(orgName),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+}
+
+// shared domains are listed with the org's domains but are not deleted with it
+func (cmd *DeleteOrg) printOrgContents(org models.Organization) (apiErr error) {
+	contents, apiErr := spacecontents.Get(cmd.appSummaryRepo, cmd.serviceSummaryRepo, cmd.routeRepo, org.Spaces)
+	if apiErr != nil {
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Deleting org %s will also delete:"), terminal.EntityNameColor(org.Name))
+
+	if len(contents) == 0 {
+		cmd.ui.Say("  " + i18n.T("no spaces"))
+	}
+
+	for _, spaceContents := range contents {
+		cmd.ui.Say("  "+i18n.T("space %s"), terminal.EntityNameColor(spaceContents.Space.Name))
+		spacecontents.Print(cmd.ui, spaceContents, "    ")
+	}
+
+	for _, domain := range org.Domains {
+		if !domain.Shared {
+			cmd.ui.Say("  "+i18n.T("private domain %s"), terminal.EntityNameColor(domain.Name))
+		}
+	}
+
+	cmd.ui.Say("")
+	return
+}
@@ -3,9 +3,12 @@ package organization_test
 import (
 	. "cf/commands/organization"
 	"cf/configuration"
+	"cf/errors"
 	"cf/models"
+	"cf/net"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io/ioutil"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
@@ -19,6 +22,9 @@ var _ = Describe("Testing with ginkgo", func() {
 	var ui *testterm.FakeUI
 	var reqFactory *testreq.FakeReqFactory
 	var orgRepo *testapi.FakeOrgRepository
+	var appSummaryRepo *testapi.FakeAppSummaryRepo
+	var serviceSummaryRepo *testapi.FakeServiceSummaryRepo
+	var routeRepo *testapi.FakeRouteRepository
 
 	BeforeEach(func() {
 		reqFactory = &testreq.FakeReqFactory{}
@@ -40,11 +46,14 @@ var _ = Describe("Testing with ginkgo", func() {
 		org.Name = "org-to-delete"
 		org.Guid = "org-to-delete-guid"
 		orgRepo = &testapi.FakeOrgRepository{Organizations: []models.Organization{org}}
+		appSummaryRepo = &testapi.FakeAppSummaryRepo{}
+		serviceSummaryRepo = &testapi.FakeServiceSummaryRepo{}
+		routeRepo = &testapi.FakeRouteRepository{}
 	})
 
 	It("TestDeleteOrgConfirmingWithY", func() {
 		ui.Inputs = []string{"y"}
-		cmd := NewDeleteOrg(ui, config, orgRepo)
+		cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
 		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		testassert.SliceContains(ui.Prompts, testassert.Lines{
@@ -62,7 +71,7 @@ var _ = Describe("Testing with ginkgo", func() {
 	It("TestDeleteOrgConfirmingWithYes", func() {
 		ui.Inputs = []string{"Yes"}
 
-		cmd := NewDeleteOrg(ui, config, orgRepo)
+		cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
 		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		testassert.SliceContains(ui.Prompts, testassert.Lines{
@@ -86,7 +95,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 		ui.Inputs = []string{"Yes"}
 
-		cmd := NewDeleteOrg(ui, config, orgRepo)
+		cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
 		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		Expect(config.OrganizationFields()).To(Equal(models.OrganizationFields{}))
@@ -105,7 +114,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 		ui.Inputs = []string{"Yes"}
 
-		cmd := NewDeleteOrg(ui, config, orgRepo)
+		cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
 		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		Expect(config.OrganizationFields().Name).To(Equal("some-other-org"))
@@ -114,7 +123,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 	It("TestDeleteOrgWithForceOption", func() {
 		ui.Inputs = []string{"Yes"}
-		cmd := NewDeleteOrg(ui, config, orgRepo)
+		cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
 		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"-f", "org-to-delete"}), reqFactory)
 
 		Expect(len(ui.Prompts)).To(Equal(0))
@@ -128,7 +137,7 @@ var _ = Describe("Testing with ginkgo", func() {
 
 	It("FailsWithUsage when 1st argument is omitted", func() {
 		ui.Inputs = []string{"Yes"}
-		cmd := NewDeleteOrg(ui, config, orgRepo)
+		cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
 		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{}), reqFactory)
 		Expect(ui.FailedWithUsage).To(BeTrue())
 	})
@@ -137,7 +146,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		orgRepo.FindByNameNotFound = true
 
 		ui.Inputs = []string{"y"}
-		cmd := NewDeleteOrg(ui, config, orgRepo)
+		cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
 		testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
 
 		Expect(len(ui.Outputs)).To(Equal(3))
@@ -149,4 +158,123 @@ var _ = Describe("Testing with ginkgo", func() {
 
 		Expect(orgRepo.FindByNameName).To(Equal("org-to-delete"))
 	})
+
+	Describe("listing what the org contains", func() {
+		BeforeEach(func() {
+			org := orgRepo.Organizations[0]
+			org.Spaces = []models.SpaceFields{
+				models.SpaceFields{Name: "space-one", Guid: "space-one-guid"},
+				models.SpaceFields{Name: "space-two", Guid: "space-two-guid"},
+			}
+			org.Domains = []models.DomainFields{
+				models.DomainFields{Name: "private.example.com", Guid: "private-domain-guid"},
+				models.DomainFields{Name: "shared.example.com", Guid: "shared-domain-guid", Shared: true},
+			}
+			orgRepo.Organizations = []models.Organization{org}
+
+			app := models.AppSummary{}
+			app.Name = "app-one"
+			app.InstanceCount = 2
+			app.RouteSummaries = []models.RouteSummary{
+				models.RouteSummary{Host: "app-one", Domain: models.DomainFields{Name: "private.example.com"}},
+			}
+			appSummaryRepo.GetSummariesInSpaceApps = map[string][]models.AppSummary{
+				"space-one-guid": []models.AppSummary{app},
+			}
+
+			instance := models.ServiceInstance{}
+			instance.Name = "my-db"
+			instance.ServicePlan = models.ServicePlanFields{Name: "small", Guid: "small-plan-guid"}
+			instance.ServiceOffering = models.ServiceOfferingFields{Label: "mysql"}
+			serviceSummaryRepo.GetSummariesInSpaceInstances = map[string][]models.ServiceInstance{
+				"space-one-guid": []models.ServiceInstance{instance},
+			}
+
+			orphanedRoute := models.Route{}
+			orphanedRoute.Host = "unmapped"
+			orphanedRoute.Domain = models.DomainFields{Name: "private.example.com"}
+			orphanedRoute.Space = models.SpaceFields{Guid: "space-two-guid"}
+			otherOrgRoute := models.Route{}
+			otherOrgRoute.Host = "elsewhere"
+			otherOrgRoute.Domain = models.DomainFields{Name: "private.example.com"}
+			otherOrgRoute.Space = models.SpaceFields{Guid: "other-space-guid"}
+			routeRepo.Routes = []models.Route{orphanedRoute, otherOrgRoute}
+		})
+
+		It("lists everything the delete removes before asking for confirmation", func() {
+			ui.Inputs = []string{"n"}
+			cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+
+			Expect(appSummaryRepo.GetSummariesInSpaceGuids).To(Equal([]string{"space-one-guid", "space-two-guid"}))
+			Expect(serviceSummaryRepo.GetSummariesInSpaceGuids).To(Equal([]string{"space-one-guid", "space-two-guid"}))
+			Expect(routeRepo.ListRoutesInSpaceGuids).To(Equal([]string{"space-one-guid", "space-two-guid"}))
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Deleting org", "org-to-delete", "will also delete"},
+				{"space", "space-one"},
+				{"app", "app-one", "2 instances"},
+				{"service instance", "my-db", "mysql", "deprovisioned"},
+				{"route", "app-one.private.example.com"},
+				{"space", "space-two"},
+				{"route", "unmapped.private.example.com"},
+				{"private domain", "private.example.com"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"shared.example.com"},
+				{"elsewhere.private.example.com"},
+			})
+			testassert.SliceContains(ui.Prompts, testassert.Lines{
+				{"Really delete", "org-to-delete"},
+			})
+			Expect(orgRepo.DeletedOrganizationGuid).To(Equal(""))
+		})
+
+		It("does not delete anything or ask for confirmation with --dry-run", func() {
+			cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"--dry-run", "org-to-delete"}), reqFactory)
+
+			Expect(len(ui.Prompts)).To(Equal(0))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"app", "app-one", "2 instances"},
+				{"Dry run", "org-to-delete", "not deleted"},
+			})
+			Expect(orgRepo.DeletedOrganizationGuid).To(Equal(""))
+		})
+
+		It("does not delete anything when the global --dry-run is set", func() {
+			net.EnableDryRun(ioutil.Discard)
+			defer net.DisableDryRun()
+			cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+
+			Expect(len(ui.Prompts)).To(Equal(0))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Dry run", "org-to-delete", "not deleted"},
+			})
+			Expect(orgRepo.DeletedOrganizationGuid).To(Equal(""))
+		})
+
+		It("does not list the contents with -f", func() {
+			cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"-f", "org-to-delete"}), reqFactory)
+
+			Expect(appSummaryRepo.GetSummariesInSpaceGuids).To(BeEmpty())
+			Expect(orgRepo.DeletedOrganizationGuid).To(Equal("org-to-delete-guid"))
+		})
+
+		It("fails without deleting when the contents can't be fetched", func() {
+			appSummaryRepo.GetSummariesInSpaceErr = errors.New("summary error")
+			ui.Inputs = []string{"y"}
+			cmd := NewDeleteOrg(ui, config, orgRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("delete-org", []string{"org-to-delete"}), reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"Failed fetching the contents of org org-to-delete"},
+				{"summary error"},
+			})
+			Expect(orgRepo.DeletedOrganizationGuid).To(Equal(""))
+		})
+	})
 })
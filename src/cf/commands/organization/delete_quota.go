@@ -0,0 +1,79 @@
+package organization
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type DeleteQuota struct {
+	ui        terminal.UI
+	config    configuration.Reader
+	quotaRepo api.QuotaRepository
+}
+
+func NewDeleteQuota(ui terminal.UI, config configuration.Reader, quotaRepo api.QuotaRepository) (cmd *DeleteQuota) {
+	cmd = new(DeleteQuota)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *DeleteQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteQuota) Run(c *cli.Context) {
+	quotaName := c.Args()[0]
+
+	if !c.Bool("f") {
+		response := cmd.ui.Confirm(
+			i18n.T("Really delete the quota %s?%s"),
+			terminal.EntityNameColor(quotaName),
+			terminal.PromptColor(">"),
+		)
+		if !response {
+			return
+		}
+	}
+
+	cmd.ui.Say(i18n.T("Deleting quota %s as %s..."),
+		terminal.EntityNameColor(quotaName),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	quota, apiErr := cmd.quotaRepo.FindByName(quotaName)
+
+	switch apiErr.(type) {
+	case nil:
+	case errors.ModelNotFoundError:
+		cmd.ui.Ok()
+		cmd.ui.Warn(i18n.T("Quota %s does not exist"), quotaName)
+		return
+	default:
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	apiErr = cmd.quotaRepo.Delete(quota.Guid)
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Ok()
+}
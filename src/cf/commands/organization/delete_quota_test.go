@@ -0,0 +1,86 @@
+package organization_test
+
+import (
+	. "cf/commands/organization"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("delete-quota command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+		quotaRepo  *testapi.FakeQuotaRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		quotaRepo = &testapi.FakeQuotaRepository{
+			FindByNameQuota: models.QuotaFields{Guid: "my-quota-guid", Name: "my-quota"},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewDeleteQuota(ui, testconfig.NewRepositoryWithDefaults(), quotaRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("delete-quota", args), reqFactory)
+	}
+
+	It("fails with usage when not given a name", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand("my-quota")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("deletes the quota after confirmation", func() {
+		ui.Inputs = []string{"y"}
+		runCommand("my-quota")
+
+		testassert.SliceContains(ui.Prompts, testassert.Lines{
+			{"Really delete the quota", "my-quota"},
+		})
+		Expect(quotaRepo.FindByNameName).To(Equal("my-quota"))
+		Expect(quotaRepo.DeleteGuid).To(Equal("my-quota-guid"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Deleting quota", "my-quota", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("does nothing when the user does not confirm", func() {
+		ui.Inputs = []string{"n"}
+		runCommand("my-quota")
+
+		Expect(quotaRepo.DeleteGuid).To(Equal(""))
+	})
+
+	It("deletes without confirmation when forced", func() {
+		runCommand("-f", "my-quota")
+
+		Expect(ui.Prompts).To(BeEmpty())
+		Expect(quotaRepo.DeleteGuid).To(Equal("my-quota-guid"))
+	})
+
+	It("warns when the quota does not exist", func() {
+		quotaRepo.FindByNameNotFound = true
+		runCommand("-f", "my-quota")
+
+		Expect(quotaRepo.DeleteGuid).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"my-quota", "does not exist"},
+		})
+	})
+})
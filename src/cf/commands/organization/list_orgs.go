@@ -3,6 +3,7 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -30,10 +31,10 @@ func (cmd ListOrgs) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 }
 
 func (cmd ListOrgs) Run(c *cli.Context) {
-	cmd.ui.Say("Getting orgs as %s...\n", terminal.EntityNameColor(cmd.config.Username()))
+	cmd.ui.Say(i18n.T("Getting orgs as %s...\n"), terminal.EntityNameColor(cmd.config.Username()))
 
 	noOrgs := true
-	table := cmd.ui.Table([]string{"name"})
+	table := cmd.ui.Table([]string{i18n.T("name")})
 
 	apiErr := cmd.orgRepo.ListOrgs(func(org models.Organization) bool {
 		table.Print([][]string{{org.Name}})
@@ -42,11 +43,11 @@ func (cmd ListOrgs) Run(c *cli.Context) {
 	})
 
 	if apiErr != nil {
-		cmd.ui.Failed("Failed fetching orgs.\n%s", apiErr)
+		cmd.ui.Failed(i18n.T("Failed fetching orgs.\n%s"), apiErr)
 		return
 	}
 
 	if noOrgs {
-		cmd.ui.Say("No orgs found")
+		cmd.ui.Say(i18n.T("No orgs found"))
 	}
 }
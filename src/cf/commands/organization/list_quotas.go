@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/formatters"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -31,25 +32,29 @@ func (cmd *ListQuotas) GetRequirements(reqFactory requirements.Factory, c *cli.C
 }
 
 func (cmd *ListQuotas) Run(c *cli.Context) {
-	cmd.ui.Say("Getting quotas as %s...", terminal.EntityNameColor(cmd.config.Username()))
+	cmd.ui.Say(i18n.T("Getting quotas as %s..."), terminal.EntityNameColor(cmd.config.Username()))
 
 	quotas, apiErr := cmd.quotaRepo.FindAll()
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
 	table := [][]string{
-		[]string{"name", "memory limit"},
+		[]string{i18n.T("name"), i18n.T("total memory limit"), i18n.T("instance memory limit"), i18n.T("routes"), i18n.T("service instances"), i18n.T("paid service plans")},
 	}
 
 	for _, quota := range quotas {
 		table = append(table, []string{
 			quota.Name,
 			formatters.ByteSize(quota.MemoryLimit * formatters.MEGABYTE),
+			formatQuotaMemory(quota.InstanceMemoryLimit),
+			formatQuotaLimit(quota.RoutesLimit),
+			formatQuotaLimit(quota.ServicesLimit),
+			formatPaidServicePlans(quota.NonBasicServicesAllowed),
 		})
 	}
 
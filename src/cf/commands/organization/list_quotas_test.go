@@ -51,6 +51,9 @@ var _ = Describe("Testing with ginkgo", func() {
 		quota := models.QuotaFields{}
 		quota.Name = "quota-name"
 		quota.MemoryLimit = 1024
+		quota.InstanceMemoryLimit = 512
+		quota.RoutesLimit = 10
+		quota.ServicesLimit = -1
 
 		quotaRepo := &testapi.FakeQuotaRepository{FindAllQuotas: []models.QuotaFields{quota}}
 		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
@@ -59,8 +62,8 @@ var _ = Describe("Testing with ginkgo", func() {
 		testassert.SliceContains(ui.Outputs, testassert.Lines{
 			{"Getting quotas as", "my-user"},
 			{"OK"},
-			{"name", "memory limit"},
-			{"quota-name", "1g"},
+			{"name", "total memory limit", "instance memory limit", "routes", "service instances", "paid service plans"},
+			{"quota-name", "1g", "512M", "10", "unlimited", "disallowed"},
 		})
 	})
 })
@@ -0,0 +1,84 @@
+package organization
+
+import (
+	"cf/formatters"
+	"cf/i18n"
+	"cf/models"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strconv"
+)
+
+func formatQuotaMemory(megabytes int64) string {
+	if megabytes == models.UnlimitedQuota {
+		return i18n.T("unlimited")
+	}
+	return formatters.ByteSize(uint64(megabytes) * formatters.MEGABYTE)
+}
+
+func formatQuotaLimit(limit int) string {
+	if limit == models.UnlimitedQuota {
+		return i18n.T("unlimited")
+	}
+	return strconv.Itoa(limit)
+}
+
+func formatPaidServicePlans(allowed bool) string {
+	if allowed {
+		return i18n.T("allowed")
+	}
+	return i18n.T("disallowed")
+}
+
+func parseQuotaMemory(value string) (megabytes int64, err error) {
+	if value == strconv.Itoa(models.UnlimitedQuota) {
+		megabytes = models.UnlimitedQuota
+		return
+	}
+
+	parsed, err := formatters.ToMegabytes(value)
+	megabytes = int64(parsed)
+	return
+}
+
+// applies the quota flags that were given on the command line,
+// leaving the other limits of the quota untouched
+func applyQuotaFlags(quota *models.QuotaFields, c *cli.Context) (err error) {
+	if c.String("m") != "" {
+		var memory int64
+		memory, err = parseQuotaMemory(c.String("m"))
+		if err != nil {
+			return
+		}
+		if memory == models.UnlimitedQuota {
+			err = errors.New(i18n.T("Total memory limit cannot be unlimited"))
+			return
+		}
+		quota.MemoryLimit = uint64(memory)
+	}
+
+	if c.String("i") != "" {
+		quota.InstanceMemoryLimit, err = parseQuotaMemory(c.String("i"))
+		if err != nil {
+			return
+		}
+	}
+
+	if c.IsSet("r") {
+		quota.RoutesLimit = c.Int("r")
+	}
+
+	if c.IsSet("s") {
+		quota.ServicesLimit = c.Int("s")
+	}
+
+	switch {
+	case c.Bool("allow-paid-service-plans") && c.Bool("disallow-paid-service-plans"):
+		err = errors.New(i18n.T("Paid service plans cannot be both allowed and disallowed"))
+	case c.Bool("allow-paid-service-plans"):
+		quota.NonBasicServicesAllowed = true
+	case c.Bool("disallow-paid-service-plans"):
+		quota.NonBasicServicesAllowed = false
+	}
+	return
+}
@@ -3,6 +3,7 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -42,7 +43,7 @@ func (cmd *RenameOrg) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganization()
 	newName := c.Args()[1]
 
-	cmd.ui.Say("Renaming org %s to %s as %s...",
+	cmd.ui.Say(i18n.T("Renaming org %s to %s as %s..."),
 		terminal.EntityNameColor(org.Name),
 		terminal.EntityNameColor(newName),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -50,7 +51,7 @@ func (cmd *RenameOrg) Run(c *cli.Context) {
 
 	apiErr := cmd.orgRepo.Rename(org.Guid, newName)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 	cmd.ui.Ok()
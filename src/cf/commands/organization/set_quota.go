@@ -3,6 +3,7 @@ package organization
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -46,11 +47,11 @@ func (cmd *SetQuota) Run(c *cli.Context) {
 	quota, apiErr := cmd.quotaRepo.FindByName(quotaName)
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
-	cmd.ui.Say("Setting quota %s to org %s as %s...",
+	cmd.ui.Say(i18n.T("Setting quota %s to org %s as %s..."),
 		terminal.EntityNameColor(quota.Name),
 		terminal.EntityNameColor(org.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -58,7 +59,7 @@ func (cmd *SetQuota) Run(c *cli.Context) {
 
 	apiErr = cmd.quotaRepo.Update(org.Guid, quota.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -2,10 +2,10 @@ package organization
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
-	"fmt"
 	"github.com/codegangsta/cli"
 	"strings"
 )
@@ -41,7 +41,7 @@ func (cmd *ShowOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 
 func (cmd *ShowOrg) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganization()
-	cmd.ui.Say("Getting info for org %s as %s...",
+	cmd.ui.Say(i18n.T("Getting info for org %s as %s..."),
 		terminal.EntityNameColor(org.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -58,9 +58,9 @@ func (cmd *ShowOrg) Run(c *cli.Context) {
 		spaces = append(spaces, space.Name)
 	}
 
-	orgMemoryLimit := fmt.Sprintf("%s (%dM memory limit)", org.QuotaDefinition.Name, org.QuotaDefinition.MemoryLimit)
+	orgMemoryLimit := i18n.T("%s (%dM memory limit)", org.QuotaDefinition.Name, org.QuotaDefinition.MemoryLimit)
 
-	cmd.ui.Say("  domains: %s", terminal.EntityNameColor(strings.Join(domains, ", ")))
-	cmd.ui.Say("  quota:   %s", terminal.EntityNameColor(orgMemoryLimit))
-	cmd.ui.Say("  spaces:  %s", terminal.EntityNameColor(strings.Join(spaces, ", ")))
+	cmd.ui.Say(i18n.T("  domains: %s"), terminal.EntityNameColor(strings.Join(domains, ", ")))
+	cmd.ui.Say(i18n.T("  quota:   %s"), terminal.EntityNameColor(orgMemoryLimit))
+	cmd.ui.Say(i18n.T("  spaces:  %s"), terminal.EntityNameColor(strings.Join(spaces, ", ")))
 }
@@ -0,0 +1,64 @@
+package organization
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/formatters"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ShowQuota struct {
+	ui        terminal.UI
+	config    configuration.Reader
+	quotaRepo api.QuotaRepository
+}
+
+func NewShowQuota(ui terminal.UI, config configuration.Reader, quotaRepo api.QuotaRepository) (cmd *ShowQuota) {
+	cmd = new(ShowQuota)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *ShowQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ShowQuota) Run(c *cli.Context) {
+	quotaName := c.Args()[0]
+
+	cmd.ui.Say(i18n.T("Getting quota %s info as %s..."),
+		terminal.EntityNameColor(quotaName),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	quota, apiErr := cmd.quotaRepo.FindByName(quotaName)
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	cmd.ui.Say("%s:", terminal.EntityNameColor(quota.Name))
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("total memory limit:")), formatters.ByteSize(quota.MemoryLimit*formatters.MEGABYTE))
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("instance memory limit:")), formatQuotaMemory(quota.InstanceMemoryLimit))
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("routes:")), formatQuotaLimit(quota.RoutesLimit))
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("service instances:")), formatQuotaLimit(quota.ServicesLimit))
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("paid service plans:")), formatPaidServicePlans(quota.NonBasicServicesAllowed))
+}
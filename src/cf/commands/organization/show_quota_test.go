@@ -0,0 +1,68 @@
+package organization_test
+
+import (
+	. "cf/commands/organization"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("quota command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+		quotaRepo  *testapi.FakeQuotaRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		quotaRepo = &testapi.FakeQuotaRepository{
+			FindByNameQuota: models.QuotaFields{
+				Name:                    "my-quota",
+				MemoryLimit:             2048,
+				InstanceMemoryLimit:     -1,
+				RoutesLimit:             50,
+				ServicesLimit:           -1,
+				NonBasicServicesAllowed: true,
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewShowQuota(ui, testconfig.NewRepositoryWithDefaults(), quotaRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("quota", args), reqFactory)
+	}
+
+	It("fails with usage when not given a name", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand("my-quota")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("shows every limit of the quota", func() {
+		runCommand("my-quota")
+
+		Expect(quotaRepo.FindByNameName).To(Equal("my-quota"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting quota", "my-quota", "my-user"},
+			{"OK"},
+			{"total memory limit", "2G"},
+			{"instance memory limit", "unlimited"},
+			{"routes", "50"},
+			{"service instances", "unlimited"},
+			{"paid service plans", "allowed"},
+		})
+	})
+})
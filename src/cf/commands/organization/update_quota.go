@@ -0,0 +1,71 @@
+package organization
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type UpdateQuota struct {
+	ui        terminal.UI
+	config    configuration.Reader
+	quotaRepo api.QuotaRepository
+}
+
+func NewUpdateQuota(ui terminal.UI, config configuration.Reader, quotaRepo api.QuotaRepository) (cmd *UpdateQuota) {
+	cmd = new(UpdateQuota)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *UpdateQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || (c.Bool("allow-paid-service-plans") && c.Bool("disallow-paid-service-plans")) {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "update-quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *UpdateQuota) Run(c *cli.Context) {
+	quotaName := c.Args()[0]
+
+	cmd.ui.Say(i18n.T("Updating quota %s as %s..."),
+		terminal.EntityNameColor(quotaName),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	quota, apiErr := cmd.quotaRepo.FindByName(quotaName)
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	err := applyQuotaFlags(&quota, c)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	if c.String("n") != "" {
+		quota.Name = c.String("n")
+	}
+
+	apiErr = cmd.quotaRepo.UpdateQuota(quota)
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Ok()
+}
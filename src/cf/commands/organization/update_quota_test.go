@@ -0,0 +1,88 @@
+package organization_test
+
+import (
+	. "cf/commands/organization"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("update-quota command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+		quotaRepo  *testapi.FakeQuotaRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		quotaRepo = &testapi.FakeQuotaRepository{
+			FindByNameQuota: models.QuotaFields{
+				Guid:                    "my-quota-guid",
+				Name:                    "my-quota",
+				MemoryLimit:             1024,
+				InstanceMemoryLimit:     -1,
+				RoutesLimit:             10,
+				ServicesLimit:           5,
+				NonBasicServicesAllowed: true,
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewUpdateQuota(ui, testconfig.NewRepositoryWithDefaults(), quotaRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("update-quota", args), reqFactory)
+	}
+
+	It("fails with usage when not given a name or given conflicting flags", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+
+		ui = &testterm.FakeUI{}
+		runCommand("--allow-paid-service-plans", "--disallow-paid-service-plans", "my-quota")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand("my-quota")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("only changes the limits that were given", func() {
+		runCommand("-i", "256M", "-s", "20", "--disallow-paid-service-plans", "-n", "new-quota", "my-quota")
+
+		Expect(quotaRepo.FindByNameName).To(Equal("my-quota"))
+		Expect(quotaRepo.UpdatedQuota).To(Equal(models.QuotaFields{
+			Guid:                    "my-quota-guid",
+			Name:                    "new-quota",
+			MemoryLimit:             1024,
+			InstanceMemoryLimit:     256,
+			RoutesLimit:             10,
+			ServicesLimit:           20,
+			NonBasicServicesAllowed: false,
+		}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Updating quota", "my-quota", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("fails when the quota does not exist", func() {
+		quotaRepo.FindByNameNotFound = true
+		runCommand("-m", "2G", "my-quota")
+
+		Expect(quotaRepo.UpdatedQuota).To(Equal(models.QuotaFields{}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"my-quota", "not found"},
+		})
+	})
+})
@@ -0,0 +1,211 @@
+package organization
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/formatters"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"strconv"
+	"strings"
+)
+
+const DefaultUsageWarningThreshold = 0.8
+
+type Usage struct {
+	ui             terminal.UI
+	config         configuration.Reader
+	orgRepo        api.OrganizationRepository
+	spaceUsageRepo api.SpaceUsageRepository
+
+	WarningThreshold float64 // fraction of a limit at which an org is flagged
+}
+
+type orgUsage struct {
+	org    models.Organization
+	spaces []models.SpaceUsage
+	total  models.SpaceUsage
+}
+
+func NewUsage(ui terminal.UI, config configuration.Reader, orgRepo api.OrganizationRepository, spaceUsageRepo api.SpaceUsageRepository) (cmd *Usage) {
+	cmd = new(Usage)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.orgRepo = orgRepo
+	cmd.spaceUsageRepo = spaceUsageRepo
+	cmd.WarningThreshold = DefaultUsageWarningThreshold
+	return
+}
+
+func (cmd *Usage) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 || (c.String("org") != "" && c.Bool("all-orgs")) {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "usage")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+
+	if c.String("org") == "" && !c.Bool("all-orgs") {
+		reqs = append(reqs, reqFactory.NewTargetedOrgRequirement())
+	}
+	return
+}
+
+func (cmd *Usage) Run(c *cli.Context) {
+	orgNames := []string{}
+
+	switch {
+	case c.Bool("all-orgs"):
+		cmd.ui.Say(i18n.T("Getting usage for all orgs as %s..."), terminal.EntityNameColor(cmd.config.Username()))
+		apiErr := cmd.orgRepo.ListOrgs(func(org models.Organization) bool {
+			orgNames = append(orgNames, org.Name)
+			return true
+		})
+		if apiErr != nil {
+			cmd.ui.Failed(i18n.T("Failed fetching orgs.\n%s"), apiErr.Error())
+			return
+		}
+	default:
+		orgName := c.String("org")
+		if orgName == "" {
+			orgName = cmd.config.OrganizationFields().Name
+		}
+		cmd.ui.Say(i18n.T("Getting usage for org %s as %s..."),
+			terminal.EntityNameColor(orgName),
+			terminal.EntityNameColor(cmd.config.Username()),
+		)
+		orgNames = append(orgNames, orgName)
+	}
+
+	usages := []orgUsage{}
+	for _, orgName := range orgNames {
+		usage, apiErr := cmd.getOrgUsage(orgName)
+		if apiErr != nil {
+			cmd.ui.Failed(i18n.T("Failed fetching usage for org %s.\n%s"), orgName, apiErr.Error())
+			return
+		}
+		usages = append(usages, usage)
+	}
+
+	cmd.ui.Ok()
+
+	flagged := []string{}
+	for _, usage := range usages {
+		cmd.ui.Say("")
+		if cmd.printOrgUsage(usage) {
+			flagged = append(flagged, usage.org.Name)
+		}
+	}
+
+	if c.Bool("all-orgs") && len(flagged) > 0 {
+		cmd.ui.Say("")
+		cmd.ui.Warn(i18n.T("%d of %d orgs are close to their quota limits: %s"), len(flagged), len(usages), strings.Join(flagged, ", "))
+	}
+}
+
+// the org is looked up by name because that is the only lookup that
+// comes back with its quota definition and spaces inlined
+func (cmd *Usage) getOrgUsage(orgName string) (usage orgUsage, apiErr error) {
+	usage.org, apiErr = cmd.orgRepo.FindByName(orgName)
+	if apiErr != nil {
+		return
+	}
+
+	for _, space := range usage.org.Spaces {
+		var spaceUsage models.SpaceUsage
+		spaceUsage, apiErr = cmd.spaceUsageRepo.GetUsage(space)
+		if apiErr != nil {
+			return
+		}
+
+		usage.spaces = append(usage.spaces, spaceUsage)
+		usage.total.AppCount += spaceUsage.AppCount
+		usage.total.Memory += spaceUsage.Memory
+		usage.total.DiskQuota += spaceUsage.DiskQuota
+		usage.total.ServiceCount += spaceUsage.ServiceCount
+		usage.total.RouteCount += spaceUsage.RouteCount
+	}
+	return
+}
+
+// prints the usage of one org and returns whether it is close to its limits
+func (cmd *Usage) printOrgUsage(usage orgUsage) (flagged bool) {
+	quota := usage.org.QuotaDefinition
+
+	cmd.ui.Say("%s %s %s %s:",
+		terminal.HeaderColor(i18n.T("org")), terminal.EntityNameColor(usage.org.Name),
+		terminal.HeaderColor(i18n.T("quota")), terminal.EntityNameColor(quota.Name),
+	)
+
+	memory, memoryFlagged := cmd.formatUsage(
+		int64(usage.total.Memory), int64(quota.MemoryLimit),
+		formatters.ByteSize(usage.total.Memory*formatters.MEGABYTE), formatters.ByteSize(quota.MemoryLimit*formatters.MEGABYTE),
+	)
+	routes, routesFlagged := cmd.formatUsage(
+		int64(usage.total.RouteCount), int64(quota.RoutesLimit),
+		strconv.Itoa(usage.total.RouteCount), formatQuotaLimit(quota.RoutesLimit),
+	)
+	services, servicesFlagged := cmd.formatUsage(
+		int64(usage.total.ServiceCount), int64(quota.ServicesLimit),
+		strconv.Itoa(usage.total.ServiceCount), formatQuotaLimit(quota.ServicesLimit),
+	)
+
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("memory:")), memory)
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("routes:")), routes)
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("service instances:")), services)
+	cmd.ui.Say("  %s %s", terminal.HeaderColor(i18n.T("disk:")), formatters.ByteSize(usage.total.DiskQuota*formatters.MEGABYTE))
+	cmd.ui.Say("")
+
+	if len(usage.spaces) == 0 {
+		cmd.ui.Say(i18n.T("No spaces found"))
+	} else {
+		table := cmd.ui.Table([]string{i18n.T("space"), i18n.T("apps"), i18n.T("memory"), i18n.T("disk"), i18n.T("service instances"), i18n.T("routes")})
+		rows := [][]string{}
+		for _, space := range usage.spaces {
+			rows = append(rows, []string{
+				space.Space.Name,
+				strconv.Itoa(space.AppCount),
+				formatters.ByteSize(space.Memory * formatters.MEGABYTE),
+				formatters.ByteSize(space.DiskQuota * formatters.MEGABYTE),
+				strconv.Itoa(space.ServiceCount),
+				strconv.Itoa(space.RouteCount),
+			})
+		}
+		table.Print(rows)
+	}
+
+	flagged = memoryFlagged || routesFlagged || servicesFlagged
+	if flagged {
+		cmd.ui.Say("")
+		cmd.ui.Warn(i18n.T("Org %s is close to its quota limits."), usage.org.Name)
+	}
+	return
+}
+
+func (cmd *Usage) formatUsage(used, limit int64, formattedUsed, formattedLimit string) (formatted string, flagged bool) {
+	if limit == models.UnlimitedQuota {
+		formatted = i18n.T("%s of unlimited", formattedUsed)
+		return
+	}
+
+	percent := 0.0
+	if limit > 0 {
+		percent = float64(used) / float64(limit)
+	} else if used > 0 {
+		percent = 1
+	}
+
+	formatted = i18n.T("%s of %s (%d%%)", formattedUsed, formattedLimit, int(percent*100))
+	flagged = percent >= cmd.WarningThreshold
+	if flagged {
+		formatted = terminal.WarningColor(formatted)
+	}
+	return
+}
@@ -0,0 +1,153 @@
+package organization_test
+
+import (
+	. "cf/commands/organization"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("usage command", func() {
+	var (
+		ui             *testterm.FakeUI
+		reqFactory     *testreq.FakeReqFactory
+		orgRepo        *testapi.FakeOrgRepository
+		spaceUsageRepo *testapi.FakeSpaceUsageRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedOrgSuccess: true}
+
+		busyOrg := models.Organization{}
+		busyOrg.Guid = "my-org-guid"
+		busyOrg.Name = "my-org"
+		busyOrg.QuotaDefinition = models.QuotaFields{Name: "small", MemoryLimit: 2048, RoutesLimit: 10, ServicesLimit: -1}
+		busyOrg.Spaces = []models.SpaceFields{
+			{Guid: "dev-guid", Name: "development"},
+			{Guid: "prod-guid", Name: "production"},
+		}
+
+		quietOrg := models.Organization{}
+		quietOrg.Guid = "quiet-org-guid"
+		quietOrg.Name = "quiet-org"
+		quietOrg.QuotaDefinition = models.QuotaFields{Name: "large", MemoryLimit: 10240, RoutesLimit: 100, ServicesLimit: 10}
+		quietOrg.Spaces = []models.SpaceFields{{Guid: "sandbox-guid", Name: "sandbox"}}
+
+		orgRepo = &testapi.FakeOrgRepository{Organizations: []models.Organization{busyOrg, quietOrg}}
+		spaceUsageRepo = &testapi.FakeSpaceUsageRepository{
+			UsageBySpaceGuid: map[string]models.SpaceUsage{
+				"dev-guid":     {AppCount: 2, Memory: 512, DiskQuota: 2048, ServiceCount: 1, RouteCount: 2},
+				"prod-guid":    {AppCount: 1, Memory: 1280, DiskQuota: 1024, ServiceCount: 3, RouteCount: 1},
+				"sandbox-guid": {AppCount: 1, Memory: 128, DiskQuota: 1024, ServiceCount: 0, RouteCount: 1},
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewUsage(ui, testconfig.NewRepositoryWithDefaults(), orgRepo, spaceUsageRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("usage", args), reqFactory)
+	}
+
+	Describe("requirements", func() {
+		It("requires the user to be logged in", func() {
+			reqFactory.LoginSuccess = false
+			runCommand()
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+
+		It("requires a targeted org when no org is given", func() {
+			reqFactory.TargetedOrgSuccess = false
+			runCommand()
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+
+		It("does not require a targeted org when an org is given", func() {
+			reqFactory.TargetedOrgSuccess = false
+			runCommand("--org", "quiet-org")
+			Expect(testcmd.CommandDidPassRequirements).To(BeTrue())
+		})
+
+		It("fails with usage when given both --org and --all-orgs", func() {
+			runCommand("--org", "my-org", "--all-orgs")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	It("compares the usage of the targeted org with its quota", func() {
+		runCommand()
+
+		Expect(orgRepo.FindByNameName).To(Equal("my-org"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting usage for org", "my-org", "my-user"},
+			{"OK"},
+			{"org", "my-org", "quota", "small"},
+			{"memory", "1.8G of 2G (87%)"},
+			{"routes", "3 of 10 (30%)"},
+			{"service instances", "4 of unlimited"},
+			{"disk", "3G"},
+		})
+	})
+
+	It("breaks the usage down by space", func() {
+		runCommand()
+
+		Expect(spaceUsageRepo.RequestedSpaces).To(Equal([]string{"development", "production"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"space", "apps", "memory", "disk", "service instances", "routes"},
+			{"development", "2", "512M", "2G", "1", "2"},
+			{"production", "1", "1.2G", "1G", "3", "1"},
+		})
+	})
+
+	It("flags orgs close to their limits", func() {
+		runCommand()
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"my-org", "close to its quota limits"},
+		})
+	})
+
+	It("does not flag orgs well within their limits", func() {
+		runCommand("--org", "quiet-org")
+
+		Expect(orgRepo.FindByNameName).To(Equal("quiet-org"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"memory", "128M of 10G (1%)"},
+			{"sandbox", "1", "128M", "1G", "0", "1"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"close to its quota limits"},
+		})
+	})
+
+	It("shows the usage of every org", func() {
+		runCommand("--all-orgs")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting usage for all orgs", "my-user"},
+			{"OK"},
+			{"org", "my-org", "quota", "small"},
+			{"org", "quiet-org", "quota", "large"},
+		})
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"1 of 2 orgs are close to their quota limits", "my-org"},
+		})
+	})
+
+	It("fails when the usage of a space cannot be fetched", func() {
+		spaceUsageRepo.GetUsageErr = true
+		runCommand()
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Failed fetching usage for org", "my-org"},
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -28,31 +29,31 @@ func (cmd Password) GetRequirements(reqFactory requirements.Factory, c *cli.Cont
 }
 
 func (cmd Password) Run(c *cli.Context) {
-	oldPassword := cmd.ui.AskForPassword("Current Password%s", terminal.PromptColor(">"))
-	newPassword := cmd.ui.AskForPassword("New Password%s", terminal.PromptColor(">"))
-	verifiedPassword := cmd.ui.AskForPassword("Verify Password%s", terminal.PromptColor(">"))
+	oldPassword := cmd.ui.AskForPassword(i18n.T("Current Password%s"), terminal.PromptColor(">"))
+	newPassword := cmd.ui.AskForPassword(i18n.T("New Password%s"), terminal.PromptColor(">"))
+	verifiedPassword := cmd.ui.AskForPassword(i18n.T("Verify Password%s"), terminal.PromptColor(">"))
 
 	if verifiedPassword != newPassword {
-		cmd.ui.Failed("Password verification does not match")
+		cmd.ui.Failed(i18n.T("Password verification does not match"))
 		return
 	}
 
-	cmd.ui.Say("Changing password...")
+	cmd.ui.Say(i18n.T("Changing password..."))
 	apiErr := cmd.pwdRepo.UpdatePassword(oldPassword, newPassword)
 
 	switch typedErr := apiErr.(type) {
 	case nil:
 	case errors.HttpError:
 		if typedErr.StatusCode() == 401 {
-			cmd.ui.Failed("Current password did not match")
+			cmd.ui.Failed(i18n.T("Current password did not match"))
 		} else {
-			cmd.ui.Failed(apiErr.Error())
+			cmd.ui.FailWithError(apiErr)
 		}
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 	}
 
 	cmd.ui.Ok()
 	cmd.config.ClearSession()
-	cmd.ui.Say("Please log in again")
+	cmd.ui.Say(i18n.T("Please log in again"))
 }
@@ -0,0 +1,126 @@
+package route
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type CheckRoute struct {
+	ui        terminal.UI
+	config    configuration.Reader
+	routeRepo api.RouteRepository
+}
+
+func NewCheckRoute(ui terminal.UI, config configuration.Reader, routeRepo api.RouteRepository) (cmd *CheckRoute) {
+	cmd = new(CheckRoute)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.routeRepo = routeRepo
+	return
+}
+
+func (cmd *CheckRoute) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "check-route")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewTargetedSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *CheckRoute) Run(c *cli.Context) {
+	host := c.Args()[0]
+	domainName := c.Args()[1]
+	url := host + "." + domainName
+	space := cmd.config.SpaceFields()
+
+	cmd.ui.Say(i18n.T("Checking route %s as %s..."),
+		terminal.EntityNameColor(url),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	route, apiErr := cmd.routeRepo.FindByHostAndDomain(host, domainName)
+	if notFoundErr, ok := apiErr.(errors.ModelNotFoundError); ok && notFoundErr.ModelType == "Route" {
+		cmd.checkInvisibleRoute(host, domainName)
+		return
+	} else if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	appNames := []string{}
+	for _, app := range route.Apps {
+		appNames = append(appNames, app.Name)
+	}
+	apps := strings.Join(appNames, ", ")
+	if apps == "" {
+		apps = i18n.T("none")
+	}
+
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("route:")), url)
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("space:")), route.Space.Name)
+	cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("apps:")), apps)
+	cmd.ui.Say("")
+
+	if route.Space.Guid != space.Guid {
+		cmd.ui.Say(i18n.T("Route %s belongs to space %s and can't be used in space %s"),
+			terminal.EntityNameColor(url),
+			terminal.EntityNameColor(route.Space.Name),
+			terminal.EntityNameColor(space.Name),
+		)
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Route %s belongs to space %s and can be mapped to its apps"),
+		terminal.EntityNameColor(url),
+		terminal.EntityNameColor(space.Name),
+	)
+}
+
+// routes of the orgs the user can't see only show up as reserved
+func (cmd *CheckRoute) checkInvisibleRoute(host, domainName string) {
+	url := host + "." + domainName
+	space := cmd.config.SpaceFields()
+
+	reserved, apiErr := cmd.routeRepo.IsReserved(host, domainName)
+	if httpErr, ok := apiErr.(errors.HttpError); ok && httpErr.ErrorCode() == errors.UNKNOWN_REQUEST {
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+		cmd.ui.Say(i18n.T("Route %s was not found in the spaces visible to you, it may still be taken by another org"),
+			terminal.EntityNameColor(url),
+		)
+		return
+	} else if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if reserved {
+		cmd.ui.Say(i18n.T("Route %s is already taken by a space you don't have access to"),
+			terminal.EntityNameColor(url),
+		)
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Route %s does not exist and can be created in space %s"),
+		terminal.EntityNameColor(url),
+		terminal.EntityNameColor(space.Name),
+	)
+}
@@ -0,0 +1,131 @@
+package route_test
+
+import (
+	. "cf/commands/route"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("check-route command", func() {
+	var (
+		ui         *testterm.FakeUI
+		routeRepo  *testapi.FakeRouteRepository
+		reqFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		routeRepo = &testapi.FakeRouteRepository{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewCheckRoute(ui, testconfig.NewRepositoryWithDefaults(), routeRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("check-route", args), reqFactory)
+	}
+
+	It("fails with usage without a host and a domain", func() {
+		runCommand("my-host")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires a targeted space", func() {
+		reqFactory.TargetedSpaceSuccess = false
+		runCommand("my-host", "example.com")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("reports a route that does not exist as free", func() {
+		routeRepo.FindByHostAndDomainApiErr = errors.NewModelNotFoundError("Route", "my-host")
+		runCommand("my-host", "example.com")
+
+		Expect(routeRepo.FindByHostAndDomainHost).To(Equal("my-host"))
+		Expect(routeRepo.FindByHostAndDomainDomain).To(Equal("example.com"))
+		Expect(routeRepo.IsReservedHost).To(Equal("my-host"))
+		Expect(routeRepo.IsReservedDomain).To(Equal("example.com"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Checking route", "my-host.example.com", "my-user"},
+			{"OK"},
+			{"Route", "my-host.example.com", "does not exist", "can be created in space", "my-space"},
+		})
+	})
+
+	It("reports a route taken by a space the user can't see", func() {
+		routeRepo.FindByHostAndDomainApiErr = errors.NewModelNotFoundError("Route", "my-host")
+		routeRepo.IsReservedReturns = true
+		runCommand("my-host", "example.com")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"Route", "my-host.example.com", "already taken", "don't have access"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"can be created"},
+		})
+	})
+
+	It("does not claim the route is free when the server can't tell", func() {
+		routeRepo.FindByHostAndDomainApiErr = errors.NewModelNotFoundError("Route", "my-host")
+		routeRepo.IsReservedApiErr = errors.NewHttpError(404, errors.UNKNOWN_REQUEST, "Unknown request")
+		runCommand("my-host", "example.com")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"Route", "my-host.example.com", "not found in the spaces visible to you"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"can be created"},
+		})
+	})
+
+	It("fails when the domain does not exist", func() {
+		routeRepo.FindByHostAndDomainApiErr = errors.NewModelNotFoundError("Domain", "example.com")
+		runCommand("my-host", "example.com")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Domain example.com not found"},
+		})
+	})
+
+	It("reports a route owned by the targeted space", func() {
+		route := models.Route{}
+		route.Host = "my-host"
+		route.Space = models.SpaceFields{Name: "my-space", Guid: "my-space-guid"}
+		route.Apps = []models.ApplicationFields{{Name: "dora"}, {Name: "bora"}}
+		routeRepo.FindByHostAndDomainRoute = route
+
+		runCommand("my-host", "example.com")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"route:", "my-host.example.com"},
+			{"space:", "my-space"},
+			{"apps:", "dora, bora"},
+			{"belongs to space", "my-space", "can be mapped"},
+		})
+	})
+
+	It("reports a route owned by another space", func() {
+		route := models.Route{}
+		route.Host = "my-host"
+		route.Space = models.SpaceFields{Name: "other-space", Guid: "other-space-guid"}
+		routeRepo.FindByHostAndDomainRoute = route
+
+		runCommand("my-host", "example.com")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"space:", "other-space"},
+			{"apps:", "none"},
+			{"belongs to space", "other-space", "can't be used in space", "my-space"},
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -60,13 +61,13 @@ func (cmd *CreateRoute) Run(c *cli.Context) {
 
 	_, apiErr := cmd.CreateRoute(hostName, domain, space.SpaceFields)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 }
 
 func (cmd *CreateRoute) CreateRoute(hostName string, domain models.DomainFields, space models.SpaceFields) (route models.Route, apiErr error) {
-	cmd.ui.Say("Creating route %s for org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Creating route %s for org %s / space %s as %s..."),
 		terminal.EntityNameColor(domain.UrlForHost(hostName)),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(space.Name),
@@ -86,7 +87,7 @@ func (cmd *CreateRoute) CreateRoute(hostName string, domain models.DomainFields,
 
 		apiErr = nil
 		cmd.ui.Ok()
-		cmd.ui.Warn("Route %s already exists", route.URL())
+		cmd.ui.Warn(i18n.T("Route %s already exists"), route.URL())
 		return
 	}
 
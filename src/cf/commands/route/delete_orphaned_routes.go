@@ -0,0 +1,103 @@
+package route
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type DeleteOrphanedRoutes struct {
+	ui        terminal.UI
+	config    configuration.Reader
+	routeRepo api.RouteRepository
+}
+
+func NewDeleteOrphanedRoutes(ui terminal.UI, config configuration.Reader, routeRepo api.RouteRepository) (cmd *DeleteOrphanedRoutes) {
+	cmd = new(DeleteOrphanedRoutes)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.routeRepo = routeRepo
+	return
+}
+
+func (cmd *DeleteOrphanedRoutes) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-orphaned-routes")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewTargetedSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteOrphanedRoutes) Run(c *cli.Context) {
+	cmd.ui.Say(i18n.T("Getting routes not mapped to any app in org %s / space %s as %s..."),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	routes := []models.Route{}
+	apiErr := cmd.routeRepo.ListOrphanedRoutesInSpace(cmd.config.SpaceFields().Guid, func(route models.Route) bool {
+		routes = append(routes, route)
+		return true
+	})
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching routes.\n%s"), apiErr.Error())
+		return
+	}
+
+	if len(routes) == 0 {
+		cmd.ui.Ok()
+		cmd.ui.Say(i18n.T("No orphaned routes found"))
+		return
+	}
+
+	if !c.Bool("f") {
+		cmd.ui.Say("")
+		table := cmd.ui.Table([]string{i18n.T("route")})
+		rows := [][]string{}
+		for _, route := range routes {
+			rows = append(rows, []string{route.URL()})
+		}
+		table.Print(rows)
+
+		response := cmd.ui.Confirm(
+			i18n.T("Really delete these %d routes?%s"),
+			len(routes),
+			terminal.PromptColor(">"),
+		)
+		if !response {
+			return
+		}
+	}
+
+	cmd.ui.Say(i18n.T("Deleting orphaned routes as %s..."), terminal.EntityNameColor(cmd.config.Username()))
+
+	// one route that can't be deleted should not keep the others around
+	failures := 0
+	for _, route := range routes {
+		apiErr = cmd.routeRepo.Delete(route.Guid)
+		if apiErr != nil {
+			failures++
+			cmd.ui.Warn(i18n.T("Could not delete route %s: %s"), route.URL(), apiErr.Error())
+		}
+	}
+
+	if failures > 0 {
+		cmd.ui.Failed(i18n.T("Failed deleting %d of %d orphaned routes"), failures, len(routes))
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(i18n.T("Deleted %d orphaned routes"), len(routes))
+}
@@ -0,0 +1,134 @@
+package route_test
+
+import (
+	. "cf/commands/route"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("delete-orphaned-routes command", func() {
+	var (
+		ui         *testterm.FakeUI
+		routeRepo  *testapi.FakeRouteRepository
+		reqFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{Inputs: []string{"y"}}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+
+		mapped := models.Route{}
+		mapped.Guid = "mapped-route-guid"
+		mapped.Host = "www"
+		mapped.Domain = models.DomainFields{Name: "example.com"}
+		mapped.Space = models.SpaceFields{Name: "my-space", Guid: "my-space-guid"}
+		mapped.Apps = []models.ApplicationFields{{Name: "dora"}}
+
+		orphaned1 := models.Route{}
+		orphaned1.Guid = "orphaned-1-guid"
+		orphaned1.Host = "feature-a"
+		orphaned1.Domain = models.DomainFields{Name: "example.com"}
+		orphaned1.Space = models.SpaceFields{Name: "my-space", Guid: "my-space-guid"}
+
+		orphaned2 := models.Route{}
+		orphaned2.Guid = "orphaned-2-guid"
+		orphaned2.Host = "feature-b"
+		orphaned2.Domain = models.DomainFields{Name: "example.com"}
+		orphaned2.Space = models.SpaceFields{Name: "my-space", Guid: "my-space-guid"}
+
+		otherSpace := models.Route{}
+		otherSpace.Guid = "other-space-route-guid"
+		otherSpace.Host = "feature-c"
+		otherSpace.Domain = models.DomainFields{Name: "example.com"}
+		otherSpace.Space = models.SpaceFields{Name: "staging", Guid: "staging-guid"}
+
+		routeRepo = &testapi.FakeRouteRepository{Routes: []models.Route{mapped, orphaned1, orphaned2, otherSpace}}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewDeleteOrphanedRoutes(ui, testconfig.NewRepositoryWithDefaults(), routeRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("delete-orphaned-routes", args), reqFactory)
+	}
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand()
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("requires a targeted space", func() {
+		reqFactory.TargetedSpaceSuccess = false
+		runCommand()
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("fails with usage when given arguments", func() {
+		runCommand("example.com")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("lists the orphaned routes in the targeted space and deletes them after confirmation", func() {
+		runCommand()
+
+		Expect(routeRepo.ListOrphanedRoutesInSpaceGuids).To(Equal([]string{"my-space-guid"}))
+		testassert.SliceContains(ui.Prompts, testassert.Lines{{"Really delete these 2 routes"}})
+		Expect(routeRepo.DeletedRouteGuids).To(Equal([]string{"orphaned-1-guid", "orphaned-2-guid"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting routes not mapped to any app", "my-org", "my-space", "my-user"},
+			{"route"},
+			{"feature-a.example.com"},
+			{"feature-b.example.com"},
+			{"Deleting orphaned routes"},
+			{"OK"},
+			{"Deleted 2 orphaned routes"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"feature-c.example.com"},
+		})
+	})
+
+	It("does not delete anything when the user does not confirm", func() {
+		ui.Inputs = []string{"n"}
+		runCommand()
+
+		Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+	})
+
+	It("does not ask for confirmation with -f", func() {
+		runCommand("-f")
+
+		Expect(ui.Prompts).To(BeEmpty())
+		Expect(routeRepo.DeletedRouteGuids).To(HaveLen(2))
+	})
+
+	It("tells the user when there are no orphaned routes", func() {
+		routeRepo.Routes = []models.Route{routeRepo.Routes[0], routeRepo.Routes[3]}
+		runCommand()
+
+		Expect(ui.Prompts).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"No orphaned routes found"},
+		})
+	})
+
+	It("keeps deleting the other routes when one cannot be deleted", func() {
+		routeRepo.DeleteErrs = map[string]error{"orphaned-1-guid": errors.New("route in use")}
+		runCommand("-f")
+
+		Expect(routeRepo.DeletedRouteGuids).To(Equal([]string{"orphaned-2-guid"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Could not delete route feature-a.example.com", "route in use"},
+			{"FAILED"},
+			{"Failed deleting 1 of 2 orphaned routes"},
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -48,7 +49,7 @@ func (cmd *DeleteRoute) Run(c *cli.Context) {
 	force := c.Bool("f")
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete route %s?%s",
+			i18n.T("Really delete route %s?%s"),
 			terminal.EntityNameColor(url),
 			terminal.PromptColor(">"),
 		)
@@ -58,7 +59,7 @@ func (cmd *DeleteRoute) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting route %s...", terminal.EntityNameColor(url))
+	cmd.ui.Say(i18n.T("Deleting route %s..."), terminal.EntityNameColor(url))
 
 	route, apiErr := cmd.routeRepo.FindByHostAndDomain(host, domainName)
 
@@ -66,16 +67,16 @@ func (cmd *DeleteRoute) Run(c *cli.Context) {
 	case nil:
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn("Route %s does not exist.", url)
+		cmd.ui.Warn(i18n.T("Route %s does not exist."), url)
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	apiErr = cmd.routeRepo.Delete(route.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
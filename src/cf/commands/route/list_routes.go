@@ -3,6 +3,7 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -29,11 +30,16 @@ func (cmd ListRoutes) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 }
 
 func (cmd ListRoutes) Run(c *cli.Context) {
-	cmd.ui.Say("Getting routes as %s ...\n",
+	if c.Bool("orphaned") {
+		cmd.listOrphanedRoutes()
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Getting routes as %s ...\n"),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
-	table := cmd.ui.Table([]string{"host", "domain", "apps"})
+	table := cmd.ui.Table([]string{i18n.T("host"), i18n.T("domain"), i18n.T("apps")})
 
 	noRoutes := true
 	apiErr := cmd.routeRepo.ListRoutes(func(route models.Route) bool {
@@ -52,11 +58,39 @@ func (cmd ListRoutes) Run(c *cli.Context) {
 	})
 
 	if apiErr != nil {
-		cmd.ui.Failed("Failed fetching routes.\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Failed fetching routes.\n%s"), apiErr.Error())
+		return
+	}
+
+	if noRoutes {
+		cmd.ui.Say(i18n.T("No routes found"))
+	}
+}
+
+func (cmd ListRoutes) listOrphanedRoutes() {
+	cmd.ui.Say(i18n.T("Getting routes not mapped to any app as %s ...\n"),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	table := cmd.ui.Table([]string{i18n.T("host"), i18n.T("domain"), i18n.T("space")})
+
+	noRoutes := true
+	apiErr := cmd.routeRepo.ListOrphanedRoutes(func(route models.Route) bool {
+		table.Print([][]string{{
+			route.Host,
+			route.Domain.Name,
+			route.Space.Name,
+		}})
+		noRoutes = false
+		return true
+	})
+
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching routes.\n%s"), apiErr.Error())
 		return
 	}
 
 	if noRoutes {
-		cmd.ui.Say("No routes found")
+		cmd.ui.Say(i18n.T("No orphaned routes found"))
 	}
 }
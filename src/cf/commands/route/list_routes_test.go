@@ -87,4 +87,40 @@ var _ = Describe("routes command", func() {
 			{"FAILED"},
 		})
 	})
+
+	Describe("--orphaned", func() {
+		BeforeEach(func() {
+			mapped := models.Route{}
+			mapped.Host = "mapped"
+			mapped.Domain = models.DomainFields{Name: "example.com"}
+			mapped.Apps = []models.ApplicationFields{{Name: "dora"}}
+
+			orphaned := models.Route{}
+			orphaned.Host = "feature-branch"
+			orphaned.Domain = models.DomainFields{Name: "example.com"}
+			orphaned.Space = models.SpaceFields{Name: "development"}
+
+			repo.Routes = []models.Route{mapped, orphaned}
+		})
+
+		It("lists the routes that are not mapped to any app", func() {
+			context := testcmd.NewContext("routes", []string{"--orphaned"})
+			testcmd.RunCommand(cmd, context, reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Getting routes not mapped to any app", "my-user"},
+				{"host", "domain", "space"},
+				{"feature-branch", "example.com", "development"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{{"mapped", "example.com"}})
+		})
+
+		It("tells the user when there are no orphaned routes", func() {
+			repo.Routes = repo.Routes[:1]
+			context := testcmd.NewContext("routes", []string{"--orphaned"})
+			testcmd.RunCommand(cmd, context, reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{{"No orphaned routes found"}})
+		})
+	})
 })
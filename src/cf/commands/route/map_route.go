@@ -3,6 +3,7 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -55,9 +56,9 @@ func (cmd *MapRoute) Run(c *cli.Context) {
 
 	route, apiErr := cmd.routeCreator.CreateRoute(hostName, domain, cmd.config.SpaceFields())
 	if apiErr != nil {
-		cmd.ui.Failed("Error resolving route:\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error resolving route:\n%s"), apiErr.Error())
 	}
-	cmd.ui.Say("Adding route %s to app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Adding route %s to app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(route.URL()),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -67,7 +68,7 @@ func (cmd *MapRoute) Run(c *cli.Context) {
 
 	apiErr = cmd.routeRepo.Bind(route.Guid, app.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
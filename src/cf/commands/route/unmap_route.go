@@ -3,6 +3,7 @@ package route
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -53,9 +54,9 @@ func (cmd *UnmapRoute) Run(c *cli.Context) {
 
 	route, apiErr := cmd.routeRepo.FindByHostAndDomain(hostName, domain.Name)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 	}
-	cmd.ui.Say("Removing route %s from app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Removing route %s from app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(route.URL()),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -65,7 +66,7 @@ func (cmd *UnmapRoute) Run(c *cli.Context) {
 
 	apiErr = cmd.routeRepo.Unbind(route.Guid, app.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
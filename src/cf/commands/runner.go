@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"cf/i18n"
+	"cf/net"
 	"cf/requirements"
+	"cf/terminal"
 	"errors"
 	"fmt"
 	"github.com/codegangsta/cli"
@@ -31,11 +34,19 @@ func NewRunner(cmdFactory Factory, reqFactory requirements.Factory) (runner Conc
 func (runner ConcreteRunner) RunCmdByName(cmdName string, c *cli.Context) (err error) {
 	cmd, err := runner.cmdFactory.GetByCmdName(cmdName)
 	if err != nil {
-		fmt.Printf("Error finding command %s\n", cmdName)
-		os.Exit(1)
+		fmt.Println(i18n.T("Error finding command %s", cmdName))
+		terminal.Exit(terminal.EXIT_USAGE)
 		return
 	}
 
+	if c.GlobalBool("dry-run") {
+		net.EnableDryRun(os.Stdout)
+	}
+
+	if c.GlobalBool("non-interactive") {
+		terminal.EnableNonInteractive()
+	}
+
 	requirements, err := cmd.GetRequirements(runner.reqFactory, c)
 	if err != nil {
 		return
@@ -44,6 +55,9 @@ func (runner ConcreteRunner) RunCmdByName(cmdName string, c *cli.Context) (err e
 	for _, requirement := range requirements {
 		success := requirement.Execute()
 		if !success {
+			if terminal.ExitStatus() == terminal.EXIT_OK {
+				terminal.SetExitStatus(terminal.EXIT_FAILURE)
+			}
 			err = errors.New("Error in requirement")
 			return
 		}
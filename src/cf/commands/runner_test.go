@@ -2,7 +2,10 @@ package commands_test
 
 import (
 	. "cf/commands"
+	"cf/net"
 	"cf/requirements"
+	"cf/terminal"
+	"flag"
 	"github.com/codegangsta/cli"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -75,4 +78,62 @@ var _ = Describe("Requirements runner", func() {
 
 		Expect(err).To(HaveOccurred())
 	})
+
+	It("turns on dry run for the gateways when the global --dry-run flag is given", func() {
+		defer net.DisableDryRun()
+
+		cmd := TestCommand{}
+		runner := NewRunner(&TestCommandFactory{Cmd: &cmd}, nil)
+
+		globalSet := flag.NewFlagSet("cf", flag.ContinueOnError)
+		globalSet.Bool("dry-run", false, "")
+		globalSet.Parse([]string{"--dry-run"})
+		ctxt := cli.NewContext(cli.NewApp(), flag.NewFlagSet("some-cmd", flag.ContinueOnError), globalSet)
+
+		err := runner.RunCmdByName("some-cmd", ctxt)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.WasRunWith).To(Equal(ctxt))
+		Expect(net.IsDryRunEnabled()).To(BeTrue())
+	})
+
+	It("turns on non-interactive mode when the global --non-interactive flag is given", func() {
+		defer terminal.DisableNonInteractive()
+
+		runner := NewRunner(&TestCommandFactory{Cmd: &TestCommand{}}, nil)
+
+		globalSet := flag.NewFlagSet("cf", flag.ContinueOnError)
+		globalSet.Bool("non-interactive", false, "")
+		globalSet.Parse([]string{"--non-interactive"})
+		ctxt := cli.NewContext(cli.NewApp(), flag.NewFlagSet("some-cmd", flag.ContinueOnError), globalSet)
+
+		runner.RunCmdByName("some-cmd", ctxt)
+
+		Expect(terminal.IsNonInteractive()).To(BeTrue())
+	})
+
+	Describe("exit status", func() {
+		AfterEach(func() {
+			terminal.SetExitStatus(terminal.EXIT_OK)
+		})
+
+		It("fails when a requirement fails", func() {
+			cmd := TestCommand{Reqs: []requirements.Requirement{&TestRequirement{Passes: false}}}
+			runner := NewRunner(&TestCommandFactory{Cmd: &cmd}, nil)
+
+			runner.RunCmdByName("some-cmd", testcmd.NewContext("login", []string{}))
+
+			Expect(terminal.ExitStatus()).To(Equal(terminal.EXIT_FAILURE))
+		})
+
+		It("keeps the exit status a failed requirement set", func() {
+			cmd := TestCommand{Reqs: []requirements.Requirement{&TestRequirement{Passes: false}}}
+			runner := NewRunner(&TestCommandFactory{Cmd: &cmd}, nil)
+
+			terminal.SetExitStatus(terminal.EXIT_AUTH_FAILURE)
+			runner.RunCmdByName("some-cmd", testcmd.NewContext("login", []string{}))
+
+			Expect(terminal.ExitStatus()).To(Equal(terminal.EXIT_AUTH_FAILURE))
+		})
+	})
 })
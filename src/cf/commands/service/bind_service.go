@@ -5,6 +5,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	cferrors "cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -55,7 +56,7 @@ func (cmd *BindService) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 
-	cmd.ui.Say("Binding service %s to app %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Binding service %s to app %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(serviceInstance.Name),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -67,15 +68,15 @@ func (cmd *BindService) Run(c *cli.Context) {
 	if err != nil {
 		if err, ok := err.(cferrors.HttpError); ok && err.ErrorCode() == AppAlreadyBoundErrorCode {
 			cmd.ui.Ok()
-			cmd.ui.Warn("App %s is already bound to %s.", app.Name, serviceInstance.Name)
+			cmd.ui.Warn(i18n.T("App %s is already bound to %s."), app.Name, serviceInstance.Name)
 			return
 		} else {
-			cmd.ui.Failed(err.Error())
+			cmd.ui.FailWithError(err)
 		}
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("TIP: Use '%s push' to ensure your env variable changes take effect", cf.Name())
+	cmd.ui.Say(i18n.T("TIP: Use '%s push' to ensure your env variable changes take effect"), cf.Name())
 }
 
 func (cmd *BindService) BindApplication(app models.Application, serviceInstance models.ServiceInstance) (apiErr error) {
@@ -3,11 +3,11 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
-	"fmt"
 	"github.com/codegangsta/cli"
 )
 
@@ -39,7 +39,13 @@ func (cmd CreateService) Run(c *cli.Context) {
 	planName := c.Args()[1]
 	name := c.Args()[2]
 
-	cmd.ui.Say("Creating service %s in org %s / space %s as %s...",
+	params, err := parseServiceParams(c.String("c"))
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Creating service %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -48,27 +54,27 @@ func (cmd CreateService) Run(c *cli.Context) {
 
 	offerings, apiErr := cmd.serviceRepo.FindServiceOfferingsForSpaceByLabel(cmd.config.SpaceFields().Guid, offeringName)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	plan, err := findPlanFromOfferings(offerings, planName)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
 		return
 	}
 
 	var identicalAlreadyExists bool
-	identicalAlreadyExists, apiErr = cmd.serviceRepo.CreateServiceInstance(name, plan.Guid)
+	identicalAlreadyExists, apiErr = cmd.serviceRepo.CreateServiceInstance(name, plan.Guid, params)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	cmd.ui.Ok()
 
 	if identicalAlreadyExists {
-		cmd.ui.Warn("Service %s already exists", name)
+		cmd.ui.Warn(i18n.T("Service %s already exists"), name)
 	}
 }
 
@@ -80,7 +86,7 @@ func findOfferings(offerings []models.ServiceOffering, name string) (matchingOff
 	}
 
 	if len(matchingOfferings) == 0 {
-		err = errors.New(fmt.Sprintf("Could not find any offerings with name %s", name))
+		err = errors.New(i18n.T("Could not find any offerings with name %s", name))
 	}
 	return
 }
@@ -94,6 +100,6 @@ func findPlanFromOfferings(offerings models.ServiceOfferings, name string) (plan
 		}
 	}
 
-	err = errors.New(fmt.Sprintf("Could not find plan with name %s", name))
+	err = errors.New(i18n.T("Could not find plan with name %s", name))
 	return
 }
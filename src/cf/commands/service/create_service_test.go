@@ -6,6 +6,8 @@ import (
 	"cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
@@ -110,4 +112,45 @@ var _ = Describe("Testing with ginkgo", func() {
 			Expect(serviceRepo.CreateServiceInstancePlanGuid).To(Equal("cleardb-spark-guid"))
 		})
 	})
+
+	Context("when given configuration parameters", func() {
+		var serviceRepo *testapi.FakeServiceRepo
+
+		BeforeEach(func() {
+			offering := models.ServiceOffering{}
+			offering.Label = "cleardb"
+			offering.Plans = []models.ServicePlanFields{{Name: "spark", Guid: "cleardb-spark-guid"}}
+
+			serviceRepo = &testapi.FakeServiceRepo{}
+			serviceRepo.FindServiceOfferingsForSpaceByLabelReturns.ServiceOfferings = []models.ServiceOffering{offering}
+		})
+
+		It("sends parameters given as JSON", func() {
+			callCreateService([]string{"-c", `{"region":"eu","replicas":2}`, "cleardb", "spark", "my-cleardb-service"}, []string{}, serviceRepo)
+
+			Expect(serviceRepo.CreateServiceInstanceParams).To(Equal(map[string]interface{}{"region": "eu", "replicas": float64(2)}))
+		})
+
+		It("sends parameters read from a file", func() {
+			file, err := ioutil.TempFile("", "service-params")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(file.Name())
+			file.WriteString(`{"region":"us"}`)
+			file.Close()
+
+			callCreateService([]string{"-c", file.Name(), "cleardb", "spark", "my-cleardb-service"}, []string{}, serviceRepo)
+
+			Expect(serviceRepo.CreateServiceInstanceParams).To(Equal(map[string]interface{}{"region": "us"}))
+		})
+
+		It("fails without creating the instance when the parameters are not a JSON object", func() {
+			ui := callCreateService([]string{"-c", "region=eu", "cleardb", "spark", "my-cleardb-service"}, []string{}, serviceRepo)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"Invalid configuration provided for -c flag"},
+			})
+			Expect(serviceRepo.CreateServiceInstanceName).To(Equal(""))
+		})
+	})
 })
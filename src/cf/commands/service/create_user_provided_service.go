@@ -3,6 +3,7 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"encoding/json"
@@ -48,7 +49,7 @@ func (cmd CreateUserProvidedService) Run(c *cli.Context) {
 		paramsMap = cmd.mapValuesFromPrompt(params, paramsMap)
 	}
 
-	cmd.ui.Say("Creating user provided service %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Creating user provided service %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -57,7 +58,7 @@ func (cmd CreateUserProvidedService) Run(c *cli.Context) {
 
 	apiErr := cmd.userProvidedServiceInstanceRepo.Create(name, drainUrl, paramsMap)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
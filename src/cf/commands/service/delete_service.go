@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -45,13 +46,13 @@ func (cmd *DeleteService) Run(c *cli.Context) {
 	force := c.Bool("f")
 
 	if !force {
-		answer := cmd.ui.Confirm("Are you sure you want to delete the service %s ?", terminal.EntityNameColor(serviceName))
+		answer := cmd.ui.Confirm(i18n.T("Are you sure you want to delete the service %s ?"), terminal.EntityNameColor(serviceName))
 		if !answer {
 			return
 		}
 	}
 
-	cmd.ui.Say("Deleting service %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Deleting service %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(serviceName),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -64,16 +65,16 @@ func (cmd *DeleteService) Run(c *cli.Context) {
 	case nil:
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn("Service %s does not exist.", serviceName)
+		cmd.ui.Warn(i18n.T("Service %s does not exist."), serviceName)
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	apiErr = cmd.serviceRepo.DeleteService(instance)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
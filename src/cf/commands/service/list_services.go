@@ -3,6 +3,7 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -31,7 +32,7 @@ func (cmd ListServices) GetRequirements(reqFactory requirements.Factory, c *cli.
 }
 
 func (cmd ListServices) Run(c *cli.Context) {
-	cmd.ui.Say("Getting services in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Getting services in org %s / space %s as %s..."),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -40,7 +41,7 @@ func (cmd ListServices) Run(c *cli.Context) {
 	serviceInstances, apiErr := cmd.serviceSummaryRepo.GetSummariesInCurrentSpace()
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -48,19 +49,19 @@ func (cmd ListServices) Run(c *cli.Context) {
 	cmd.ui.Say("")
 
 	if len(serviceInstances) == 0 {
-		cmd.ui.Say("No services found")
+		cmd.ui.Say(i18n.T("No services found"))
 		return
 	}
 
 	table := [][]string{
-		[]string{"name", "service", "plan", "bound apps"},
+		[]string{i18n.T("name"), i18n.T("service"), i18n.T("plan"), i18n.T("bound apps")},
 	}
 
 	for _, instance := range serviceInstances {
 		var serviceColumn string
 
 		if instance.IsUserProvided() {
-			serviceColumn = "user-provided"
+			serviceColumn = i18n.T("user-provided")
 		} else {
 			serviceColumn = instance.ServiceOffering.Label
 		}
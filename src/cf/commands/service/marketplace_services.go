@@ -3,24 +3,28 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
+	"fmt"
 	"github.com/codegangsta/cli"
 	"sort"
 	"strings"
 )
 
 type MarketplaceServices struct {
-	ui          terminal.UI
-	config      configuration.Reader
-	serviceRepo api.ServiceRepository
+	ui                terminal.UI
+	config            configuration.Reader
+	serviceRepo       api.ServiceRepository
+	serviceBrokerRepo api.ServiceBrokerRepository
 }
 
-func NewMarketplaceServices(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository) (cmd MarketplaceServices) {
+func NewMarketplaceServices(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository, serviceBrokerRepo api.ServiceBrokerRepository) (cmd MarketplaceServices) {
 	cmd.ui = ui
 	cmd.config = config
 	cmd.serviceRepo = serviceRepo
+	cmd.serviceBrokerRepo = serviceBrokerRepo
 	return
 }
 
@@ -35,22 +39,43 @@ func (cmd MarketplaceServices) Run(c *cli.Context) {
 		apiErr           error
 	)
 
+	serviceName := c.String("s")
+	subject := i18n.T("services from marketplace")
+	if serviceName != "" {
+		subject = i18n.T("service plan information for service %s", terminal.EntityNameColor(serviceName))
+	}
+
 	if cmd.config.HasSpace() {
-		cmd.ui.Say("Getting services from marketplace in org %s / space %s as %s...",
+		cmd.ui.Say(i18n.T("Getting %s in org %s / space %s as %s..."),
+			subject,
 			terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 			terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 			terminal.EntityNameColor(cmd.config.Username()),
 		)
 		serviceOfferings, apiErr = cmd.serviceRepo.GetServiceOfferingsForSpace(cmd.config.SpaceFields().Guid)
 	} else if !cmd.config.IsLoggedIn() {
-		cmd.ui.Say("Getting all services from marketplace...")
+		if serviceName == "" {
+			subject = i18n.T("all services from marketplace")
+		}
+		cmd.ui.Say(i18n.T("Getting %s..."), subject)
 		serviceOfferings, apiErr = cmd.serviceRepo.GetAllServiceOfferings()
 	} else {
-		cmd.ui.Failed("Cannot list marketplace services without a targeted space")
+		cmd.ui.Failed(i18n.T("Cannot list marketplace services without a targeted space"))
+	}
+
+	if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
 	}
 
+	serviceOfferings, apiErr = cmd.filterOfferings(serviceOfferings, c)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	if serviceName != "" {
+		cmd.showServicePlans(serviceOfferings, serviceName)
 		return
 	}
 
@@ -58,12 +83,12 @@ func (cmd MarketplaceServices) Run(c *cli.Context) {
 	cmd.ui.Say("")
 
 	if len(serviceOfferings) == 0 {
-		cmd.ui.Say("No service offerings found")
+		cmd.ui.Say(i18n.T("No service offerings found"))
 		return
 	}
 
 	table := [][]string{
-		[]string{"service", "plans", "description"},
+		[]string{i18n.T("service"), i18n.T("plans"), i18n.T("description")},
 	}
 
 	sort.Sort(serviceOfferings)
@@ -89,3 +114,124 @@ func (cmd MarketplaceServices) Run(c *cli.Context) {
 	cmd.ui.DisplayTable(table)
 	return
 }
+
+func (cmd MarketplaceServices) filterOfferings(offerings models.ServiceOfferings, c *cli.Context) (filtered models.ServiceOfferings, apiErr error) {
+	brokerGuid := ""
+	if c.String("broker") != "" {
+		var broker models.ServiceBroker
+		broker, apiErr = cmd.serviceBrokerRepo.FindByName(c.String("broker"))
+		if apiErr != nil {
+			return
+		}
+		brokerGuid = broker.Guid
+	}
+
+	provider := c.String("provider")
+	searchTerm := strings.ToLower(c.String("search"))
+
+	for _, offering := range offerings {
+		if brokerGuid != "" && offering.BrokerGuid != brokerGuid {
+			continue
+		}
+		if provider != "" && !strings.EqualFold(offering.Provider, provider) {
+			continue
+		}
+		if searchTerm != "" && !offeringMatches(offering, searchTerm) {
+			continue
+		}
+		filtered = append(filtered, offering)
+	}
+	return
+}
+
+func offeringMatches(offering models.ServiceOffering, searchTerm string) bool {
+	candidates := []string{offering.Label, offering.Description, offering.Provider}
+	for _, plan := range offering.Plans {
+		candidates = append(candidates, plan.Name, plan.Description, plan.Extra.DisplayName)
+	}
+
+	for _, candidate := range candidates {
+		if strings.Contains(strings.ToLower(candidate), searchTerm) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cmd MarketplaceServices) showServicePlans(offerings models.ServiceOfferings, serviceName string) {
+	matching := models.ServiceOfferings{}
+	for _, offering := range offerings {
+		if offering.Label == serviceName {
+			matching = append(matching, offering)
+		}
+	}
+
+	if len(matching) == 0 {
+		cmd.ui.Failed(i18n.T("Service offering %s not found"), serviceName)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	for _, offering := range matching {
+		cmd.ui.Say("")
+		cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("service:")), offering.Label)
+		cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("description:")), offering.Description)
+		if offering.Provider != "" {
+			cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("provider:")), offering.Provider)
+		}
+		if offering.DocumentationUrl != "" {
+			cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("documentation:")), offering.DocumentationUrl)
+		}
+		cmd.ui.Say("")
+
+		rows := [][]string{}
+		for _, plan := range offering.Plans {
+			if plan.Name == "" {
+				continue
+			}
+
+			freeOrPaid := i18n.T("paid")
+			if plan.Free {
+				freeOrPaid = i18n.T("free")
+			}
+
+			rows = append(rows, []string{
+				plan.Name,
+				plan.Description,
+				freeOrPaid,
+				plan.Extra.DisplayName,
+				formatPlanCosts(plan.Extra.Costs),
+				strings.Join(plan.Extra.Bullets, "; "),
+			})
+		}
+
+		if len(rows) == 0 {
+			cmd.ui.Say(i18n.T("No service plans found"))
+			continue
+		}
+
+		table := cmd.ui.Table([]string{i18n.T("service plan"), i18n.T("description"), i18n.T("free or paid"), i18n.T("display name"), i18n.T("costs"), i18n.T("features")})
+		table.Print(rows)
+	}
+}
+
+func formatPlanCosts(costs []models.ServicePlanCost) string {
+	formatted := []string{}
+	for _, cost := range costs {
+		currencies := []string{}
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			amount := fmt.Sprintf("%.2f %s", cost.Amount[currency], currency)
+			if cost.Unit != "" {
+				amount = fmt.Sprintf("%s/%s", amount, strings.ToLower(cost.Unit))
+			}
+			formatted = append(formatted, amount)
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -19,10 +19,12 @@ var _ = Describe("Marketplace Services", func() {
 	var reqFactory *testreq.FakeReqFactory
 	var config configuration.ReadWriter
 	var serviceRepo *testapi.FakeServiceRepo
+	var serviceBrokerRepo *testapi.FakeServiceBrokerRepo
 	var fakeServiceOfferings []models.ServiceOffering
 
 	BeforeEach(func() {
 		serviceRepo = &testapi.FakeServiceRepo{}
+		serviceBrokerRepo = &testapi.FakeServiceBrokerRepo{}
 		ui = &testterm.FakeUI{}
 		reqFactory = &testreq.FakeReqFactory{ApiEndpointSuccess: true}
 
@@ -50,7 +52,7 @@ var _ = Describe("Marketplace Services", func() {
 	Context("when the an API endpoint is not targeted", func() {
 		It("does not meet its requirements", func() {
 			config := testconfig.NewRepository()
-			cmd := NewMarketplaceServices(ui, config, serviceRepo)
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
 			reqFactory.ApiEndpointSuccess = false
 
 			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{}), reqFactory)
@@ -74,7 +76,7 @@ var _ = Describe("Marketplace Services", func() {
 			It("lists all of the service offerings for the space", func() {
 				serviceRepo := &testapi.FakeServiceRepo{}
 				serviceRepo.GetServiceOfferingsForSpaceReturns.ServiceOfferings = fakeServiceOfferings
-				cmd := NewMarketplaceServices(ui, config, serviceRepo)
+				cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
 				testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{}), reqFactory)
 
 				Expect(serviceRepo.GetServiceOfferingsForSpaceArgs.SpaceGuid).To(Equal("the-space-guid"))
@@ -95,7 +97,7 @@ var _ = Describe("Marketplace Services", func() {
 			})
 
 			It("tells the user to target a space", func() {
-				cmd := NewMarketplaceServices(ui, config, serviceRepo)
+				cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
 				testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{}), reqFactory)
 				testassert.SliceContains(ui.Outputs, testassert.Lines{
 					{"without", "space"},
@@ -113,7 +115,7 @@ var _ = Describe("Marketplace Services", func() {
 			serviceRepo := &testapi.FakeServiceRepo{}
 			serviceRepo.GetAllServiceOfferingsReturns.ServiceOfferings = fakeServiceOfferings
 
-			cmd := NewMarketplaceServices(ui, config, serviceRepo)
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
 			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{}), reqFactory)
 
 			testassert.SliceContains(ui.Outputs, testassert.Lines{
@@ -129,7 +131,7 @@ var _ = Describe("Marketplace Services", func() {
 			serviceRepo := &testapi.FakeServiceRepo{}
 			serviceRepo.GetAllServiceOfferingsReturns.ServiceOfferings = []models.ServiceOffering{}
 
-			cmd := NewMarketplaceServices(ui, config, serviceRepo)
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
 			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{}), reqFactory)
 
 			testassert.SliceContains(ui.Outputs, testassert.Lines{
@@ -140,4 +142,138 @@ var _ = Describe("Marketplace Services", func() {
 			})
 		})
 	})
+
+	Describe("filtering and plan details", func() {
+		BeforeEach(func() {
+			config = testconfig.NewRepositoryWithDefaults()
+			config.SetSpaceFields(models.SpaceFields{Guid: "the-space-guid", Name: "the-space-name"})
+
+			serviceRepo.GetServiceOfferingsForSpaceReturns.ServiceOfferings = []models.ServiceOffering{
+				models.ServiceOffering{
+					ServiceOfferingFields: models.ServiceOfferingFields{
+						Label:            "cleardb",
+						Provider:         "cleardb-inc",
+						BrokerGuid:       "cleardb-broker-guid",
+						Description:      "Highly available MySQL",
+						DocumentationUrl: "http://docs.example.com/cleardb",
+					},
+					Plans: []models.ServicePlanFields{
+						models.ServicePlanFields{Name: "spark", Description: "Great for getting started", Free: true},
+						models.ServicePlanFields{
+							Name:        "boost",
+							Description: "Best for light production",
+							Extra: models.ServicePlanExtra{
+								DisplayName: "Boost",
+								Bullets:     []string{"20 connections", "1 GB storage"},
+								Costs: []models.ServicePlanCost{
+									models.ServicePlanCost{Amount: map[string]float64{"usd": 10, "eur": 8.5}, Unit: "MONTHLY"},
+								},
+							},
+						},
+					},
+				},
+				models.ServiceOffering{
+					ServiceOfferingFields: models.ServiceOfferingFields{
+						Label:       "rediscloud",
+						Provider:    "garantia",
+						BrokerGuid:  "redis-broker-guid",
+						Description: "Enterprise-class Redis",
+					},
+					Plans: []models.ServicePlanFields{
+						models.ServicePlanFields{Name: "25mb", Free: true},
+					},
+				},
+			}
+		})
+
+		It("shows the plans of a service offering with their metadata", func() {
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{"-s", "cleardb"}), reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Getting service plan information for service", "cleardb", "my-org", "the-space-name", "my-user"},
+				{"OK"},
+				{"service:", "cleardb"},
+				{"description:", "Highly available MySQL"},
+				{"provider:", "cleardb-inc"},
+				{"documentation:", "http://docs.example.com/cleardb"},
+				{"service plan", "description", "free or paid", "display name", "costs", "features"},
+				{"spark", "Great for getting started", "free"},
+				{"boost", "Best for light production", "paid", "Boost", "8.50 eur/monthly, 10.00 usd/monthly", "20 connections; 1 GB storage"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"rediscloud"},
+			})
+		})
+
+		It("fails when the service offering does not exist", func() {
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{"-s", "nope"}), reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"Service offering nope not found"},
+			})
+		})
+
+		It("only lists offerings matching the search term", func() {
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{"--search", "REDIS"}), reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"rediscloud", "25mb", "Enterprise-class Redis"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"cleardb"},
+			})
+		})
+
+		It("searches the plans of each offering", func() {
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{"--search", "light production"}), reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"cleardb", "spark, boost"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"rediscloud"},
+			})
+		})
+
+		It("only lists offerings from the given provider", func() {
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{"--provider", "Garantia"}), reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"rediscloud"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"cleardb"},
+			})
+		})
+
+		It("only lists offerings from the given service broker", func() {
+			serviceBrokerRepo.FindByNameServiceBroker = models.ServiceBroker{Guid: "cleardb-broker-guid", Name: "cleardb-broker"}
+
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{"--broker", "cleardb-broker"}), reqFactory)
+
+			Expect(serviceBrokerRepo.FindByNameName).To(Equal("cleardb-broker"))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"cleardb", "spark, boost"},
+			})
+			testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+				{"rediscloud"},
+			})
+		})
+
+		It("says so when no offerings match the filters", func() {
+			cmd := NewMarketplaceServices(ui, config, serviceRepo, serviceBrokerRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("marketplace", []string{"--search", "postgres"}), reqFactory)
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"No service offerings found"},
+			})
+		})
+	})
 })
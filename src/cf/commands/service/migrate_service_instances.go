@@ -4,9 +4,9 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
-	"fmt"
 	"github.com/codegangsta/cli"
 )
 
@@ -53,10 +53,10 @@ func (cmd *MigrateServiceInstances) Run(c *cli.Context) {
 	switch apiErr.(type) {
 	case nil:
 	case errors.ModelNotFoundError:
-		cmd.ui.Failed("Plan %s cannot be found", terminal.EntityNameColor(v1.String()))
+		cmd.ui.Failed(i18n.T("Plan %s cannot be found"), terminal.EntityNameColor(v1.String()))
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -64,34 +64,34 @@ func (cmd *MigrateServiceInstances) Run(c *cli.Context) {
 	switch apiErr.(type) {
 	case nil:
 	case errors.ModelNotFoundError:
-		cmd.ui.Failed("Plan %s cannot be found", terminal.EntityNameColor(v2.String()))
+		cmd.ui.Failed(i18n.T("Plan %s cannot be found"), terminal.EntityNameColor(v2.String()))
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	count, apiErr := cmd.serviceRepo.GetServiceInstanceCountForServicePlan(v1Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	} else if count == 0 {
-		cmd.ui.Failed("Plan %s has no service instances to migrate", terminal.EntityNameColor(v1.String()))
+		cmd.ui.Failed(i18n.T("Plan %s has no service instances to migrate"), terminal.EntityNameColor(v1.String()))
 		return
 	}
 
-	cmd.ui.Warn("WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and" +
+	cmd.ui.Warn(i18n.T("WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and" +
 		" resources for service instances will not be altered. The primary use case for this operation is" +
 		" to replace a service broker which implements the v1 Service Broker API with a broker which" +
 		" implements the v2 API by remapping service instances from v1 plans to v2 plans.  We recommend" +
 		" making the v1 plan private or shutting down the v1 broker to prevent additional instances from" +
 		" being created. Once service instances have been migrated, the v1 services and plans can be" +
-		" removed from Cloud Foundry.")
+		" removed from Cloud Foundry."))
 
 	serviceInstancesPhrase := pluralizeServiceInstances(count)
 
 	if !force {
-		response := cmd.ui.Confirm("Really migrate %s from plan %s to %s?>",
+		response := cmd.ui.Confirm(i18n.T("Really migrate %s from plan %s to %s?>"),
 			serviceInstancesPhrase,
 			terminal.EntityNameColor(v1.String()),
 			terminal.EntityNameColor(v2.String()),
@@ -101,26 +101,22 @@ func (cmd *MigrateServiceInstances) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Attempting to migrate %s...", serviceInstancesPhrase)
+	cmd.ui.Say(i18n.T("Attempting to migrate %s..."), serviceInstancesPhrase)
 
 	changedCount, apiErr := cmd.serviceRepo.MigrateServicePlanFromV1ToV2(v1Guid, v2Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 	}
 
-	cmd.ui.Say("%s migrated.", pluralizeServiceInstances(changedCount))
+	cmd.ui.Say(i18n.T("%s migrated."), pluralizeServiceInstances(changedCount))
 	cmd.ui.Ok()
 
 	return
 }
 
 func pluralizeServiceInstances(count int) string {
-	var phrase string
 	if count == 1 {
-		phrase = "service instance"
-	} else {
-		phrase = "service instances"
+		return i18n.T("%d service instance", count)
 	}
-
-	return fmt.Sprintf("%d %s", count, phrase)
+	return i18n.T("%d service instances", count)
 }
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -30,22 +31,22 @@ func (cmd PurgeServiceOffering) Run(c *cli.Context) {
 	switch apiErr.(type) {
 	case nil:
 	case errors.ModelNotFoundError:
-		cmd.ui.Warn("Service offering does not exist\nTIP: If you are trying to purge a v1 service offering, you must set the -p flag.")
+		cmd.ui.Warn(i18n.T("Service offering does not exist\nTIP: If you are trying to purge a v1 service offering, you must set the -p flag."))
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 	}
 
 	confirmed := c.Bool("f")
 	if !confirmed {
-		cmd.ui.Warn(`Warning: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.`)
-		confirmed = cmd.ui.Confirm("Really purge service offering %s from Cloud Foundry?", serviceName)
+		cmd.ui.Warn(i18n.T(`Warning: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.`))
+		confirmed = cmd.ui.Confirm(i18n.T("Really purge service offering %s from Cloud Foundry?"), serviceName)
 	}
 
 	if !confirmed {
 		return
 	}
-	cmd.ui.Say("Purging service %s...", serviceName)
+	cmd.ui.Say(i18n.T("Purging service %s..."), serviceName)
 	cmd.serviceRepo.PurgeServiceOffering(offering)
 	cmd.ui.Ok()
 
@@ -5,6 +5,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -47,7 +48,7 @@ func (cmd *RenameService) Run(c *cli.Context) {
 	newName := c.Args()[1]
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 
-	cmd.ui.Say("Renaming service %s to %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Renaming service %s to %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(serviceInstance.Name),
 		terminal.EntityNameColor(newName),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -58,9 +59,9 @@ func (cmd *RenameService) Run(c *cli.Context) {
 
 	if err != nil {
 		if err, ok := err.(errors.HttpError); ok && err.ErrorCode() == errors.SERVICE_INSTANCE_NAME_TAKEN {
-			cmd.ui.Failed("%s\nTIP: Use '%s services' to view all services in this org and space.", err.Error(), cf.Name())
+			cmd.ui.Failed(i18n.T("%s\nTIP: Use '%s services' to view all services in this org and space."), err.Error(), cf.Name())
 		} else {
-			cmd.ui.Failed(err.Error())
+			cmd.ui.FailWithError(err)
 		}
 	}
 
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"cf/i18n"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+)
+
+// the -c flag takes either inline JSON or the path to a file containing it
+func parseServiceParams(value string) (params map[string]interface{}, err error) {
+	if value == "" {
+		return
+	}
+
+	contents := []byte(value)
+	if info, statErr := os.Stat(value); statErr == nil && !info.IsDir() {
+		contents, err = ioutil.ReadFile(value)
+		if err != nil {
+			return
+		}
+	}
+
+	err = json.Unmarshal(contents, &params)
+	if err != nil {
+		err = errors.New(i18n.T("Invalid configuration provided for -c flag. Please provide a valid JSON object or the path to a file containing a valid JSON object."))
+	}
+	return
+}
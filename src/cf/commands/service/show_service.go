@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -39,14 +40,14 @@ func (cmd *ShowService) Run(c *cli.Context) {
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 
 	cmd.ui.Say("")
-	cmd.ui.Say("Service instance: %s", terminal.EntityNameColor(serviceInstance.Name))
+	cmd.ui.Say(i18n.T("Service instance: %s"), terminal.EntityNameColor(serviceInstance.Name))
 
 	if serviceInstance.IsUserProvided() {
-		cmd.ui.Say("Service: %s", terminal.EntityNameColor("user-provided"))
+		cmd.ui.Say(i18n.T("Service: %s"), terminal.EntityNameColor(i18n.T("user-provided")))
 	} else {
-		cmd.ui.Say("Service: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering.Label))
-		cmd.ui.Say("Plan: %s", terminal.EntityNameColor(serviceInstance.ServicePlan.Name))
-		cmd.ui.Say("Description: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering.Description))
-		cmd.ui.Say("Documentation url: %s", terminal.EntityNameColor(serviceInstance.ServiceOffering.DocumentationUrl))
+		cmd.ui.Say(i18n.T("Service: %s"), terminal.EntityNameColor(serviceInstance.ServiceOffering.Label))
+		cmd.ui.Say(i18n.T("Plan: %s"), terminal.EntityNameColor(serviceInstance.ServicePlan.Name))
+		cmd.ui.Say(i18n.T("Description: %s"), terminal.EntityNameColor(serviceInstance.ServiceOffering.Description))
+		cmd.ui.Say(i18n.T("Documentation url: %s"), terminal.EntityNameColor(serviceInstance.ServiceOffering.DocumentationUrl))
 	}
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -46,7 +47,7 @@ func (cmd *UnbindService) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
 	instance := cmd.serviceInstanceReq.GetServiceInstance()
 
-	cmd.ui.Say("Unbinding app %s from service %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Unbinding app %s from service %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(app.Name),
 		terminal.EntityNameColor(instance.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -56,14 +57,14 @@ func (cmd *UnbindService) Run(c *cli.Context) {
 
 	found, apiErr := cmd.serviceBindingRepo.Delete(instance, app.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	cmd.ui.Ok()
 
 	if !found {
-		cmd.ui.Warn("Binding between %s and %s did not exist", instance.Name, app.Name)
+		cmd.ui.Warn(i18n.T("Binding between %s and %s did not exist"), instance.Name, app.Name)
 	}
 
 }
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+	"net/http"
+)
+
+type UpdateService struct {
+	ui                 terminal.UI
+	config             configuration.Reader
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewUpdateService(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository) (cmd *UpdateService) {
+	cmd = new(UpdateService)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *UpdateService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || (c.String("p") == "" && c.String("c") == "") {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "update-service")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewTargetedSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *UpdateService) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+	planName := c.String("p")
+
+	params, err := parseServiceParams(c.String("c"))
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	cmd.ui.Say(i18n.T("Updating service instance %s in org %s / space %s as %s..."),
+		terminal.EntityNameColor(instance.Name),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	if instance.IsUserProvided() {
+		cmd.ui.Failed(i18n.T("Service instance %s is user-provided and has no plan.\nTIP: Use '%s update-user-provided-service' to change its credentials."), instance.Name, cf.Name())
+		return
+	}
+
+	planGuid := ""
+	if planName != "" {
+		if planName == instance.ServicePlan.Name && params == nil {
+			cmd.ui.Ok()
+			cmd.ui.Warn(i18n.T("Service instance %s already uses plan %s"), instance.Name, planName)
+			return
+		}
+
+		offerings, apiErr := cmd.serviceRepo.FindServiceOfferingsForSpaceByLabel(cmd.config.SpaceFields().Guid, instance.ServiceOffering.Label)
+		if apiErr != nil {
+			cmd.ui.FailWithError(apiErr)
+			return
+		}
+
+		plan, err := findPlanFromOfferings(offerings, planName)
+		if err != nil {
+			cmd.ui.Failed(i18n.T("%s\nTIP: Use '%s marketplace' to see the plans of service %s."), err.Error(), cf.Name(), instance.ServiceOffering.Label)
+			return
+		}
+		planGuid = plan.Guid
+	}
+
+	apiErr := cmd.serviceRepo.UpdateServiceInstance(instance.Guid, planGuid, params)
+	if httpErr, ok := apiErr.(errors.HttpError); ok && brokerRefusedUpdate(httpErr) {
+		cmd.ui.Failed(i18n.T("The service broker refused to update service instance %s%s.\n%s"),
+			instance.Name, planChangeDescription(instance.ServicePlan.Name, planName), httpErr.Error())
+		return
+	} else if apiErr != nil {
+		cmd.ui.FailWithError(apiErr)
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+// errors about the request itself, or a bad gateway from the broker,
+// mean the broker did not accept the change
+func brokerRefusedUpdate(err errors.HttpError) bool {
+	switch err.StatusCode() {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return false
+	case http.StatusBadGateway:
+		return true
+	}
+	return err.StatusCode() >= 400 && err.StatusCode() < 500
+}
+
+func planChangeDescription(currentPlan, newPlan string) string {
+	if newPlan == "" {
+		return ""
+	}
+	return i18n.T(" from plan %s to plan %s", currentPlan, newPlan)
+}
@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	. "cf/commands/service"
+	"cf/errors"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("update-service command", func() {
+	var (
+		ui          *testterm.FakeUI
+		reqFactory  *testreq.FakeReqFactory
+		serviceRepo *testapi.FakeServiceRepo
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+
+		instance := models.ServiceInstance{}
+		instance.Guid = "my-db-guid"
+		instance.Name = "my-db"
+		instance.ServicePlan = models.ServicePlanFields{Guid: "silver-guid", Name: "silver"}
+		instance.ServiceOffering = models.ServiceOfferingFields{Label: "db-service"}
+
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true, ServiceInstance: instance}
+
+		offering := models.ServiceOffering{}
+		offering.Label = "db-service"
+		offering.Plans = []models.ServicePlanFields{
+			{Guid: "silver-guid", Name: "silver"},
+			{Guid: "gold-guid", Name: "gold"},
+		}
+		serviceRepo = &testapi.FakeServiceRepo{}
+		serviceRepo.FindServiceOfferingsForSpaceByLabelReturns.ServiceOfferings = []models.ServiceOffering{offering}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewUpdateService(ui, testconfig.NewRepositoryWithDefaults(), serviceRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("update-service", args), reqFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given a plan or parameters", func() {
+			runCommand("my-db")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails with usage when not given an instance", func() {
+			runCommand("-p", "gold")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("requires a targeted space", func() {
+			reqFactory.TargetedSpaceSuccess = false
+			runCommand("-p", "gold", "my-db")
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+
+		It("requires the service instance to exist", func() {
+			runCommand("-p", "gold", "my-db")
+			Expect(reqFactory.ServiceInstanceName).To(Equal("my-db"))
+		})
+	})
+
+	It("changes the plan of the instance", func() {
+		runCommand("-p", "gold", "my-db")
+
+		Expect(serviceRepo.FindServiceOfferingsForSpaceByLabelArgs.Name).To(Equal("db-service"))
+		Expect(serviceRepo.UpdateServiceInstanceGuid).To(Equal("my-db-guid"))
+		Expect(serviceRepo.UpdateServiceInstancePlanGuid).To(Equal("gold-guid"))
+		Expect(serviceRepo.UpdateServiceInstanceParams).To(BeNil())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Updating service instance", "my-db", "my-org", "my-space", "my-user"},
+			{"OK"},
+		})
+	})
+
+	It("only sends the parameters when no plan is given", func() {
+		runCommand("-c", `{"ram_gb":8}`, "my-db")
+
+		Expect(serviceRepo.UpdateServiceInstancePlanGuid).To(Equal(""))
+		Expect(serviceRepo.UpdateServiceInstanceParams).To(Equal(map[string]interface{}{"ram_gb": float64(8)}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{{"OK"}})
+	})
+
+	It("does nothing when the instance already uses the plan", func() {
+		runCommand("-p", "silver", "my-db")
+
+		Expect(serviceRepo.UpdateServiceInstanceGuid).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"my-db", "already uses plan silver"},
+		})
+	})
+
+	It("fails when the plan does not exist", func() {
+		runCommand("-p", "platinum", "my-db")
+
+		Expect(serviceRepo.UpdateServiceInstanceGuid).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Could not find plan with name platinum"},
+		})
+	})
+
+	It("fails for user-provided service instances", func() {
+		instance := reqFactory.ServiceInstance
+		instance.ServicePlan = models.ServicePlanFields{}
+		reqFactory.ServiceInstance = instance
+
+		runCommand("-p", "gold", "my-db")
+
+		Expect(serviceRepo.UpdateServiceInstanceGuid).To(Equal(""))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"my-db", "user-provided"},
+		})
+	})
+
+	It("explains when the broker refuses the plan change", func() {
+		serviceRepo.UpdateServiceInstanceErr = errors.NewHttpError(400, "60015", "The service does not support changing plans.")
+
+		runCommand("-p", "gold", "my-db")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"service broker refused to update service instance my-db from plan silver to plan gold"},
+			{"does not support changing plans"},
+		})
+	})
+
+	It("reports other errors as they are", func() {
+		serviceRepo.UpdateServiceInstanceErr = errors.NewHttpError(500, "10001", "Something went wrong")
+
+		runCommand("-p", "gold", "my-db")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Something went wrong"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"refused"},
+		})
+	})
+})
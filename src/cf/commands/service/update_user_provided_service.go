@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"encoding/json"
@@ -47,7 +48,7 @@ func (cmd *UpdateUserProvidedService) Run(c *cli.Context) {
 
 	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
 	if !serviceInstance.IsUserProvided() {
-		cmd.ui.Failed("Service Instance is not user provided")
+		cmd.ui.Failed(i18n.T("Service Instance is not user provided"))
 		return
 	}
 
@@ -59,12 +60,12 @@ func (cmd *UpdateUserProvidedService) Run(c *cli.Context) {
 
 		err := json.Unmarshal([]byte(params), &paramsMap)
 		if err != nil {
-			cmd.ui.Failed("JSON is invalid: %s", err.Error())
+			cmd.ui.Failed(i18n.T("JSON is invalid: %s"), err.Error())
 			return
 		}
 	}
 
-	cmd.ui.Say("Updating user provided service %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Updating user provided service %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(serviceInstance.Name),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -76,14 +77,14 @@ func (cmd *UpdateUserProvidedService) Run(c *cli.Context) {
 
 	apiErr := cmd.userProvidedServiceInstanceRepo.Update(serviceInstance.ServiceInstanceFields)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	cmd.ui.Ok()
-	cmd.ui.Say("TIP: To make these changes take effect, use '%s unbind-service' to unbind the service, '%s bind-service' to rebind, and then '%s push' to update the app with the new env variables", cf.Name(), cf.Name(), cf.Name())
+	cmd.ui.Say(i18n.T("TIP: To make these changes take effect, use '%s unbind-service' to unbind the service, '%s bind-service' to rebind, and then '%s push' to update the app with the new env variables"), cf.Name(), cf.Name(), cf.Name())
 
 	if params == "" && drainUrl == "" {
-		cmd.ui.Warn("No flags specified. No changes were made.")
+		cmd.ui.Warn(i18n.T("No flags specified. No changes were made."))
 	}
 }
@@ -0,0 +1,111 @@
+package serviceaccess
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type DisableServiceAccess struct {
+	ui             terminal.UI
+	config         configuration.Reader
+	serviceRepo    api.ServiceRepository
+	planRepo       api.ServicePlanRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgReq         requirements.OrganizationRequirement
+}
+
+func NewDisableServiceAccess(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository, planRepo api.ServicePlanRepository, visibilityRepo api.ServicePlanVisibilityRepository) (cmd *DisableServiceAccess) {
+	cmd = new(DisableServiceAccess)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceRepo = serviceRepo
+	cmd.planRepo = planRepo
+	cmd.visibilityRepo = visibilityRepo
+	return
+}
+
+func (cmd *DisableServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "disable-service-access")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+
+	if c.String("o") != "" {
+		cmd.orgReq = reqFactory.NewOrganizationRequirement(c.String("o"))
+		reqs = append(reqs, cmd.orgReq)
+	}
+	return
+}
+
+func (cmd *DisableServiceAccess) Run(c *cli.Context) {
+	serviceName := c.Args()[0]
+	planName := c.String("p")
+	orgName := c.String("o")
+
+	cmd.ui.Say("%s", describeAccessChange(i18n.T("Disabling access to %s for %s as %s..."), serviceName, planName, orgName, cmd.config.Username()))
+
+	plans, err := findServicePlans(cmd.serviceRepo, serviceName, planName)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	visibilities, err := cmd.visibilityRepo.List()
+	if err != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching service plan visibilities.\n%s"), err.Error())
+		return
+	}
+	byPlan := visibilitiesByPlan(visibilities)
+
+	var org models.Organization
+	if orgName != "" {
+		org = cmd.orgReq.GetOrganization()
+	}
+
+	stillPublic := []string{}
+	for _, plan := range plans {
+		if orgName == "" && plan.Public {
+			err = cmd.planRepo.Update(plan.Guid, false)
+			if err != nil {
+				cmd.ui.Failed(i18n.T("Failed disabling access to plan %s.\n%s"), plan.Name, err.Error())
+				return
+			}
+		} else if plan.Public {
+			stillPublic = append(stillPublic, plan.Name)
+			continue
+		}
+
+		for _, visibility := range byPlan[plan.Guid] {
+			if orgName != "" && visibility.OrganizationGuid != org.Guid {
+				continue
+			}
+
+			err = cmd.visibilityRepo.Delete(visibility.Guid)
+			if err != nil {
+				cmd.ui.Failed(i18n.T("Failed disabling access to plan %s.\n%s"), plan.Name, err.Error())
+				return
+			}
+		}
+	}
+
+	cmd.ui.Ok()
+
+	// a public plan is visible to every org, so it cannot be hidden
+	// from a single org without first making it private
+	for _, name := range stillPublic {
+		cmd.ui.Warn(i18n.T("Plan %s is public and remains accessible to org %s.\nTIP: Use '%s disable-service-access %s -p %s' and then enable it for each org that should keep access."),
+			name, org.Name, cf.Name(), serviceName, name)
+	}
+}
@@ -0,0 +1,97 @@
+package serviceaccess_test
+
+import (
+	. "cf/commands/serviceaccess"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("disable-service-access command", func() {
+	var (
+		ui             *testterm.FakeUI
+		reqFactory     *testreq.FakeReqFactory
+		serviceRepo    *testapi.FakeServiceRepo
+		planRepo       *testapi.FakeServicePlanRepo
+		visibilityRepo *testapi.FakeServicePlanVisibilityRepo
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		serviceRepo = &testapi.FakeServiceRepo{}
+		serviceRepo.GetAllServiceOfferingsReturns.ServiceOfferings = fakeServiceOfferings()
+		planRepo = &testapi.FakeServicePlanRepo{}
+		visibilityRepo = &testapi.FakeServicePlanVisibilityRepo{
+			Visibilities: []models.ServicePlanVisibilityFields{
+				{Guid: "v1", ServicePlanGuid: "boost-guid", OrganizationGuid: "my-org-guid"},
+				{Guid: "v2", ServicePlanGuid: "boost-guid", OrganizationGuid: "other-org-guid"},
+				{Guid: "v3", ServicePlanGuid: "25mb-guid", OrganizationGuid: "my-org-guid"},
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewDisableServiceAccess(ui, testconfig.NewRepositoryWithDefaults(), serviceRepo, planRepo, visibilityRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("disable-service-access", args), reqFactory)
+	}
+
+	It("requires a service name", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand("cleardb")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("makes every plan private and removes all visibilities", func() {
+		runCommand("cleardb")
+
+		Expect(planRepo.UpdatedPlanGuids).To(Equal([]string{"spark-guid"}))
+		Expect(planRepo.UpdatedPublic).To(Equal([]bool{false}))
+		Expect(visibilityRepo.Deleted).To(Equal([]string{"v1", "v2"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Disabling access to all plans of service cleardb for all orgs as my-user"},
+			{"OK"},
+		})
+	})
+
+	It("only removes the visibilities of the given org", func() {
+		reqFactory.Organization = models.Organization{}
+		reqFactory.Organization.Guid = "my-org-guid"
+		reqFactory.Organization.Name = "my-org"
+
+		runCommand("-o", "my-org", "-p", "boost", "cleardb")
+
+		Expect(planRepo.UpdatedPlanGuids).To(BeEmpty())
+		Expect(visibilityRepo.Deleted).To(Equal([]string{"v1"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Disabling access to plan boost of service cleardb for org my-org"},
+			{"OK"},
+		})
+	})
+
+	It("warns that public plans stay visible to the org", func() {
+		reqFactory.Organization = models.Organization{}
+		reqFactory.Organization.Guid = "my-org-guid"
+		reqFactory.Organization.Name = "my-org"
+
+		runCommand("-o", "my-org", "cleardb")
+
+		Expect(planRepo.UpdatedPlanGuids).To(BeEmpty())
+		Expect(visibilityRepo.Deleted).To(Equal([]string{"v1"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"OK"},
+			{"Plan spark is public and remains accessible to org my-org"},
+		})
+	})
+})
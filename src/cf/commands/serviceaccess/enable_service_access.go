@@ -0,0 +1,119 @@
+package serviceaccess
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type EnableServiceAccess struct {
+	ui             terminal.UI
+	config         configuration.Reader
+	serviceRepo    api.ServiceRepository
+	planRepo       api.ServicePlanRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgReq         requirements.OrganizationRequirement
+}
+
+func NewEnableServiceAccess(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository, planRepo api.ServicePlanRepository, visibilityRepo api.ServicePlanVisibilityRepository) (cmd *EnableServiceAccess) {
+	cmd = new(EnableServiceAccess)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceRepo = serviceRepo
+	cmd.planRepo = planRepo
+	cmd.visibilityRepo = visibilityRepo
+	return
+}
+
+func (cmd *EnableServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "enable-service-access")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+
+	if c.String("o") != "" {
+		cmd.orgReq = reqFactory.NewOrganizationRequirement(c.String("o"))
+		reqs = append(reqs, cmd.orgReq)
+	}
+	return
+}
+
+func (cmd *EnableServiceAccess) Run(c *cli.Context) {
+	serviceName := c.Args()[0]
+	planName := c.String("p")
+	orgName := c.String("o")
+
+	cmd.ui.Say("%s", describeAccessChange(i18n.T("Enabling access to %s for %s as %s..."), serviceName, planName, orgName, cmd.config.Username()))
+
+	plans, err := findServicePlans(cmd.serviceRepo, serviceName, planName)
+	if err != nil {
+		cmd.ui.FailWithError(err)
+		return
+	}
+
+	if orgName == "" {
+		for _, plan := range plans {
+			if plan.Public {
+				continue
+			}
+
+			err = cmd.planRepo.Update(plan.Guid, true)
+			if err != nil {
+				cmd.ui.Failed(i18n.T("Failed enabling access to plan %s.\n%s"), plan.Name, err.Error())
+				return
+			}
+		}
+
+		cmd.ui.Ok()
+		return
+	}
+
+	org := cmd.orgReq.GetOrganization()
+
+	visibilities, err := cmd.visibilityRepo.List()
+	if err != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching service plan visibilities.\n%s"), err.Error())
+		return
+	}
+	byPlan := visibilitiesByPlan(visibilities)
+
+	alreadyPublic := []string{}
+	for _, plan := range plans {
+		if plan.Public {
+			alreadyPublic = append(alreadyPublic, plan.Name)
+			continue
+		}
+
+		visible := false
+		for _, visibility := range byPlan[plan.Guid] {
+			if visibility.OrganizationGuid == org.Guid {
+				visible = true
+				break
+			}
+		}
+		if visible {
+			continue
+		}
+
+		err = cmd.visibilityRepo.Create(plan.Guid, org.Guid)
+		if err != nil {
+			cmd.ui.Failed(i18n.T("Failed enabling access to plan %s for org %s.\n%s"), plan.Name, org.Name, err.Error())
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+
+	for _, name := range alreadyPublic {
+		cmd.ui.Warn(i18n.T("Plan %s is public and already accessible to all orgs"), name)
+	}
+}
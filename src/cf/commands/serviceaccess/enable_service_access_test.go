@@ -0,0 +1,116 @@
+package serviceaccess_test
+
+import (
+	. "cf/commands/serviceaccess"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("enable-service-access command", func() {
+	var (
+		ui             *testterm.FakeUI
+		reqFactory     *testreq.FakeReqFactory
+		serviceRepo    *testapi.FakeServiceRepo
+		planRepo       *testapi.FakeServicePlanRepo
+		visibilityRepo *testapi.FakeServicePlanVisibilityRepo
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		serviceRepo = &testapi.FakeServiceRepo{}
+		serviceRepo.GetAllServiceOfferingsReturns.ServiceOfferings = fakeServiceOfferings()
+		planRepo = &testapi.FakeServicePlanRepo{}
+		visibilityRepo = &testapi.FakeServicePlanVisibilityRepo{
+			Visibilities: []models.ServicePlanVisibilityFields{
+				{Guid: "v1", ServicePlanGuid: "boost-guid", OrganizationGuid: "my-org-guid"},
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewEnableServiceAccess(ui, testconfig.NewRepositoryWithDefaults(), serviceRepo, planRepo, visibilityRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("enable-service-access", args), reqFactory)
+	}
+
+	Describe("requirements", func() {
+		It("requires a service name", func() {
+			runCommand()
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("requires the user to be logged in", func() {
+			reqFactory.LoginSuccess = false
+			runCommand("cleardb")
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+
+		It("requires the org to exist when one is given", func() {
+			runCommand("-o", "my-org", "cleardb")
+			Expect(reqFactory.OrganizationName).To(Equal("my-org"))
+		})
+	})
+
+	It("makes every private plan of the service public", func() {
+		runCommand("cleardb")
+
+		Expect(planRepo.UpdatedPlanGuids).To(Equal([]string{"boost-guid", "amp-guid"}))
+		Expect(planRepo.UpdatedPublic).To(Equal([]bool{true, true}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Enabling access to all plans of service cleardb for all orgs as my-user"},
+			{"OK"},
+		})
+	})
+
+	It("makes a single plan public", func() {
+		runCommand("-p", "amp", "cleardb")
+
+		Expect(planRepo.UpdatedPlanGuids).To(Equal([]string{"amp-guid"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Enabling access to plan amp of service cleardb for all orgs"},
+			{"OK"},
+		})
+	})
+
+	It("creates visibilities for plans the org cannot see yet", func() {
+		reqFactory.Organization = models.Organization{}
+		reqFactory.Organization.Guid = "my-org-guid"
+		reqFactory.Organization.Name = "my-org"
+
+		runCommand("-o", "my-org", "cleardb")
+
+		Expect(planRepo.UpdatedPlanGuids).To(BeEmpty())
+		Expect(visibilityRepo.Created).To(Equal([]string{"amp-guid/my-org-guid"}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Enabling access to all plans of service cleardb for org my-org"},
+			{"OK"},
+			{"Plan spark is public and already accessible to all orgs"},
+		})
+	})
+
+	It("fails when the service does not exist", func() {
+		runCommand("mysql")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Service offering mysql not found"},
+		})
+	})
+
+	It("fails when the plan does not exist", func() {
+		runCommand("-p", "ultra", "cleardb")
+
+		Expect(planRepo.UpdatedPlanGuids).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Service plan ultra not found for service cleardb"},
+		})
+	})
+})
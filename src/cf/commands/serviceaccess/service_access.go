@@ -0,0 +1,175 @@
+package serviceaccess
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+)
+
+type ServiceAccess struct {
+	ui             terminal.UI
+	config         configuration.Reader
+	serviceRepo    api.ServiceRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgRepo        api.OrganizationRepository
+}
+
+func NewServiceAccess(ui terminal.UI, config configuration.Reader, serviceRepo api.ServiceRepository, visibilityRepo api.ServicePlanVisibilityRepository, orgRepo api.OrganizationRepository) (cmd *ServiceAccess) {
+	cmd = new(ServiceAccess)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceRepo = serviceRepo
+	cmd.visibilityRepo = visibilityRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *ServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "service-access")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ServiceAccess) Run(c *cli.Context) {
+	cmd.ui.Say(i18n.T("Getting service access as %s..."), terminal.EntityNameColor(cmd.config.Username()))
+
+	offerings, apiErr := cmd.serviceRepo.GetAllServiceOfferings()
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching service offerings.\n%s"), apiErr.Error())
+		return
+	}
+
+	visibilities, apiErr := cmd.visibilityRepo.List()
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching service plan visibilities.\n%s"), apiErr.Error())
+		return
+	}
+
+	orgNamesByGuid := map[string]string{}
+	apiErr = cmd.orgRepo.ListOrgs(func(org models.Organization) bool {
+		orgNamesByGuid[org.Guid] = org.Name
+		return true
+	})
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching orgs.\n%s"), apiErr.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if len(offerings) == 0 {
+		cmd.ui.Say("")
+		cmd.ui.Say(i18n.T("No service offerings found"))
+		return
+	}
+
+	byPlan := visibilitiesByPlan(visibilities)
+
+	sort.Sort(offerings)
+	for _, offering := range offerings {
+		cmd.ui.Say("")
+		cmd.ui.Say("%s %s", terminal.HeaderColor(i18n.T("service:")), terminal.EntityNameColor(offering.Label))
+
+		rows := [][]string{}
+		for _, plan := range offering.Plans {
+			orgNames := []string{}
+			for _, visibility := range byPlan[plan.Guid] {
+				orgName, found := orgNamesByGuid[visibility.OrganizationGuid]
+				if !found {
+					orgName = visibility.OrganizationGuid
+				}
+				orgNames = append(orgNames, orgName)
+			}
+			sort.Strings(orgNames)
+
+			rows = append(rows, []string{plan.Name, planAccess(plan, orgNames), strings.Join(orgNames, ", ")})
+		}
+
+		if len(rows) == 0 {
+			cmd.ui.Say(i18n.T("No service plans found"))
+			continue
+		}
+
+		cmd.ui.Table([]string{i18n.T("plan"), i18n.T("access"), i18n.T("orgs")}).Print(rows)
+	}
+}
+
+func planAccess(plan models.ServicePlanFields, orgNames []string) string {
+	switch {
+	case plan.Public:
+		return i18n.T("all")
+	case len(orgNames) > 0:
+		return i18n.T("limited")
+	}
+	return i18n.T("none")
+}
+
+func visibilitiesByPlan(visibilities []models.ServicePlanVisibilityFields) (byPlan map[string][]models.ServicePlanVisibilityFields) {
+	byPlan = map[string][]models.ServicePlanVisibilityFields{}
+	for _, visibility := range visibilities {
+		byPlan[visibility.ServicePlanGuid] = append(byPlan[visibility.ServicePlanGuid], visibility)
+	}
+	return
+}
+
+// the plans of every offering with the given label, or only the
+// plan with the given name when one is given
+func findServicePlans(serviceRepo api.ServiceRepository, serviceName, planName string) (plans []models.ServicePlanFields, err error) {
+	offerings, err := serviceRepo.GetAllServiceOfferings()
+	if err != nil {
+		return
+	}
+
+	foundOffering := false
+	for _, offering := range offerings {
+		if offering.Label != serviceName {
+			continue
+		}
+		foundOffering = true
+
+		for _, plan := range offering.Plans {
+			if planName == "" || plan.Name == planName {
+				plans = append(plans, plan)
+			}
+		}
+	}
+
+	switch {
+	case !foundOffering:
+		err = errors.NewModelNotFoundError("Service offering", serviceName)
+	case len(plans) == 0 && planName != "":
+		err = errors.New(i18n.T("Service plan %s not found for service %s", planName, serviceName))
+	case len(plans) == 0:
+		err = errors.New(i18n.T("Service %s has no plans", serviceName))
+	}
+	return
+}
+
+func describeAccessChange(message, serviceName, planName, orgName, username string) string {
+	plans := i18n.T("all plans of service %s", terminal.EntityNameColor(serviceName))
+	if planName != "" {
+		plans = i18n.T("plan %s of service %s", terminal.EntityNameColor(planName), terminal.EntityNameColor(serviceName))
+	}
+
+	orgs := i18n.T("all orgs")
+	if orgName != "" {
+		orgs = i18n.T("org %s", terminal.EntityNameColor(orgName))
+	}
+
+	return fmt.Sprintf(message, plans, orgs, terminal.EntityNameColor(username))
+}
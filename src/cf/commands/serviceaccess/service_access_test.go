@@ -0,0 +1,104 @@
+package serviceaccess_test
+
+import (
+	. "cf/commands/serviceaccess"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+func fakeServiceOfferings() []models.ServiceOffering {
+	return []models.ServiceOffering{
+		models.ServiceOffering{
+			ServiceOfferingFields: models.ServiceOfferingFields{Label: "rediscloud"},
+			Plans: []models.ServicePlanFields{
+				models.ServicePlanFields{Guid: "25mb-guid", Name: "25mb", Public: true},
+			},
+		},
+		models.ServiceOffering{
+			ServiceOfferingFields: models.ServiceOfferingFields{Label: "cleardb"},
+			Plans: []models.ServicePlanFields{
+				models.ServicePlanFields{Guid: "spark-guid", Name: "spark", Public: true},
+				models.ServicePlanFields{Guid: "boost-guid", Name: "boost"},
+				models.ServicePlanFields{Guid: "amp-guid", Name: "amp"},
+			},
+		},
+	}
+}
+
+var _ = Describe("service-access command", func() {
+	var (
+		ui             *testterm.FakeUI
+		reqFactory     *testreq.FakeReqFactory
+		serviceRepo    *testapi.FakeServiceRepo
+		visibilityRepo *testapi.FakeServicePlanVisibilityRepo
+		orgRepo        *testapi.FakeOrgRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		serviceRepo = &testapi.FakeServiceRepo{}
+		serviceRepo.GetAllServiceOfferingsReturns.ServiceOfferings = fakeServiceOfferings()
+		visibilityRepo = &testapi.FakeServicePlanVisibilityRepo{
+			Visibilities: []models.ServicePlanVisibilityFields{
+				{Guid: "v1", ServicePlanGuid: "boost-guid", OrganizationGuid: "org-2-guid"},
+				{Guid: "v2", ServicePlanGuid: "boost-guid", OrganizationGuid: "org-1-guid"},
+			},
+		}
+		orgRepo = &testapi.FakeOrgRepository{
+			Organizations: []models.Organization{
+				{OrganizationFields: models.OrganizationFields{Guid: "org-1-guid", Name: "org-1"}},
+				{OrganizationFields: models.OrganizationFields{Guid: "org-2-guid", Name: "org-2"}},
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewServiceAccess(ui, testconfig.NewRepositoryWithDefaults(), serviceRepo, visibilityRepo, orgRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("service-access", args), reqFactory)
+	}
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand()
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("fails with usage when given arguments", func() {
+		runCommand("cleardb")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("lists the access of every plan of every offering", func() {
+		runCommand()
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting service access as", "my-user"},
+			{"OK"},
+			{"service:", "cleardb"},
+			{"plan", "access", "orgs"},
+			{"spark", "all"},
+			{"boost", "limited", "org-1, org-2"},
+			{"amp", "none"},
+			{"service:", "rediscloud"},
+			{"25mb", "all"},
+		})
+	})
+
+	It("fails when the visibilities cannot be fetched", func() {
+		visibilityRepo.ListErr = true
+		runCommand()
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Failed fetching service plan visibilities"},
+		})
+	})
+})
@@ -3,6 +3,7 @@ package serviceauthtoken
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -37,7 +38,7 @@ func (cmd CreateServiceAuthTokenFields) GetRequirements(reqFactory requirements.
 }
 
 func (cmd CreateServiceAuthTokenFields) Run(c *cli.Context) {
-	cmd.ui.Say("Creating service auth token as %s...", terminal.EntityNameColor(cmd.config.Username()))
+	cmd.ui.Say(i18n.T("Creating service auth token as %s..."), terminal.EntityNameColor(cmd.config.Username()))
 
 	serviceAuthTokenRepo := models.ServiceAuthTokenFields{
 		Label:    c.Args()[0],
@@ -47,7 +48,7 @@ func (cmd CreateServiceAuthTokenFields) Run(c *cli.Context) {
 
 	apiErr := cmd.authTokenRepo.Create(serviceAuthTokenRepo)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
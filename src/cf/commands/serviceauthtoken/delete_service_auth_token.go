@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"fmt"
@@ -40,7 +41,7 @@ func (cmd DeleteServiceAuthTokenFields) Run(c *cli.Context) {
 
 	if c.Bool("f") == false {
 		response := cmd.ui.Confirm(
-			"Are you sure you want to delete %s?%s",
+			i18n.T("Are you sure you want to delete %s?%s"),
 			terminal.EntityNameColor(fmt.Sprintf("%s %s", tokenLabel, tokenProvider)),
 			terminal.PromptColor(">"),
 		)
@@ -49,22 +50,22 @@ func (cmd DeleteServiceAuthTokenFields) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting service auth token as %s", terminal.EntityNameColor(cmd.config.Username()))
+	cmd.ui.Say(i18n.T("Deleting service auth token as %s"), terminal.EntityNameColor(cmd.config.Username()))
 	token, apiErr := cmd.authTokenRepo.FindByLabelAndProvider(tokenLabel, tokenProvider)
 
 	switch apiErr.(type) {
 	case nil:
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn("Service Auth Token %s %s does not exist.", tokenLabel, tokenProvider)
+		cmd.ui.Warn(i18n.T("Service Auth Token %s %s does not exist."), tokenLabel, tokenProvider)
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 	}
 
 	apiErr = cmd.authTokenRepo.Delete(token)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
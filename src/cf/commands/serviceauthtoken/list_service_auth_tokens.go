@@ -3,6 +3,7 @@ package serviceauthtoken
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -29,17 +30,17 @@ func (cmd ListServiceAuthTokens) GetRequirements(reqFactory requirements.Factory
 }
 
 func (cmd ListServiceAuthTokens) Run(c *cli.Context) {
-	cmd.ui.Say("Getting service auth tokens as %s...", terminal.EntityNameColor(cmd.config.Username()))
+	cmd.ui.Say(i18n.T("Getting service auth tokens as %s..."), terminal.EntityNameColor(cmd.config.Username()))
 	authTokens, apiErr := cmd.authTokenRepo.FindAll()
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
 	table := [][]string{
-		{"label", "provider"},
+		{i18n.T("label"), i18n.T("provider")},
 	}
 
 	for _, authToken := range authTokens {
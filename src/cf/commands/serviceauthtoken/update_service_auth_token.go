@@ -3,6 +3,7 @@ package serviceauthtoken
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -36,11 +37,11 @@ func (cmd UpdateServiceAuthTokenFields) GetRequirements(reqFactory requirements.
 }
 
 func (cmd UpdateServiceAuthTokenFields) Run(c *cli.Context) {
-	cmd.ui.Say("Updating service auth token as %s...", terminal.EntityNameColor(cmd.config.Username()))
+	cmd.ui.Say(i18n.T("Updating service auth token as %s..."), terminal.EntityNameColor(cmd.config.Username()))
 
 	serviceAuthToken, apiErr := cmd.authTokenRepo.FindByLabelAndProvider(c.Args()[0], c.Args()[1])
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -48,7 +49,7 @@ func (cmd UpdateServiceAuthTokenFields) Run(c *cli.Context) {
 
 	apiErr = cmd.authTokenRepo.Update(serviceAuthToken)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -1,8 +1,10 @@
 package servicebroker
 
 import (
+	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -41,14 +43,14 @@ func (cmd CreateServiceBroker) Run(c *cli.Context) {
 	password := c.Args()[2]
 	url := c.Args()[3]
 
-	cmd.ui.Say("Creating service broker %s as %s...",
+	cmd.ui.Say(i18n.T("Creating service broker %s as %s..."),
 		terminal.EntityNameColor(name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
 	apiErr := cmd.serviceBrokerRepo.Create(name, url, username, password)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.Failed(i18n.T("%s\nTIP: Use '%s validate-service-broker %s' to check the broker's catalog"), apiErr.Error(), cf.Name(), url)
 		return
 	}
 
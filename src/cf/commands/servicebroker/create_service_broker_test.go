@@ -2,6 +2,7 @@ package servicebroker_test
 
 import (
 	. "cf/commands/servicebroker"
+	"cf/errors"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testapi "testhelpers/api"
@@ -63,6 +64,20 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(serviceBrokerRepo.CreateUsername).To(Equal("my username"))
 		Expect(serviceBrokerRepo.CreatePassword).To(Equal("my password"))
 	})
+	It("suggests validating the catalog when the broker cannot be created", func() {
+		reqFactory := &testreq.FakeReqFactory{LoginSuccess: true}
+		serviceBrokerRepo := &testapi.FakeServiceBrokerRepo{
+			CreateErr: errors.NewHttpError(502, "270012", "Service broker catalog is invalid"),
+		}
+		args := []string{"my-broker", "my username", "my password", "http://example.com"}
+		ui := callCreateServiceBroker(args, reqFactory, serviceBrokerRepo)
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Service broker catalog is invalid"},
+			{"TIP", "validate-service-broker http://example.com"},
+		})
+	})
 })
 
 func callCreateServiceBroker(args []string, reqFactory *testreq.FakeReqFactory, serviceBrokerRepo *testapi.FakeServiceBrokerRepo) (ui *testterm.FakeUI) {
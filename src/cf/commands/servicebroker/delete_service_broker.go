@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -39,7 +40,7 @@ func (cmd DeleteServiceBroker) Run(c *cli.Context) {
 
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete %s?%s",
+			i18n.T("Really delete %s?%s"),
 			terminal.EntityNameColor(brokerName),
 			terminal.PromptColor(">"),
 		)
@@ -48,7 +49,7 @@ func (cmd DeleteServiceBroker) Run(c *cli.Context) {
 		}
 	}
 
-	cmd.ui.Say("Deleting service broker %s as %s...",
+	cmd.ui.Say(i18n.T("Deleting service broker %s as %s..."),
 		terminal.EntityNameColor(brokerName),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -59,16 +60,16 @@ func (cmd DeleteServiceBroker) Run(c *cli.Context) {
 	case nil:
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn("Service Broker %s does not exist.", brokerName)
+		cmd.ui.Warn(i18n.T("Service Broker %s does not exist."), brokerName)
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	apiErr = cmd.repo.Delete(broker.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
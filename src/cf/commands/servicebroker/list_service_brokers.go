@@ -3,6 +3,7 @@ package servicebroker
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -28,9 +29,9 @@ func (cmd ListServiceBrokers) GetRequirements(reqFactory requirements.Factory, c
 }
 
 func (cmd ListServiceBrokers) Run(c *cli.Context) {
-	cmd.ui.Say("Getting service brokers as %s...\n", terminal.EntityNameColor(cmd.config.Username()))
+	cmd.ui.Say(i18n.T("Getting service brokers as %s...\n"), terminal.EntityNameColor(cmd.config.Username()))
 
-	table := cmd.ui.Table([]string{"name", "url"})
+	table := cmd.ui.Table([]string{i18n.T("name"), i18n.T("url")})
 	foundBrokers := false
 	apiErr := cmd.repo.ListServiceBrokers(func(serviceBroker models.ServiceBroker) bool {
 		table.Print([][]string{{serviceBroker.Name, serviceBroker.Url}})
@@ -39,11 +40,11 @@ func (cmd ListServiceBrokers) Run(c *cli.Context) {
 	})
 
 	if apiErr != nil {
-		cmd.ui.Failed("Failed fetching service brokers.\n%s", apiErr)
+		cmd.ui.Failed(i18n.T("Failed fetching service brokers.\n%s"), apiErr)
 		return
 	}
 
 	if !foundBrokers {
-		cmd.ui.Say("No service brokers found")
+		cmd.ui.Say(i18n.T("No service brokers found"))
 	}
 }
@@ -3,6 +3,7 @@ package servicebroker
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -37,11 +38,11 @@ func (cmd RenameServiceBroker) GetRequirements(reqFactory requirements.Factory,
 func (cmd RenameServiceBroker) Run(c *cli.Context) {
 	serviceBroker, apiErr := cmd.repo.FindByName(c.Args()[0])
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
-	cmd.ui.Say("Renaming service broker %s to %s as %s",
+	cmd.ui.Say(i18n.T("Renaming service broker %s to %s as %s"),
 		terminal.EntityNameColor(serviceBroker.Name),
 		terminal.EntityNameColor(c.Args()[1]),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -52,7 +53,7 @@ func (cmd RenameServiceBroker) Run(c *cli.Context) {
 	apiErr = cmd.repo.Rename(serviceBroker.Guid, newName)
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
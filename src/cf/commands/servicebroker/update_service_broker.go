@@ -3,6 +3,7 @@ package servicebroker
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -37,11 +38,11 @@ func (cmd UpdateServiceBroker) GetRequirements(reqFactory requirements.Factory,
 func (cmd UpdateServiceBroker) Run(c *cli.Context) {
 	serviceBroker, apiErr := cmd.repo.FindByName(c.Args()[0])
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
-	cmd.ui.Say("Updating service broker %s as %s...",
+	cmd.ui.Say(i18n.T("Updating service broker %s as %s..."),
 		terminal.EntityNameColor(serviceBroker.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -53,7 +54,7 @@ func (cmd UpdateServiceBroker) Run(c *cli.Context) {
 	apiErr = cmd.repo.Update(serviceBroker)
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
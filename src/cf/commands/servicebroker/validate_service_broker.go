@@ -0,0 +1,62 @@
+package servicebroker
+
+import (
+	"cf"
+	"cf/api"
+	"cf/broker_catalog"
+	"cf/errors"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ValidateServiceBroker struct {
+	ui          terminal.UI
+	catalogRepo api.ServiceBrokerCatalogRepository
+}
+
+func NewValidateServiceBroker(ui terminal.UI, catalogRepo api.ServiceBrokerCatalogRepository) (cmd ValidateServiceBroker) {
+	cmd.ui = ui
+	cmd.catalogRepo = catalogRepo
+	return
+}
+
+// the broker is contacted directly, so neither a targeted API
+// nor a login is required
+func (cmd ValidateServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "validate-service-broker")
+		return
+	}
+	return
+}
+
+func (cmd ValidateServiceBroker) Run(c *cli.Context) {
+	url := c.Args()[0]
+
+	cmd.ui.Say(i18n.T("Validating catalog of service broker at %s..."), terminal.EntityNameColor(url))
+
+	catalog, apiErr := cmd.catalogRepo.GetCatalog(url, c.String("u"), c.String("p"))
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Could not fetch the catalog.\n%s"), apiErr.Error())
+		return
+	}
+
+	report := broker_catalog.Validate(catalog)
+	if !report.Errors.Empty() {
+		cmd.ui.Say("")
+		for _, err := range report.Errors {
+			cmd.ui.Say("  %s", err.Error())
+		}
+		cmd.ui.Say("")
+		cmd.ui.Failed(i18n.T("Found %d problems in the catalog"), len(report.Errors))
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	cmd.ui.Say(i18n.T("Catalog is valid: %d services with %d plans"), report.ServiceCount, report.PlanCount)
+	cmd.ui.Say(i18n.T("TIP: Use '%s create-service-broker' to register the broker"), cf.Name())
+}
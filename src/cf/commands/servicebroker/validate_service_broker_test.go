@@ -0,0 +1,84 @@
+package servicebroker_test
+
+import (
+	. "cf/commands/servicebroker"
+	"cf/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("validate-service-broker command", func() {
+	var (
+		ui          *testterm.FakeUI
+		reqFactory  *testreq.FakeReqFactory
+		catalogRepo *testapi.FakeServiceBrokerCatalogRepo
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{}
+		catalogRepo = &testapi.FakeServiceBrokerCatalogRepo{}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewValidateServiceBroker(ui, catalogRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("validate-service-broker", args), reqFactory)
+	}
+
+	It("fails with usage when no URL is given", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("does not need a targeted API or a login", func() {
+		catalogRepo.Catalog = `{"services": []}`
+		runCommand("http://localhost:9292")
+		Expect(testcmd.CommandDidPassRequirements).To(BeTrue())
+	})
+
+	It("fetches the catalog with the given credentials", func() {
+		catalogRepo.Catalog = `{"services": [{
+			"id": "service-guid", "name": "cleardb", "description": "MySQL", "bindable": true,
+			"plans": [{"id": "plan-guid", "name": "spark", "description": "Free"}]
+		}]}`
+		runCommand("-u", "admin", "-p", "secret", "http://localhost:9292")
+
+		Expect(catalogRepo.GetCatalogUrl).To(Equal("http://localhost:9292"))
+		Expect(catalogRepo.GetCatalogUsername).To(Equal("admin"))
+		Expect(catalogRepo.GetCatalogPassword).To(Equal("secret"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Validating catalog of service broker at", "http://localhost:9292"},
+			{"OK"},
+			{"Catalog is valid: 1 services with 1 plans"},
+		})
+	})
+
+	It("lists every problem in the catalog", func() {
+		catalogRepo.Catalog = `{"services": [{"id": "service-guid", "name": "Clear DB", "bindable": true, "plans": []}]}`
+		runCommand("http://localhost:9292")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"services[0].name", "Clear DB", "no spaces"},
+			{"services[0].description: is required"},
+			{"services[0].plans: must contain at least one plan"},
+			{"FAILED"},
+			{"Found 3 problems in the catalog"},
+		})
+	})
+
+	It("fails when the catalog cannot be fetched", func() {
+		catalogRepo.GetCatalogErr = errors.NewHttpError(401, "", "Not authorized")
+		runCommand("http://localhost:9292")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Could not fetch the catalog"},
+			{"Not authorized"},
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"cf/commands/user"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -55,7 +56,7 @@ func (cmd CreateSpace) Run(c *cli.Context) {
 		orgGuid = cmd.config.OrganizationFields().Guid
 	}
 
-	cmd.ui.Say("Creating space %s in org %s as %s...",
+	cmd.ui.Say(i18n.T("Creating space %s in org %s as %s..."),
 		terminal.EntityNameColor(spaceName),
 		terminal.EntityNameColor(orgName),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -66,10 +67,10 @@ func (cmd CreateSpace) Run(c *cli.Context) {
 		switch apiErr.(type) {
 		case nil:
 		case errors.ModelNotFoundError:
-			cmd.ui.Failed("Org %s does not exist or is not accessible", orgName)
+			cmd.ui.Failed(i18n.T("Org %s does not exist or is not accessible"), orgName)
 			return
 		default:
-			cmd.ui.Failed("Error finding org %s\n%s", orgName, apiErr.Error())
+			cmd.ui.Failed(i18n.T("Error finding org %s\n%s"), orgName, apiErr.Error())
 			return
 		}
 
@@ -80,25 +81,25 @@ func (cmd CreateSpace) Run(c *cli.Context) {
 	if err != nil {
 		if err, ok := err.(errors.HttpError); ok && err.ErrorCode() == errors.SPACE_EXISTS {
 			cmd.ui.Ok()
-			cmd.ui.Warn("Space %s already exists", spaceName)
+			cmd.ui.Warn(i18n.T("Space %s already exists"), spaceName)
 			return
 		}
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
 		return
 	}
 	cmd.ui.Ok()
 
 	err = cmd.spaceRoleSetter.SetSpaceRole(space, models.SPACE_MANAGER, cmd.config.UserGuid(), cmd.config.Username())
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
 		return
 	}
 
 	err = cmd.spaceRoleSetter.SetSpaceRole(space, models.SPACE_DEVELOPER, cmd.config.UserGuid(), cmd.config.Username())
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
 		return
 	}
 
-	cmd.ui.Say("\nTIP: Use '%s' to target new space", terminal.CommandColor(cf.Name()+" target -o "+orgName+" -s "+space.Name))
+	cmd.ui.Say(i18n.T("\nTIP: Use '%s' to target new space"), terminal.CommandColor(cf.Name()+" target -o "+orgName+" -s "+space.Name))
 }
@@ -4,25 +4,34 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
+	"cf/net"
 	"cf/requirements"
+	"cf/spacecontents"
 	"cf/terminal"
 	"errors"
 	"github.com/codegangsta/cli"
 )
 
 type DeleteSpace struct {
-	ui        terminal.UI
-	config    configuration.ReadWriter
-	spaceRepo api.SpaceRepository
-	spaceReq  requirements.SpaceRequirement
+	ui                 terminal.UI
+	config             configuration.ReadWriter
+	spaceRepo          api.SpaceRepository
+	appSummaryRepo     api.AppSummaryRepository
+	serviceSummaryRepo api.ServiceSummaryRepository
+	routeRepo          api.RouteRepository
+	spaceReq           requirements.SpaceRequirement
 }
 
-func NewDeleteSpace(ui terminal.UI, config configuration.ReadWriter, spaceRepo api.SpaceRepository) (cmd *DeleteSpace) {
+func NewDeleteSpace(ui terminal.UI, config configuration.ReadWriter, spaceRepo api.SpaceRepository, appSummaryRepo api.AppSummaryRepository, serviceSummaryRepo api.ServiceSummaryRepository, routeRepo api.RouteRepository) (cmd *DeleteSpace) {
 	cmd = new(DeleteSpace)
 	cmd.ui = ui
 	cmd.config = config
 	cmd.spaceRepo = spaceRepo
+	cmd.appSummaryRepo = appSummaryRepo
+	cmd.serviceSummaryRepo = serviceSummaryRepo
+	cmd.routeRepo = routeRepo
 	return
 }
 
@@ -45,18 +54,30 @@ func (cmd *DeleteSpace) GetRequirements(reqFactory requirements.Factory, c *cli.
 func (cmd *DeleteSpace) Run(c *cli.Context) {
 	spaceName := c.Args()[0]
 	force := c.Bool("f")
-
-	cmd.ui.Say("Deleting space %s in org %s as %s...",
-		terminal.EntityNameColor(spaceName),
-		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-		terminal.EntityNameColor(cmd.config.Username()),
-	)
+	dryRun := c.Bool("dry-run") || net.IsDryRunEnabled()
 
 	space := cmd.spaceReq.GetSpace()
 
+	if dryRun || !force {
+		contents, apiErr := spacecontents.Get(cmd.appSummaryRepo, cmd.serviceSummaryRepo, cmd.routeRepo, []models.SpaceFields{space.SpaceFields})
+		if apiErr != nil {
+			cmd.ui.Failed(i18n.T("Failed fetching the contents of space %s.\n%s"), spaceName, apiErr.Error())
+			return
+		}
+
+		cmd.ui.Say(i18n.T("Deleting space %s will also delete:"), terminal.EntityNameColor(spaceName))
+		spacecontents.Print(cmd.ui, contents[0], "  ")
+		cmd.ui.Say("")
+	}
+
+	if dryRun {
+		cmd.ui.Say(i18n.T("Dry run, space %s was not deleted"), terminal.EntityNameColor(spaceName))
+		return
+	}
+
 	if !force {
 		response := cmd.ui.Confirm(
-			"Really delete space %s and everything associated with it?%s",
+			i18n.T("Really delete space %s and everything associated with it?%s"),
 			terminal.EntityNameColor(spaceName),
 			terminal.PromptColor(">"),
 		)
@@ -65,9 +86,15 @@ func (cmd *DeleteSpace) Run(c *cli.Context) {
 		}
 	}
 
+	cmd.ui.Say(i18n.T("Deleting space %s in org %s as %s..."),
+		terminal.EntityNameColor(spaceName),
+		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
 	apiErr := cmd.spaceRepo.Delete(space.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -75,7 +102,7 @@ func (cmd *DeleteSpace) Run(c *cli.Context) {
 
 	if cmd.config.SpaceFields().Name == spaceName {
 		cmd.config.SetSpaceFields(models.SpaceFields{})
-		cmd.ui.Say("TIP: No space targeted, use '%s target -s' to target a space", cf.Name())
+		cmd.ui.Say(i18n.T("TIP: No space targeted, use '%s target -s' to target a space"), cf.Name())
 	}
 
 	return
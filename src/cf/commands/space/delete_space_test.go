@@ -2,9 +2,13 @@ package space_test
 
 import (
 	. "cf/commands/space"
+	"cf/errors"
 	"cf/models"
+	"cf/net"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"strings"
 	testapi "testhelpers/api"
 	testassert "testhelpers/assert"
 	testcmd "testhelpers/commands"
@@ -32,7 +36,7 @@ func deleteSpace(inputs []string, args []string, reqFactory *testreq.FakeReqFact
 	}
 	ctxt := testcmd.NewContext("delete-space", args)
 	configRepo := testconfig.NewRepositoryWithDefaults()
-	cmd := NewDeleteSpace(ui, configRepo, spaceRepo)
+	cmd := NewDeleteSpace(ui, configRepo, spaceRepo, &testapi.FakeAppSummaryRepo{}, &testapi.FakeServiceSummaryRepo{}, &testapi.FakeRouteRepository{})
 	testcmd.RunCommand(cmd, ctxt, reqFactory)
 	return
 }
@@ -105,7 +109,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := &testterm.FakeUI{}
 		ctxt := testcmd.NewContext("delete", []string{"-f", "space-to-delete"})
 
-		cmd := NewDeleteSpace(ui, config, spaceRepo)
+		cmd := NewDeleteSpace(ui, config, spaceRepo, &testapi.FakeAppSummaryRepo{}, &testapi.FakeServiceSummaryRepo{}, &testapi.FakeRouteRepository{})
 		testcmd.RunCommand(cmd, ctxt, reqFactory)
 
 		Expect(config.HasSpace()).To(Equal(false))
@@ -125,7 +129,7 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui := &testterm.FakeUI{}
 		ctxt := testcmd.NewContext("delete", []string{"-f", "space-to-delete"})
 
-		cmd := NewDeleteSpace(ui, config, spaceRepo)
+		cmd := NewDeleteSpace(ui, config, spaceRepo, &testapi.FakeAppSummaryRepo{}, &testapi.FakeServiceSummaryRepo{}, &testapi.FakeRouteRepository{})
 		testcmd.RunCommand(cmd, ctxt, reqFactory)
 
 		Expect(config.HasSpace()).To(Equal(true))
@@ -139,4 +143,131 @@ var _ = Describe("Testing with ginkgo", func() {
 		ui, _ = deleteSpace([]string{"Yes"}, []string{"space-to-delete"}, defaultDeleteSpaceReqFactory())
 		Expect(ui.FailedWithUsage).To(BeFalse())
 	})
+
+	Describe("listing what the space contains", func() {
+		var (
+			ui                 *testterm.FakeUI
+			spaceRepo          *testapi.FakeSpaceRepository
+			appSummaryRepo     *testapi.FakeAppSummaryRepo
+			serviceSummaryRepo *testapi.FakeServiceSummaryRepo
+			routeRepo          *testapi.FakeRouteRepository
+		)
+
+		BeforeEach(func() {
+			spaceRepo = &testapi.FakeSpaceRepository{}
+
+			app := models.AppSummary{}
+			app.Name = "my-app"
+			app.InstanceCount = 1
+			route := models.RouteSummary{Host: "my-app", Domain: models.DomainFields{Name: "example.com"}}
+			app.RouteSummaries = []models.RouteSummary{route}
+
+			otherApp := models.AppSummary{}
+			otherApp.Name = "my-other-app"
+			otherApp.InstanceCount = 3
+			otherApp.RouteSummaries = []models.RouteSummary{route}
+
+			appSummaryRepo = &testapi.FakeAppSummaryRepo{
+				GetSummariesInSpaceApps: map[string][]models.AppSummary{
+					"space-to-delete-guid": []models.AppSummary{app, otherApp},
+				},
+			}
+
+			managed := models.ServiceInstance{}
+			managed.Name = "my-db"
+			managed.ServicePlan = models.ServicePlanFields{Name: "small", Guid: "small-plan-guid"}
+			managed.ServiceOffering = models.ServiceOfferingFields{Label: "mysql"}
+
+			userProvided := models.ServiceInstance{}
+			userProvided.Name = "my-ups"
+
+			serviceSummaryRepo = &testapi.FakeServiceSummaryRepo{
+				GetSummariesInSpaceInstances: map[string][]models.ServiceInstance{
+					"space-to-delete-guid": []models.ServiceInstance{managed, userProvided},
+				},
+			}
+
+			mappedRoute := models.Route{}
+			mappedRoute.RouteSummary = route
+			mappedRoute.Space = models.SpaceFields{Guid: "space-to-delete-guid"}
+			mappedRoute.Apps = []models.ApplicationFields{models.ApplicationFields{Name: "my-app"}}
+			orphanedRoute := models.Route{}
+			orphanedRoute.Host = "unmapped"
+			orphanedRoute.Domain = models.DomainFields{Name: "example.com"}
+			orphanedRoute.Space = models.SpaceFields{Guid: "space-to-delete-guid"}
+			routeRepo = &testapi.FakeRouteRepository{Routes: []models.Route{mappedRoute, orphanedRoute}}
+		})
+
+		runDeleteSpace := func(inputs []string, args ...string) {
+			ui = &testterm.FakeUI{Inputs: inputs}
+			cmd := NewDeleteSpace(ui, testconfig.NewRepositoryWithDefaults(), spaceRepo, appSummaryRepo, serviceSummaryRepo, routeRepo)
+			testcmd.RunCommand(cmd, testcmd.NewContext("delete-space", args), defaultDeleteSpaceReqFactory())
+		}
+
+		It("lists the apps, service instances and routes before asking for confirmation", func() {
+			runDeleteSpace([]string{"y"}, "space-to-delete")
+
+			Expect(appSummaryRepo.GetSummariesInSpaceGuids).To(Equal([]string{"space-to-delete-guid"}))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Deleting space", "space-to-delete", "will also delete"},
+				{"app", "my-app", "1 instance"},
+				{"app", "my-other-app", "3 instances"},
+				{"service instance", "my-db", "mysql", "deprovisioned by its service broker"},
+				{"service instance", "my-ups", "user-provided"},
+				{"route", "my-app.example.com"},
+				{"route", "unmapped.example.com"},
+				{"Deleting space", "space-to-delete", "my-org", "my-user"},
+				{"OK"},
+			})
+			Expect(spaceRepo.DeletedSpaceGuid).To(Equal("space-to-delete-guid"))
+		})
+
+		It("lists a route mapped to several apps once", func() {
+			runDeleteSpace([]string{"n"}, "space-to-delete")
+
+			routeLines := 0
+			for _, line := range ui.Outputs {
+				if strings.Contains(line, "route my-app.example.com") {
+					routeLines++
+				}
+			}
+			Expect(routeLines).To(Equal(1))
+			Expect(spaceRepo.DeletedSpaceGuid).To(Equal(""))
+		})
+
+		It("does not delete anything or ask for confirmation with --dry-run", func() {
+			runDeleteSpace([]string{}, "--dry-run", "space-to-delete")
+
+			Expect(len(ui.Prompts)).To(Equal(0))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"app", "my-app"},
+				{"Dry run", "space-to-delete", "not deleted"},
+			})
+			Expect(spaceRepo.DeletedSpaceGuid).To(Equal(""))
+		})
+
+		It("does not delete anything when the global --dry-run is set", func() {
+			net.EnableDryRun(ioutil.Discard)
+			defer net.DisableDryRun()
+			runDeleteSpace([]string{}, "space-to-delete")
+
+			Expect(len(ui.Prompts)).To(Equal(0))
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"Dry run", "space-to-delete", "not deleted"},
+			})
+			Expect(spaceRepo.DeletedSpaceGuid).To(Equal(""))
+		})
+
+		It("fails without deleting when the contents can't be fetched", func() {
+			appSummaryRepo.GetSummariesInSpaceErr = errors.New("summary error")
+
+			runDeleteSpace([]string{"y"}, "space-to-delete")
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{
+				{"FAILED"},
+				{"Failed fetching the contents of space space-to-delete"},
+			})
+			Expect(spaceRepo.DeletedSpaceGuid).To(Equal(""))
+		})
+	})
 })
@@ -3,6 +3,7 @@ package space
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -31,12 +32,12 @@ func (cmd ListSpaces) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 }
 
 func (cmd ListSpaces) Run(c *cli.Context) {
-	cmd.ui.Say("Getting spaces in org %s as %s...\n",
+	cmd.ui.Say(i18n.T("Getting spaces in org %s as %s...\n"),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.Username()))
 
 	foundSpaces := false
-	table := cmd.ui.Table([]string{"name"})
+	table := cmd.ui.Table([]string{i18n.T("name")})
 	apiErr := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
 		table.Print([][]string{{space.Name}})
 		foundSpaces = true
@@ -44,11 +45,11 @@ func (cmd ListSpaces) Run(c *cli.Context) {
 	})
 
 	if apiErr != nil {
-		cmd.ui.Failed("Failed fetching spaces.\n%s", apiErr.Error())
+		cmd.ui.Failed(i18n.T("Failed fetching spaces.\n%s"), apiErr.Error())
 		return
 	}
 
 	if !foundSpaces {
-		cmd.ui.Say("No spaces found")
+		cmd.ui.Say(i18n.T("No spaces found"))
 	}
 }
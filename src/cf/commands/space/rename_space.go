@@ -3,6 +3,7 @@ package space
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -42,7 +43,7 @@ func (cmd *RenameSpace) GetRequirements(reqFactory requirements.Factory, c *cli.
 func (cmd *RenameSpace) Run(c *cli.Context) {
 	space := cmd.spaceReq.GetSpace()
 	newName := c.Args()[1]
-	cmd.ui.Say("Renaming space %s to %s in org %s as %s...",
+	cmd.ui.Say(i18n.T("Renaming space %s to %s in org %s as %s..."),
 		terminal.EntityNameColor(space.Name),
 		terminal.EntityNameColor(newName),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -51,7 +52,7 @@ func (cmd *RenameSpace) Run(c *cli.Context) {
 
 	apiErr := cmd.spaceRepo.Rename(space.Guid, newName)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -2,6 +2,7 @@ package space
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -40,30 +41,30 @@ func (cmd *ShowSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 
 func (cmd *ShowSpace) Run(c *cli.Context) {
 	space := cmd.spaceReq.GetSpace()
-	cmd.ui.Say("Getting info for space %s in org %s as %s...",
+	cmd.ui.Say(i18n.T("Getting info for space %s in org %s as %s..."),
 		terminal.EntityNameColor(space.Name),
 		terminal.EntityNameColor(space.Organization.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 	cmd.ui.Ok()
 	cmd.ui.Say("\n%s:", terminal.EntityNameColor(space.Name))
-	cmd.ui.Say("  Org: %s", terminal.EntityNameColor(space.Organization.Name))
+	cmd.ui.Say(i18n.T("  Org: %s"), terminal.EntityNameColor(space.Organization.Name))
 
 	apps := []string{}
 	for _, app := range space.Applications {
 		apps = append(apps, app.Name)
 	}
-	cmd.ui.Say("  Apps: %s", terminal.EntityNameColor(strings.Join(apps, ", ")))
+	cmd.ui.Say(i18n.T("  Apps: %s"), terminal.EntityNameColor(strings.Join(apps, ", ")))
 
 	domains := []string{}
 	for _, domain := range space.Domains {
 		domains = append(domains, domain.Name)
 	}
-	cmd.ui.Say("  Domains: %s", terminal.EntityNameColor(strings.Join(domains, ", ")))
+	cmd.ui.Say(i18n.T("  Domains: %s"), terminal.EntityNameColor(strings.Join(domains, ", ")))
 
 	services := []string{}
 	for _, service := range space.ServiceInstances {
 		services = append(services, service.Name)
 	}
-	cmd.ui.Say("  Services: %s", terminal.EntityNameColor(strings.Join(services, ", ")))
+	cmd.ui.Say(i18n.T("  Services: %s"), terminal.EntityNameColor(strings.Join(services, ", ")))
 }
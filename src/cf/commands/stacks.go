@@ -3,6 +3,7 @@ package commands
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -27,7 +28,7 @@ func (cmd ListStacks) GetRequirements(reqFactory requirements.Factory, c *cli.Co
 }
 
 func (cmd ListStacks) Run(c *cli.Context) {
-	cmd.ui.Say("Getting stacks in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Getting stacks in org %s / space %s as %s..."),
 		terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 		terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 		terminal.EntityNameColor(cmd.config.Username()),
@@ -35,7 +36,7 @@ func (cmd ListStacks) Run(c *cli.Context) {
 
 	stacks, apiErr := cmd.stacksRepo.FindAll()
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -43,7 +44,7 @@ func (cmd ListStacks) Run(c *cli.Context) {
 	cmd.ui.Say("")
 
 	table := [][]string{
-		[]string{"name", "description"},
+		[]string{i18n.T("name"), i18n.T("description")},
 	}
 
 	for _, stack := range stacks {
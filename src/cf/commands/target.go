@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -51,14 +52,14 @@ func (cmd Target) Run(c *cli.Context) {
 	if orgName != "" {
 		err := cmd.setOrganization(orgName)
 		if err != nil {
-			cmd.ui.Failed(err.Error())
+			cmd.ui.FailWithError(err)
 		}
 	}
 
 	if spaceName != "" {
 		err := cmd.setSpace(spaceName)
 		if err != nil {
-			cmd.ui.Failed(err.Error())
+			cmd.ui.FailWithError(err)
 		}
 	}
 
@@ -73,7 +74,7 @@ func (cmd Target) setOrganization(orgName string) error {
 
 	org, apiErr := cmd.orgRepo.FindByName(orgName)
 	if apiErr != nil {
-		return errors.NewWithFmt("Could not target org.\n%s", apiErr.Error())
+		return errors.New(i18n.T("Could not target org.\n%s", apiErr.Error()))
 	}
 
 	cmd.config.SetOrganizationFields(org.OrganizationFields)
@@ -84,12 +85,12 @@ func (cmd Target) setSpace(spaceName string) error {
 	cmd.config.SetSpaceFields(models.SpaceFields{})
 
 	if !cmd.config.HasOrganization() {
-		return errors.New("An org must be targeted before targeting a space")
+		return errors.New(i18n.T("An org must be targeted before targeting a space"))
 	}
 
 	space, apiErr := cmd.spaceRepo.FindByName(spaceName)
 	if apiErr != nil {
-		return errors.NewWithFmt("Unable to access space %s.\n%s", spaceName, apiErr.Error())
+		return errors.New(i18n.T("Unable to access space %s.\n%s", spaceName, apiErr.Error()))
 	}
 
 	cmd.config.SetSpaceFields(space.SpaceFields)
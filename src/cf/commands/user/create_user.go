@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"errors"
@@ -38,18 +39,18 @@ func (cmd CreateUserFields) Run(c *cli.Context) {
 	username := c.Args()[0]
 	password := c.Args()[1]
 
-	cmd.ui.Say("Creating user %s as %s...",
+	cmd.ui.Say(i18n.T("Creating user %s as %s..."),
 		terminal.EntityNameColor(username),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
 	apiErr := cmd.userRepo.Create(username, password)
 	if apiErr != nil {
-		cmd.ui.Failed("Error creating user %s.\n%s", terminal.EntityNameColor(username), apiErr.Error())
+		cmd.ui.Failed(i18n.T("Error creating user %s.\n%s"), terminal.EntityNameColor(username), apiErr.Error())
 		return
 	}
 
 	cmd.ui.Ok()
 
-	cmd.ui.Say("\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'", cf.Name(), cf.Name())
+	cmd.ui.Say(i18n.T("\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'"), cf.Name(), cf.Name())
 }
@@ -4,6 +4,7 @@ import (
 	"cf/api"
 	"cf/configuration"
 	"cf/errors"
+	"cf/i18n"
 	"cf/requirements"
 	"cf/terminal"
 	"github.com/codegangsta/cli"
@@ -38,14 +39,14 @@ func (cmd DeleteUserFields) Run(c *cli.Context) {
 	username := c.Args()[0]
 	force := c.Bool("f")
 
-	if !force && !cmd.ui.Confirm("Really delete user %s?%s",
+	if !force && !cmd.ui.Confirm(i18n.T("Really delete user %s?%s"),
 		terminal.EntityNameColor(username),
 		terminal.PromptColor(">"),
 	) {
 		return
 	}
 
-	cmd.ui.Say("Deleting user %s as %s...",
+	cmd.ui.Say(i18n.T("Deleting user %s as %s..."),
 		terminal.EntityNameColor(username),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -55,16 +56,16 @@ func (cmd DeleteUserFields) Run(c *cli.Context) {
 	case nil:
 	case errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn("User %s does not exist.", username)
+		cmd.ui.Warn(i18n.T("User %s does not exist."), username)
 		return
 	default:
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	apiErr = cmd.userRepo.Delete(user.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"bytes"
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"encoding/csv"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type ExportUsers struct {
+	ui       terminal.UI
+	config   configuration.Reader
+	userRepo api.UserRepository
+	orgReq   requirements.OrganizationRequirement
+}
+
+func NewExportUsers(ui terminal.UI, config configuration.Reader, userRepo api.UserRepository) (cmd *ExportUsers) {
+	cmd = new(ExportUsers)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.userRepo = userRepo
+	return
+}
+
+func (cmd *ExportUsers) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 || c.String("org") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "export-users")
+		return
+	}
+
+	cmd.orgReq = reqFactory.NewOrganizationRequirement(c.String("org"))
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		cmd.orgReq,
+	}
+	return
+}
+
+// only the CSV is printed so the output can be redirected to a file
+// and imported again with import-users
+func (cmd *ExportUsers) Run(c *cli.Context) {
+	org := cmd.orgReq.GetOrganization()
+
+	rows, apiErr := listUserRowsInOrg(cmd.userRepo, org)
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching users in org %s.\n%s"), org.Name, apiErr.Error())
+		return
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	writer.Write(usersCSVHeader)
+	for _, row := range rows {
+		writer.Write(row.record())
+	}
+	writer.Flush()
+
+	cmd.ui.Say("%s", strings.TrimSuffix(buffer.String(), "\n"))
+}
@@ -0,0 +1,78 @@
+package user_test
+
+import (
+	. "cf/commands/user"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("export-users command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+		userRepo   *testapi.FakeUserRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+
+		org := models.Organization{}
+		org.Guid = "my-org-guid"
+		org.Name = "my-org"
+		org.Spaces = []models.SpaceFields{{Guid: "dev-guid", Name: "development"}}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true, Organization: org}
+
+		alice := models.UserFields{Guid: "alice-guid", Username: "alice"}
+		bob := models.UserFields{Guid: "bob-guid", Username: "bob"}
+		carol := models.UserFields{Guid: "carol-guid", Username: "carol"}
+
+		userRepo = &testapi.FakeUserRepository{
+			ListUsersByGuidAndRole: map[string]map[string][]models.UserFields{
+				"my-org-guid": {
+					models.ORG_MANAGER: {alice},
+					models.ORG_USER:    {alice, bob, carol},
+				},
+				"dev-guid": {
+					models.SPACE_DEVELOPER: {bob, alice},
+				},
+			},
+		}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewExportUsers(ui, testconfig.NewRepositoryWithDefaults(), userRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("export-users", args), reqFactory)
+	}
+
+	It("fails with usage when not given an org", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand("--org", "my-org")
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("writes the org and space roles in the import-users format", func() {
+		runCommand("--org", "my-org")
+
+		Expect(reqFactory.OrganizationName).To(Equal("my-org"))
+		Expect(ui.Outputs).To(Equal([]string{
+			"username,password,org,org_role,space,space_role",
+			"alice,,my-org,OrgUser,,",
+			"alice,,my-org,OrgManager,,",
+			"alice,,my-org,,development,SpaceDeveloper",
+			"bob,,my-org,OrgUser,,",
+			"bob,,my-org,,development,SpaceDeveloper",
+			"carol,,my-org,OrgUser,,",
+		}))
+	})
+})
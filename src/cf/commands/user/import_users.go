@@ -0,0 +1,215 @@
+package user
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
+	"cf/models"
+	"cf/net"
+	"cf/requirements"
+	"cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strconv"
+	"strings"
+)
+
+type ImportUsers struct {
+	ui       terminal.UI
+	config   configuration.Reader
+	userRepo api.UserRepository
+	orgRepo  api.OrganizationRepository
+
+	orgsByName map[string]models.Organization
+}
+
+func NewImportUsers(ui terminal.UI, config configuration.Reader, userRepo api.UserRepository, orgRepo api.OrganizationRepository) (cmd *ImportUsers) {
+	cmd = new(ImportUsers)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.userRepo = userRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *ImportUsers) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "import-users")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ImportUsers) Run(c *cli.Context) {
+	path := c.Args()[0]
+	dryRun := c.Bool("dry-run") || net.IsDryRunEnabled()
+	cmd.orgsByName = map[string]models.Organization{}
+
+	if dryRun {
+		cmd.ui.Say(i18n.T("Checking users in %s as %s (dry run, no changes will be made)..."),
+			terminal.EntityNameColor(path),
+			terminal.EntityNameColor(cmd.config.Username()),
+		)
+	} else {
+		cmd.ui.Say(i18n.T("Importing users from %s as %s..."),
+			terminal.EntityNameColor(path),
+			terminal.EntityNameColor(cmd.config.Username()),
+		)
+	}
+
+	rows, err := readUsersCSV(path)
+	if err != nil {
+		cmd.ui.Failed(i18n.T("Could not read %s\n%s"), path, err.Error())
+		return
+	}
+
+	if len(rows) == 0 {
+		cmd.ui.Failed(i18n.T("No users found in %s"), path)
+		return
+	}
+
+	failed := 0
+	results := [][]string{}
+	for _, row := range rows {
+		actions, err := cmd.importRow(row, dryRun)
+		result := strings.Join(actions, ", ")
+		if err != nil {
+			failed++
+			result = terminal.FailureColor(i18n.T("failed: %s", err.Error()))
+			if len(actions) > 0 {
+				result = fmt.Sprintf("%s, %s", strings.Join(actions, ", "), result)
+			}
+		}
+		results = append(results, []string{strconv.Itoa(row.line), row.username, result})
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Table([]string{i18n.T("line"), i18n.T("username"), i18n.T("result")}).Print(results)
+	cmd.ui.Say("")
+
+	if failed > 0 {
+		cmd.ui.Failed(i18n.T("%d of %d rows could not be imported"), failed, len(rows))
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+func (cmd *ImportUsers) importRow(row userRow, dryRun bool) (actions []string, err error) {
+	err = row.validate()
+	if err != nil {
+		return
+	}
+
+	var org models.Organization
+	var space models.SpaceFields
+	if row.org != "" {
+		org, err = cmd.findOrg(row.org)
+		if err != nil {
+			return
+		}
+	}
+	if row.space != "" {
+		space, err = findSpaceInOrg(org, row.space)
+		if err != nil {
+			return
+		}
+	}
+
+	user, actions, err := cmd.createUser(row, dryRun)
+	if err != nil {
+		return
+	}
+
+	assigned := i18n.T("assigned %s in %s")
+	assignedInSpace := i18n.T("assigned %s in %s / %s")
+	if dryRun {
+		assigned = i18n.T("would assign %s in %s")
+		assignedInSpace = i18n.T("would assign %s in %s / %s")
+	}
+
+	if row.orgRole != "" {
+		if !dryRun {
+			err = cmd.userRepo.SetOrgRole(user.Guid, org.Guid, csvOrgRoles[row.orgRole])
+			if err != nil {
+				return
+			}
+		}
+		actions = append(actions, fmt.Sprintf(assigned, row.orgRole, org.Name))
+	}
+
+	if row.spaceRole != "" {
+		if !dryRun {
+			err = cmd.userRepo.SetSpaceRole(user.Guid, space.Guid, org.Guid, models.UserInputToSpaceRole[row.spaceRole])
+			if err != nil {
+				return
+			}
+		}
+		actions = append(actions, fmt.Sprintf(assignedInSpace, row.spaceRole, org.Name, space.Name))
+	}
+	return
+}
+
+// rows without a password refer to users that must already exist
+func (cmd *ImportUsers) createUser(row userRow, dryRun bool) (user models.UserFields, actions []string, err error) {
+	if dryRun || row.password == "" {
+		user, err = cmd.userRepo.FindByUsername(row.username)
+		switch err.(type) {
+		case nil:
+			actions = append(actions, i18n.T("user exists"))
+		case errors.ModelNotFoundError:
+			if row.password == "" {
+				err = errors.New(i18n.T("user does not exist and no password was given"))
+				return
+			}
+			err = nil
+			actions = append(actions, i18n.T("would create user"))
+		}
+		return
+	}
+
+	err = cmd.userRepo.Create(row.username, row.password)
+	if httpErr, ok := err.(errors.HttpError); ok && httpErr.ErrorCode() == errors.USER_EXISTS {
+		err = nil
+		actions = append(actions, i18n.T("user exists"))
+	} else if err != nil {
+		return
+	} else {
+		actions = append(actions, i18n.T("created user"))
+	}
+
+	user, err = cmd.userRepo.FindByUsername(row.username)
+	return
+}
+
+func (cmd *ImportUsers) findOrg(name string) (org models.Organization, err error) {
+	org, found := cmd.orgsByName[name]
+	if found {
+		return
+	}
+
+	org, err = cmd.orgRepo.FindByName(name)
+	if err != nil {
+		return
+	}
+
+	cmd.orgsByName[name] = org
+	return
+}
+
+func findSpaceInOrg(org models.Organization, name string) (space models.SpaceFields, err error) {
+	for _, space = range org.Spaces {
+		if strings.EqualFold(space.Name, name) {
+			return
+		}
+	}
+
+	err = errors.NewModelNotFoundError("Space", name)
+	return
+}
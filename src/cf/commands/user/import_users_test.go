@@ -0,0 +1,165 @@
+package user_test
+
+import (
+	. "cf/commands/user"
+	"cf/models"
+	"cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("import-users command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+		userRepo   *testapi.FakeUserRepository
+		orgRepo    *testapi.FakeOrgRepository
+		csvPath    string
+	)
+
+	writeCSV := func(contents string) {
+		file, err := ioutil.TempFile("", "users-csv")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.WriteString(contents)
+		Expect(err).NotTo(HaveOccurred())
+		file.Close()
+		csvPath = file.Name()
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+
+		org := models.Organization{}
+		org.Guid = "my-org-guid"
+		org.Name = "my-org"
+		org.Spaces = []models.SpaceFields{
+			{Guid: "dev-guid", Name: "development"},
+			{Guid: "prod-guid", Name: "production"},
+		}
+		orgRepo = &testapi.FakeOrgRepository{Organizations: []models.Organization{org}}
+
+		userRepo = &testapi.FakeUserRepository{
+			UsersByUsername: map[string]models.UserFields{
+				"alice": {Guid: "alice-guid", Username: "alice"},
+				"bob":   {Guid: "bob-guid", Username: "bob"},
+			},
+			ExistingUsernames: []string{"alice"},
+		}
+
+		writeCSV("username,password,org,org_role,space,space_role\n" +
+			"alice,secret,my-org,OrgManager,,\n" +
+			"bob,secret,my-org,,development,SpaceDeveloper\n" +
+			"bob,,my-org,,production,SpaceAuditor\n")
+	})
+
+	AfterEach(func() {
+		os.Remove(csvPath)
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewImportUsers(ui, testconfig.NewRepositoryWithDefaults(), userRepo, orgRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("import-users", args), reqFactory)
+	}
+
+	It("fails with usage when not given a file", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("requires the user to be logged in", func() {
+		reqFactory.LoginSuccess = false
+		runCommand(csvPath)
+		Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+	})
+
+	It("creates the users and assigns their roles", func() {
+		runCommand(csvPath)
+
+		Expect(userRepo.CreatedUsernames).To(Equal([]string{"bob"}))
+		Expect(userRepo.SetOrgRoles).To(Equal([]string{"alice-guid/my-org-guid/" + models.ORG_MANAGER}))
+		Expect(userRepo.SetSpaceRoles).To(Equal([]string{
+			"bob-guid/dev-guid/" + models.SPACE_DEVELOPER,
+			"bob-guid/prod-guid/" + models.SPACE_AUDITOR,
+		}))
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Importing users from", csvPath, "my-user"},
+			{"line", "username", "result"},
+			{"2", "alice", "user exists", "assigned OrgManager in my-org"},
+			{"3", "bob", "created user", "assigned SpaceDeveloper in my-org / development"},
+			{"4", "bob", "user exists", "assigned SpaceAuditor in my-org / production"},
+			{"OK"},
+		})
+	})
+
+	It("does not change anything in a dry run", func() {
+		delete(userRepo.UsersByUsername, "bob")
+		writeCSV("bob,secret,my-org,OrgAuditor,development,SpaceDeveloper\n")
+
+		runCommand("--dry-run", csvPath)
+
+		Expect(userRepo.CreatedUsernames).To(BeEmpty())
+		Expect(userRepo.SetOrgRoles).To(BeEmpty())
+		Expect(userRepo.SetSpaceRoles).To(BeEmpty())
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"dry run"},
+			{"1", "bob", "would create user", "would assign OrgAuditor in my-org", "would assign SpaceDeveloper in my-org / development"},
+			{"OK"},
+		})
+	})
+
+	It("does a dry run when the global --dry-run is set", func() {
+		delete(userRepo.UsersByUsername, "bob")
+		writeCSV("bob,secret,my-org,OrgAuditor,development,SpaceDeveloper\n")
+
+		net.EnableDryRun(ioutil.Discard)
+		defer net.DisableDryRun()
+		runCommand(csvPath)
+
+		Expect(userRepo.CreatedUsernames).To(BeEmpty())
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"dry run"},
+			{"1", "bob", "would create user"},
+		})
+	})
+
+	It("reports the rows that could not be imported", func() {
+		writeCSV("carol,,my-org,OrgManager,,\n" +
+			"alice,,my-org,,staging,SpaceDeveloper\n" +
+			"alice,,my-org,Owner,,\n" +
+			"alice,,other-org,OrgManager,,\n" +
+			"alice,,my-org,OrgAuditor,,\n")
+
+		runCommand(csvPath)
+
+		Expect(userRepo.SetOrgRoles).To(Equal([]string{"alice-guid/my-org-guid/" + models.ORG_AUDITOR}))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"1", "carol", "failed", "no password was given"},
+			{"2", "alice", "failed", "staging not found"},
+			{"3", "alice", "failed", "invalid org role Owner"},
+			{"4", "alice", "failed"},
+			{"5", "alice", "assigned OrgAuditor in my-org"},
+			{"FAILED"},
+			{"4 of 5 rows could not be imported"},
+		})
+	})
+
+	It("fails when the file cannot be read", func() {
+		runCommand("/does/not/exist.csv")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Could not read", "/does/not/exist.csv"},
+		})
+	})
+})
@@ -3,6 +3,7 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -12,11 +13,16 @@ import (
 
 var orgRoles = []string{models.ORG_MANAGER, models.BILLING_MANAGER, models.ORG_AUDITOR}
 
-var orgRoleToDisplayName = map[string]string{
-	models.ORG_USER:        "USERS",
-	models.ORG_MANAGER:     "ORG MANAGER",
-	models.BILLING_MANAGER: "BILLING MANAGER",
-	models.ORG_AUDITOR:     "ORG AUDITOR",
+func orgRoleDisplayName(role string) string {
+	switch role {
+	case models.ORG_MANAGER:
+		return i18n.T("ORG MANAGER")
+	case models.BILLING_MANAGER:
+		return i18n.T("BILLING MANAGER")
+	case models.ORG_AUDITOR:
+		return i18n.T("ORG AUDITOR")
+	}
+	return i18n.T("USERS")
 }
 
 type OrgUsers struct {
@@ -52,7 +58,7 @@ func (cmd *OrgUsers) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganization()
 	all := c.Bool("a")
 
-	cmd.ui.Say("Getting users in org %s as %s...",
+	cmd.ui.Say(i18n.T("Getting users in org %s as %s..."),
 		terminal.EntityNameColor(org.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
@@ -63,7 +69,7 @@ func (cmd *OrgUsers) Run(c *cli.Context) {
 	}
 
 	for _, role := range roles {
-		displayName := orgRoleToDisplayName[role]
+		displayName := orgRoleDisplayName(role)
 
 		users, apiErr := cmd.userRepo.ListUsersInOrgForRole(org.Guid, role)
 
@@ -75,7 +81,7 @@ func (cmd *OrgUsers) Run(c *cli.Context) {
 		}
 
 		if apiErr != nil {
-			cmd.ui.Failed("Failed fetching org-users for role %s.\n%s", apiErr.Error(), displayName)
+			cmd.ui.Failed(i18n.T("Failed fetching org-users for role %s.\n%s"), apiErr.Error(), displayName)
 			return
 		}
 	}
@@ -3,6 +3,7 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -50,7 +51,7 @@ func (cmd *SetOrgRole) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganization()
 	role := models.UserInputToOrgRole[c.Args()[2]]
 
-	cmd.ui.Say("Assigning role %s to user %s in org %s as %s...",
+	cmd.ui.Say(i18n.T("Assigning role %s to user %s in org %s as %s..."),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(user.Username),
 		terminal.EntityNameColor(org.Name),
@@ -59,7 +60,7 @@ func (cmd *SetOrgRole) Run(c *cli.Context) {
 
 	apiErr := cmd.userRepo.SetOrgRole(user.Guid, org.Guid, role)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -3,6 +3,7 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -58,19 +59,19 @@ func (cmd *SetSpaceRole) Run(c *cli.Context) {
 
 	space, apiErr := cmd.spaceRepo.FindByNameInOrg(spaceName, org.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
 	err := cmd.SetSpaceRole(space, role, user.Guid, user.Username)
 	if err != nil {
-		cmd.ui.Failed(err.Error())
+		cmd.ui.FailWithError(err)
 		return
 	}
 }
 
 func (cmd *SetSpaceRole) SetSpaceRole(space models.Space, role, userGuid, userName string) (err error) {
-	cmd.ui.Say("Assigning role %s to user %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Assigning role %s to user %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(userName),
 		terminal.EntityNameColor(space.Organization.Name),
@@ -3,6 +3,7 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -12,10 +13,14 @@ import (
 
 var spaceRoles = []string{models.SPACE_MANAGER, models.SPACE_DEVELOPER, models.SPACE_AUDITOR}
 
-var spaceRoleToDisplayName = map[string]string{
-	models.SPACE_MANAGER:   "SPACE MANAGER",
-	models.SPACE_DEVELOPER: "SPACE DEVELOPER",
-	models.SPACE_AUDITOR:   "SPACE AUDITOR",
+func spaceRoleDisplayName(role string) string {
+	switch role {
+	case models.SPACE_MANAGER:
+		return i18n.T("SPACE MANAGER")
+	case models.SPACE_DEVELOPER:
+		return i18n.T("SPACE DEVELOPER")
+	}
+	return i18n.T("SPACE AUDITOR")
 }
 
 type SpaceUsers struct {
@@ -55,17 +60,17 @@ func (cmd *SpaceUsers) Run(c *cli.Context) {
 
 	space, apiErr := cmd.spaceRepo.FindByNameInOrg(spaceName, org.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 	}
 
-	cmd.ui.Say("Getting users in org %s / space %s as %s",
+	cmd.ui.Say(i18n.T("Getting users in org %s / space %s as %s"),
 		terminal.EntityNameColor(org.Name),
 		terminal.EntityNameColor(space.Name),
 		terminal.EntityNameColor(cmd.config.Username()),
 	)
 
 	for _, role := range spaceRoles {
-		displayName := spaceRoleToDisplayName[role]
+		displayName := spaceRoleDisplayName(role)
 
 		users, apiErr := cmd.userRepo.ListUsersInSpaceForRole(space.Guid, role)
 
@@ -77,7 +82,7 @@ func (cmd *SpaceUsers) Run(c *cli.Context) {
 		}
 
 		if apiErr != nil {
-			cmd.ui.Failed("Failed fetching space-users for role %s.\n%s", apiErr.Error(), displayName)
+			cmd.ui.Failed(i18n.T("Failed fetching space-users for role %s.\n%s"), apiErr.Error(), displayName)
 			return
 		}
 	}
@@ -3,6 +3,7 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -51,7 +52,7 @@ func (cmd *UnsetOrgRole) Run(c *cli.Context) {
 	user := cmd.userReq.GetUser()
 	org := cmd.orgReq.GetOrganization()
 
-	cmd.ui.Say("Removing role %s from user %s in org %s as %s...",
+	cmd.ui.Say(i18n.T("Removing role %s from user %s in org %s as %s..."),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(c.Args()[0]),
 		terminal.EntityNameColor(c.Args()[1]),
@@ -61,7 +62,7 @@ func (cmd *UnsetOrgRole) Run(c *cli.Context) {
 	apiErr := cmd.userRepo.UnsetOrgRole(user.Guid, org.Guid, role)
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
@@ -3,6 +3,7 @@ package user
 import (
 	"cf/api"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/requirements"
 	"cf/terminal"
@@ -55,11 +56,11 @@ func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
 	org := cmd.orgReq.GetOrganization()
 	space, apiErr := cmd.spaceRepo.FindByNameInOrg(spaceName, org.Guid)
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
-	cmd.ui.Say("Removing role %s from user %s in org %s / space %s as %s...",
+	cmd.ui.Say(i18n.T("Removing role %s from user %s in org %s / space %s as %s..."),
 		terminal.EntityNameColor(role),
 		terminal.EntityNameColor(user.Username),
 		terminal.EntityNameColor(org.Name),
@@ -70,7 +71,7 @@ func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
 	apiErr = cmd.userRepo.UnsetSpaceRole(user.Guid, space.Guid, role)
 
 	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+		cmd.ui.FailWithError(apiErr)
 		return
 	}
 
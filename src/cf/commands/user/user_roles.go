@@ -0,0 +1,170 @@
+package user
+
+import (
+	"bytes"
+	"cf/api"
+	"cf/configuration"
+	"cf/i18n"
+	"cf/models"
+	"cf/requirements"
+	"cf/terminal"
+	"encoding/csv"
+	"errors"
+	"github.com/codegangsta/cli"
+	"sort"
+	"strings"
+)
+
+var userRolesCSVHeader = []string{"username", "org", "space", "role"}
+
+var roleOrder = []string{
+	models.ORG_USER, models.ORG_MANAGER, models.BILLING_MANAGER, models.ORG_AUDITOR,
+	models.SPACE_MANAGER, models.SPACE_DEVELOPER, models.SPACE_AUDITOR,
+}
+
+type UserRoles struct {
+	ui       terminal.UI
+	config   configuration.Reader
+	userRepo api.UserRepository
+	orgRepo  api.OrganizationRepository
+	userReq  requirements.UserRequirement
+}
+
+func NewUserRoles(ui terminal.UI, config configuration.Reader, userRepo api.UserRepository, orgRepo api.OrganizationRepository) (cmd *UserRoles) {
+	cmd = new(UserRoles)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.userRepo = userRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *UserRoles) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if (c.Bool("all-users") && len(c.Args()) != 0) || (!c.Bool("all-users") && len(c.Args()) != 1) {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "user-roles")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+
+	if !c.Bool("all-users") {
+		cmd.userReq = reqFactory.NewUserRequirement(c.Args()[0])
+		reqs = append(reqs, cmd.userReq)
+	}
+	return
+}
+
+func (cmd *UserRoles) Run(c *cli.Context) {
+	if c.Bool("all-users") {
+		cmd.exportAllUsers()
+		return
+	}
+
+	user := cmd.userReq.GetUser()
+
+	cmd.ui.Say(i18n.T("Getting roles of user %s as %s..."),
+		terminal.EntityNameColor(user.Username),
+		terminal.EntityNameColor(cmd.config.Username()),
+	)
+
+	roles, apiErr := cmd.userRepo.ListRolesForUser(user.Guid)
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching roles.\n%s"), apiErr.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(roles) == 0 {
+		cmd.ui.Say(i18n.T("User %s has no org or space roles"), user.Username)
+		return
+	}
+
+	sort.Sort(userRolesByOrgAndSpace(roles))
+
+	table := cmd.ui.Table([]string{i18n.T("org"), i18n.T("space"), i18n.T("role")})
+	rows := [][]string{}
+	for _, role := range roles {
+		rows = append(rows, []string{role.Organization.Name, role.Space.Name, role.Role})
+	}
+	table.Print(rows)
+}
+
+// only the CSV is printed so the output can be redirected to a file
+func (cmd *UserRoles) exportAllUsers() {
+	orgNames := []string{}
+	apiErr := cmd.orgRepo.ListOrgs(func(org models.Organization) bool {
+		orgNames = append(orgNames, org.Name)
+		return true
+	})
+	if apiErr != nil {
+		cmd.ui.Failed(i18n.T("Failed fetching orgs.\n%s"), apiErr.Error())
+		return
+	}
+
+	rows := []userRow{}
+	for _, orgName := range orgNames {
+		org, apiErr := cmd.orgRepo.FindByName(orgName)
+		if apiErr != nil {
+			cmd.ui.Failed(i18n.T("Failed fetching org %s.\n%s"), orgName, apiErr.Error())
+			return
+		}
+
+		orgRows, apiErr := listUserRowsInOrg(cmd.userRepo, org)
+		if apiErr != nil {
+			cmd.ui.Failed(i18n.T("Failed fetching users in org %s.\n%s"), orgName, apiErr.Error())
+			return
+		}
+		rows = append(rows, orgRows...)
+	}
+
+	sort.Stable(userRowsByName(rows))
+
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+	writer.Write(userRolesCSVHeader)
+	for _, row := range rows {
+		role := row.orgRole
+		if row.space != "" {
+			role = row.spaceRole
+		}
+		writer.Write([]string{row.username, row.org, row.space, role})
+	}
+	writer.Flush()
+
+	cmd.ui.Say("%s", strings.TrimSuffix(buffer.String(), "\n"))
+}
+
+type userRolesByOrgAndSpace []models.UserRole
+
+func (roles userRolesByOrgAndSpace) Len() int {
+	return len(roles)
+}
+
+func (roles userRolesByOrgAndSpace) Less(i, j int) bool {
+	a, b := roles[i], roles[j]
+	if a.Organization.Name != b.Organization.Name {
+		return a.Organization.Name < b.Organization.Name
+	}
+	if a.Space.Name != b.Space.Name {
+		return a.Space.Name < b.Space.Name
+	}
+	return roleIndex(a.Role) < roleIndex(b.Role)
+}
+
+func (roles userRolesByOrgAndSpace) Swap(i, j int) {
+	roles[i], roles[j] = roles[j], roles[i]
+}
+
+func roleIndex(role string) int {
+	for index, knownRole := range roleOrder {
+		if role == knownRole {
+			return index
+		}
+	}
+	return len(roleOrder)
+}
@@ -0,0 +1,131 @@
+package user_test
+
+import (
+	. "cf/commands/user"
+	"cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testapi "testhelpers/api"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+)
+
+var _ = Describe("user-roles command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+		userRepo   *testapi.FakeUserRepository
+		orgRepo    *testapi.FakeOrgRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{
+			LoginSuccess: true,
+			UserFields:   models.UserFields{Guid: "alice-guid", Username: "alice"},
+		}
+		userRepo = &testapi.FakeUserRepository{}
+		orgRepo = &testapi.FakeOrgRepository{}
+	})
+
+	runCommand := func(args ...string) {
+		cmd := NewUserRoles(ui, testconfig.NewRepositoryWithDefaults(), userRepo, orgRepo)
+		testcmd.RunCommand(cmd, testcmd.NewContext("user-roles", args), reqFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given a username", func() {
+			runCommand()
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails with usage when given a username and --all-users", func() {
+			runCommand("--all-users", "alice")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("requires the user to be logged in", func() {
+			reqFactory.LoginSuccess = false
+			runCommand("alice")
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+	})
+
+	It("lists the roles of the user sorted by org and space", func() {
+		org1 := models.OrganizationFields{Guid: "org-1-guid", Name: "org-1"}
+		org2 := models.OrganizationFields{Guid: "org-2-guid", Name: "org-2"}
+		userRepo.ListRolesRoles = []models.UserRole{
+			{Organization: org2, Role: models.ORG_AUDITOR},
+			{Organization: org1, Space: models.SpaceFields{Name: "space-1"}, Role: models.SPACE_DEVELOPER},
+			{Organization: org1, Role: models.ORG_MANAGER},
+			{Organization: org1, Role: models.ORG_USER},
+		}
+
+		runCommand("alice")
+
+		Expect(reqFactory.UserUsername).To(Equal("alice"))
+		Expect(userRepo.ListRolesUserGuid).To(Equal("alice-guid"))
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"Getting roles of user", "alice", "my-user"},
+			{"OK"},
+			{"org", "space", "role"},
+			{"org-1", "OrgUser"},
+			{"org-1", "OrgManager"},
+			{"org-1", "space-1", "SpaceDeveloper"},
+			{"org-2", "OrgAuditor"},
+		})
+	})
+
+	It("says so when the user has no roles", func() {
+		runCommand("alice")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"User alice has no org or space roles"},
+		})
+	})
+
+	It("fails when the roles cannot be fetched", func() {
+		userRepo.ListRolesErr = true
+		runCommand("alice")
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"FAILED"},
+			{"Failed fetching roles"},
+		})
+	})
+
+	It("exports the roles of every user in every org as CSV", func() {
+		org1 := models.Organization{}
+		org1.Guid = "org-1-guid"
+		org1.Name = "org-1"
+		org1.Spaces = []models.SpaceFields{{Guid: "space-1-guid", Name: "space-1"}}
+
+		org2 := models.Organization{}
+		org2.Guid = "org-2-guid"
+		org2.Name = "org-2"
+		orgRepo.Organizations = []models.Organization{org1, org2}
+
+		alice := models.UserFields{Guid: "alice-guid", Username: "alice"}
+		bob := models.UserFields{Guid: "bob-guid", Username: "bob"}
+		userRepo.ListUsersByGuidAndRole = map[string]map[string][]models.UserFields{
+			"org-1-guid":   {models.ORG_MANAGER: {bob}, models.ORG_USER: {alice, bob}},
+			"space-1-guid": {models.SPACE_DEVELOPER: {alice}},
+			"org-2-guid":   {models.ORG_AUDITOR: {alice}, models.ORG_USER: {alice}},
+		}
+
+		runCommand("--all-users")
+
+		Expect(ui.Outputs).To(Equal([]string{
+			"username,org,space,role",
+			"alice,org-1,,OrgUser",
+			"alice,org-1,space-1,SpaceDeveloper",
+			"alice,org-2,,OrgUser",
+			"alice,org-2,,OrgAuditor",
+			"bob,org-1,,OrgUser",
+			"bob,org-1,,OrgManager",
+		}))
+	})
+})
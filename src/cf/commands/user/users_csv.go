@@ -0,0 +1,151 @@
+package user
+
+import (
+	"cf/api"
+	"cf/i18n"
+	"cf/models"
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+var usersCSVHeader = []string{"username", "password", "org", "org_role", "space", "space_role"}
+
+// users who only belong to an org are exported with the OrgUser role,
+// so the file can also be used to add plain org members
+var csvOrgRoles = map[string]string{
+	"OrgUser":        models.ORG_USER,
+	"OrgManager":     models.ORG_MANAGER,
+	"BillingManager": models.BILLING_MANAGER,
+	"OrgAuditor":     models.ORG_AUDITOR,
+}
+
+type userRow struct {
+	line      int
+	username  string
+	password  string
+	org       string
+	orgRole   string
+	space     string
+	spaceRole string
+}
+
+func (row userRow) validate() error {
+	switch {
+	case row.username == "":
+		return errors.New(i18n.T("username is missing"))
+	case row.org == "" && (row.orgRole != "" || row.space != ""):
+		return errors.New(i18n.T("org is missing"))
+	case row.orgRole != "" && csvOrgRoles[row.orgRole] == "":
+		return errors.New(i18n.T("invalid org role %s", row.orgRole))
+	case row.space != "" && row.spaceRole == "":
+		return errors.New(i18n.T("space role is missing"))
+	case row.space == "" && row.spaceRole != "":
+		return errors.New(i18n.T("space is missing"))
+	case row.spaceRole != "" && models.UserInputToSpaceRole[row.spaceRole] == "":
+		return errors.New(i18n.T("invalid space role %s", row.spaceRole))
+	}
+	return nil
+}
+
+func (row userRow) record() []string {
+	return []string{row.username, row.password, row.org, row.orgRole, row.space, row.spaceRole}
+}
+
+func readUsersCSV(path string) (rows []userRow, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	for line := 1; ; line++ {
+		var record []string
+		record, err = reader.Read()
+		if err == io.EOF {
+			err = nil
+			return
+		}
+		if err != nil {
+			return
+		}
+
+		if line == 1 && strings.ToLower(record[0]) == usersCSVHeader[0] {
+			continue
+		}
+
+		if len(record) > len(usersCSVHeader) {
+			err = errors.New(i18n.T("line %d has %d columns, expected at most %d", line, len(record), len(usersCSVHeader)))
+			return
+		}
+
+		for len(record) < len(usersCSVHeader) {
+			record = append(record, "")
+		}
+
+		rows = append(rows, userRow{
+			line:      line,
+			username:  strings.TrimSpace(record[0]),
+			password:  record[1],
+			org:       strings.TrimSpace(record[2]),
+			orgRole:   strings.TrimSpace(record[3]),
+			space:     strings.TrimSpace(record[4]),
+			spaceRole: strings.TrimSpace(record[5]),
+		})
+	}
+}
+
+// lists every role in the org and its spaces, sorted by username; org
+// membership is a role of its own, which the other org roles don't grant,
+// so every member gets an OrgUser row
+func listUserRowsInOrg(userRepo api.UserRepository, org models.Organization) (rows []userRow, apiErr error) {
+	for _, roleName := range []string{"OrgUser", "OrgManager", "BillingManager", "OrgAuditor"} {
+		var users []models.UserFields
+		users, apiErr = userRepo.ListUsersInOrgForRole(org.Guid, csvOrgRoles[roleName])
+		if apiErr != nil {
+			return
+		}
+
+		for _, user := range users {
+			rows = append(rows, userRow{username: user.Username, org: org.Name, orgRole: roleName})
+		}
+	}
+
+	for _, space := range org.Spaces {
+		for _, roleName := range []string{"SpaceManager", "SpaceDeveloper", "SpaceAuditor"} {
+			var users []models.UserFields
+			users, apiErr = userRepo.ListUsersInSpaceForRole(space.Guid, models.UserInputToSpaceRole[roleName])
+			if apiErr != nil {
+				return
+			}
+
+			for _, user := range users {
+				rows = append(rows, userRow{username: user.Username, org: org.Name, space: space.Name, spaceRole: roleName})
+			}
+		}
+	}
+
+	sort.Stable(userRowsByName(rows))
+	return
+}
+
+type userRowsByName []userRow
+
+func (rows userRowsByName) Len() int {
+	return len(rows)
+}
+
+func (rows userRowsByName) Less(i, j int) bool {
+	return rows[i].username < rows[j].username
+}
+
+func (rows userRowsByName) Swap(i, j int) {
+	rows[i], rows[j] = rows[j], rows[i]
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"cf/configuration"
+	"cf/i18n"
+	"cf/requirements"
+	"cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strings"
+	"time"
+)
+
+type Whoami struct {
+	ui     terminal.UI
+	config configuration.Reader
+}
+
+func NewWhoami(ui terminal.UI, config configuration.Reader) (cmd *Whoami) {
+	cmd = new(Whoami)
+	cmd.ui = ui
+	cmd.config = config
+	return
+}
+
+func (cmd *Whoami) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "whoami")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+// everything shown comes from the access token, so it is what the API sees
+func (cmd *Whoami) Run(c *cli.Context) {
+	info := configuration.NewTokenInfo(cmd.config.AccessToken())
+
+	cmd.ui.Say(i18n.T("user:    %s"), terminal.EntityNameColor(cmd.config.Username()))
+	if info.Email != "" {
+		cmd.ui.Say(i18n.T("email:   %s"), terminal.EntityNameColor(info.Email))
+	}
+	if info.UserGuid != "" {
+		cmd.ui.Say(i18n.T("id:      %s"), terminal.EntityNameColor(info.UserGuid))
+	}
+	if info.ClientId != "" {
+		cmd.ui.Say(i18n.T("client:  %s"), terminal.EntityNameColor(info.ClientId))
+	}
+	cmd.ui.Say(i18n.T("scopes:  %s"), terminal.EntityNameColor(strings.Join(info.Scopes, ", ")))
+	cmd.ui.Say(i18n.T("expires: %s"), tokenExpiry(info.ExpiresAt(), time.Now()))
+}
+
+func tokenExpiry(expiresAt time.Time, now time.Time) string {
+	if expiresAt.IsZero() {
+		return i18n.T("unknown")
+	}
+
+	formatted := expiresAt.Local().Format(time.RFC3339)
+	if !expiresAt.After(now) {
+		return terminal.FailureColor(i18n.T("%s (expired, it will be refreshed on the next request)", formatted))
+	}
+	return terminal.EntityNameColor(i18n.T("%s (in %s)", formatted, expiresAt.Sub(now)/time.Second*time.Second))
+}
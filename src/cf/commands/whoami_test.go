@@ -0,0 +1,83 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"cf/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	testassert "testhelpers/assert"
+	testcmd "testhelpers/commands"
+	testconfig "testhelpers/configuration"
+	testreq "testhelpers/requirements"
+	testterm "testhelpers/terminal"
+	"time"
+)
+
+var _ = Describe("whoami command", func() {
+	var (
+		ui         *testterm.FakeUI
+		reqFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		reqFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+	})
+
+	runWhoami := func(tokenInfo configuration.TokenInfo, args ...string) {
+		config := testconfig.NewRepositoryWithAccessToken(tokenInfo)
+		testcmd.RunCommand(NewWhoami(ui, config), testcmd.NewContext("whoami", args), reqFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when given arguments", func() {
+			runWhoami(configuration.TokenInfo{}, "extra")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails if the user is not logged in", func() {
+			reqFactory.LoginSuccess = false
+			runWhoami(configuration.TokenInfo{})
+			Expect(testcmd.CommandDidPassRequirements).To(BeFalse())
+		})
+	})
+
+	It("shows the user, email, scopes and when the token expires", func() {
+		runWhoami(configuration.TokenInfo{
+			Username: "my-user",
+			Email:    "my-user@example.com",
+			UserGuid: "my-user-guid",
+			Scopes:   []string{"cloud_controller.read", "openid"},
+			Expiry:   time.Now().Add(10 * time.Minute).Unix(),
+		})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"user:", "my-user"},
+			{"email:", "my-user@example.com"},
+			{"id:", "my-user-guid"},
+			{"scopes:", "cloud_controller.read, openid"},
+			{"expires:", "(in 9m"},
+		})
+	})
+
+	It("shows the client for client credentials tokens", func() {
+		runWhoami(configuration.TokenInfo{ClientId: "my-robot"})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"user:", "my-robot"},
+			{"client:", "my-robot"},
+			{"expires:", "unknown"},
+		})
+		testassert.SliceDoesNotContain(ui.Outputs, testassert.Lines{
+			{"email:"},
+		})
+	})
+
+	It("says when the token has already expired", func() {
+		runWhoami(configuration.TokenInfo{Username: "my-user", Expiry: time.Now().Add(-time.Minute).Unix()})
+
+		testassert.SliceContains(ui.Outputs, testassert.Lines{
+			{"expires:", "expired, it will be refreshed on the next request"},
+		})
+	})
+})
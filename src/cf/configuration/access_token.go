@@ -4,12 +4,24 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"strings"
+	"time"
 )
 
 type TokenInfo struct {
-	Username string `json:"user_name"`
-	Email    string `json:"email"`
-	UserGuid string `json:"user_id"`
+	Username string   `json:"user_name"`
+	Email    string   `json:"email"`
+	UserGuid string   `json:"user_id"`
+	ClientId string   `json:"client_id"`
+	Scopes   []string `json:"scope,omitempty"`
+	Expiry   int64    `json:"exp,omitempty"`
+}
+
+// ExpiresAt is the zero time for tokens that don't say when they expire
+func (info TokenInfo) ExpiresAt() time.Time {
+	if info.Expiry == 0 {
+		return time.Time{}
+	}
+	return time.Unix(info.Expiry, 0)
 }
 
 func NewTokenInfo(accessToken string) (info TokenInfo) {
@@ -41,8 +53,13 @@ func DecodeAccessToken(accessToken string) (tokenJson []byte, err error) {
 	return base64Decode(encodedTokenJson)
 }
 
-func base64Decode(encodedData string) ([]byte, error) {
-	return base64.StdEncoding.DecodeString(restorePadding(encodedData))
+// UAA encodes its tokens with the URL-safe alphabet
+func base64Decode(encodedData string) (data []byte, err error) {
+	data, err = base64.StdEncoding.DecodeString(restorePadding(encodedData))
+	if err != nil {
+		data, err = base64.URLEncoding.DecodeString(restorePadding(encodedData))
+	}
+	return
 }
 
 func restorePadding(seg string) string {
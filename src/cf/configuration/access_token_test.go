@@ -4,6 +4,7 @@ import (
 	. "cf/configuration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"time"
 )
 
 var _ = Describe("Testing with ginkgo", func() {
@@ -23,4 +24,24 @@ var _ = Describe("Testing with ginkgo", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(string(decodedInfo)).To(ContainSubstring("tlang@gopivotal.com"))
 	})
+
+	It("decodes the scopes and expiry of a token", func() {
+		accessToken := "bearer eyJhbGciOiJSUzI1NiJ9.eyJqdGkiOiJjNDE4OTllNS1kZTE1LTQ5NGQtYWFiNC04ZmNlYzUxN2UwMDUiLCJzdWIiOiI3NzJkZGEzZi02NjlmLTQyNzYtYjJiZC05MDQ4NmFiZTFmNmYiLCJzY29wZSI6WyJjbG91ZF9jb250cm9sbGVyLnJlYWQiLCJjbG91ZF9jb250cm9sbGVyLndyaXRlIiwib3BlbmlkIiwicGFzc3dvcmQud3JpdGUiXSwiY2xpZW50X2lkIjoiY2YiLCJjaWQiOiJjZiIsImdyYW50X3R5cGUiOiJwYXNzd29yZCIsInVzZXJfaWQiOiI3NzJkZGEzZi02NjlmLTQyNzYtYjJiZC05MDQ4NmFiZTFmNmYiLCJ1c2VyX25hbWUiOiJ1c2VyMUBleGFtcGxlLmNvbSIsImVtYWlsIjoidXNlcjFAZXhhbXBsZS5jb20iLCJpYXQiOjEzNzcwMjgzNTYsImV4cCI6MTM3NzAzNTU1NiwiaXNzIjoiaHR0cHM6Ly91YWEuYXJib3JnbGVuLmNmLWFwcC5jb20vb2F1dGgvdG9rZW4iLCJhdWQiOlsib3BlbmlkIiwiY2xvdWRfY29udHJvbGxlciIsInBhc3N3b3JkIl19.kjFJHi0Qir9kfqi2eyhHy6kdewhicAFu8hrPR1a5AxFvxGB45slKEjuP0_72cM_vEYICgZn3PcUUkHU9wghJO9wjZ6kiIKK1h5f2K9g-Iprv9BbTOWUODu1HoLIvg2TtGsINxcRYy_8LW1RtvQc1b4dBPoopaEH4no-BIzp0E5E"
+		info := NewTokenInfo(accessToken)
+
+		Expect(info.Scopes).To(Equal([]string{"cloud_controller.read", "cloud_controller.write", "openid", "password.write"}))
+		Expect(info.ExpiresAt()).To(Equal(time.Unix(1377035556, 0)))
+	})
+
+	It("decodes tokens encoded with the URL-safe alphabet", func() {
+		accessToken := "bearer eyJhbGciOiJSUzI1NiJ9.eyJ1c2VyX25hbWUiOiJ1c2VyMUBleGFtcGxlLmNvbSIsInNjb3BlIjpbIm9wZW5pZCJdLCJleHAiOjEzNzcwMzU1NTYsIngiOiI_Pz8-Pj4ifQ.signature"
+		info := NewTokenInfo(accessToken)
+
+		Expect(info.Username).To(Equal("user1@example.com"))
+		Expect(info.ExpiresAt()).To(Equal(time.Unix(1377035556, 0)))
+	})
+
+	It("has no expiry when the token does not say when it expires", func() {
+		Expect(NewTokenInfo("bearer not-a-jwt").ExpiresAt().IsZero()).To(BeTrue())
+	})
 })
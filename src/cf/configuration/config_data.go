@@ -25,9 +25,14 @@ type Data struct {
 	UaaEndpoint           string
 	AccessToken           string
 	RefreshToken          string
+	UAAOAuthClient        string
+	UAAOAuthClientSecret  string
 	OrganizationFields    models.OrganizationFields
 	SpaceFields           models.SpaceFields
 	SSLDisabled           bool
+	SSLCACert             string
+	SSLClientCert         string
+	SSLClientKey          string
 }
 
 func NewData() (data *Data) {
@@ -14,9 +14,14 @@ type configJsonV3 struct {
 	UaaEndpoint           string
 	AccessToken           string
 	RefreshToken          string
+	UAAOAuthClient        string
+	UAAOAuthClientSecret  string
 	OrganizationFields    models.OrganizationFields
 	SpaceFields           models.SpaceFields
 	SSLDisabled           bool
+	SSLCACert             string
+	SSLClientCert         string
+	SSLClientKey          string
 }
 
 func JsonMarshalV3(config *Data) (output []byte, err error) {
@@ -29,9 +34,14 @@ func JsonMarshalV3(config *Data) (output []byte, err error) {
 		UaaEndpoint:           config.UaaEndpoint,
 		AccessToken:           config.AccessToken,
 		RefreshToken:          config.RefreshToken,
+		UAAOAuthClient:        config.UAAOAuthClient,
+		UAAOAuthClientSecret:  config.UAAOAuthClientSecret,
 		OrganizationFields:    config.OrganizationFields,
 		SpaceFields:           config.SpaceFields,
 		SSLDisabled:           config.SSLDisabled,
+		SSLCACert:             config.SSLCACert,
+		SSLClientCert:         config.SSLClientCert,
+		SSLClientKey:          config.SSLClientKey,
 	})
 }
 
@@ -51,12 +61,17 @@ func JsonUnmarshalV3(input []byte, config *Data) (err error) {
 	config.ApiVersion = configJson.ApiVersion
 	config.AccessToken = configJson.AccessToken
 	config.RefreshToken = configJson.RefreshToken
+	config.UAAOAuthClient = configJson.UAAOAuthClient
+	config.UAAOAuthClientSecret = configJson.UAAOAuthClientSecret
 	config.SpaceFields = configJson.SpaceFields
 	config.OrganizationFields = configJson.OrganizationFields
 	config.LoggregatorEndPoint = configJson.LoggregatorEndpoint
 	config.AuthorizationEndpoint = configJson.AuthorizationEndpoint
 	config.UaaEndpoint = configJson.UaaEndpoint
 	config.SSLDisabled = configJson.SSLDisabled
+	config.SSLCACert = configJson.SSLCACert
+	config.SSLClientCert = configJson.SSLClientCert
+	config.SSLClientKey = configJson.SSLClientKey
 
 	return
 }
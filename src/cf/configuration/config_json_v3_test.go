@@ -18,20 +18,29 @@ var exampleJSON = `
 	"UaaEndpoint": "uaa.example.com",
 	"AccessToken": "the-access-token",
 	"RefreshToken": "the-refresh-token",
+	"UAAOAuthClient": "the-client",
+	"UAAOAuthClientSecret": "the-client-secret",
 	"OrganizationFields": {
 		"Guid": "the-org-guid",
 		"Name": "the-org",
 		"QuotaDefinition": {
 			"Guid": "",
 			"Name": "",
-			"MemoryLimit": 0
+			"MemoryLimit": 0,
+			"InstanceMemoryLimit": 0,
+			"RoutesLimit": 0,
+			"ServicesLimit": 0,
+			"NonBasicServicesAllowed": false
 		}
 	},
 	"SpaceFields": {
 		"Guid": "the-space-guid",
 		"Name": "the-space"
 	},
-	"SSLDisabled": true
+	"SSLDisabled": true,
+	"SSLCACert": "the-ca-cert",
+	"SSLClientCert": "the-client-cert",
+	"SSLClientKey": "the-client-key"
 }`
 
 var exampleConfig = &Data{
@@ -42,6 +51,8 @@ var exampleConfig = &Data{
 	UaaEndpoint:           "uaa.example.com",
 	AccessToken:           "the-access-token",
 	RefreshToken:          "the-refresh-token",
+	UAAOAuthClient:        "the-client",
+	UAAOAuthClientSecret:  "the-client-secret",
 	OrganizationFields: models.OrganizationFields{
 		Guid: "the-org-guid",
 		Name: "the-org",
@@ -50,7 +61,10 @@ var exampleConfig = &Data{
 		Guid: "the-space-guid",
 		Name: "the-space",
 	},
-	SSLDisabled: true,
+	SSLDisabled:   true,
+	SSLCACert:     "the-ca-cert",
+	SSLClientCert: "the-client-cert",
+	SSLClientKey:  "the-client-key",
 }
 
 var _ = Describe("V3 Config files", func() {
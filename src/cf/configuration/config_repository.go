@@ -36,6 +36,8 @@ type Reader interface {
 	UaaEndpoint() string
 	AccessToken() string
 	RefreshToken() string
+	UAAOAuthClient() string
+	UAAOAuthClientSecret() string
 
 	OrganizationFields() models.OrganizationFields
 	HasOrganization() bool
@@ -48,6 +50,9 @@ type Reader interface {
 	UserEmail() string
 	IsLoggedIn() bool
 	IsSSLDisabled() bool
+	SSLCACert() string
+	SSLClientCert() string
+	SSLClientKey() string
 }
 
 type ReadWriter interface {
@@ -60,9 +65,13 @@ type ReadWriter interface {
 	SetUaaEndpoint(string)
 	SetAccessToken(string)
 	SetRefreshToken(string)
+	SetUAAOAuthClient(string)
+	SetUAAOAuthClientSecret(string)
 	SetOrganizationFields(models.OrganizationFields)
 	SetSpaceFields(models.SpaceFields)
 	SetSSLDisabled(bool)
+	SetSSLCACert(string)
+	SetSSLClientCertificate(cert, key string)
 }
 
 type Repository interface {
@@ -169,6 +178,22 @@ func (c *configRepository) RefreshToken() (refreshToken string) {
 	return
 }
 
+// the client is only set for sessions authenticated with client credentials;
+// everyone else logs in through the cf client
+func (c *configRepository) UAAOAuthClient() (client string) {
+	c.read(func() {
+		client = c.data.UAAOAuthClient
+	})
+	return
+}
+
+func (c *configRepository) UAAOAuthClientSecret() (secret string) {
+	c.read(func() {
+		secret = c.data.UAAOAuthClientSecret
+	})
+	return
+}
+
 func (c *configRepository) OrganizationFields() (org models.OrganizationFields) {
 	c.read(func() {
 		org = c.data.OrganizationFields
@@ -199,7 +224,11 @@ func (c *configRepository) UserGuid() (guid string) {
 
 func (c *configRepository) Username() (name string) {
 	c.read(func() {
-		name = NewTokenInfo(c.data.AccessToken).Username
+		info := NewTokenInfo(c.data.AccessToken)
+		name = info.Username
+		if name == "" {
+			name = info.ClientId
+		}
 	})
 	return
 }
@@ -232,12 +261,37 @@ func (c *configRepository) IsSSLDisabled() (isSSLDisabled bool) {
 	return
 }
 
+// the PEM encoded certificates trusted on top of the system's, for
+// foundations signed by an internal CA
+func (c *configRepository) SSLCACert() (caCert string) {
+	c.read(func() {
+		caCert = c.data.SSLCACert
+	})
+	return
+}
+
+func (c *configRepository) SSLClientCert() (clientCert string) {
+	c.read(func() {
+		clientCert = c.data.SSLClientCert
+	})
+	return
+}
+
+func (c *configRepository) SSLClientKey() (clientKey string) {
+	c.read(func() {
+		clientKey = c.data.SSLClientKey
+	})
+	return
+}
+
 // SETTERS
 
 func (c *configRepository) ClearSession() {
 	c.write(func() {
 		c.data.AccessToken = ""
 		c.data.RefreshToken = ""
+		c.data.UAAOAuthClient = ""
+		c.data.UAAOAuthClientSecret = ""
 		c.data.OrganizationFields = models.OrganizationFields{}
 		c.data.SpaceFields = models.SpaceFields{}
 	})
@@ -285,6 +339,18 @@ func (c *configRepository) SetRefreshToken(token string) {
 	})
 }
 
+func (c *configRepository) SetUAAOAuthClient(client string) {
+	c.write(func() {
+		c.data.UAAOAuthClient = client
+	})
+}
+
+func (c *configRepository) SetUAAOAuthClientSecret(secret string) {
+	c.write(func() {
+		c.data.UAAOAuthClientSecret = secret
+	})
+}
+
 func (c *configRepository) SetOrganizationFields(org models.OrganizationFields) {
 	c.write(func() {
 		c.data.OrganizationFields = org
@@ -302,3 +368,16 @@ func (c *configRepository) SetSSLDisabled(disabled bool) {
 		c.data.SSLDisabled = disabled
 	})
 }
+
+func (c *configRepository) SetSSLCACert(caCert string) {
+	c.write(func() {
+		c.data.SSLCACert = caCert
+	})
+}
+
+func (c *configRepository) SetSSLClientCertificate(cert, key string) {
+	c.write(func() {
+		c.data.SSLClientCert = cert
+		c.data.SSLClientKey = key
+	})
+}
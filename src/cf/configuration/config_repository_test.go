@@ -69,6 +69,12 @@ var _ = Describe("Configuration Repository", func() {
 		config.SetRefreshToken("the-token")
 		Expect(config.RefreshToken()).To(Equal("the-token"))
 
+		config.SetUAAOAuthClient("the-client")
+		Expect(config.UAAOAuthClient()).To(Equal("the-client"))
+
+		config.SetUAAOAuthClientSecret("the-client-secret")
+		Expect(config.UAAOAuthClientSecret()).To(Equal("the-client-secret"))
+
 		organization := maker.NewOrgFields(maker.Overrides{"name": "the-org"})
 		config.SetOrganizationFields(organization)
 		Expect(config.OrganizationFields()).To(Equal(organization))
@@ -79,6 +85,13 @@ var _ = Describe("Configuration Repository", func() {
 
 		config.SetSSLDisabled(false)
 		Expect(config.IsSSLDisabled()).To(BeFalse())
+
+		config.SetSSLCACert("the-ca-cert")
+		Expect(config.SSLCACert()).To(Equal("the-ca-cert"))
+
+		config.SetSSLClientCertificate("the-client-cert", "the-client-key")
+		Expect(config.SSLClientCert()).To(Equal("the-client-cert"))
+		Expect(config.SSLClientKey()).To(Equal("the-client-key"))
 	})
 
 	Describe("HasAPIEndpoint", func() {
@@ -117,6 +130,24 @@ var _ = Describe("Configuration Repository", func() {
 		Expect(config.UserEmail()).To(Equal("user1@example.com"))
 	})
 
+	It("uses the client as the username of tokens issued to a client", func() {
+		token, err := testconfig.EncodeAccessToken(TokenInfo{ClientId: "my-robot"})
+		Expect(err).NotTo(HaveOccurred())
+
+		config.SetAccessToken(token)
+		Expect(config.Username()).To(Equal("my-robot"))
+	})
+
+	It("forgets the client credentials when the session is cleared", func() {
+		config.SetUAAOAuthClient("the-client")
+		config.SetUAAOAuthClientSecret("the-client-secret")
+
+		config.ClearSession()
+
+		Expect(config.UAAOAuthClient()).To(BeEmpty())
+		Expect(config.UAAOAuthClientSecret()).To(BeEmpty())
+	})
+
 	It("User has an invalid Access Token", func() {
 		config.SetAccessToken("bearer")
 		Expect(config.UserGuid()).To(BeEmpty())
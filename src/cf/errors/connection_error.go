@@ -0,0 +1,22 @@
+package errors
+
+// ConnectionError is a request that never got an answer, because the
+// server could not be reached or took too long to respond
+type ConnectionError struct {
+	err error
+}
+
+func NewConnectionError(err error) *ConnectionError {
+	return &ConnectionError{err: err}
+}
+
+func (err *ConnectionError) Error() string {
+	return "Error performing request: " + err.err.Error()
+}
+
+func (err *ConnectionError) Timeout() bool {
+	timeoutErr, ok := err.err.(interface {
+		Timeout() bool
+	})
+	return ok && timeoutErr.Timeout()
+}
@@ -2,6 +2,7 @@ package errors
 
 const (
 	INVALID_RELATION            = "1002"
+	UNKNOWN_REQUEST             = "10000"
 	BAD_QUERY_PARAM             = "10005"
 	USER_EXISTS                 = "20002"
 	USER_NOT_FOUND              = "20003"
@@ -10,5 +11,6 @@ const (
 	SERVICE_INSTANCE_NAME_TAKEN = "60002"
 	APP_NOT_STAGED              = "170002"
 	APP_STOPPED                 = "220001"
+	QUOTA_NAME_TAKEN            = "240001"
 	BUILDPACK_EXISTS            = "290001"
 )
@@ -0,0 +1,15 @@
+package errors
+
+import "fmt"
+
+type TimeoutError struct {
+	description string
+}
+
+func NewTimeoutError(message string, args ...interface{}) TimeoutError {
+	return TimeoutError{description: fmt.Sprintf(message, args...)}
+}
+
+func (err TimeoutError) Error() string {
+	return err.description
+}
@@ -0,0 +1,15 @@
+package errors
+
+// TokenRefreshError is a session that could not be renewed, so the user has
+// to log in again
+type TokenRefreshError struct {
+	cause error
+}
+
+func NewTokenRefreshError(cause error) *TokenRefreshError {
+	return &TokenRefreshError{cause: cause}
+}
+
+func (err *TokenRefreshError) Error() string {
+	return "Your session has expired and could not be renewed, please log in again: " + err.cause.Error()
+}
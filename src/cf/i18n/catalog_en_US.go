@@ -0,0 +1,857 @@
+package i18n
+
+// every message the CLI translates, as it is shown in English
+var en_US = map[string]string{
+	"OK":               "OK",
+	"FAILED":           "FAILED",
+	"Incorrect Usage.": "Incorrect Usage.",
+	"y":                "y",
+	"yes":              "yes",
+	"Please use '%s api' to set an API endpoint and then '%s login' to login.": "Please use '%s api' to set an API endpoint and then '%s login' to login.",
+	"Not logged in. Use '%s' to log in.":                                       "Not logged in. Use '%s' to log in.",
+	"API endpoint: %s (API version: %s)":                                       "API endpoint: %s (API version: %s)",
+	"User:":                                                                    "User:",
+	"Org:":                                                                     "Org:",
+	"Space:":                                                                   "Space:",
+	"No org or space targeted, use '%s'":                                       "No org or space targeted, use '%s'",
+	"No org targeted, use '%s'":                                                "No org targeted, use '%s'",
+	"No space targeted, use '%s'":                                              "No space targeted, use '%s'",
+	"NAME:":                                                                    "NAME:",
+	"USAGE:":                                                                   "USAGE:",
+	"VERSION:":                                                                 "VERSION:",
+	"ENVIRONMENT VARIABLES":                                                    "ENVIRONMENT VARIABLES",
+	"GLOBAL OPTIONS":                                                           "GLOBAL OPTIONS",
+	"GETTING STARTED":                                                          "GETTING STARTED",
+	"APPS":                                                                     "APPS",
+	"SERVICES":                                                                 "SERVICES",
+	"ORGS":                                                                     "ORGS",
+	"SPACES":                                                                   "SPACES",
+	"DOMAINS":                                                                  "DOMAINS",
+	"ROUTES":                                                                   "ROUTES",
+	"BUILDPACKS":                                                               "BUILDPACKS",
+	"USER ADMIN":                                                               "USER ADMIN",
+	"ORG ADMIN":                                                                "ORG ADMIN",
+	"SERVICE ADMIN":                                                            "SERVICE ADMIN",
+	"ADVANCED":                                                                 "ADVANCED",
+	"Deleting space %s will also delete:":                                      "Deleting space %s will also delete:",
+	"Failed fetching the contents of space %s.\n%s":                         "Failed fetching the contents of space %s.\n%s",
+	"Dry run, space %s was not deleted":                                     "Dry run, space %s was not deleted",
+	"Really delete space %s and everything associated with it?%s":           "Really delete space %s and everything associated with it?%s",
+	"Deleting space %s in org %s as %s...":                                  "Deleting space %s in org %s as %s...",
+	"TIP: No space targeted, use '%s target -s' to target a space":          "TIP: No space targeted, use '%s target -s' to target a space",
+	"no apps, service instances or routes":                                  "no apps, service instances or routes",
+	"app %s (%s)":                                                           "app %s (%s)",
+	"service instance %s (user-provided)":                                   "service instance %s (user-provided)",
+	"service instance %s (%s, will be deprovisioned by its service broker)": "service instance %s (%s, will be deprovisioned by its service broker)",
+	"route %s":                 "route %s",
+	"1 instance":               "1 instance",
+	"%d instances":             "%d instances",
+	"Deleting org %s as %s...": "Deleting org %s as %s...",
+	"Org %s does not exist.":   "Org %s does not exist.",
+	"Failed fetching the contents of org %s.\n%s":               "Failed fetching the contents of org %s.\n%s",
+	"Dry run, org %s was not deleted":                           "Dry run, org %s was not deleted",
+	"Really delete org %s and everything associated with it?%s": "Really delete org %s and everything associated with it?%s",
+	"Deleting org %s will also delete:":                         "Deleting org %s will also delete:",
+	"no spaces":                                                 "no spaces",
+	"space %s":                                                  "space %s",
+	"private domain %s":                                         "private domain %s",
+	"Input required, but prompts are disabled in non-interactive mode: %s": "Input required, but prompts are disabled in non-interactive mode: %s",
+	"EXIT STATUS": "EXIT STATUS",
+	"Show help":   "Show help",
+	"A command line tool to interact with Cloud Foundry":                         "A command line tool to interact with Cloud Foundry",
+	"Print the requests that would change anything instead of sending them":      "Print the requests that would change anything instead of sending them",
+	"Fail instead of prompting for input":                                        "Fail instead of prompting for input",
+	"Set or view target api url":                                                 "Set or view target api url",
+	"EXAMPLE:":                                                                   "EXAMPLE:",
+	"trust the certificates signed by an internal CA":                            "trust the certificates signed by an internal CA",
+	"Trust the PEM encoded CA certificates in this file, defaults to CF_CA_CERT": "Trust the PEM encoded CA certificates in this file, defaults to CF_CA_CERT",
+	"PEM encoded client certificate, for endpoints requiring mutual TLS":         "PEM encoded client certificate, for endpoints requiring mutual TLS",
+	"PEM encoded private key of the client certificate":                          "PEM encoded private key of the client certificate",
+	"Please don't":                      "Please don't",
+	"Display health and status for app": "Display health and status for app",
+	"SORT KEYS:":                        "SORT KEYS:",
+	"Refresh the instance list until interrupted":             "Refresh the instance list until interrupted",
+	"Sort instances by the given key when watching":           "Sort instances by the given key when watching",
+	"Seconds between refreshes when watching (defaults to 5)": "Seconds between refreshes when watching (defaults to 5)",
+	"List all apps in the target space":                       "List all apps in the target space",
+	"Authenticate user non-interactively":                     "Authenticate user non-interactively",
+	"WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history": "WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history",
+	"use quotes for passwords with a space":                                         "use quotes for passwords with a space",
+	"escape quotes if used in password":                                             "escape quotes if used in password",
+	"authenticate a service account as an OAuth client":                             "authenticate a service account as an OAuth client",
+	"Authenticate as an OAuth client, with its ID and secret, instead of a user":    "Authenticate as an OAuth client, with its ID and secret, instead of a user",
+	"Bind a service instance to an app":                                             "Bind a service instance to an app",
+	"List all buildpacks":                                                           "List all buildpacks",
+	"Check whether a route exists and whether it can be used in the targeted space": "Check whether a route exists and whether it can be used in the targeted space",
+	"Create a buildpack":                                                            "Create a buildpack",
+	"TIP:":                                                                          "TIP:",
+	"Path should be a zip file, a url to a zip file, or a local directory. Position is an integer, sets priority, and is sorted from lowest to highest.": "Path should be a zip file, a url to a zip file, or a local directory. Position is an integer, sets priority, and is sorted from lowest to highest.",
+	"Enable the buildpack":                         "Enable the buildpack",
+	"Disable the buildpack":                        "Disable the buildpack",
+	"Create a domain in an org for later use":      "Create a domain in an org for later use",
+	"Create an org":                                "Create an org",
+	"Define a new resource quota":                  "Define a new resource quota",
+	"Total amount of memory (e.g. 1024M, 1G, 10G)": "Total amount of memory (e.g. 1024M, 1G, 10G)",
+	"Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G), -1 means unlimited": "Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G), -1 means unlimited",
+	"Total number of routes, -1 means unlimited":                                                          "Total number of routes, -1 means unlimited",
+	"Total number of service instances, -1 means unlimited":                                               "Total number of service instances, -1 means unlimited",
+	"Can provision instances of paid service plans":                                                       "Can provision instances of paid service plans",
+	"Create a url route in a space for later use":                                                         "Create a url route in a space for later use",
+	"Hostname":                  "Hostname",
+	"Create a service instance": "Create a service instance",
+	"Use '%s' to make user-provided services available to cf apps":                                                 "Use '%s' to make user-provided services available to cf apps",
+	"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file": "Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file",
+	"Create a service auth token": "Create a service auth token",
+	"Create a service broker":     "Create a service broker",
+	"Create a space":              "Create a space",
+	"Organization":                "Organization",
+	"Create a new user":           "Create a new user",
+	"Make a user-provided service available to cf apps":                "Make a user-provided service available to cf apps",
+	"Pass comma separated parameter names to enable interactive mode:": "Pass comma separated parameter names to enable interactive mode:",
+	"Pass parameters as JSON to create a service non-interactively:":   "Pass parameters as JSON to create a service non-interactively:",
+	"Parameters":       "Parameters",
+	"Syslog Drain Url": "Syslog Drain Url",
+	"Executes a raw request, content-type set to application/json by default":        "Executes a raw request, content-type set to application/json by default",
+	"Custom headers to include in the request, flag can be specified multiple times": "Custom headers to include in the request, flag can be specified multiple times",
+	"HTTP data to include in the request body":                                       "HTTP data to include in the request body",
+	"Include response headers in the output":                                         "Include response headers in the output",
+	"Enable CF_TRACE output for all requests and responses":                          "Enable CF_TRACE output for all requests and responses",
+	"Delete an app":                       "Delete an app",
+	"Force deletion without confirmation": "Force deletion without confirmation",
+	"Delete a buildpack":                  "Delete a buildpack",
+	"Delete a domain":                     "Delete a domain",
+	"Delete a shared domain":              "Delete a shared domain",
+	"Delete an org":                       "Delete an org",
+	"List everything that would be deleted without deleting it, implied by the global --dry-run": "List everything that would be deleted without deleting it, implied by the global --dry-run",
+	"Delete the routes in the target space that are not mapped to any app":                       "Delete the routes in the target space that are not mapped to any app",
+	"Delete a quota":              "Delete a quota",
+	"Delete a route":              "Delete a route",
+	"Delete a service instance":   "Delete a service instance",
+	"Delete a service auth token": "Delete a service auth token",
+	"Delete a service broker":     "Delete a service broker",
+	"Delete a space":              "Delete a space",
+	"Delete a user":               "Delete a user",
+	"Disable access to a service or service plan for one or all orgs":                             "Disable access to a service or service plan for one or all orgs",
+	"Disable access to a particular service plan":                                                 "Disable access to a particular service plan",
+	"Disable access for a particular organization":                                                "Disable access for a particular organization",
+	"Enable access to a service or service plan for one or all orgs":                              "Enable access to a service or service plan for one or all orgs",
+	"Enable access to a particular service plan":                                                  "Enable access to a particular service plan",
+	"Enable access for a particular organization":                                                 "Enable access for a particular organization",
+	"List domains in the target org":                                                              "List domains in the target org",
+	"Show all env variables for an app":                                                           "Show all env variables for an app",
+	"Show recent app events":                                                                      "Show recent app events",
+	"Event types are crash, create, update and delete, several can be given separated by commas.": "Event types are crash, create, update and delete, several can be given separated by commas.",
+	"Dates are given as YYYY-MM-DD or as YYYY-MM-DDTHH:MM:SS in local time.":                      "Dates are given as YYYY-MM-DD or as YYYY-MM-DDTHH:MM:SS in local time.",
+	"Show events for every app in the targeted space":                                             "Show events for every app in the targeted space",
+	"Show events for every app in the targeted org":                                               "Show events for every app in the targeted org",
+	"Only show events of the given types":                                                         "Only show events of the given types",
+	"Only show events caused by the given user":                                                   "Only show events caused by the given user",
+	"Only show events from this date on":                                                          "Only show events from this date on",
+	"Only show events up to this date":                                                            "Only show events up to this date",
+	"Print events as csv or json instead of a table":                                              "Print events as csv or json instead of a table",
+	"Save all buildpacks with their settings and bits to a directory":                             "Save all buildpacks with their settings and bits to a directory",
+	"Writes a zip file for each buildpack and a buildpacks.json file with\n   their names, positions and enabled/locked flags.": "Writes a zip file for each buildpack and a buildpacks.json file with\n   their names, positions and enabled/locked flags.",
+	"Use '%s' to copy the buildpacks to another Cloud Foundry":                                                                  "Use '%s' to copy the buildpacks to another Cloud Foundry",
+	"Export the users of an org and their roles as CSV":                                                                         "Export the users of an org and their roles as CSV",
+	"Org to export": "Org to export",
+	"Print out a list of files in a directory or the contents of a specific file":                        "Print out a list of files in a directory or the contents of a specific file",
+	"list the logs directory of the fourth instance":                                                     "list the logs directory of the fourth instance",
+	"recursively download the logs directory":                                                            "recursively download the logs directory",
+	"follow a log file as it grows":                                                                      "follow a log file as it grows",
+	"show a file on every instance":                                                                      "show a file on every instance",
+	"Index of the app instance (defaults to 0)":                                                          "Index of the app instance (defaults to 0)",
+	"Show the path on every instance, prefixing each line with the instance index":                       "Show the path on every instance, prefixing each line with the instance index",
+	"Recursively download the path to a local directory":                                                 "Recursively download the path to a local directory",
+	"Follow a file as it grows":                                                                          "Follow a file as it grows",
+	"Show the commands run from this machine":                                                            "Show the commands run from this machine",
+	"Only show commands started since a duration ago (e.g. 2h, 3d) or a date (e.g. 2014-05-01)":          "Only show commands started since a duration ago (e.g. 2h, 3d) or a date (e.g. 2014-05-01)",
+	"Only show commands that failed":                                                                     "Only show commands that failed",
+	"Create or update buildpacks from a directory written by export-buildpacks":                          "Create or update buildpacks from a directory written by export-buildpacks",
+	"Buildpacks that already exist are updated, others are created.":                                     "Buildpacks that already exist are updated, others are created.",
+	"Buildpacks that are not in DIR are left unchanged.":                                                 "Buildpacks that are not in DIR are left unchanged.",
+	"Create users and assign their org and space roles from a CSV file":                                  "Create users and assign their org and space roles from a CSV file",
+	"Each row has the columns username,password,org,org_role,space,space_role.":                          "Each row has the columns username,password,org,org_role,space,space_role.",
+	"Users that already exist are not created again, rows for them can leave the password empty.":        "Users that already exist are not created again, rows for them can leave the password empty.",
+	"Org roles are OrgUser, OrgManager, BillingManager and OrgAuditor.":                                  "Org roles are OrgUser, OrgManager, BillingManager and OrgAuditor.",
+	"Space roles are SpaceManager, SpaceDeveloper and SpaceAuditor.":                                     "Space roles are SpaceManager, SpaceDeveloper and SpaceAuditor.",
+	"Check the file and show what would be done without making changes, implied by the global --dry-run": "Check the file and show what would be done without making changes, implied by the global --dry-run",
+	"Log user in": "Log user in",
+	"omit username and password to login interactively -- %s will prompt for both": "omit username and password to login interactively -- %s will prompt for both",
+	"specify username and password as arguments":                                   "specify username and password as arguments",
+	"log in with a one-time passcode from your single sign-on provider":            "log in with a one-time passcode from your single sign-on provider",
+	"Username":                            "Username",
+	"Password":                            "Password",
+	"Org":                                 "Org",
+	"Space":                               "Space",
+	"Log in with a one-time passcode":     "Log in with a one-time passcode",
+	"Log user out":                        "Log user out",
+	"Tail or show recent logs for an app": "Tail or show recent logs for an app",
+	"Dump recent logs instead of tailing": "Dump recent logs instead of tailing",
+	"List available offerings in the marketplace":                                     "List available offerings in the marketplace",
+	"Show plan details for a particular service offering":                             "Show plan details for a particular service offering",
+	"Only show offerings whose name, description, provider or plans contain the term": "Only show offerings whose name, description, provider or plans contain the term",
+	"Only show offerings from the given service broker":                               "Only show offerings from the given service broker",
+	"Only show offerings from the given provider":                                     "Only show offerings from the given provider",
+	"Add a url route to an app":                                                       "Add a url route to an app",
+	"Print a fresh OAuth token, e.g. for use with other tools":                        "Print a fresh OAuth token, e.g. for use with other tools",
+	"Show org info":             "Show org info",
+	"Show org users by role":    "Show org users by role",
+	"List all users in the org": "List all users in the org",
+	"List all orgs":             "List all orgs",
+	"Change user password":      "Change user password",
+	"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker": "Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker",
+	"WARNING:": "WARNING:",
+	"This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.": "This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.",
+	"Provider": "Provider",
+	"Push a new app or sync changes to an existing app": "Push a new app or sync changes to an existing app",
+	"Push a single app (with or without a manifest):":   "Push a single app (with or without a manifest):",
+	"Push multiple apps with a manifest:":               "Push multiple apps with a manifest:",
+	"Custom buildpack by name (e.g. my-buildpack) or GIT URL (e.g. https://github.com/heroku/heroku-buildpack-play.git)": "Custom buildpack by name (e.g. my-buildpack) or GIT URL (e.g. https://github.com/heroku/heroku-buildpack-play.git)",
+	"Startup command, set to null to reset to default start command":                                                     "Startup command, set to null to reset to default start command",
+	"Domain (e.g. example.com)":                                        "Domain (e.g. example.com)",
+	"Path to manifest":                                                 "Path to manifest",
+	"Number of instances":                                              "Number of instances",
+	"Memory limit (e.g. 256M, 1024M, 1G)":                              "Memory limit (e.g. 256M, 1024M, 1G)",
+	"Hostname (e.g. my-subdomain)":                                     "Hostname (e.g. my-subdomain)",
+	"Path of app directory or zip file":                                "Path of app directory or zip file",
+	"Stack to use":                                                     "Stack to use",
+	"Start timeout in seconds":                                         "Start timeout in seconds",
+	"Map the root domain to this app":                                  "Map the root domain to this app",
+	"Ignore manifest file":                                             "Ignore manifest file",
+	"Do not map a route to this app":                                   "Do not map a route to this app",
+	"Do not start an app after pushing":                                "Do not start an app after pushing",
+	"Unmap routes that are no longer listed for this app":              "Unmap routes that are no longer listed for this app",
+	"Create a random route for this app":                               "Create a random route for this app",
+	"Show quota info":                                                  "Show quota info",
+	"List available usage quotas ":                                     "List available usage quotas ",
+	"Rename an app":                                                    "Rename an app",
+	"Rename an org":                                                    "Rename an org",
+	"Rename a service instance":                                        "Rename a service instance",
+	"Rename a service broker":                                          "Rename a service broker",
+	"Rename a space":                                                   "Rename a space",
+	"Set the priority order of all buildpacks at once":                 "Set the priority order of all buildpacks at once",
+	"Every buildpack has to be listed, the first one gets position 1.": "Every buildpack has to be listed, the first one gets position 1.",
+	"Restart an app":                                                   "Restart an app",
+	"restart two instances at a time":                                  "restart two instances at a time",
+	"Restart instances in batches, waiting for each batch to be running before the next": "Restart instances in batches, waiting for each batch to be running before the next",
+	"Number of instances to restart at a time with --rolling (defaults to 1)":            "Number of instances to restart at a time with --rolling (defaults to 1)",
+	"Restart a single instance of an app":                                                "Restart a single instance of an app",
+	"List all routes":                                                                    "List all routes",
+	"Only list routes that are not mapped to any app":                                    "Only list routes that are not mapped to any app",
+	"Change or view the instance count, disk space limit, and memory limit for an app":   "Change or view the instance count, disk space limit, and memory limit for an app",
+	"Disk limit (e.g. 256M, 1024M, 1G)":                                                  "Disk limit (e.g. 256M, 1024M, 1G)",
+	"Force restart of app without prompt":                                                "Force restart of app without prompt",
+	"Show service instance info":                                                         "Show service instance info",
+	"List service access settings":                                                       "List service access settings",
+	"List service auth tokens":                                                           "List service auth tokens",
+	"List service brokers":                                                               "List service brokers",
+	"List all services in the target space":                                              "List all services in the target space",
+	"Migrate service instances from one service plan to another":                         "Migrate service instances from one service plan to another",
+	"WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and resources for service instances will not be altered. The primary use case for this operation is to replace a service broker which implements the v1 Service Broker API with a broker which implements the v2 API by remapping service instances from v1 plans to v2 plans.  We recommend making the v1 plan private or shutting down the v1 broker to prevent additional instances from being created. Once service instances have been migrated, the v1 services and plans can be removed from Cloud Foundry.": "WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and resources for service instances will not be altered. The primary use case for this operation is to replace a service broker which implements the v1 Service Broker API with a broker which implements the v2 API by remapping service instances from v1 plans to v2 plans.  We recommend making the v1 plan private or shutting down the v1 broker to prevent additional instances from being created. Once service instances have been migrated, the v1 services and plans can be removed from Cloud Foundry.",
+	"Force migration without confirmation": "Force migration without confirmation",
+	"Set an env variable for an app":       "Set an env variable for an app",
+	"Assign an org role to a user":         "Assign an org role to a user",
+	"ROLES:":                               "ROLES:",
+	"Invite and manage users, select and change plans, and set spending limits": "Invite and manage users, select and change plans, and set spending limits",
+	"Create and manage the billing account and payment info":                    "Create and manage the billing account and payment info",
+	"Read-only access to org info and reports":                                  "Read-only access to org info and reports",
+	"Define the quota for an org":                                               "Define the quota for an org",
+	"View allowable quotas with '%s'":                                           "View allowable quotas with '%s'",
+	"Assign a space role to a user":                                             "Assign a space role to a user",
+	"Invite and manage users, and enable features for a given space":            "Invite and manage users, and enable features for a given space",
+	"Create and manage apps and services, and see logs and reports":             "Create and manage apps and services, and see logs and reports",
+	"View logs, reports, and settings on this space":                            "View logs, reports, and settings on this space",
+	"Create a domain that can be used by all orgs (admin-only)":                 "Create a domain that can be used by all orgs (admin-only)",
+	"Show space info":           "Show space info",
+	"Show space users by role":  "Show space users by role",
+	"List all spaces in an org": "List all spaces in an org",
+	"List all stacks":           "List all stacks",
+	"Run a buildpack against an app on this machine to debug staging":                                                             "Run a buildpack against an app on this machine to debug staging",
+	"BUILDPACK can be a directory, a zip file or a URL. When it is not given,\n   the buildpack from the app's manifest is used.": "BUILDPACK can be a directory, a zip file or a URL. When it is not given,\n   the buildpack from the app's manifest is used.",
+	"The files ignored by .cfignore are left out, as they are when pushing.":                                                      "The files ignored by .cfignore are left out, as they are when pushing.",
+	"Buildpacks can only be run on Linux.":                                                                                        "Buildpacks can only be run on Linux.",
+	"Buildpack directory, zip file or URL":                                                                                        "Buildpack directory, zip file or URL",
+	"Path to the app directory (default is the current directory)":                                                                "Path to the app directory (default is the current directory)",
+	"Start an app":                          "Start an app",
+	"Stop an app":                           "Stop an app",
+	"Set or view the targeted org or space": "Set or view the targeted org or space",
+	"organization":                          "organization",
+	"space":                                 "space",
+	"Display a refreshing dashboard of all apps in the target space": "Display a refreshing dashboard of all apps in the target space",
+	"Sort apps by the given key":                                     "Sort apps by the given key",
+	"Seconds between refreshes (defaults to 5)":                      "Seconds between refreshes (defaults to 5)",
+	"Unbind a service instance from an app":                          "Unbind a service instance from an app",
+	"Remove a url route from an app":                                 "Remove a url route from an app",
+	"Remove an env variable":                                         "Remove an env variable",
+	"Remove an org role from a user":                                 "Remove an org role from a user",
+	"Remove a space role from a user":                                "Remove a space role from a user",
+	"Update a buildpack":                                             "Update a buildpack",
+	"Buildpack position among other buildpacks":                      "Buildpack position among other buildpacks",
+	"Path to directory or zip file":                                  "Path to directory or zip file",
+	"Lock the buildpack":                                             "Lock the buildpack",
+	"Unlock the buildpack":                                           "Unlock the buildpack",
+	"Update an existing resource quota":                              "Update an existing resource quota",
+	"Cannot provision instances of paid service plans":               "Cannot provision instances of paid service plans",
+	"New name": "New name",
+	"Change the plan or the configuration parameters of a service instance":                                    "Change the plan or the configuration parameters of a service instance",
+	"Not every service broker supports plan changes, the broker may refuse the update.":                        "Not every service broker supports plan changes, the broker may refuse the update.",
+	"Change the service plan of the instance":                                                                  "Change the service plan of the instance",
+	"Update a service broker":                                                                                  "Update a service broker",
+	"Update a service auth token":                                                                              "Update a service auth token",
+	"Update user-provided service name value pairs":                                                            "Update user-provided service name value pairs",
+	"Show resource usage of an org compared to its quota":                                                      "Show resource usage of an org compared to its quota",
+	"Memory counts the started apps only, the same way the quota is enforced.":                                 "Memory counts the started apps only, the same way the quota is enforced.",
+	"Orgs using %d%% or more of a limit are flagged.":                                                          "Orgs using %d%% or more of a limit are flagged.",
+	"Show usage for the given org instead of the targeted org":                                                 "Show usage for the given org instead of the targeted org",
+	"Show usage for every org":                                                                                 "Show usage for every org",
+	"Show every org and space role of a user":                                                                  "Show every org and space role of a user",
+	"export the roles of every user as CSV":                                                                    "export the roles of every user as CSV",
+	"Export the roles of every user in every org as CSV":                                                       "Export the roles of every user in every org as CSV",
+	"Check a buildpack directory, zip file or URL for problems before uploading it":                            "Check a buildpack directory, zip file or URL for problems before uploading it",
+	"%s and %s run the same checks before uploading":                                                           "%s and %s run the same checks before uploading",
+	"Check the catalog of a service broker before registering it":                                              "Check the catalog of a service broker before registering it",
+	"Username for the broker's basic auth":                                                                     "Username for the broker's basic auth",
+	"Password for the broker's basic auth":                                                                     "Password for the broker's basic auth",
+	"Show who you are logged in as, with your scopes and when your session expires":                            "Show who you are logged in as, with your scopes and when your session expires",
+	"Trust the CA certificates in this file when setting the api endpoint":                                     "Trust the CA certificates in this file when setting the api endpoint",
+	"Do not colorize output":                                                                                   "Do not colorize output",
+	"Override path to default config directory":                                                                "Override path to default config directory",
+	"Language of messages, overrides LANG (en_US, ja_JP or pt_BR)":                                             "Language of messages, overrides LANG (en_US, ja_JP or pt_BR)",
+	"Max wait time for buildpack staging, in minutes":                                                          "Max wait time for buildpack staging, in minutes",
+	"Max wait time for app instance startup, in minutes":                                                       "Max wait time for app instance startup, in minutes",
+	"Print API request diagnostics to stdout":                                                                  "Print API request diagnostics to stdout",
+	"Append API request diagnostics to a log file":                                                             "Append API request diagnostics to a log file",
+	"Enable HTTP proxying for API requests":                                                                    "Enable HTTP proxying for API requests",
+	"Print the version":                                                                                        "Print the version",
+	"Success":                                                                                                  "Success",
+	"Any failure not listed below":                                                                             "Any failure not listed below",
+	"Incorrect usage, or input needed in non-interactive mode":                                                 "Incorrect usage, or input needed in non-interactive mode",
+	"Not logged in, bad credentials or an expired session":                                                     "Not logged in, bad credentials or an expired session",
+	"The app, space, service or other resource does not exist":                                                 "The app, space, service or other resource does not exist",
+	"The server failed or could not be reached":                                                                "The server failed or could not be reached",
+	"The server, staging or an async job took too long":                                                        "The server, staging or an async job took too long",
+	"No api endpoint set. Use '%s' to set an endpoint":                                                         "No api endpoint set. Use '%s' to set an endpoint",
+	"Setting api endpoint to %s...":                                                                            "Setting api endpoint to %s...",
+	"TIP: Use '%s' to trust a custom certificate authority, or '%s' to continue with an insecure API endpoint": "TIP: Use '%s' to trust a custom certificate authority, or '%s' to continue with an insecure API endpoint",
+	"Invalid SSL Cert for %s\n%s":                                                                              "Invalid SSL Cert for %s\n%s",
+	"Warning: Insecure http API endpoint detected: secure https API endpoints are recommended":                 "Warning: Insecure http API endpoint detected: secure https API endpoints are recommended",
+	"Could not read CA certificate file %s\n%s":                                                                "Could not read CA certificate file %s\n%s",
+	"Could not read client certificate file %s\n%s":                                                            "Could not read client certificate file %s\n%s",
+	"Could not read client key file %s\n%s":                                                                    "Could not read client key file %s\n%s",
+	"Really delete %s?%s":                                                                                      "Really delete %s?%s",
+	"Deleting app %s in org %s / space %s as %s...":                                                            "Deleting app %s in org %s / space %s as %s...",
+	"App %s does not exist.":                                                                                   "App %s does not exist.",
+	"Getting env variables for app %s in org %s / space %s as %s...":                                           "Getting env variables for app %s in org %s / space %s as %s...",
+	"No env variables exist":                                                                                   "No env variables exist",
+	"org %s":                                                                                                   "org %s",
+	"org %s / space %s":                                                                                        "org %s / space %s",
+	"app %s":                                                                                                   "app %s",
+	"app %s in org %s / space %s":                                                                              "app %s in org %s / space %s",
+	"Failed fetching events.\n%s":                                                                              "Failed fetching events.\n%s",
+	"Getting events for %s as %s...\n":                                                                         "Getting events for %s as %s...\n",
+	"No events for %s":                                                                                         "No events for %s",
+	"time":                                                                                                     "time",
+	"event":                                                                                                    "event",
+	"actor":                                                                                                    "actor",
+	"description":                                                                                              "description",
+	"target":                                                                                                   "target",
+	"the app exited on its own":                                                                                "the app exited on its own",
+	"the start command is not executable":                                                                      "the start command is not executable",
+	"the start command was not found":                                                                          "the start command was not found",
+	"killed by SIGKILL, usually because the app exceeded its memory limit":                                     "killed by SIGKILL, usually because the app exceeded its memory limit",
+	"terminated by SIGTERM":                                                                                    "terminated by SIGTERM",
+	"segmentation fault":                                                                                       "segmentation fault",
+	"aborted by SIGABRT":                                                                                       "aborted by SIGABRT",
+	"killed by signal %d":                                                                                      "killed by signal %d",
+	"the app exited with an error":                                                                             "the app exited with an error",
+	"Instance %d of app %s does not exist. The app has %d instance(s).":                                        "Instance %d of app %s does not exist. The app has %d instance(s).",
+	"Getting files for app %s in org %s / space %s as %s...":                                                   "Getting files for app %s in org %s / space %s as %s...",
+	"Getting files for all instances of app %s in org %s / space %s as %s...":                                  "Getting files for all instances of app %s in org %s / space %s as %s...",
+	"Downloading %s from instance %d of app %s in org %s / space %s as %s...":                                  "Downloading %s from instance %d of app %s in org %s / space %s as %s...",
+	"Downloaded %d file(s) to %s":                                                                              "Downloaded %d file(s) to %s",
+	"Tailing %s on instance %d of app %s in org %s / space %s as %s...":                                        "Tailing %s on instance %d of app %s in org %s / space %s as %s...",
+	"Getting apps in org %s / space %s as %s...":                                                               "Getting apps in org %s / space %s as %s...",
+	"No apps found":                                                                                            "No apps found",
+	"name":                                                                                                     "name",
+	"requested state":                                                                                          "requested state",
+	"instances":                                                                                                "instances",
+	"memory":                                                                                                   "memory",
+	"disk":                                                                                                     "disk",
+	"urls":                                                                                                     "urls",
+	"Connected, dumping recent logs for app %s in org %s / space %s as %s...\n":                                "Connected, dumping recent logs for app %s in org %s / space %s as %s...\n",
+	"Connected, tailing logs for app %s in org %s / space %s as %s...\n":                                       "Connected, tailing logs for app %s in org %s / space %s as %s...\n",
+	"TIP: use the --skip-ssl-validation to suppress this error":                                                "TIP: use the --skip-ssl-validation to suppress this error",
+	"Uploading %s...":                                                                                          "Uploading %s...",
+	"Error uploading application.\n%s":                                                                         "Error uploading application.\n%s",
+	"Could not find service %s to bind to %s":                                                                  "Could not find service %s to bind to %s",
+	"Binding service %s to %s in org %s / space %s as %s":                                                      "Binding service %s to %s in org %s / space %s as %s",
+	"Could not find to service %s\nError: %s":                                                                  "Could not find to service %s\nError: %s",
+	"Uploading from: %s\n%s, %d files":                                                                         "Uploading from: %s\n%s, %d files",
+	"Using stack %s...":                                                                                        "Using stack %s...",
+	"App %s is a worker, skipping route creation":                                                              "App %s is a worker, skipping route creation",
+	"Using route %s":                                                                                           "Using route %s",
+	"Creating route %s...":                                                                                     "Creating route %s...",
+	"Binding %s to %s...":                                                                                      "Binding %s to %s...",
+	"The route %s is already in use.\nTIP: Change the hostname with -n HOSTNAME or use --random-route to generate a new route and then push again.": "The route %s is already in use.\nTIP: Change the hostname with -n HOSTNAME or use --random-route to generate a new route and then push again.",
+	"Unbinding %s from %s...":                       "Unbinding %s from %s...",
+	"No default domain exists":                      "No default domain exists",
+	"No domain found for route %s":                  "No domain found for route %s",
+	"Could not find a default domain":               "Could not find a default domain",
+	"Error: No name found for app":                  "Error: No name found for app",
+	"Creating app %s in org %s / space %s as %s...": "Creating app %s in org %s / space %s as %s...",
+	"Updating app %s in org %s / space %s as %s...": "Updating app %s in org %s / space %s as %s...",
+	"Error: %s": "Error: %s",
+	"Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file.": "Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file.",
+	"Could not determine the current working directory!":                                                                 "Could not determine the current working directory!",
+	"Error reading manifest file:\n%s":                                                                                   "Error reading manifest file:\n%s",
+	"Using manifest file %s\n":                                                                                           "Using manifest file %s\n",
+	"Could not find app named '%s' in manifest":                                                                          "Could not find app named '%s' in manifest",
+	"app name is a required field":                                                                                       "app name is a required field",
+	"Could not find named app in manifest":                                                                               "Could not find named app in manifest",
+	"Invalid memory param: %s\n%s":                                                                                       "Invalid memory param: %s\n%s",
+	"Invalid instances param: %s\n%s":                                                                                    "Invalid instances param: %s\n%s",
+	"Invalid timeout param: %s\n%s":                                                                                      "Invalid timeout param: %s\n%s",
+	"Renaming app %s to %s in org %s / space %s as %s...":                                                                "Renaming app %s to %s in org %s / space %s as %s...",
+	"Restarting app %s in org %s / space %s as %s, %d instance(s) at a time...":                                          "Restarting app %s in org %s / space %s as %s, %d instance(s) at a time...",
+	"App %s is not started. Use '%s' to start it.":                                                                       "App %s is not started. Use '%s' to start it.",
+	"App %s has only one instance, it will be unavailable while it restarts.":                                            "App %s has only one instance, it will be unavailable while it restarts.",
+	"Restarting %s...": "Restarting %s...",
+	"%s\n\nRolling restart aborted, instances after %s were not restarted.\n\nTIP: use '%s' for more information": "%s\n\nRolling restart aborted, instances after %s were not restarted.\n\nTIP: use '%s' for more information",
+	"App restarted":                       "App restarted",
+	"Timed out waiting for %s to start":   "Timed out waiting for %s to start",
+	"Instance #%d is crashing":            "Instance #%d is crashing",
+	"%d of %d instances in batch running": "%d of %d instances in batch running",
+	"instances %s":                        "instances %s",
+	"instance %s":                         "instance %s",
+	"Restarting instance %d of app %s in org %s / space %s as %s...": "Restarting instance %d of app %s in org %s / space %s as %s...",
+	"Showing current scale of app %s in org %s / space %s as %s...":  "Showing current scale of app %s in org %s / space %s as %s...",
+	"memory:":                  "memory:",
+	"disk:":                    "disk:",
+	"instances:":               "instances:",
+	"Invalid value for memory": "Invalid value for memory",
+	"Invalid value for disk":   "Invalid value for disk",
+	"Scaling app %s in org %s / space %s as %s...":                               "Scaling app %s in org %s / space %s as %s...",
+	"This will cause the app to restage. Are you sure you want to scale %s?":     "This will cause the app to restage. Are you sure you want to scale %s?",
+	"Setting env variable '%s' to '%s' for app %s in org %s / space %s as %s...": "Setting env variable '%s' to '%s' for app %s in org %s / space %s as %s...",
+	"TIP: Use '%s' to ensure your env variable changes take effect":              "TIP: Use '%s' to ensure your env variable changes take effect",
+	"Showing health and status for app %s in org %s / space %s as %s...":         "Showing health and status for app %s in org %s / space %s as %s...",
+	"requested state:":     "requested state:",
+	"%s %s x %d instances": "%s %s x %d instances",
+	"usage:":               "usage:",
+	"urls:":                "urls:",
+	"There are no running instances of this app.": "There are no running instances of this app.",
+	"state":    "state",
+	"since":    "since",
+	"cpu":      "cpu",
+	"%s of %s": "%s of %s",
+	"Error finding the current directory: %s": "Error finding the current directory: %s",
+	"No buildpack given.\nTIP: Use '%s stage-local -b BUILDPACK' or set a buildpack in the app's manifest.": "No buildpack given.\nTIP: Use '%s stage-local -b BUILDPACK' or set a buildpack in the app's manifest.",
+	"Staging app in %s locally with buildpack %s...":                                                        "Staging app in %s locally with buildpack %s...",
+	"detected buildpack:": "detected buildpack:",
+	"start command:":      "start command:",
+	"release:":            "release:",
+	"invalid value for env var CF_STAGING_TIMEOUT\n%s": "invalid value for env var CF_STAGING_TIMEOUT\n%s",
+	"invalid value for env var CF_STARTUP_TIMEOUT\n%s": "invalid value for env var CF_STARTUP_TIMEOUT\n%s",
+	"App %s is already started":                        "App %s is already started",
+	"Starting app %s in org %s / space %s as %s...":    "Starting app %s in org %s / space %s as %s...",
+	"App started":                                              "App started",
+	"Warning: error tailing logs":                              "Warning: error tailing logs",
+	"%s\n\nTIP: use '%s' for more information":                 "%s\n\nTIP: use '%s' for more information",
+	"Start app timeout\n\nTIP: use '%s' for more information":  "Start app timeout\n\nTIP: use '%s' for more information",
+	"Start unsuccessful\n\nTIP: use '%s' for more information": "Start unsuccessful\n\nTIP: use '%s' for more information",
+	"%d of %d instances running":                               "%d of %d instances running",
+	"%d starting":                                              "%d starting",
+	"%d down":                                                  "%d down",
+	"%d failing":                                               "%d failing",
+	"App %s is already stopped":                                "App %s is already stopped",
+	"Stopping app %s in org %s / space %s as %s...":            "Stopping app %s in org %s / space %s as %s...",
+	"Watching apps in org %s / space %s as %s...":              "Watching apps in org %s / space %s as %s...",
+	"%d/%d (%d crashing)":                                      "%d/%d (%d crashing)",
+	"Watching app %s in org %s / space %s as %s...":            "Watching app %s in org %s / space %s as %s...",
+	"%d of %d instances are crashing or down":                  "%d of %d instances are crashing or down",
+	"%s %s, %s every %s, press Ctrl-C to quit":                 "%s %s, %s every %s, press Ctrl-C to quit",
+	"sorted by":  "sorted by",
+	"refreshing": "refreshing",
+	"Removing env variable %s from app %s in org %s / space %s as %s...": "Removing env variable %s from app %s in org %s / space %s as %s...",
+	"Env variable %s was not set.":                                       "Env variable %s was not set.",
+	"API endpoint: %s":                                                   "API endpoint: %s",
+	"Authenticating...":                                                  "Authenticating...",
+	"Use '%s' to view or set your target org and space":                  "Use '%s' to view or set your target org and space",
+	"Creating buildpack %s...":                                           "Creating buildpack %s...",
+	"Buildpack %s already exists":                                        "Buildpack %s already exists",
+	"TIP: use '%s' to update this buildpack":                             "TIP: use '%s' to update this buildpack",
+	"Uploading buildpack %s...":                                          "Uploading buildpack %s...",
+	"Invalid position. %s":                                               "Invalid position. %s",
+	"Cannot specify both enabled and disabled.":                          "Cannot specify both enabled and disabled.",
+	"Are you sure you want to delete the buildpack %s ?":                 "Are you sure you want to delete the buildpack %s ?",
+	"Deleting buildpack %s...":                                           "Deleting buildpack %s...",
+	"Buildpack %s does not exist.":                                       "Buildpack %s does not exist.",
+	"Error deleting buildpack %s\n%s":                                    "Error deleting buildpack %s\n%s",
+	"Exporting buildpacks to %s...":                                      "Exporting buildpacks to %s...",
+	"Failed fetching buildpacks.\n%s":                                    "Failed fetching buildpacks.\n%s",
+	"Error creating directory %s: %s":                                    "Error creating directory %s: %s",
+	"  downloading %s":                                                   "  downloading %s",
+	"Failed downloading buildpack %s.\n%s":                               "Failed downloading buildpack %s.\n%s",
+	"Error writing %s: %s":                                               "Error writing %s: %s",
+	"Exported %d buildpacks":                                             "Exported %d buildpacks",
+	"Importing buildpacks from %s...":                                    "Importing buildpacks from %s...",
+	"  updating %s":                                                      "  updating %s",
+	"  creating %s":                                                      "  creating %s",
+	"Failed importing buildpack %s.\n%s":                                 "Failed importing buildpack %s.\n%s",
+	"Imported %d buildpacks":                                             "Imported %d buildpacks",
+	"Error reading %s: %s":                                               "Error reading %s: %s",
+	"Error parsing %s: %s":                                               "Error parsing %s: %s",
+	"Error parsing %s: every buildpack needs a name":                     "Error parsing %s: every buildpack needs a name",
+	"Getting buildpacks...\n":                                            "Getting buildpacks...\n",
+	"buildpack":                                                          "buildpack",
+	"position":                                                           "position",
+	"enabled":                                                            "enabled",
+	"locked":                                                             "locked",
+	"filename":                                                           "filename",
+	"No buildpacks found":                                                "No buildpacks found",
+	"Reordering buildpacks...":                                           "Reordering buildpacks...",
+	"Failed moving buildpack %s.\n%s":                                    "Failed moving buildpack %s.\n%s",
+	"%s\nThe original order could not be restored: %s":                   "%s\nThe original order could not be restored: %s",
+	"%s\nThe original order was restored.":                               "%s\nThe original order was restored.",
+	"Buildpack %s not found":                                             "Buildpack %s not found",
+	"Buildpack %s is listed more than once":                              "Buildpack %s is listed more than once",
+	"The order must include every buildpack. Missing: %s":                "The order must include every buildpack. Missing: %s",
+	"Failed verifying the buildpack order.\n%s":                          "Failed verifying the buildpack order.\n%s",
+	"The buildpack order could not be verified.\nExpected: %s\nActual:   %s": "The buildpack order could not be verified.\nExpected: %s\nActual:   %s",
+	"Updating buildpack %s...":                          "Updating buildpack %s...",
+	"Cannot specify both enabled and disabled options.": "Cannot specify both enabled and disabled options.",
+	"Cannot specify both lock and unlock options.":      "Cannot specify both lock and unlock options.",
+	"Cannot specify buildpack bits and lock/unlock.":    "Cannot specify buildpack bits and lock/unlock.",
+	"Error updating buildpack %s\n%s":                   "Error updating buildpack %s\n%s",
+	"Error uploading buildpack %s\n%s":                  "Error uploading buildpack %s\n%s",
+	"Validating buildpack %s...":                        "Validating buildpack %s...",
+	"layout:":                                           "layout:",
+	"files:":                                            "files:",
+	"size:":                                             "size:",
+	"sha1:":                                             "sha1:",
+	"Buildpack %s is not valid":                         "Buildpack %s is not valid",
+	"Error creating request:\n%s":                       "Error creating request:\n%s",
+	"Creating domain %s for org %s as %s...":            "Creating domain %s for org %s as %s...",
+	"Creating shared domain %s as %s...":                "Creating shared domain %s as %s...",
+	"Deleting domain %s as %s...":                       "Deleting domain %s as %s...",
+	"Error finding domain %s\n%s":                       "Error finding domain %s\n%s",
+	"Are you sure you want to delete the domain %s and all of its associations?": "Are you sure you want to delete the domain %s and all of its associations?",
+	"Error deleting domain %s\n%s": "Error deleting domain %s\n%s",
+	"This domain is shared across all orgs.\nDeleting it will remove all associated routes, and will make any app with this domain unreachable.\nAre you sure you want to delete the domain %s? ": "This domain is shared across all orgs.\nDeleting it will remove all associated routes, and will make any app with this domain unreachable.\nAre you sure you want to delete the domain %s? ",
+	"Getting domains in org %s as %s...":    "Getting domains in org %s as %s...",
+	"status":                                "status",
+	"Failed fetching shared domains.\n%s":   "Failed fetching shared domains.\n%s",
+	"Failed fetching private domains.\n%s":  "Failed fetching private domains.\n%s",
+	"No domains found":                      "No domains found",
+	"shared":                                "shared",
+	"owned":                                 "owned",
+	"Getting command history...":            "Getting command history...",
+	"Error reading the command history: %s": "Error reading the command history: %s",
+	"No commands found":                     "No commands found",
+	"started":                               "started",
+	"user":                                  "user",
+	"api":                                   "api",
+	"org":                                   "org",
+	"command":                               "command",
+	"failed (%d)":                           "failed (%d)",
+	"ok":                                    "ok",
+	"Invalid value for --since: %s\nUse a duration like 2h or 3d, or a date like 2014-05-01": "Invalid value for --since: %s\nUse a duration like 2h or 3d, or a date like 2014-05-01",
+	"API endpoint%s":                                                  "API endpoint%s",
+	"Unable to authenticate.":                                         "Unable to authenticate.",
+	"Single sign-on is not supported by %s":                           "Single sign-on is not supported by %s",
+	"Error finding avilable orgs\n%s":                                 "Error finding avilable orgs\n%s",
+	"Error finding org %s\n%s":                                        "Error finding org %s\n%s",
+	"Select an org (or press enter to skip):":                         "Select an org (or press enter to skip):",
+	"Targeted org %s\n":                                               "Targeted org %s\n",
+	"Error finding available spaces\n%s":                              "Error finding available spaces\n%s",
+	"Error finding space %s\n%s":                                      "Error finding space %s\n%s",
+	"Select a space (or press enter to skip):":                        "Select a space (or press enter to skip):",
+	"Targeted space %s\n":                                             "Targeted space %s\n",
+	"There are too many options to display, please type in the name.": "There are too many options to display, please type in the name.",
+	"Logging out...":                                                  "Logging out...",
+	"Creating org %s as %s...":                                        "Creating org %s as %s...",
+	"Org %s already exists":                                           "Org %s already exists",
+	"\nTIP: Use '%s' to target new org":                               "\nTIP: Use '%s' to target new org",
+	"Creating quota %s as %s...":                                      "Creating quota %s as %s...",
+	"Quota %s already exists":                                         "Quota %s already exists",
+	"TIP: use '%s' to change its limits":                              "TIP: use '%s' to change its limits",
+	"Really delete the quota %s?%s":                                   "Really delete the quota %s?%s",
+	"Deleting quota %s as %s...":                                      "Deleting quota %s as %s...",
+	"Quota %s does not exist":                                         "Quota %s does not exist",
+	"Getting orgs as %s...\n":                                         "Getting orgs as %s...\n",
+	"Failed fetching orgs.\n%s":                                       "Failed fetching orgs.\n%s",
+	"No orgs found":                                                   "No orgs found",
+	"Getting quotas as %s...":                                         "Getting quotas as %s...",
+	"total memory limit":                                              "total memory limit",
+	"instance memory limit":                                           "instance memory limit",
+	"routes":                                                          "routes",
+	"service instances":                                               "service instances",
+	"paid service plans":                                              "paid service plans",
+	"unlimited":                                                       "unlimited",
+	"allowed":                                                         "allowed",
+	"disallowed":                                                      "disallowed",
+	"Total memory limit cannot be unlimited":                          "Total memory limit cannot be unlimited",
+	"Paid service plans cannot be both allowed and disallowed":        "Paid service plans cannot be both allowed and disallowed",
+	"Renaming org %s to %s as %s...":                                  "Renaming org %s to %s as %s...",
+	"Setting quota %s to org %s as %s...":                             "Setting quota %s to org %s as %s...",
+	"Getting info for org %s as %s...":                                "Getting info for org %s as %s...",
+	"%s (%dM memory limit)":                                           "%s (%dM memory limit)",
+	"  domains: %s":                                                   "  domains: %s",
+	"  quota:   %s":                                                   "  quota:   %s",
+	"  spaces:  %s":                                                   "  spaces:  %s",
+	"Getting quota %s info as %s...":                                  "Getting quota %s info as %s...",
+	"total memory limit:":                                             "total memory limit:",
+	"instance memory limit:":                                          "instance memory limit:",
+	"routes:":                                                         "routes:",
+	"service instances:":                                              "service instances:",
+	"paid service plans:":                                             "paid service plans:",
+	"Updating quota %s as %s...":                                      "Updating quota %s as %s...",
+	"Getting usage for all orgs as %s...":                             "Getting usage for all orgs as %s...",
+	"Getting usage for org %s as %s...":                               "Getting usage for org %s as %s...",
+	"Failed fetching usage for org %s.\n%s":                           "Failed fetching usage for org %s.\n%s",
+	"%d of %d orgs are close to their quota limits: %s":               "%d of %d orgs are close to their quota limits: %s",
+	"quota":                                "quota",
+	"No spaces found":                      "No spaces found",
+	"apps":                                 "apps",
+	"Org %s is close to its quota limits.": "Org %s is close to its quota limits.",
+	"%s of unlimited":                      "%s of unlimited",
+	"%s of %s (%d%%)":                      "%s of %s (%d%%)",
+	"Current Password%s":                   "Current Password%s",
+	"New Password%s":                       "New Password%s",
+	"Verify Password%s":                    "Verify Password%s",
+	"Password verification does not match": "Password verification does not match",
+	"Changing password...":                 "Changing password...",
+	"Current password did not match":       "Current password did not match",
+	"Please log in again":                  "Please log in again",
+	"Checking route %s as %s...":           "Checking route %s as %s...",
+	"none":                                 "none",
+	"route:":                               "route:",
+	"space:":                               "space:",
+	"apps:":                                "apps:",
+	"Route %s belongs to space %s and can't be used in space %s":                                "Route %s belongs to space %s and can't be used in space %s",
+	"Route %s belongs to space %s and can be mapped to its apps":                                "Route %s belongs to space %s and can be mapped to its apps",
+	"Route %s was not found in the spaces visible to you, it may still be taken by another org": "Route %s was not found in the spaces visible to you, it may still be taken by another org",
+	"Route %s is already taken by a space you don't have access to":                             "Route %s is already taken by a space you don't have access to",
+	"Route %s does not exist and can be created in space %s":                                    "Route %s does not exist and can be created in space %s",
+	"Creating route %s for org %s / space %s as %s...":                                          "Creating route %s for org %s / space %s as %s...",
+	"Route %s already exists":                                                                   "Route %s already exists",
+	"Getting routes not mapped to any app in org %s / space %s as %s...":                        "Getting routes not mapped to any app in org %s / space %s as %s...",
+	"Failed fetching routes.\n%s":                                                               "Failed fetching routes.\n%s",
+	"No orphaned routes found":                                                                  "No orphaned routes found",
+	"route":                                                                                     "route",
+	"Really delete these %d routes?%s":                                                          "Really delete these %d routes?%s",
+	"Deleting orphaned routes as %s...":                                                         "Deleting orphaned routes as %s...",
+	"Could not delete route %s: %s":                                                             "Could not delete route %s: %s",
+	"Failed deleting %d of %d orphaned routes":                                                  "Failed deleting %d of %d orphaned routes",
+	"Deleted %d orphaned routes":                                                                "Deleted %d orphaned routes",
+	"Really delete route %s?%s":                                                                 "Really delete route %s?%s",
+	"Deleting route %s...":                                                                      "Deleting route %s...",
+	"Route %s does not exist.":                                                                  "Route %s does not exist.",
+	"Getting routes as %s ...\n":                                                                "Getting routes as %s ...\n",
+	"host":                                                                                      "host",
+	"domain":                                                                                    "domain",
+	"No routes found":                                                                           "No routes found",
+	"Getting routes not mapped to any app as %s ...\n":                                          "Getting routes not mapped to any app as %s ...\n",
+	"Error resolving route:\n%s":                                                                "Error resolving route:\n%s",
+	"Adding route %s to app %s in org %s / space %s as %s...":                                   "Adding route %s to app %s in org %s / space %s as %s...",
+	"Removing route %s from app %s in org %s / space %s as %s...":                               "Removing route %s from app %s in org %s / space %s as %s...",
+	"Error finding command %s":                                                                  "Error finding command %s",
+	"Binding service %s to app %s in org %s / space %s as %s...":                                "Binding service %s to app %s in org %s / space %s as %s...",
+	"App %s is already bound to %s.":                                                            "App %s is already bound to %s.",
+	"TIP: Use '%s push' to ensure your env variable changes take effect":                        "TIP: Use '%s push' to ensure your env variable changes take effect",
+	"Creating service %s in org %s / space %s as %s...":                                         "Creating service %s in org %s / space %s as %s...",
+	"Service %s already exists":                                                                 "Service %s already exists",
+	"Could not find any offerings with name %s":                                                 "Could not find any offerings with name %s",
+	"Could not find plan with name %s":                                                          "Could not find plan with name %s",
+	"Creating user provided service %s in org %s / space %s as %s...":                           "Creating user provided service %s in org %s / space %s as %s...",
+	"Are you sure you want to delete the service %s ?":                                          "Are you sure you want to delete the service %s ?",
+	"Deleting service %s in org %s / space %s as %s...":                                         "Deleting service %s in org %s / space %s as %s...",
+	"Service %s does not exist.":                                                                "Service %s does not exist.",
+	"Getting services in org %s / space %s as %s...":                                            "Getting services in org %s / space %s as %s...",
+	"No services found":                                                                         "No services found",
+	"service":                                                                                   "service",
+	"plan":                                                                                      "plan",
+	"bound apps":                                                                                "bound apps",
+	"user-provided":                                                                             "user-provided",
+	"services from marketplace":                                                                 "services from marketplace",
+	"service plan information for service %s":                                                   "service plan information for service %s",
+	"Getting %s in org %s / space %s as %s...":                                                  "Getting %s in org %s / space %s as %s...",
+	"all services from marketplace":                                                             "all services from marketplace",
+	"Getting %s...":                                                                             "Getting %s...",
+	"Cannot list marketplace services without a targeted space":                                 "Cannot list marketplace services without a targeted space",
+	"No service offerings found":                                                                "No service offerings found",
+	"plans":                                                                                     "plans",
+	"Service offering %s not found":                                                             "Service offering %s not found",
+	"service:":                                                                                  "service:",
+	"description:":                                                                              "description:",
+	"provider:":                                                                                 "provider:",
+	"documentation:":                                                                            "documentation:",
+	"paid":                                                                                      "paid",
+	"free":                                                                                      "free",
+	"No service plans found":                                                                    "No service plans found",
+	"service plan":                                                                              "service plan",
+	"free or paid":                                                                              "free or paid",
+	"display name":                                                                              "display name",
+	"costs":                                                                                     "costs",
+	"features":                                                                                  "features",
+	"Plan %s cannot be found":                                                                   "Plan %s cannot be found",
+	"Plan %s has no service instances to migrate":                                               "Plan %s has no service instances to migrate",
+	"Really migrate %s from plan %s to %s?>":                                                    "Really migrate %s from plan %s to %s?>",
+	"Attempting to migrate %s...":                                                               "Attempting to migrate %s...",
+	"%s migrated.":                                                                              "%s migrated.",
+	"%d service instance":                                                                       "%d service instance",
+	"%d service instances":                                                                      "%d service instances",
+	"Service offering does not exist\nTIP: If you are trying to purge a v1 service offering, you must set the -p flag.": "Service offering does not exist\nTIP: If you are trying to purge a v1 service offering, you must set the -p flag.",
+	"Warning: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.": "Warning: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.",
+	"Really purge service offering %s from Cloud Foundry?":                   "Really purge service offering %s from Cloud Foundry?",
+	"Purging service %s...":                                                  "Purging service %s...",
+	"Renaming service %s to %s in org %s / space %s as %s...":                "Renaming service %s to %s in org %s / space %s as %s...",
+	"%s\nTIP: Use '%s services' to view all services in this org and space.": "%s\nTIP: Use '%s services' to view all services in this org and space.",
+	"Invalid configuration provided for -c flag. Please provide a valid JSON object or the path to a file containing a valid JSON object.": "Invalid configuration provided for -c flag. Please provide a valid JSON object or the path to a file containing a valid JSON object.",
+	"Service instance: %s":  "Service instance: %s",
+	"Service: %s":           "Service: %s",
+	"Plan: %s":              "Plan: %s",
+	"Description: %s":       "Description: %s",
+	"Documentation url: %s": "Documentation url: %s",
+	"Unbinding app %s from service %s in org %s / space %s as %s...":                                                               "Unbinding app %s from service %s in org %s / space %s as %s...",
+	"Binding between %s and %s did not exist":                                                                                      "Binding between %s and %s did not exist",
+	"Updating service instance %s in org %s / space %s as %s...":                                                                   "Updating service instance %s in org %s / space %s as %s...",
+	"Service instance %s is user-provided and has no plan.\nTIP: Use '%s update-user-provided-service' to change its credentials.": "Service instance %s is user-provided and has no plan.\nTIP: Use '%s update-user-provided-service' to change its credentials.",
+	"Service instance %s already uses plan %s":                                                                                     "Service instance %s already uses plan %s",
+	"%s\nTIP: Use '%s marketplace' to see the plans of service %s.":                                                                "%s\nTIP: Use '%s marketplace' to see the plans of service %s.",
+	"The service broker refused to update service instance %s%s.\n%s":                                                              "The service broker refused to update service instance %s%s.\n%s",
+	" from plan %s to plan %s":                                                                                                     " from plan %s to plan %s",
+	"Service Instance is not user provided":                                                                                        "Service Instance is not user provided",
+	"JSON is invalid: %s":                                                                                                          "JSON is invalid: %s",
+	"Updating user provided service %s in org %s / space %s as %s...":                                                              "Updating user provided service %s in org %s / space %s as %s...",
+	"TIP: To make these changes take effect, use '%s unbind-service' to unbind the service, '%s bind-service' to rebind, and then '%s push' to update the app with the new env variables": "TIP: To make these changes take effect, use '%s unbind-service' to unbind the service, '%s bind-service' to rebind, and then '%s push' to update the app with the new env variables",
+	"No flags specified. No changes were made.":      "No flags specified. No changes were made.",
+	"Disabling access to %s for %s as %s...":         "Disabling access to %s for %s as %s...",
+	"Failed fetching service plan visibilities.\n%s": "Failed fetching service plan visibilities.\n%s",
+	"Failed disabling access to plan %s.\n%s":        "Failed disabling access to plan %s.\n%s",
+	"Plan %s is public and remains accessible to org %s.\nTIP: Use '%s disable-service-access %s -p %s' and then enable it for each org that should keep access.": "Plan %s is public and remains accessible to org %s.\nTIP: Use '%s disable-service-access %s -p %s' and then enable it for each org that should keep access.",
+	"Enabling access to %s for %s as %s...":                "Enabling access to %s for %s as %s...",
+	"Failed enabling access to plan %s.\n%s":               "Failed enabling access to plan %s.\n%s",
+	"Failed enabling access to plan %s for org %s.\n%s":    "Failed enabling access to plan %s for org %s.\n%s",
+	"Plan %s is public and already accessible to all orgs": "Plan %s is public and already accessible to all orgs",
+	"Getting service access as %s...":                      "Getting service access as %s...",
+	"Failed fetching service offerings.\n%s":               "Failed fetching service offerings.\n%s",
+	"access":                                               "access",
+	"orgs":                                                 "orgs",
+	"all":                                                  "all",
+	"limited":                                              "limited",
+	"Service plan %s not found for service %s":             "Service plan %s not found for service %s",
+	"Service %s has no plans":                              "Service %s has no plans",
+	"all plans of service %s":                              "all plans of service %s",
+	"plan %s of service %s":                                "plan %s of service %s",
+	"all orgs":                                             "all orgs",
+	"Creating service auth token as %s...":                 "Creating service auth token as %s...",
+	"Are you sure you want to delete %s?%s":                "Are you sure you want to delete %s?%s",
+	"Deleting service auth token as %s":                    "Deleting service auth token as %s",
+	"Service Auth Token %s %s does not exist.":             "Service Auth Token %s %s does not exist.",
+	"Getting service auth tokens as %s...":                 "Getting service auth tokens as %s...",
+	"label":                                                "label",
+	"provider":                                             "provider",
+	"Updating service auth token as %s...":                 "Updating service auth token as %s...",
+	"Creating service broker %s as %s...":                  "Creating service broker %s as %s...",
+	"%s\nTIP: Use '%s validate-service-broker %s' to check the broker's catalog": "%s\nTIP: Use '%s validate-service-broker %s' to check the broker's catalog",
+	"Deleting service broker %s as %s...":                                        "Deleting service broker %s as %s...",
+	"Service Broker %s does not exist.":                                          "Service Broker %s does not exist.",
+	"Getting service brokers as %s...\n":                                         "Getting service brokers as %s...\n",
+	"url":                                                                        "url",
+	"Failed fetching service brokers.\n%s":                                       "Failed fetching service brokers.\n%s",
+	"No service brokers found":                                                   "No service brokers found",
+	"Renaming service broker %s to %s as %s":                                     "Renaming service broker %s to %s as %s",
+	"Updating service broker %s as %s...":                                        "Updating service broker %s as %s...",
+	"Validating catalog of service broker at %s...":                              "Validating catalog of service broker at %s...",
+	"Could not fetch the catalog.\n%s":                                           "Could not fetch the catalog.\n%s",
+	"Found %d problems in the catalog":                                           "Found %d problems in the catalog",
+	"Catalog is valid: %d services with %d plans":                                "Catalog is valid: %d services with %d plans",
+	"TIP: Use '%s create-service-broker' to register the broker":                 "TIP: Use '%s create-service-broker' to register the broker",
+	"Creating space %s in org %s as %s...":                                       "Creating space %s in org %s as %s...",
+	"Org %s does not exist or is not accessible":                                 "Org %s does not exist or is not accessible",
+	"Space %s already exists":                                                    "Space %s already exists",
+	"\nTIP: Use '%s' to target new space":                                        "\nTIP: Use '%s' to target new space",
+	"Getting spaces in org %s as %s...\n":                                        "Getting spaces in org %s as %s...\n",
+	"Failed fetching spaces.\n%s":                                                "Failed fetching spaces.\n%s",
+	"Renaming space %s to %s in org %s as %s...":                                 "Renaming space %s to %s in org %s as %s...",
+	"Getting info for space %s in org %s as %s...":                               "Getting info for space %s in org %s as %s...",
+	"  Org: %s":      "  Org: %s",
+	"  Apps: %s":     "  Apps: %s",
+	"  Domains: %s":  "  Domains: %s",
+	"  Services: %s": "  Services: %s",
+	"Getting stacks in org %s / space %s as %s...":                       "Getting stacks in org %s / space %s as %s...",
+	"Could not target org.\n%s":                                          "Could not target org.\n%s",
+	"An org must be targeted before targeting a space":                   "An org must be targeted before targeting a space",
+	"Unable to access space %s.\n%s":                                     "Unable to access space %s.\n%s",
+	"Creating user %s as %s...":                                          "Creating user %s as %s...",
+	"Error creating user %s.\n%s":                                        "Error creating user %s.\n%s",
+	"\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'": "\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'",
+	"Really delete user %s?%s":                                           "Really delete user %s?%s",
+	"Deleting user %s as %s...":                                          "Deleting user %s as %s...",
+	"User %s does not exist.":                                            "User %s does not exist.",
+	"Failed fetching users in org %s.\n%s":                               "Failed fetching users in org %s.\n%s",
+	"Checking users in %s as %s (dry run, no changes will be made)...":   "Checking users in %s as %s (dry run, no changes will be made)...",
+	"Importing users from %s as %s...":                                   "Importing users from %s as %s...",
+	"Could not read %s\n%s":                                              "Could not read %s\n%s",
+	"No users found in %s":                                               "No users found in %s",
+	"failed: %s":                                                         "failed: %s",
+	"line":                                                               "line",
+	"username":                                                           "username",
+	"result":                                                             "result",
+	"%d of %d rows could not be imported":                                "%d of %d rows could not be imported",
+	"assigned %s in %s":                                                  "assigned %s in %s",
+	"assigned %s in %s / %s":                                             "assigned %s in %s / %s",
+	"would assign %s in %s":                                              "would assign %s in %s",
+	"would assign %s in %s / %s":                                         "would assign %s in %s / %s",
+	"user exists":                                                        "user exists",
+	"user does not exist and no password was given":                      "user does not exist and no password was given",
+	"would create user":                                                  "would create user",
+	"created user":                                                       "created user",
+	"ORG MANAGER":                                                        "ORG MANAGER",
+	"BILLING MANAGER":                                                    "BILLING MANAGER",
+	"ORG AUDITOR":                                                        "ORG AUDITOR",
+	"USERS":                                                              "USERS",
+	"Getting users in org %s as %s...":                                   "Getting users in org %s as %s...",
+	"Failed fetching org-users for role %s.\n%s":                         "Failed fetching org-users for role %s.\n%s",
+	"Assigning role %s to user %s in org %s as %s...":                    "Assigning role %s to user %s in org %s as %s...",
+	"Assigning role %s to user %s in org %s / space %s as %s...":         "Assigning role %s to user %s in org %s / space %s as %s...",
+	"SPACE MANAGER":                                                      "SPACE MANAGER",
+	"SPACE DEVELOPER":                                                    "SPACE DEVELOPER",
+	"SPACE AUDITOR":                                                      "SPACE AUDITOR",
+	"Getting users in org %s / space %s as %s":                           "Getting users in org %s / space %s as %s",
+	"Failed fetching space-users for role %s.\n%s":                       "Failed fetching space-users for role %s.\n%s",
+	"Removing role %s from user %s in org %s as %s...":                   "Removing role %s from user %s in org %s as %s...",
+	"Removing role %s from user %s in org %s / space %s as %s...":        "Removing role %s from user %s in org %s / space %s as %s...",
+	"Getting roles of user %s as %s...":                                  "Getting roles of user %s as %s...",
+	"Failed fetching roles.\n%s":                                         "Failed fetching roles.\n%s",
+	"User %s has no org or space roles":                                  "User %s has no org or space roles",
+	"role":                                                               "role",
+	"Failed fetching org %s.\n%s":                                        "Failed fetching org %s.\n%s",
+	"username is missing":                                                "username is missing",
+	"org is missing":                                                     "org is missing",
+	"invalid org role %s":                                                "invalid org role %s",
+	"space role is missing":                                              "space role is missing",
+	"space is missing":                                                   "space is missing",
+	"invalid space role %s":                                              "invalid space role %s",
+	"line %d has %d columns, expected at most %d":                        "line %d has %d columns, expected at most %d",
+	"user:    %s":                                                        "user:    %s",
+	"email:   %s":                                                        "email:   %s",
+	"id:      %s":                                                        "id:      %s",
+	"client:  %s":                                                        "client:  %s",
+	"scopes:  %s":                                                        "scopes:  %s",
+	"expires: %s":                                                        "expires: %s",
+	"unknown":                                                            "unknown",
+	"%s (expired, it will be refreshed on the next request)":             "%s (expired, it will be refreshed on the next request)",
+	"%s (in %s)":                                                         "%s (in %s)",
+	"ALIAS:":                                                             "ALIAS:",
+	"OPTIONS:":                                                           "OPTIONS:",
+	"%s (dry run)":                                                       "%s (dry run)",
+	"Refusing to download %s, it is not a file or directory name": "Refusing to download %s, it is not a file or directory name",
+	"error: %s": "error: %s",
+	"No API endpoint targeted. Use '%s' or '%s' to target an endpoint.": "No API endpoint targeted. Use '%s' or '%s' to target an endpoint.",
+	"No org and space targeted, use '%s' to target an org and space":    "No org and space targeted, use '%s' to target an org and space",
+	"No space targeted, use '%s' to target a space":                     "No space targeted, use '%s' to target a space",
+	"No org targeted, use '%s' to target an org.":                       "No org targeted, use '%s' to target an org.",
+	"Config error: %s": "Config error: %s",
+}
@@ -0,0 +1,857 @@
+package i18n
+
+// Japanese
+var ja_JP = map[string]string{
+	"OK":               "OK",
+	"FAILED":           "失敗",
+	"Incorrect Usage.": "使用方法が正しくありません。",
+	"y":                "y",
+	"yes":              "はい",
+	"Please use '%s api' to set an API endpoint and then '%s login' to login.": "'%s api' で API エンドポイントを設定してから、'%s login' でログインしてください。",
+	"Not logged in. Use '%s' to log in.":                                       "ログインしていません。'%s' でログインしてください。",
+	"API endpoint: %s (API version: %s)":                                       "API エンドポイント: %s (API バージョン: %s)",
+	"User:":                                                                    "ユーザー:",
+	"Org:":                                                                     "組織:",
+	"Space:":                                                                   "スペース:",
+	"No org or space targeted, use '%s'":                                       "組織とスペースがターゲットになっていません。'%s' を使用してください",
+	"No org targeted, use '%s'":                                                "組織がターゲットになっていません。'%s' を使用してください",
+	"No space targeted, use '%s'":                                              "スペースがターゲットになっていません。'%s' を使用してください",
+	"NAME:":                                                                    "名前:",
+	"USAGE:":                                                                   "使用法:",
+	"VERSION:":                                                                 "バージョン:",
+	"ENVIRONMENT VARIABLES":                                                    "環境変数",
+	"GLOBAL OPTIONS":                                                           "グローバル・オプション",
+	"GETTING STARTED":                                                          "はじめに",
+	"APPS":                                                                     "アプリ",
+	"SERVICES":                                                                 "サービス",
+	"ORGS":                                                                     "組織",
+	"SPACES":                                                                   "スペース",
+	"DOMAINS":                                                                  "ドメイン",
+	"ROUTES":                                                                   "ルート",
+	"BUILDPACKS":                                                               "ビルドパック",
+	"USER ADMIN":                                                               "ユーザー管理",
+	"ORG ADMIN":                                                                "組織管理",
+	"SERVICE ADMIN":                                                            "サービス管理",
+	"ADVANCED":                                                                 "高度な機能",
+	"Deleting space %s will also delete:":                                      "スペース %s を削除すると、次のものも削除されます:",
+	"Failed fetching the contents of space %s.\n%s":                         "スペース %s の内容を取得できませんでした。\n%s",
+	"Dry run, space %s was not deleted":                                     "ドライランのため、スペース %s は削除されませんでした",
+	"Really delete space %s and everything associated with it?%s":           "スペース %s とそれに関連するすべてのものを削除しますか?%s",
+	"Deleting space %s in org %s as %s...":                                  "%[3]s として組織 %[2]s のスペース %[1]s を削除しています...",
+	"TIP: No space targeted, use '%s target -s' to target a space":          "ヒント: スペースがターゲットになっていません。'%s target -s' でスペースをターゲットにしてください",
+	"no apps, service instances or routes":                                  "アプリ、サービス・インスタンス、ルートはありません",
+	"app %s (%s)":                                                           "アプリ %s (%s)",
+	"service instance %s (user-provided)":                                   "サービス・インスタンス %s (ユーザー提供)",
+	"service instance %s (%s, will be deprovisioned by its service broker)": "サービス・インスタンス %s (%s、サービス・ブローカーによってプロビジョン解除されます)",
+	"route %s":                 "ルート %s",
+	"1 instance":               "1 インスタンス",
+	"%d instances":             "%d インスタンス",
+	"Deleting org %s as %s...": "%[2]s として組織 %[1]s を削除しています...",
+	"Org %s does not exist.":   "組織 %s は存在しません。",
+	"Failed fetching the contents of org %s.\n%s":               "組織 %s の内容を取得できませんでした。\n%s",
+	"Dry run, org %s was not deleted":                           "ドライランのため、組織 %s は削除されませんでした",
+	"Really delete org %s and everything associated with it?%s": "組織 %s とそれに関連するすべてのものを削除しますか?%s",
+	"Deleting org %s will also delete:":                         "組織 %s を削除すると、次のものも削除されます:",
+	"no spaces":                                                 "スペースはありません",
+	"space %s":                                                  "スペース %s",
+	"private domain %s":                                         "プライベート・ドメイン %s",
+	"Input required, but prompts are disabled in non-interactive mode: %s": "入力が必要ですが、非対話モードではプロンプトが無効です: %s",
+	"EXIT STATUS": "終了ステータス",
+	"Show help":   "ヘルプを表示します",
+	"A command line tool to interact with Cloud Foundry":                         "Cloud Foundry を操作するためのコマンドライン・ツール",
+	"Print the requests that would change anything instead of sending them":      "変更を伴う要求を送信せずに出力します",
+	"Fail instead of prompting for input":                                        "入力を求める代わりに失敗します",
+	"Set or view target api url":                                                 "ターゲット API の URL を設定または表示します",
+	"EXAMPLE:":                                                                   "例:",
+	"trust the certificates signed by an internal CA":                            "社内 CA が署名した証明書を信頼します",
+	"Trust the PEM encoded CA certificates in this file, defaults to CF_CA_CERT": "このファイルの PEM 形式の CA 証明書を信頼します (デフォルトは CF_CA_CERT)",
+	"PEM encoded client certificate, for endpoints requiring mutual TLS":         "PEM 形式のクライアント証明書 (相互 TLS を必要とするエンドポイント用)",
+	"PEM encoded private key of the client certificate":                          "クライアント証明書の PEM 形式の秘密鍵",
+	"Please don't":                      "使用しないでください",
+	"Display health and status for app": "アプリの正常性と状況を表示します",
+	"SORT KEYS:":                        "ソートキー:",
+	"Refresh the instance list until interrupted":             "中断されるまでインスタンスのリストを更新します",
+	"Sort instances by the given key when watching":           "監視中に指定したキーでインスタンスをソートします",
+	"Seconds between refreshes when watching (defaults to 5)": "監視中の更新間隔 (秒) (デフォルトは 5)",
+	"List all apps in the target space":                       "ターゲット・スペースのすべてのアプリをリストします",
+	"Authenticate user non-interactively":                     "ユーザーを非対話式に認証します",
+	"WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history": "警告:\n   コマンドライン・オプションでパスワードを指定することは強く推奨されません\n   パスワードが他のユーザーに見えたり、シェルの履歴に記録されたりする可能性があります",
+	"use quotes for passwords with a space":                                         "スペースを含むパスワードには引用符を使用します",
+	"escape quotes if used in password":                                             "パスワードに引用符を使用する場合はエスケープします",
+	"authenticate a service account as an OAuth client":                             "サービス・アカウントを OAuth クライアントとして認証します",
+	"Authenticate as an OAuth client, with its ID and secret, instead of a user":    "ユーザーの代わりに、ID とシークレットを使用して OAuth クライアントとして認証します",
+	"Bind a service instance to an app":                                             "サービス・インスタンスをアプリにバインドします",
+	"List all buildpacks":                                                           "すべてのビルドパックをリストします",
+	"Check whether a route exists and whether it can be used in the targeted space": "ルートが存在するかどうか、およびターゲット・スペースで使用できるかどうかを確認します",
+	"Create a buildpack":                                                            "ビルドパックを作成します",
+	"TIP:":                                                                          "ヒント:",
+	"Path should be a zip file, a url to a zip file, or a local directory. Position is an integer, sets priority, and is sorted from lowest to highest.": "パスには zip ファイル、zip ファイルの URL、またはローカル・ディレクトリーを指定します。位置は整数で優先順位を設定し、小さい順にソートされます。",
+	"Enable the buildpack":                         "ビルドパックを有効にします",
+	"Disable the buildpack":                        "ビルドパックを無効にします",
+	"Create a domain in an org for later use":      "後で使用するために組織内にドメインを作成します",
+	"Create an org":                                "組織を作成します",
+	"Define a new resource quota":                  "新しいリソース・クォータを定義します",
+	"Total amount of memory (e.g. 1024M, 1G, 10G)": "メモリーの合計量 (例: 1024M、1G、10G)",
+	"Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G), -1 means unlimited": "アプリケーション・インスタンスが使用できるメモリーの最大量 (例: 1024M、1G、10G)。-1 は無制限",
+	"Total number of routes, -1 means unlimited":                                                          "ルートの合計数。-1 は無制限",
+	"Total number of service instances, -1 means unlimited":                                               "サービス・インスタンスの合計数。-1 は無制限",
+	"Can provision instances of paid service plans":                                                       "有料サービス・プランのインスタンスをプロビジョンできます",
+	"Create a url route in a space for later use":                                                         "後で使用するためにスペース内に URL ルートを作成します",
+	"Hostname":                  "ホスト名",
+	"Create a service instance": "サービス・インスタンスを作成します",
+	"Use '%s' to make user-provided services available to cf apps":                                                 "'%s' を使用して、ユーザー提供サービスを cf アプリで使用できるようにします",
+	"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file": "サービス固有の構成パラメーターを含む有効な JSON オブジェクト (インラインまたはファイルで指定)",
+	"Create a service auth token": "サービス認証トークンを作成します",
+	"Create a service broker":     "サービス・ブローカーを作成します",
+	"Create a space":              "スペースを作成します",
+	"Organization":                "組織",
+	"Create a new user":           "新規ユーザーを作成します",
+	"Make a user-provided service available to cf apps":                "ユーザー提供サービスを cf アプリで使用できるようにします",
+	"Pass comma separated parameter names to enable interactive mode:": "対話モードを有効にするには、コンマ区切りのパラメーター名を渡します:",
+	"Pass parameters as JSON to create a service non-interactively:":   "非対話式にサービスを作成するには、パラメーターを JSON として渡します:",
+	"Parameters":       "パラメーター",
+	"Syslog Drain Url": "Syslog ドレイン URL",
+	"Executes a raw request, content-type set to application/json by default":        "生の要求を実行します。content-type はデフォルトで application/json に設定されます",
+	"Custom headers to include in the request, flag can be specified multiple times": "要求に含めるカスタム・ヘッダー。このフラグは複数回指定できます",
+	"HTTP data to include in the request body":                                       "要求本体に含める HTTP データ",
+	"Include response headers in the output":                                         "出力に応答ヘッダーを含めます",
+	"Enable CF_TRACE output for all requests and responses":                          "すべての要求と応答の CF_TRACE 出力を有効にします",
+	"Delete an app":                       "アプリを削除します",
+	"Force deletion without confirmation": "確認せずに強制的に削除します",
+	"Delete a buildpack":                  "ビルドパックを削除します",
+	"Delete a domain":                     "ドメインを削除します",
+	"Delete a shared domain":              "共有ドメインを削除します",
+	"Delete an org":                       "組織を削除します",
+	"List everything that would be deleted without deleting it, implied by the global --dry-run": "削除せずに、削除されるものをすべてリストします (グローバルな --dry-run でも有効)",
+	"Delete the routes in the target space that are not mapped to any app":                       "ターゲット・スペース内の、どのアプリにもマップされていないルートを削除します",
+	"Delete a quota":              "クォータを削除します",
+	"Delete a route":              "ルートを削除します",
+	"Delete a service instance":   "サービス・インスタンスを削除します",
+	"Delete a service auth token": "サービス認証トークンを削除します",
+	"Delete a service broker":     "サービス・ブローカーを削除します",
+	"Delete a space":              "スペースを削除します",
+	"Delete a user":               "ユーザーを削除します",
+	"Disable access to a service or service plan for one or all orgs":                             "1 つまたはすべての組織について、サービスまたはサービス・プランへのアクセスを無効にします",
+	"Disable access to a particular service plan":                                                 "特定のサービス・プランへのアクセスを無効にします",
+	"Disable access for a particular organization":                                                "特定の組織のアクセスを無効にします",
+	"Enable access to a service or service plan for one or all orgs":                              "1 つまたはすべての組織について、サービスまたはサービス・プランへのアクセスを有効にします",
+	"Enable access to a particular service plan":                                                  "特定のサービス・プランへのアクセスを有効にします",
+	"Enable access for a particular organization":                                                 "特定の組織のアクセスを有効にします",
+	"List domains in the target org":                                                              "ターゲット組織のドメインをリストします",
+	"Show all env variables for an app":                                                           "アプリのすべての環境変数を表示します",
+	"Show recent app events":                                                                      "アプリの最近のイベントを表示します",
+	"Event types are crash, create, update and delete, several can be given separated by commas.": "イベント・タイプは crash、create、update、delete で、コンマで区切って複数指定できます。",
+	"Dates are given as YYYY-MM-DD or as YYYY-MM-DDTHH:MM:SS in local time.":                      "日付は YYYY-MM-DD または YYYY-MM-DDTHH:MM:SS の形式で、ローカル時刻で指定します。",
+	"Show events for every app in the targeted space":                                             "ターゲット・スペースのすべてのアプリのイベントを表示します",
+	"Show events for every app in the targeted org":                                               "ターゲット組織のすべてのアプリのイベントを表示します",
+	"Only show events of the given types":                                                         "指定したタイプのイベントのみを表示します",
+	"Only show events caused by the given user":                                                   "指定したユーザーが発生させたイベントのみを表示します",
+	"Only show events from this date on":                                                          "この日付以降のイベントのみを表示します",
+	"Only show events up to this date":                                                            "この日付までのイベントのみを表示します",
+	"Print events as csv or json instead of a table":                                              "イベントを表ではなく csv または json で出力します",
+	"Save all buildpacks with their settings and bits to a directory":                             "すべてのビルドパックを設定とビットとともにディレクトリーに保存します",
+	"Writes a zip file for each buildpack and a buildpacks.json file with\n   their names, positions and enabled/locked flags.": "ビルドパックごとの zip ファイルと、その名前、位置、および\n   有効/ロックのフラグを含む buildpacks.json ファイルを書き込みます。",
+	"Use '%s' to copy the buildpacks to another Cloud Foundry":                                                                  "'%s' を使用して、ビルドパックを別の Cloud Foundry にコピーします",
+	"Export the users of an org and their roles as CSV":                                                                         "組織のユーザーとそのロールを CSV としてエクスポートします",
+	"Org to export": "エクスポートする組織",
+	"Print out a list of files in a directory or the contents of a specific file":                        "ディレクトリー内のファイルのリスト、または特定のファイルの内容を出力します",
+	"list the logs directory of the fourth instance":                                                     "4 番目のインスタンスの logs ディレクトリーをリストします",
+	"recursively download the logs directory":                                                            "logs ディレクトリーを再帰的にダウンロードします",
+	"follow a log file as it grows":                                                                      "ログ・ファイルの増加を追跡します",
+	"show a file on every instance":                                                                      "すべてのインスタンスのファイルを表示します",
+	"Index of the app instance (defaults to 0)":                                                          "アプリ・インスタンスの索引 (デフォルトは 0)",
+	"Show the path on every instance, prefixing each line with the instance index":                       "すべてのインスタンスのパスを表示し、各行の先頭にインスタンスの索引を付けます",
+	"Recursively download the path to a local directory":                                                 "パスをローカル・ディレクトリーに再帰的にダウンロードします",
+	"Follow a file as it grows":                                                                          "ファイルの増加を追跡します",
+	"Show the commands run from this machine":                                                            "このマシンで実行したコマンドを表示します",
+	"Only show commands started since a duration ago (e.g. 2h, 3d) or a date (e.g. 2014-05-01)":          "指定した期間前 (例: 2h、3d) または日付 (例: 2014-05-01) 以降に開始したコマンドのみを表示します",
+	"Only show commands that failed":                                                                     "失敗したコマンドのみを表示します",
+	"Create or update buildpacks from a directory written by export-buildpacks":                          "export-buildpacks で書き込んだディレクトリーからビルドパックを作成または更新します",
+	"Buildpacks that already exist are updated, others are created.":                                     "既に存在するビルドパックは更新され、それ以外は作成されます。",
+	"Buildpacks that are not in DIR are left unchanged.":                                                 "DIR にないビルドパックは変更されません。",
+	"Create users and assign their org and space roles from a CSV file":                                  "CSV ファイルからユーザーを作成し、組織とスペースのロールを割り当てます",
+	"Each row has the columns username,password,org,org_role,space,space_role.":                          "各行の列は username,password,org,org_role,space,space_role です。",
+	"Users that already exist are not created again, rows for them can leave the password empty.":        "既に存在するユーザーは再作成されず、その行のパスワードは空のままにできます。",
+	"Org roles are OrgUser, OrgManager, BillingManager and OrgAuditor.":                                  "組織のロールは OrgUser、OrgManager、BillingManager、OrgAuditor です。",
+	"Space roles are SpaceManager, SpaceDeveloper and SpaceAuditor.":                                     "スペースのロールは SpaceManager、SpaceDeveloper、SpaceAuditor です。",
+	"Check the file and show what would be done without making changes, implied by the global --dry-run": "変更を加えずにファイルを確認し、実行される内容を表示します (グローバルな --dry-run でも有効)",
+	"Log user in": "ユーザーをログインさせます",
+	"omit username and password to login interactively -- %s will prompt for both": "対話式にログインするにはユーザー名とパスワードを省略します -- %s が両方の入力を求めます",
+	"specify username and password as arguments":                                   "ユーザー名とパスワードを引数として指定します",
+	"log in with a one-time passcode from your single sign-on provider":            "シングル・サインオン・プロバイダーのワンタイム・パスコードでログインします",
+	"Username":                            "ユーザー名",
+	"Password":                            "パスワード",
+	"Org":                                 "組織",
+	"Space":                               "スペース",
+	"Log in with a one-time passcode":     "ワンタイム・パスコードでログインします",
+	"Log user out":                        "ユーザーをログアウトさせます",
+	"Tail or show recent logs for an app": "アプリのログを追跡するか、最近のログを表示します",
+	"Dump recent logs instead of tailing": "追跡せずに最近のログをダンプします",
+	"List available offerings in the marketplace":                                     "マーケットプレイスで利用可能なサービスをリストします",
+	"Show plan details for a particular service offering":                             "特定のサービスのプランの詳細を表示します",
+	"Only show offerings whose name, description, provider or plans contain the term": "名前、説明、プロバイダー、またはプランにその語句を含むサービスのみを表示します",
+	"Only show offerings from the given service broker":                               "指定したサービス・ブローカーのサービスのみを表示します",
+	"Only show offerings from the given provider":                                     "指定したプロバイダーのサービスのみを表示します",
+	"Add a url route to an app":                                                       "URL ルートをアプリに追加します",
+	"Print a fresh OAuth token, e.g. for use with other tools":                        "新しい OAuth トークンを出力します (他のツールで使用する場合など)",
+	"Show org info":             "組織の情報を表示します",
+	"Show org users by role":    "組織のユーザーをロール別に表示します",
+	"List all users in the org": "組織内のすべてのユーザーをリストします",
+	"List all orgs":             "すべての組織をリストします",
+	"Change user password":      "ユーザーのパスワードを変更します",
+	"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker": "サービス・ブローカーに要求を送信せずに、サービスとその子オブジェクトを Cloud Foundry データベースから再帰的に削除します",
+	"WARNING:": "警告:",
+	"This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.": "この操作は、このサービスを担当するサービス・ブローカーが既に使用できなくなっており、すべてのサービス・インスタンスが削除されて、Cloud Foundry のデータベースに孤立したレコードが残っていることを前提としています。サービス・インスタンスとサービス・バインディングを含め、このサービスに関するすべての情報が Cloud Foundry から削除されます。サービス・ブローカーへの接続は試行されません。サービス・ブローカーを破棄せずにこのコマンドを実行すると、サービス・インスタンスが孤立します。このコマンドの実行後、クリーンアップを完了するために delete-service-auth-token または delete-service-broker を実行することができます。",
+	"Provider": "プロバイダー",
+	"Push a new app or sync changes to an existing app": "新規アプリをプッシュするか、既存のアプリに変更を同期します",
+	"Push a single app (with or without a manifest):":   "単一のアプリをプッシュします (マニフェストの有無にかかわらず):",
+	"Push multiple apps with a manifest:":               "マニフェストを使用して複数のアプリをプッシュします:",
+	"Custom buildpack by name (e.g. my-buildpack) or GIT URL (e.g. https://github.com/heroku/heroku-buildpack-play.git)": "名前 (例: my-buildpack) または GIT URL (例: https://github.com/heroku/heroku-buildpack-play.git) で指定するカスタム・ビルドパック",
+	"Startup command, set to null to reset to default start command":                                                     "始動コマンド。null に設定するとデフォルトの始動コマンドに戻ります",
+	"Domain (e.g. example.com)":                                        "ドメイン (例: example.com)",
+	"Path to manifest":                                                 "マニフェストのパス",
+	"Number of instances":                                              "インスタンス数",
+	"Memory limit (e.g. 256M, 1024M, 1G)":                              "メモリー制限 (例: 256M、1024M、1G)",
+	"Hostname (e.g. my-subdomain)":                                     "ホスト名 (例: my-subdomain)",
+	"Path of app directory or zip file":                                "アプリ・ディレクトリーまたは zip ファイルのパス",
+	"Stack to use":                                                     "使用するスタック",
+	"Start timeout in seconds":                                         "始動のタイムアウト (秒)",
+	"Map the root domain to this app":                                  "ルート・ドメインをこのアプリにマップします",
+	"Ignore manifest file":                                             "マニフェスト・ファイルを無視します",
+	"Do not map a route to this app":                                   "このアプリにルートをマップしません",
+	"Do not start an app after pushing":                                "プッシュ後にアプリを始動しません",
+	"Unmap routes that are no longer listed for this app":              "このアプリに対してリストされなくなったルートをアンマップします",
+	"Create a random route for this app":                               "このアプリのランダムなルートを作成します",
+	"Show quota info":                                                  "クォータの情報を表示します",
+	"List available usage quotas ":                                     "使用可能な使用量クォータをリストします ",
+	"Rename an app":                                                    "アプリの名前を変更します",
+	"Rename an org":                                                    "組織の名前を変更します",
+	"Rename a service instance":                                        "サービス・インスタンスの名前を変更します",
+	"Rename a service broker":                                          "サービス・ブローカーの名前を変更します",
+	"Rename a space":                                                   "スペースの名前を変更します",
+	"Set the priority order of all buildpacks at once":                 "すべてのビルドパックの優先順位を一度に設定します",
+	"Every buildpack has to be listed, the first one gets position 1.": "すべてのビルドパックをリストする必要があり、最初のものが位置 1 になります。",
+	"Restart an app":                                                   "アプリを再始動します",
+	"restart two instances at a time":                                  "一度に 2 つのインスタンスを再始動します",
+	"Restart instances in batches, waiting for each batch to be running before the next": "インスタンスをバッチで再始動し、各バッチが実行されるのを待ってから次のバッチに進みます",
+	"Number of instances to restart at a time with --rolling (defaults to 1)":            "--rolling で一度に再始動するインスタンス数 (デフォルトは 1)",
+	"Restart a single instance of an app":                                                "アプリの単一のインスタンスを再始動します",
+	"List all routes":                                                                    "すべてのルートをリストします",
+	"Only list routes that are not mapped to any app":                                    "どのアプリにもマップされていないルートのみをリストします",
+	"Change or view the instance count, disk space limit, and memory limit for an app":   "アプリのインスタンス数、ディスク・スペース制限、およびメモリー制限を変更または表示します",
+	"Disk limit (e.g. 256M, 1024M, 1G)":                                                  "ディスク制限 (例: 256M、1024M、1G)",
+	"Force restart of app without prompt":                                                "プロンプトを出さずにアプリを強制的に再始動します",
+	"Show service instance info":                                                         "サービス・インスタンスの情報を表示します",
+	"List service access settings":                                                       "サービス・アクセスの設定をリストします",
+	"List service auth tokens":                                                           "サービス認証トークンをリストします",
+	"List service brokers":                                                               "サービス・ブローカーをリストします",
+	"List all services in the target space":                                              "ターゲット・スペースのすべてのサービスをリストします",
+	"Migrate service instances from one service plan to another":                         "サービス・インスタンスをあるサービス・プランから別のサービス・プランにマイグレーションします",
+	"WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and resources for service instances will not be altered. The primary use case for this operation is to replace a service broker which implements the v1 Service Broker API with a broker which implements the v2 API by remapping service instances from v1 plans to v2 plans.  We recommend making the v1 plan private or shutting down the v1 broker to prevent additional instances from being created. Once service instances have been migrated, the v1 services and plans can be removed from Cloud Foundry.": "警告: この操作は Cloud Foundry の内部操作です。サービス・ブローカーへの接続は行われず、サービス・インスタンスのリソースは変更されません。この操作の主な用途は、v1 サービスのインスタンスを v2 プランに再マップすることで、v1 Service Broker API を実装するブローカーを v2 API を実装するブローカーに置き換えることです。追加のインスタンスが作成されないように、v1 プランを非公開にするか、v1 ブローカーをシャットダウンすることをお勧めします。サービス・インスタンスのマイグレーション後、v1 サービスとプランは Cloud Foundry から削除できます。",
+	"Force migration without confirmation": "確認せずに強制的にマイグレーションします",
+	"Set an env variable for an app":       "アプリの環境変数を設定します",
+	"Assign an org role to a user":         "ユーザーに組織のロールを割り当てます",
+	"ROLES:":                               "ロール:",
+	"Invite and manage users, select and change plans, and set spending limits": "ユーザーの招待と管理、プランの選択と変更、および支出限度の設定を行います",
+	"Create and manage the billing account and payment info":                    "課金アカウントと支払い情報を作成および管理します",
+	"Read-only access to org info and reports":                                  "組織の情報とレポートへの読み取り専用アクセス",
+	"Define the quota for an org":                                               "組織のクォータを定義します",
+	"View allowable quotas with '%s'":                                           "'%s' で許可されているクォータを表示します",
+	"Assign a space role to a user":                                             "ユーザーにスペースのロールを割り当てます",
+	"Invite and manage users, and enable features for a given space":            "ユーザーの招待と管理、および特定のスペースの機能の有効化を行います",
+	"Create and manage apps and services, and see logs and reports":             "アプリとサービスの作成と管理、およびログとレポートの表示を行います",
+	"View logs, reports, and settings on this space":                            "このスペースのログ、レポート、および設定を表示します",
+	"Create a domain that can be used by all orgs (admin-only)":                 "すべての組織が使用できるドメインを作成します (管理者のみ)",
+	"Show space info":           "スペースの情報を表示します",
+	"Show space users by role":  "スペースのユーザーをロール別に表示します",
+	"List all spaces in an org": "組織内のすべてのスペースをリストします",
+	"List all stacks":           "すべてのスタックをリストします",
+	"Run a buildpack against an app on this machine to debug staging":                                                             "このマシンでアプリに対してビルドパックを実行し、ステージングをデバッグします",
+	"BUILDPACK can be a directory, a zip file or a URL. When it is not given,\n   the buildpack from the app's manifest is used.": "BUILDPACK にはディレクトリー、zip ファイル、または URL を指定します。指定しない場合は、\n   アプリのマニフェストのビルドパックが使用されます。",
+	"The files ignored by .cfignore are left out, as they are when pushing.":                                                      ".cfignore で無視されるファイルは、プッシュ時と同様に除外されます。",
+	"Buildpacks can only be run on Linux.":                                                                                        "ビルドパックは Linux でのみ実行できます。",
+	"Buildpack directory, zip file or URL":                                                                                        "ビルドパックのディレクトリー、zip ファイル、または URL",
+	"Path to the app directory (default is the current directory)":                                                                "アプリ・ディレクトリーのパス (デフォルトは現行ディレクトリー)",
+	"Start an app":                          "アプリを始動します",
+	"Stop an app":                           "アプリを停止します",
+	"Set or view the targeted org or space": "ターゲットの組織またはスペースを設定または表示します",
+	"organization":                          "組織",
+	"space":                                 "スペース",
+	"Display a refreshing dashboard of all apps in the target space": "ターゲット・スペースのすべてのアプリのダッシュボードを表示し、定期的に更新します",
+	"Sort apps by the given key":                                     "指定したキーでアプリをソートします",
+	"Seconds between refreshes (defaults to 5)":                      "更新間隔 (秒) (デフォルトは 5)",
+	"Unbind a service instance from an app":                          "サービス・インスタンスをアプリからアンバインドします",
+	"Remove a url route from an app":                                 "URL ルートをアプリから削除します",
+	"Remove an env variable":                                         "環境変数を削除します",
+	"Remove an org role from a user":                                 "ユーザーから組織のロールを削除します",
+	"Remove a space role from a user":                                "ユーザーからスペースのロールを削除します",
+	"Update a buildpack":                                             "ビルドパックを更新します",
+	"Buildpack position among other buildpacks":                      "他のビルドパックの中でのビルドパックの位置",
+	"Path to directory or zip file":                                  "ディレクトリーまたは zip ファイルのパス",
+	"Lock the buildpack":                                             "ビルドパックをロックします",
+	"Unlock the buildpack":                                           "ビルドパックをアンロックします",
+	"Update an existing resource quota":                              "既存のリソース・クォータを更新します",
+	"Cannot provision instances of paid service plans":               "有料サービス・プランのインスタンスをプロビジョンできません",
+	"New name": "新しい名前",
+	"Change the plan or the configuration parameters of a service instance":                                    "サービス・インスタンスのプランまたは構成パラメーターを変更します",
+	"Not every service broker supports plan changes, the broker may refuse the update.":                        "すべてのサービス・ブローカーがプランの変更をサポートしているわけではなく、ブローカーが更新を拒否する場合があります。",
+	"Change the service plan of the instance":                                                                  "インスタンスのサービス・プランを変更します",
+	"Update a service broker":                                                                                  "サービス・ブローカーを更新します",
+	"Update a service auth token":                                                                              "サービス認証トークンを更新します",
+	"Update user-provided service name value pairs":                                                            "ユーザー提供サービスの名前と値のペアを更新します",
+	"Show resource usage of an org compared to its quota":                                                      "組織のリソース使用量をクォータと比較して表示します",
+	"Memory counts the started apps only, the same way the quota is enforced.":                                 "メモリーは、クォータの適用と同じく、始動しているアプリのみを数えます。",
+	"Orgs using %d%% or more of a limit are flagged.":                                                          "いずれかの制限の %d%% 以上を使用している組織にはフラグが付けられます。",
+	"Show usage for the given org instead of the targeted org":                                                 "ターゲット組織の代わりに指定した組織の使用量を表示します",
+	"Show usage for every org":                                                                                 "すべての組織の使用量を表示します",
+	"Show every org and space role of a user":                                                                  "ユーザーのすべての組織とスペースのロールを表示します",
+	"export the roles of every user as CSV":                                                                    "すべてのユーザーのロールを CSV としてエクスポートします",
+	"Export the roles of every user in every org as CSV":                                                       "すべての組織のすべてのユーザーのロールを CSV としてエクスポートします",
+	"Check a buildpack directory, zip file or URL for problems before uploading it":                            "アップロードする前に、ビルドパックのディレクトリー、zip ファイル、または URL に問題がないか確認します",
+	"%s and %s run the same checks before uploading":                                                           "%s と %s はアップロードする前に同じ確認を実行します",
+	"Check the catalog of a service broker before registering it":                                              "登録する前にサービス・ブローカーのカタログを確認します",
+	"Username for the broker's basic auth":                                                                     "ブローカーの基本認証のユーザー名",
+	"Password for the broker's basic auth":                                                                     "ブローカーの基本認証のパスワード",
+	"Show who you are logged in as, with your scopes and when your session expires":                            "ログインしているユーザーを、そのスコープとセッションの有効期限とともに表示します",
+	"Trust the CA certificates in this file when setting the api endpoint":                                     "API エンドポイントを設定するときに、このファイルの CA 証明書を信頼します",
+	"Do not colorize output":                                                                                   "出力をカラー表示しません",
+	"Override path to default config directory":                                                                "デフォルトの構成ディレクトリーのパスをオーバーライドします",
+	"Language of messages, overrides LANG (en_US, ja_JP or pt_BR)":                                             "メッセージの言語。LANG をオーバーライドします (en_US、ja_JP、または pt_BR)",
+	"Max wait time for buildpack staging, in minutes":                                                          "ビルドパックのステージングの最大待機時間 (分)",
+	"Max wait time for app instance startup, in minutes":                                                       "アプリ・インスタンスの始動の最大待機時間 (分)",
+	"Print API request diagnostics to stdout":                                                                  "API 要求の診断を標準出力に出力します",
+	"Append API request diagnostics to a log file":                                                             "API 要求の診断をログ・ファイルに追加します",
+	"Enable HTTP proxying for API requests":                                                                    "API 要求の HTTP プロキシーを有効にします",
+	"Print the version":                                                                                        "バージョンを出力します",
+	"Success":                                                                                                  "成功",
+	"Any failure not listed below":                                                                             "以下に記載されていないすべての失敗",
+	"Incorrect usage, or input needed in non-interactive mode":                                                 "使用方法が正しくないか、非対話モードで入力が必要",
+	"Not logged in, bad credentials or an expired session":                                                     "ログインしていないか、資格情報が正しくないか、セッションの有効期限切れ",
+	"The app, space, service or other resource does not exist":                                                 "アプリ、スペース、サービス、またはその他のリソースが存在しない",
+	"The server failed or could not be reached":                                                                "サーバーで障害が発生したか、サーバーに到達できなかった",
+	"The server, staging or an async job took too long":                                                        "サーバー、ステージング、または非同期ジョブに時間がかかりすぎた",
+	"No api endpoint set. Use '%s' to set an endpoint":                                                         "API エンドポイントが設定されていません。'%s' を使用してエンドポイントを設定してください",
+	"Setting api endpoint to %s...":                                                                            "API エンドポイントを %s に設定しています...",
+	"TIP: Use '%s' to trust a custom certificate authority, or '%s' to continue with an insecure API endpoint": "ヒント: カスタム認証局を信頼するには '%s' を、セキュアでない API エンドポイントで続行するには '%s' を使用してください",
+	"Invalid SSL Cert for %s\n%s":                                                                              "%s の SSL 証明書が無効です\n%s",
+	"Warning: Insecure http API endpoint detected: secure https API endpoints are recommended":                 "警告: セキュアでない http API エンドポイントが検出されました。セキュアな https API エンドポイントをお勧めします",
+	"Could not read CA certificate file %s\n%s":                                                                "CA 証明書ファイル %s を読み取れませんでした\n%s",
+	"Could not read client certificate file %s\n%s":                                                            "クライアント証明書ファイル %s を読み取れませんでした\n%s",
+	"Could not read client key file %s\n%s":                                                                    "クライアント鍵ファイル %s を読み取れませんでした\n%s",
+	"Really delete %s?%s":                                                                                      "%s を削除しますか?%s",
+	"Deleting app %s in org %s / space %s as %s...":                                                            "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s を削除しています...",
+	"App %s does not exist.":                                                                                   "アプリ %s は存在しません。",
+	"Getting env variables for app %s in org %s / space %s as %s...":                                           "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s の環境変数を取得しています...",
+	"No env variables exist":                                                                                   "環境変数が存在しません",
+	"org %s":                                                                                                   "組織 %s",
+	"org %s / space %s":                                                                                        "組織 %s / スペース %s",
+	"app %s":                                                                                                   "アプリ %s",
+	"app %s in org %s / space %s":                                                                              "組織 %[2]s / スペース %[3]s のアプリ %[1]s",
+	"Failed fetching events.\n%s":                                                                              "イベントを取得できませんでした。\n%s",
+	"Getting events for %s as %s...\n":                                                                         "%[2]s として %[1]s のイベントを取得しています...\n",
+	"No events for %s":                                                                                         "%s のイベントはありません",
+	"time":                                                                                                     "時刻",
+	"event":                                                                                                    "イベント",
+	"actor":                                                                                                    "アクター",
+	"description":                                                                                              "説明",
+	"target":                                                                                                   "ターゲット",
+	"the app exited on its own":                                                                                "アプリが自ら終了しました",
+	"the start command is not executable":                                                                      "始動コマンドが実行可能ではありません",
+	"the start command was not found":                                                                          "始動コマンドが見つかりませんでした",
+	"killed by SIGKILL, usually because the app exceeded its memory limit":                                     "SIGKILL で強制終了されました (通常、アプリがメモリー制限を超えたため)",
+	"terminated by SIGTERM":                                                                                    "SIGTERM で終了されました",
+	"segmentation fault":                                                                                       "セグメンテーション違反",
+	"aborted by SIGABRT":                                                                                       "SIGABRT で異常終了されました",
+	"killed by signal %d":                                                                                      "シグナル %d で強制終了されました",
+	"the app exited with an error":                                                                             "アプリがエラーで終了しました",
+	"Instance %d of app %s does not exist. The app has %d instance(s).":                                        "アプリ %[2]s のインスタンス %[1]d は存在しません。アプリのインスタンス数は %[3]d です。",
+	"Getting files for app %s in org %s / space %s as %s...":                                                   "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s のファイルを取得しています...",
+	"Getting files for all instances of app %s in org %s / space %s as %s...":                                  "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s のすべてのインスタンスのファイルを取得しています...",
+	"Downloading %s from instance %d of app %s in org %s / space %s as %s...":                                  "%[6]s として組織 %[4]s / スペース %[5]s のアプリ %[3]s のインスタンス %[2]d から %[1]s をダウンロードしています...",
+	"Downloaded %d file(s) to %s":                                                                              "%[1]d 個のファイルを %[2]s にダウンロードしました",
+	"Tailing %s on instance %d of app %s in org %s / space %s as %s...":                                        "%[6]s として組織 %[4]s / スペース %[5]s のアプリ %[3]s のインスタンス %[2]d の %[1]s を追跡しています...",
+	"Getting apps in org %s / space %s as %s...":                                                               "%[3]s として組織 %[1]s / スペース %[2]s のアプリを取得しています...",
+	"No apps found":                                                                                            "アプリが見つかりません",
+	"name":                                                                                                     "名前",
+	"requested state":                                                                                          "要求された状態",
+	"instances":                                                                                                "インスタンス",
+	"memory":                                                                                                   "メモリー",
+	"disk":                                                                                                     "ディスク",
+	"urls":                                                                                                     "URL",
+	"Connected, dumping recent logs for app %s in org %s / space %s as %s...\n":                                "接続されました。%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s の最近のログをダンプしています...\n",
+	"Connected, tailing logs for app %s in org %s / space %s as %s...\n":                                       "接続されました。%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s のログを追跡しています...\n",
+	"TIP: use the --skip-ssl-validation to suppress this error":                                                "ヒント: このエラーを抑止するには --skip-ssl-validation を使用してください",
+	"Uploading %s...":                                                                                          "%s をアップロードしています...",
+	"Error uploading application.\n%s":                                                                         "アプリケーションのアップロード中にエラーが発生しました。\n%s",
+	"Could not find service %s to bind to %s":                                                                  "%[2]s にバインドするサービス %[1]s が見つかりませんでした",
+	"Binding service %s to %s in org %s / space %s as %s":                                                      "%[5]s として組織 %[3]s / スペース %[4]s のサービス %[1]s を %[2]s にバインドしています",
+	"Could not find to service %s\nError: %s":                                                                  "サービス %s が見つかりませんでした\nエラー: %s",
+	"Uploading from: %s\n%s, %d files":                                                                         "アップロード元: %s\n%s、%d 個のファイル",
+	"Using stack %s...":                                                                                        "スタック %s を使用しています...",
+	"App %s is a worker, skipping route creation":                                                              "アプリ %s はワーカーであるため、ルートの作成をスキップします",
+	"Using route %s":                                                                                           "ルート %s を使用しています",
+	"Creating route %s...":                                                                                     "ルート %s を作成しています...",
+	"Binding %s to %s...":                                                                                      "%s を %s にバインドしています...",
+	"The route %s is already in use.\nTIP: Change the hostname with -n HOSTNAME or use --random-route to generate a new route and then push again.": "ルート %s は既に使用されています。\nヒント: -n HOSTNAME でホスト名を変更するか、--random-route を使用して新しいルートを生成してから、再度プッシュしてください。",
+	"Unbinding %s from %s...":                       "%s を %s からアンバインドしています...",
+	"No default domain exists":                      "デフォルト・ドメインが存在しません",
+	"No domain found for route %s":                  "ルート %s のドメインが見つかりません",
+	"Could not find a default domain":               "デフォルト・ドメインが見つかりませんでした",
+	"Error: No name found for app":                  "エラー: アプリの名前が見つかりません",
+	"Creating app %s in org %s / space %s as %s...": "%[4]s として組織 %[2]s / スペース %[3]s にアプリ %[1]s を作成しています...",
+	"Updating app %s in org %s / space %s as %s...": "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s を更新しています...",
+	"Error: %s": "エラー: %s",
+	"Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file.": "使用方法が正しくありません。マニフェスト・ファイルから複数のアプリをプッシュする場合、コマンドライン・フラグ (-f を除く) は適用できません。",
+	"Could not determine the current working directory!":                                                                 "現行作業ディレクトリーを判別できませんでした。",
+	"Error reading manifest file:\n%s":                                                                                   "マニフェスト・ファイルの読み取り中にエラーが発生しました:\n%s",
+	"Using manifest file %s\n":                                                                                           "マニフェスト・ファイル %s を使用しています\n",
+	"Could not find app named '%s' in manifest":                                                                          "マニフェストに '%s' という名前のアプリが見つかりませんでした",
+	"app name is a required field":                                                                                       "アプリ名は必須フィールドです",
+	"Could not find named app in manifest":                                                                               "マニフェストに指定された名前のアプリが見つかりませんでした",
+	"Invalid memory param: %s\n%s":                                                                                       "メモリーのパラメーターが無効です: %s\n%s",
+	"Invalid instances param: %s\n%s":                                                                                    "インスタンスのパラメーターが無効です: %s\n%s",
+	"Invalid timeout param: %s\n%s":                                                                                      "タイムアウトのパラメーターが無効です: %s\n%s",
+	"Renaming app %s to %s in org %s / space %s as %s...":                                                                "%[5]s として組織 %[3]s / スペース %[4]s のアプリ %[1]s の名前を %[2]s に変更しています...",
+	"Restarting app %s in org %s / space %s as %s, %d instance(s) at a time...":                                          "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s を一度に %[5]d インスタンスずつ再始動しています...",
+	"App %s is not started. Use '%s' to start it.":                                                                       "アプリ %s は始動していません。'%s' を使用して始動してください。",
+	"App %s has only one instance, it will be unavailable while it restarts.":                                            "アプリ %s のインスタンスは 1 つのみであるため、再始動中は使用できなくなります。",
+	"Restarting %s...": "%s を再始動しています...",
+	"%s\n\nRolling restart aborted, instances after %s were not restarted.\n\nTIP: use '%s' for more information": "%s\n\nローリング再始動が中止されました。%s 以降のインスタンスは再始動されませんでした。\n\nヒント: 詳細については '%s' を使用してください",
+	"App restarted":                       "アプリが再始動されました",
+	"Timed out waiting for %s to start":   "%s の始動の待機中にタイムアウトになりました",
+	"Instance #%d is crashing":            "インスタンス #%d がクラッシュしています",
+	"%d of %d instances in batch running": "バッチ内の %d/%d 個のインスタンスが実行中",
+	"instances %s":                        "インスタンス %s",
+	"instance %s":                         "インスタンス %s",
+	"Restarting instance %d of app %s in org %s / space %s as %s...": "%[5]s として組織 %[3]s / スペース %[4]s のアプリ %[2]s のインスタンス %[1]d を再始動しています...",
+	"Showing current scale of app %s in org %s / space %s as %s...":  "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s の現在のスケールを表示しています...",
+	"memory:":                  "メモリー:",
+	"disk:":                    "ディスク:",
+	"instances:":               "インスタンス:",
+	"Invalid value for memory": "メモリーの値が無効です",
+	"Invalid value for disk":   "ディスクの値が無効です",
+	"Scaling app %s in org %s / space %s as %s...":                               "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s をスケーリングしています...",
+	"This will cause the app to restage. Are you sure you want to scale %s?":     "アプリが再ステージングされます。%s をスケーリングしますか?",
+	"Setting env variable '%s' to '%s' for app %s in org %s / space %s as %s...": "%[6]s として組織 %[4]s / スペース %[5]s のアプリ %[3]s の環境変数 '%[1]s' を '%[2]s' に設定しています...",
+	"TIP: Use '%s' to ensure your env variable changes take effect":              "ヒント: 環境変数の変更を確実に有効にするには '%s' を使用してください",
+	"Showing health and status for app %s in org %s / space %s as %s...":         "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s の正常性と状況を表示しています...",
+	"requested state:":     "要求された状態:",
+	"%s %s x %d instances": "%s %s x %d インスタンス",
+	"usage:":               "使用量:",
+	"urls:":                "URL:",
+	"There are no running instances of this app.": "このアプリの実行中のインスタンスはありません。",
+	"state":    "状態",
+	"since":    "開始時刻",
+	"cpu":      "CPU",
+	"%s of %s": "%[1]s / %[2]s",
+	"Error finding the current directory: %s": "現行ディレクトリーの検索中にエラーが発生しました: %s",
+	"No buildpack given.\nTIP: Use '%s stage-local -b BUILDPACK' or set a buildpack in the app's manifest.": "ビルドパックが指定されていません。\nヒント: '%s stage-local -b BUILDPACK' を使用するか、アプリのマニフェストでビルドパックを設定してください。",
+	"Staging app in %s locally with buildpack %s...":                                                        "ビルドパック %[2]s を使用して %[1]s のアプリをローカルでステージングしています...",
+	"detected buildpack:": "検出されたビルドパック:",
+	"start command:":      "始動コマンド:",
+	"release:":            "リリース:",
+	"invalid value for env var CF_STAGING_TIMEOUT\n%s": "環境変数 CF_STAGING_TIMEOUT の値が無効です\n%s",
+	"invalid value for env var CF_STARTUP_TIMEOUT\n%s": "環境変数 CF_STARTUP_TIMEOUT の値が無効です\n%s",
+	"App %s is already started":                        "アプリ %s は既に始動しています",
+	"Starting app %s in org %s / space %s as %s...":    "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s を始動しています...",
+	"App started":                                              "アプリが始動しました",
+	"Warning: error tailing logs":                              "警告: ログの追跡中にエラーが発生しました",
+	"%s\n\nTIP: use '%s' for more information":                 "%s\n\nヒント: 詳細については '%s' を使用してください",
+	"Start app timeout\n\nTIP: use '%s' for more information":  "アプリの始動がタイムアウトになりました\n\nヒント: 詳細については '%s' を使用してください",
+	"Start unsuccessful\n\nTIP: use '%s' for more information": "始動に失敗しました\n\nヒント: 詳細については '%s' を使用してください",
+	"%d of %d instances running":                               "%d/%d 個のインスタンスが実行中",
+	"%d starting":                                              "%d 個が始動中",
+	"%d down":                                                  "%d 個が停止",
+	"%d failing":                                               "%d 個が失敗",
+	"App %s is already stopped":                                "アプリ %s は既に停止しています",
+	"Stopping app %s in org %s / space %s as %s...":            "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s を停止しています...",
+	"Watching apps in org %s / space %s as %s...":              "%[3]s として組織 %[1]s / スペース %[2]s のアプリを監視しています...",
+	"%d/%d (%d crashing)":                                      "%d/%d (%d 個がクラッシュ中)",
+	"Watching app %s in org %s / space %s as %s...":            "%[4]s として組織 %[2]s / スペース %[3]s のアプリ %[1]s を監視しています...",
+	"%d of %d instances are crashing or down":                  "%d/%d 個のインスタンスがクラッシュしているか停止しています",
+	"%s %s, %s every %s, press Ctrl-C to quit":                 "%s %s、%s 間隔 %s、Ctrl-C で終了",
+	"sorted by":  "ソート順",
+	"refreshing": "更新",
+	"Removing env variable %s from app %s in org %s / space %s as %s...": "%[5]s として組織 %[3]s / スペース %[4]s のアプリ %[2]s から環境変数 %[1]s を削除しています...",
+	"Env variable %s was not set.":                                       "環境変数 %s は設定されていませんでした。",
+	"API endpoint: %s":                                                   "API エンドポイント: %s",
+	"Authenticating...":                                                  "認証しています...",
+	"Use '%s' to view or set your target org and space":                  "'%s' を使用して、ターゲットの組織とスペースを表示または設定してください",
+	"Creating buildpack %s...":                                           "ビルドパック %s を作成しています...",
+	"Buildpack %s already exists":                                        "ビルドパック %s は既に存在します",
+	"TIP: use '%s' to update this buildpack":                             "ヒント: このビルドパックを更新するには '%s' を使用してください",
+	"Uploading buildpack %s...":                                          "ビルドパック %s をアップロードしています...",
+	"Invalid position. %s":                                               "位置が無効です。%s",
+	"Cannot specify both enabled and disabled.":                          "enabled と disabled の両方を指定することはできません。",
+	"Are you sure you want to delete the buildpack %s ?":                 "ビルドパック %s を削除しますか?",
+	"Deleting buildpack %s...":                                           "ビルドパック %s を削除しています...",
+	"Buildpack %s does not exist.":                                       "ビルドパック %s は存在しません。",
+	"Error deleting buildpack %s\n%s":                                    "ビルドパック %s の削除中にエラーが発生しました\n%s",
+	"Exporting buildpacks to %s...":                                      "ビルドパックを %s にエクスポートしています...",
+	"Failed fetching buildpacks.\n%s":                                    "ビルドパックを取得できませんでした。\n%s",
+	"Error creating directory %s: %s":                                    "ディレクトリー %s の作成中にエラーが発生しました: %s",
+	"  downloading %s":                                                   "  %s をダウンロードしています",
+	"Failed downloading buildpack %s.\n%s":                               "ビルドパック %s をダウンロードできませんでした。\n%s",
+	"Error writing %s: %s":                                               "%s の書き込み中にエラーが発生しました: %s",
+	"Exported %d buildpacks":                                             "%d 個のビルドパックをエクスポートしました",
+	"Importing buildpacks from %s...":                                    "%s からビルドパックをインポートしています...",
+	"  updating %s":                                                      "  %s を更新しています",
+	"  creating %s":                                                      "  %s を作成しています",
+	"Failed importing buildpack %s.\n%s":                                 "ビルドパック %s をインポートできませんでした。\n%s",
+	"Imported %d buildpacks":                                             "%d 個のビルドパックをインポートしました",
+	"Error reading %s: %s":                                               "%s の読み取り中にエラーが発生しました: %s",
+	"Error parsing %s: %s":                                               "%s の解析中にエラーが発生しました: %s",
+	"Error parsing %s: every buildpack needs a name":                     "%s の解析中にエラーが発生しました: すべてのビルドパックに名前が必要です",
+	"Getting buildpacks...\n":                                            "ビルドパックを取得しています...\n",
+	"buildpack":                                                          "ビルドパック",
+	"position":                                                           "位置",
+	"enabled":                                                            "有効",
+	"locked":                                                             "ロック",
+	"filename":                                                           "ファイル名",
+	"No buildpacks found":                                                "ビルドパックが見つかりません",
+	"Reordering buildpacks...":                                           "ビルドパックの順序を変更しています...",
+	"Failed moving buildpack %s.\n%s":                                    "ビルドパック %s を移動できませんでした。\n%s",
+	"%s\nThe original order could not be restored: %s":                   "%s\n元の順序を復元できませんでした: %s",
+	"%s\nThe original order was restored.":                               "%s\n元の順序が復元されました。",
+	"Buildpack %s not found":                                             "ビルドパック %s が見つかりません",
+	"Buildpack %s is listed more than once":                              "ビルドパック %s が複数回リストされています",
+	"The order must include every buildpack. Missing: %s":                "順序にはすべてのビルドパックを含める必要があります。不足: %s",
+	"Failed verifying the buildpack order.\n%s":                          "ビルドパックの順序を検証できませんでした。\n%s",
+	"The buildpack order could not be verified.\nExpected: %s\nActual:   %s": "ビルドパックの順序を検証できませんでした。\n予期した順序: %s\n実際の順序: %s",
+	"Updating buildpack %s...":                          "ビルドパック %s を更新しています...",
+	"Cannot specify both enabled and disabled options.": "enabled オプションと disabled オプションの両方を指定することはできません。",
+	"Cannot specify both lock and unlock options.":      "lock オプションと unlock オプションの両方を指定することはできません。",
+	"Cannot specify buildpack bits and lock/unlock.":    "ビルドパックのビットと lock/unlock を同時に指定することはできません。",
+	"Error updating buildpack %s\n%s":                   "ビルドパック %s の更新中にエラーが発生しました\n%s",
+	"Error uploading buildpack %s\n%s":                  "ビルドパック %s のアップロード中にエラーが発生しました\n%s",
+	"Validating buildpack %s...":                        "ビルドパック %s を検証しています...",
+	"layout:":                                           "レイアウト:",
+	"files:":                                            "ファイル:",
+	"size:":                                             "サイズ:",
+	"sha1:":                                             "SHA1:",
+	"Buildpack %s is not valid":                         "ビルドパック %s は無効です",
+	"Error creating request:\n%s":                       "要求の作成中にエラーが発生しました:\n%s",
+	"Creating domain %s for org %s as %s...":            "%[3]s として組織 %[2]s のドメイン %[1]s を作成しています...",
+	"Creating shared domain %s as %s...":                "%[2]s として共有ドメイン %[1]s を作成しています...",
+	"Deleting domain %s as %s...":                       "%[2]s としてドメイン %[1]s を削除しています...",
+	"Error finding domain %s\n%s":                       "ドメイン %s の検索中にエラーが発生しました\n%s",
+	"Are you sure you want to delete the domain %s and all of its associations?": "ドメイン %s とそのすべての関連付けを削除しますか?",
+	"Error deleting domain %s\n%s": "ドメイン %s の削除中にエラーが発生しました\n%s",
+	"This domain is shared across all orgs.\nDeleting it will remove all associated routes, and will make any app with this domain unreachable.\nAre you sure you want to delete the domain %s? ": "このドメインはすべての組織で共有されています。\n削除すると、関連するすべてのルートが削除され、このドメインを使用するアプリに到達できなくなります。\nドメイン %s を削除しますか? ",
+	"Getting domains in org %s as %s...":    "%[2]s として組織 %[1]s のドメインを取得しています...",
+	"status":                                "状況",
+	"Failed fetching shared domains.\n%s":   "共有ドメインを取得できませんでした。\n%s",
+	"Failed fetching private domains.\n%s":  "プライベート・ドメインを取得できませんでした。\n%s",
+	"No domains found":                      "ドメインが見つかりません",
+	"shared":                                "共有",
+	"owned":                                 "所有",
+	"Getting command history...":            "コマンド履歴を取得しています...",
+	"Error reading the command history: %s": "コマンド履歴の読み取り中にエラーが発生しました: %s",
+	"No commands found":                     "コマンドが見つかりません",
+	"started":                               "開始時刻",
+	"user":                                  "ユーザー",
+	"api":                                   "API",
+	"org":                                   "組織",
+	"command":                               "コマンド",
+	"failed (%d)":                           "失敗 (%d)",
+	"ok":                                    "OK",
+	"Invalid value for --since: %s\nUse a duration like 2h or 3d, or a date like 2014-05-01": "--since の値が無効です: %s\n2h や 3d などの期間、または 2014-05-01 などの日付を使用してください",
+	"API endpoint%s":                                                  "API エンドポイント%s",
+	"Unable to authenticate.":                                         "認証できません。",
+	"Single sign-on is not supported by %s":                           "%s ではシングル・サインオンはサポートされていません",
+	"Error finding avilable orgs\n%s":                                 "使用可能な組織の検索中にエラーが発生しました\n%s",
+	"Error finding org %s\n%s":                                        "組織 %s の検索中にエラーが発生しました\n%s",
+	"Select an org (or press enter to skip):":                         "組織を選択してください (スキップするには Enter を押してください):",
+	"Targeted org %s\n":                                               "ターゲットの組織 %s\n",
+	"Error finding available spaces\n%s":                              "使用可能なスペースの検索中にエラーが発生しました\n%s",
+	"Error finding space %s\n%s":                                      "スペース %s の検索中にエラーが発生しました\n%s",
+	"Select a space (or press enter to skip):":                        "スペースを選択してください (スキップするには Enter を押してください):",
+	"Targeted space %s\n":                                             "ターゲットのスペース %s\n",
+	"There are too many options to display, please type in the name.": "表示するオプションが多すぎます。名前を入力してください。",
+	"Logging out...":                                                  "ログアウトしています...",
+	"Creating org %s as %s...":                                        "%[2]s として組織 %[1]s を作成しています...",
+	"Org %s already exists":                                           "組織 %s は既に存在します",
+	"\nTIP: Use '%s' to target new org":                               "\nヒント: 新しい組織をターゲットにするには '%s' を使用してください",
+	"Creating quota %s as %s...":                                      "%[2]s としてクォータ %[1]s を作成しています...",
+	"Quota %s already exists":                                         "クォータ %s は既に存在します",
+	"TIP: use '%s' to change its limits":                              "ヒント: 制限を変更するには '%s' を使用してください",
+	"Really delete the quota %s?%s":                                   "クォータ %s を削除しますか?%s",
+	"Deleting quota %s as %s...":                                      "%[2]s としてクォータ %[1]s を削除しています...",
+	"Quota %s does not exist":                                         "クォータ %s は存在しません",
+	"Getting orgs as %s...\n":                                         "%s として組織を取得しています...\n",
+	"Failed fetching orgs.\n%s":                                       "組織を取得できませんでした。\n%s",
+	"No orgs found":                                                   "組織が見つかりません",
+	"Getting quotas as %s...":                                         "%s としてクォータを取得しています...",
+	"total memory limit":                                              "合計メモリー制限",
+	"instance memory limit":                                           "インスタンス・メモリー制限",
+	"routes":                                                          "ルート",
+	"service instances":                                               "サービス・インスタンス",
+	"paid service plans":                                              "有料サービス・プラン",
+	"unlimited":                                                       "無制限",
+	"allowed":                                                         "許可",
+	"disallowed":                                                      "禁止",
+	"Total memory limit cannot be unlimited":                          "合計メモリー制限を無制限にすることはできません",
+	"Paid service plans cannot be both allowed and disallowed":        "有料サービス・プランを許可と禁止の両方にすることはできません",
+	"Renaming org %s to %s as %s...":                                  "%[3]s として組織 %[1]s の名前を %[2]s に変更しています...",
+	"Setting quota %s to org %s as %s...":                             "%[3]s としてクォータ %[1]s を組織 %[2]s に設定しています...",
+	"Getting info for org %s as %s...":                                "%[2]s として組織 %[1]s の情報を取得しています...",
+	"%s (%dM memory limit)":                                           "%s (メモリー制限 %dM)",
+	"  domains: %s":                                                   "  ドメイン: %s",
+	"  quota:   %s":                                                   "  クォータ: %s",
+	"  spaces:  %s":                                                   "  スペース: %s",
+	"Getting quota %s info as %s...":                                  "%[2]s としてクォータ %[1]s の情報を取得しています...",
+	"total memory limit:":                                             "合計メモリー制限:",
+	"instance memory limit:":                                          "インスタンス・メモリー制限:",
+	"routes:":                                                         "ルート:",
+	"service instances:":                                              "サービス・インスタンス:",
+	"paid service plans:":                                             "有料サービス・プラン:",
+	"Updating quota %s as %s...":                                      "%[2]s としてクォータ %[1]s を更新しています...",
+	"Getting usage for all orgs as %s...":                             "%s としてすべての組織の使用量を取得しています...",
+	"Getting usage for org %s as %s...":                               "%[2]s として組織 %[1]s の使用量を取得しています...",
+	"Failed fetching usage for org %s.\n%s":                           "組織 %s の使用量を取得できませんでした。\n%s",
+	"%d of %d orgs are close to their quota limits: %s":               "%d/%d 個の組織がクォータ制限に近づいています: %s",
+	"quota":                                "クォータ",
+	"No spaces found":                      "スペースが見つかりません",
+	"apps":                                 "アプリ",
+	"Org %s is close to its quota limits.": "組織 %s はクォータ制限に近づいています。",
+	"%s of unlimited":                      "%s / 無制限",
+	"%s of %s (%d%%)":                      "%s / %s (%d%%)",
+	"Current Password%s":                   "現在のパスワード%s",
+	"New Password%s":                       "新しいパスワード%s",
+	"Verify Password%s":                    "パスワードの確認%s",
+	"Password verification does not match": "確認用のパスワードが一致しません",
+	"Changing password...":                 "パスワードを変更しています...",
+	"Current password did not match":       "現在のパスワードが一致しませんでした",
+	"Please log in again":                  "再度ログインしてください",
+	"Checking route %s as %s...":           "%[2]s としてルート %[1]s を確認しています...",
+	"none":                                 "なし",
+	"route:":                               "ルート:",
+	"space:":                               "スペース:",
+	"apps:":                                "アプリ:",
+	"Route %s belongs to space %s and can't be used in space %s":                                "ルート %s はスペース %s に属しているため、スペース %s では使用できません",
+	"Route %s belongs to space %s and can be mapped to its apps":                                "ルート %s はスペース %s に属しており、そのスペースのアプリにマップできます",
+	"Route %s was not found in the spaces visible to you, it may still be taken by another org": "ルート %s は表示可能なスペースに見つかりませんでした。別の組織が使用している可能性があります",
+	"Route %s is already taken by a space you don't have access to":                             "ルート %s は、アクセス権のないスペースで既に使用されています",
+	"Route %s does not exist and can be created in space %s":                                    "ルート %s は存在せず、スペース %s に作成できます",
+	"Creating route %s for org %s / space %s as %s...":                                          "%[4]s として組織 %[2]s / スペース %[3]s のルート %[1]s を作成しています...",
+	"Route %s already exists":                                                                   "ルート %s は既に存在します",
+	"Getting routes not mapped to any app in org %s / space %s as %s...":                        "%[3]s として組織 %[1]s / スペース %[2]s の、どのアプリにもマップされていないルートを取得しています...",
+	"Failed fetching routes.\n%s":                                                               "ルートを取得できませんでした。\n%s",
+	"No orphaned routes found":                                                                  "孤立したルートが見つかりません",
+	"route":                                                                                     "ルート",
+	"Really delete these %d routes?%s":                                                          "これらの %d 個のルートを削除しますか?%s",
+	"Deleting orphaned routes as %s...":                                                         "%s として孤立したルートを削除しています...",
+	"Could not delete route %s: %s":                                                             "ルート %s を削除できませんでした: %s",
+	"Failed deleting %d of %d orphaned routes":                                                  "%[2]d 個中 %[1]d 個の孤立したルートを削除できませんでした",
+	"Deleted %d orphaned routes":                                                                "%d 個の孤立したルートを削除しました",
+	"Really delete route %s?%s":                                                                 "ルート %s を削除しますか?%s",
+	"Deleting route %s...":                                                                      "ルート %s を削除しています...",
+	"Route %s does not exist.":                                                                  "ルート %s は存在しません。",
+	"Getting routes as %s ...\n":                                                                "%s としてルートを取得しています...\n",
+	"host":                                                                                      "ホスト",
+	"domain":                                                                                    "ドメイン",
+	"No routes found":                                                                           "ルートが見つかりません",
+	"Getting routes not mapped to any app as %s ...\n":                                          "%s として、どのアプリにもマップされていないルートを取得しています...\n",
+	"Error resolving route:\n%s":                                                                "ルートの解決中にエラーが発生しました:\n%s",
+	"Adding route %s to app %s in org %s / space %s as %s...":                                   "%[5]s として組織 %[3]s / スペース %[4]s のアプリ %[2]s にルート %[1]s を追加しています...",
+	"Removing route %s from app %s in org %s / space %s as %s...":                               "%[5]s として組織 %[3]s / スペース %[4]s のアプリ %[2]s からルート %[1]s を削除しています...",
+	"Error finding command %s":                                                                  "コマンド %s の検索中にエラーが発生しました",
+	"Binding service %s to app %s in org %s / space %s as %s...":                                "%[5]s として組織 %[3]s / スペース %[4]s のアプリ %[2]s にサービス %[1]s をバインドしています...",
+	"App %s is already bound to %s.":                                                            "アプリ %s は既に %s にバインドされています。",
+	"TIP: Use '%s push' to ensure your env variable changes take effect":                        "ヒント: 環境変数の変更を有効にするには '%s push' を使用してください",
+	"Creating service %s in org %s / space %s as %s...":                                         "%[4]s として組織 %[2]s / スペース %[3]s にサービス %[1]s を作成しています...",
+	"Service %s already exists":                                                                 "サービス %s は既に存在します",
+	"Could not find any offerings with name %s":                                                 "名前が %s のオファリングが見つかりませんでした",
+	"Could not find plan with name %s":                                                          "名前が %s のプランが見つかりませんでした",
+	"Creating user provided service %s in org %s / space %s as %s...":                           "%[4]s として組織 %[2]s / スペース %[3]s にユーザー提供サービス %[1]s を作成しています...",
+	"Are you sure you want to delete the service %s ?":                                          "サービス %s を削除しますか?",
+	"Deleting service %s in org %s / space %s as %s...":                                         "%[4]s として組織 %[2]s / スペース %[3]s のサービス %[1]s を削除しています...",
+	"Service %s does not exist.":                                                                "サービス %s は存在しません。",
+	"Getting services in org %s / space %s as %s...":                                            "%[3]s として組織 %[1]s / スペース %[2]s のサービスを取得しています...",
+	"No services found":                                                                         "サービスが見つかりません",
+	"service":                                                                                   "サービス",
+	"plan":                                                                                      "プラン",
+	"bound apps":                                                                                "バインドされたアプリ",
+	"user-provided":                                                                             "ユーザー提供",
+	"services from marketplace":                                                                 "マーケットプレイスのサービス",
+	"service plan information for service %s":                                                   "サービス %s のサービス・プラン情報",
+	"Getting %s in org %s / space %s as %s...":                                                  "%[4]s として組織 %[2]s / スペース %[3]s の%[1]sを取得しています...",
+	"all services from marketplace":                                                             "マーケットプレイスのすべてのサービス",
+	"Getting %s...":                                                                             "%s を取得しています...",
+	"Cannot list marketplace services without a targeted space":                                 "スペースをターゲットにしていないと、マーケットプレイスのサービスをリストできません",
+	"No service offerings found":                                                                "サービス・オファリングが見つかりません",
+	"plans":                                                                                     "プラン",
+	"Service offering %s not found":                                                             "サービス・オファリング %s が見つかりません",
+	"service:":                                                                                  "サービス:",
+	"description:":                                                                              "説明:",
+	"provider:":                                                                                 "プロバイダー:",
+	"documentation:":                                                                            "資料:",
+	"paid":                                                                                      "有料",
+	"free":                                                                                      "無料",
+	"No service plans found":                                                                    "サービス・プランが見つかりません",
+	"service plan":                                                                              "サービス・プラン",
+	"free or paid":                                                                              "無料/有料",
+	"display name":                                                                              "表示名",
+	"costs":                                                                                     "コスト",
+	"features":                                                                                  "機能",
+	"Plan %s cannot be found":                                                                   "プラン %s が見つかりません",
+	"Plan %s has no service instances to migrate":                                               "プラン %s にはマイグレーションするサービス・インスタンスがありません",
+	"Really migrate %s from plan %s to %s?>":                                                    "%[1]s をプラン %[2]s から %[3]s にマイグレーションしますか?>",
+	"Attempting to migrate %s...":                                                               "%s をマイグレーションしています...",
+	"%s migrated.":                                                                              "%s をマイグレーションしました。",
+	"%d service instance":                                                                       "%d 個のサービス・インスタンス",
+	"%d service instances":                                                                      "%d 個のサービス・インスタンス",
+	"Service offering does not exist\nTIP: If you are trying to purge a v1 service offering, you must set the -p flag.": "サービス・オファリングが存在しません\nヒント: v1 サービス・オファリングをパージする場合は、-p フラグを設定する必要があります。",
+	"Warning: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.": "警告: この操作は、このサービス・オファリングを担当するサービス・ブローカーが使用できなくなり、すべてのサービス・インスタンスが削除されて、Cloud Foundry のデータベースに孤立したレコードが残っていることを前提としています。サービス・インスタンスとサービス・バインディングを含め、このサービスに関するすべての情報が Cloud Foundry から削除されます。サービス・ブローカーへの接続は試行されません。サービス・ブローカーを破棄せずにこのコマンドを実行すると、孤立したサービス・インスタンスが発生します。このコマンドの実行後、クリーンアップを完了するために delete-service-auth-token または delete-service-broker を実行することをお勧めします。",
+	"Really purge service offering %s from Cloud Foundry?":                   "サービス・オファリング %s を Cloud Foundry からパージしますか?",
+	"Purging service %s...":                                                  "サービス %s をパージしています...",
+	"Renaming service %s to %s in org %s / space %s as %s...":                "%[5]s として組織 %[3]s / スペース %[4]s のサービス %[1]s の名前を %[2]s に変更しています...",
+	"%s\nTIP: Use '%s services' to view all services in this org and space.": "%s\nヒント: この組織とスペースのすべてのサービスを表示するには '%s services' を使用してください。",
+	"Invalid configuration provided for -c flag. Please provide a valid JSON object or the path to a file containing a valid JSON object.": "-c フラグに無効な構成が指定されました。有効な JSON オブジェクト、または有効な JSON オブジェクトを含むファイルのパスを指定してください。",
+	"Service instance: %s":  "サービス・インスタンス: %s",
+	"Service: %s":           "サービス: %s",
+	"Plan: %s":              "プラン: %s",
+	"Description: %s":       "説明: %s",
+	"Documentation url: %s": "資料 URL: %s",
+	"Unbinding app %s from service %s in org %s / space %s as %s...":                                                               "%[5]s として組織 %[3]s / スペース %[4]s のサービス %[2]s からアプリ %[1]s をアンバインドしています...",
+	"Binding between %s and %s did not exist":                                                                                      "%s と %s の間のバインディングは存在しませんでした",
+	"Updating service instance %s in org %s / space %s as %s...":                                                                   "%[4]s として組織 %[2]s / スペース %[3]s のサービス・インスタンス %[1]s を更新しています...",
+	"Service instance %s is user-provided and has no plan.\nTIP: Use '%s update-user-provided-service' to change its credentials.": "サービス・インスタンス %s はユーザー提供であり、プランがありません。\nヒント: 資格情報を変更するには '%s update-user-provided-service' を使用してください。",
+	"Service instance %s already uses plan %s":                                                                                     "サービス・インスタンス %s は既にプラン %s を使用しています",
+	"%s\nTIP: Use '%s marketplace' to see the plans of service %s.":                                                                "%s\nヒント: サービス %s のプランを表示するには '%s marketplace' を使用してください。",
+	"The service broker refused to update service instance %s%s.\n%s":                                                              "サービス・ブローカーがサービス・インスタンス %s%s の更新を拒否しました。\n%s",
+	" from plan %s to plan %s":                                                                                                     " (プラン %s からプラン %s へ)",
+	"Service Instance is not user provided":                                                                                        "サービス・インスタンスはユーザー提供ではありません",
+	"JSON is invalid: %s":                                                                                                          "JSON が無効です: %s",
+	"Updating user provided service %s in org %s / space %s as %s...":                                                              "%[4]s として組織 %[2]s / スペース %[3]s のユーザー提供サービス %[1]s を更新しています...",
+	"TIP: To make these changes take effect, use '%s unbind-service' to unbind the service, '%s bind-service' to rebind, and then '%s push' to update the app with the new env variables": "ヒント: これらの変更を有効にするには、'%s unbind-service' でサービスをアンバインドし、'%s bind-service' で再バインドしてから、'%s push' で新しい環境変数を使用してアプリを更新してください",
+	"No flags specified. No changes were made.":      "フラグが指定されていません。変更は行われませんでした。",
+	"Disabling access to %s for %s as %s...":         "%[3]s として %[2]s の %[1]s へのアクセスを無効にしています...",
+	"Failed fetching service plan visibilities.\n%s": "サービス・プランの可視性を取得できませんでした。\n%s",
+	"Failed disabling access to plan %s.\n%s":        "プラン %s へのアクセスを無効にできませんでした。\n%s",
+	"Plan %s is public and remains accessible to org %s.\nTIP: Use '%s disable-service-access %s -p %s' and then enable it for each org that should keep access.": "プラン %s はパブリックであり、組織 %s からは引き続きアクセスできます。\nヒント: '%s disable-service-access %s -p %s' を使用してから、アクセスを維持する組織ごとに有効にしてください。",
+	"Enabling access to %s for %s as %s...":                "%[3]s として %[2]s の %[1]s へのアクセスを有効にしています...",
+	"Failed enabling access to plan %s.\n%s":               "プラン %s へのアクセスを有効にできませんでした。\n%s",
+	"Failed enabling access to plan %s for org %s.\n%s":    "組織 %[2]s のプラン %[1]s へのアクセスを有効にできませんでした。\n%[3]s",
+	"Plan %s is public and already accessible to all orgs": "プラン %s はパブリックであり、既にすべての組織からアクセスできます",
+	"Getting service access as %s...":                      "%s としてサービス・アクセスを取得しています...",
+	"Failed fetching service offerings.\n%s":               "サービス・オファリングを取得できませんでした。\n%s",
+	"access":                                               "アクセス",
+	"orgs":                                                 "組織",
+	"all":                                                  "すべて",
+	"limited":                                              "制限付き",
+	"Service plan %s not found for service %s":             "サービス %[2]s のサービス・プラン %[1]s が見つかりません",
+	"Service %s has no plans":                              "サービス %s にはプランがありません",
+	"all plans of service %s":                              "サービス %s のすべてのプラン",
+	"plan %s of service %s":                                "サービス %[2]s のプラン %[1]s",
+	"all orgs":                                             "すべての組織",
+	"Creating service auth token as %s...":                 "%s としてサービス認証トークンを作成しています...",
+	"Are you sure you want to delete %s?%s":                "%s を削除しますか?%s",
+	"Deleting service auth token as %s":                    "%s としてサービス認証トークンを削除しています",
+	"Service Auth Token %s %s does not exist.":             "サービス認証トークン %s %s は存在しません。",
+	"Getting service auth tokens as %s...":                 "%s としてサービス認証トークンを取得しています...",
+	"label":                                                "ラベル",
+	"provider":                                             "プロバイダー",
+	"Updating service auth token as %s...":                 "%s としてサービス認証トークンを更新しています...",
+	"Creating service broker %s as %s...":                  "%[2]s としてサービス・ブローカー %[1]s を作成しています...",
+	"%s\nTIP: Use '%s validate-service-broker %s' to check the broker's catalog": "%s\nヒント: ブローカーのカタログを確認するには '%s validate-service-broker %s' を使用してください",
+	"Deleting service broker %s as %s...":                                        "%[2]s としてサービス・ブローカー %[1]s を削除しています...",
+	"Service Broker %s does not exist.":                                          "サービス・ブローカー %s は存在しません。",
+	"Getting service brokers as %s...\n":                                         "%s としてサービス・ブローカーを取得しています...\n",
+	"url":                                                                        "URL",
+	"Failed fetching service brokers.\n%s":                                       "サービス・ブローカーを取得できませんでした。\n%s",
+	"No service brokers found":                                                   "サービス・ブローカーが見つかりません",
+	"Renaming service broker %s to %s as %s":                                     "%[3]s としてサービス・ブローカー %[1]s の名前を %[2]s に変更しています",
+	"Updating service broker %s as %s...":                                        "%[2]s としてサービス・ブローカー %[1]s を更新しています...",
+	"Validating catalog of service broker at %s...":                              "%s のサービス・ブローカーのカタログを検証しています...",
+	"Could not fetch the catalog.\n%s":                                           "カタログを取得できませんでした。\n%s",
+	"Found %d problems in the catalog":                                           "カタログに %d 個の問題が見つかりました",
+	"Catalog is valid: %d services with %d plans":                                "カタログは有効です: %d 個のサービス、%d 個のプラン",
+	"TIP: Use '%s create-service-broker' to register the broker":                 "ヒント: ブローカーを登録するには '%s create-service-broker' を使用してください",
+	"Creating space %s in org %s as %s...":                                       "%[3]s として組織 %[2]s にスペース %[1]s を作成しています...",
+	"Org %s does not exist or is not accessible":                                 "組織 %s は存在しないか、アクセスできません",
+	"Space %s already exists":                                                    "スペース %s は既に存在します",
+	"\nTIP: Use '%s' to target new space":                                        "\nヒント: 新しいスペースをターゲットにするには '%s' を使用してください",
+	"Getting spaces in org %s as %s...\n":                                        "%[2]s として組織 %[1]s のスペースを取得しています...\n",
+	"Failed fetching spaces.\n%s":                                                "スペースを取得できませんでした。\n%s",
+	"Renaming space %s to %s in org %s as %s...":                                 "%[4]s として組織 %[3]s のスペース %[1]s の名前を %[2]s に変更しています...",
+	"Getting info for space %s in org %s as %s...":                               "%[3]s として組織 %[2]s のスペース %[1]s の情報を取得しています...",
+	"  Org: %s":      "  組織: %s",
+	"  Apps: %s":     "  アプリ: %s",
+	"  Domains: %s":  "  ドメイン: %s",
+	"  Services: %s": "  サービス: %s",
+	"Getting stacks in org %s / space %s as %s...":                       "%[3]s として組織 %[1]s / スペース %[2]s のスタックを取得しています...",
+	"Could not target org.\n%s":                                          "組織をターゲットにできませんでした。\n%s",
+	"An org must be targeted before targeting a space":                   "スペースをターゲットにする前に組織をターゲットにする必要があります",
+	"Unable to access space %s.\n%s":                                     "スペース %s にアクセスできません。\n%s",
+	"Creating user %s as %s...":                                          "%[2]s としてユーザー %[1]s を作成しています...",
+	"Error creating user %s.\n%s":                                        "ユーザー %s の作成中にエラーが発生しました。\n%s",
+	"\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'": "\nヒント: '%s set-org-role' および '%s set-space-role' を使用して役割を割り当ててください",
+	"Really delete user %s?%s":                                           "ユーザー %s を削除しますか?%s",
+	"Deleting user %s as %s...":                                          "%[2]s としてユーザー %[1]s を削除しています...",
+	"User %s does not exist.":                                            "ユーザー %s は存在しません。",
+	"Failed fetching users in org %s.\n%s":                               "組織 %s のユーザーを取得できませんでした。\n%s",
+	"Checking users in %s as %s (dry run, no changes will be made)...":   "%[2]s として %[1]s のユーザーを確認しています (ドライラン、変更は行われません)...",
+	"Importing users from %s as %s...":                                   "%[2]s として %[1]s からユーザーをインポートしています...",
+	"Could not read %s\n%s":                                              "%s を読み取れませんでした\n%s",
+	"No users found in %s":                                               "%s にユーザーが見つかりません",
+	"failed: %s":                                                         "失敗: %s",
+	"line":                                                               "行",
+	"username":                                                           "ユーザー名",
+	"result":                                                             "結果",
+	"%d of %d rows could not be imported":                                "%[2]d 行中 %[1]d 行をインポートできませんでした",
+	"assigned %s in %s":                                                  "%[2]s で %[1]s を割り当てました",
+	"assigned %s in %s / %s":                                             "%[2]s / %[3]s で %[1]s を割り当てました",
+	"would assign %s in %s":                                              "%[2]s で %[1]s を割り当てます",
+	"would assign %s in %s / %s":                                         "%[2]s / %[3]s で %[1]s を割り当てます",
+	"user exists":                                                        "ユーザーは存在します",
+	"user does not exist and no password was given":                      "ユーザーが存在せず、パスワードが指定されていません",
+	"would create user":                                                  "ユーザーを作成します",
+	"created user":                                                       "ユーザーを作成しました",
+	"ORG MANAGER":                                                        "組織マネージャー",
+	"BILLING MANAGER":                                                    "請求マネージャー",
+	"ORG AUDITOR":                                                        "組織監査員",
+	"USERS":                                                              "ユーザー",
+	"Getting users in org %s as %s...":                                   "%[2]s として組織 %[1]s のユーザーを取得しています...",
+	"Failed fetching org-users for role %s.\n%s":                         "役割 %s の組織ユーザーを取得できませんでした。\n%s",
+	"Assigning role %s to user %s in org %s as %s...":                    "%[4]s として組織 %[3]s のユーザー %[2]s に役割 %[1]s を割り当てています...",
+	"Assigning role %s to user %s in org %s / space %s as %s...":         "%[5]s として組織 %[3]s / スペース %[4]s のユーザー %[2]s に役割 %[1]s を割り当てています...",
+	"SPACE MANAGER":                                                      "スペース・マネージャー",
+	"SPACE DEVELOPER":                                                    "スペース開発者",
+	"SPACE AUDITOR":                                                      "スペース監査員",
+	"Getting users in org %s / space %s as %s":                           "%[3]s として組織 %[1]s / スペース %[2]s のユーザーを取得しています",
+	"Failed fetching space-users for role %s.\n%s":                       "役割 %s のスペース・ユーザーを取得できませんでした。\n%s",
+	"Removing role %s from user %s in org %s as %s...":                   "%[4]s として組織 %[3]s のユーザー %[2]s から役割 %[1]s を削除しています...",
+	"Removing role %s from user %s in org %s / space %s as %s...":        "%[5]s として組織 %[3]s / スペース %[4]s のユーザー %[2]s から役割 %[1]s を削除しています...",
+	"Getting roles of user %s as %s...":                                  "%[2]s としてユーザー %[1]s の役割を取得しています...",
+	"Failed fetching roles.\n%s":                                         "役割を取得できませんでした。\n%s",
+	"User %s has no org or space roles":                                  "ユーザー %s には組織またはスペースの役割がありません",
+	"role":                                                               "役割",
+	"Failed fetching org %s.\n%s":                                        "組織 %s を取得できませんでした。\n%s",
+	"username is missing":                                                "ユーザー名がありません",
+	"org is missing":                                                     "組織がありません",
+	"invalid org role %s":                                                "組織の役割 %s は無効です",
+	"space role is missing":                                              "スペースの役割がありません",
+	"space is missing":                                                   "スペースがありません",
+	"invalid space role %s":                                              "スペースの役割 %s は無効です",
+	"line %d has %d columns, expected at most %d":                        "%[1]d 行目には %[2]d 列ありますが、最大 %[3]d 列である必要があります",
+	"user:    %s":                                                        "ユーザー:       %s",
+	"email:   %s":                                                        "E メール:       %s",
+	"id:      %s":                                                        "ID:             %s",
+	"client:  %s":                                                        "クライアント:   %s",
+	"scopes:  %s":                                                        "スコープ:       %s",
+	"expires: %s":                                                        "有効期限:       %s",
+	"unknown":                                                            "不明",
+	"%s (expired, it will be refreshed on the next request)":             "%s (期限切れ、次の要求時にリフレッシュされます)",
+	"%s (in %s)":                                                         "%s (残り %s)",
+	"ALIAS:":                                                             "別名:",
+	"OPTIONS:":                                                           "オプション:",
+	"%s (dry run)":                                                       "%s (ドライラン)",
+	"Refusing to download %s, it is not a file or directory name": "%s はファイル名またはディレクトリー名ではないため、ダウンロードを拒否しています",
+	"error: %s": "エラー: %s",
+	"No API endpoint targeted. Use '%s' or '%s' to target an endpoint.": "API エンドポイントがターゲットになっていません。エンドポイントをターゲットにするには '%s' または '%s' を使用してください。",
+	"No org and space targeted, use '%s' to target an org and space":    "組織とスペースがターゲットになっていません。組織とスペースをターゲットにするには '%s' を使用してください",
+	"No space targeted, use '%s' to target a space":                     "スペースがターゲットになっていません。スペースをターゲットにするには '%s' を使用してください",
+	"No org targeted, use '%s' to target an org.":                       "組織がターゲットになっていません。組織をターゲットにするには '%s' を使用してください。",
+	"Config error: %s": "構成エラー: %s",
+}
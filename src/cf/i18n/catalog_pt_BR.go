@@ -0,0 +1,857 @@
+package i18n
+
+// Brazilian Portuguese
+var pt_BR = map[string]string{
+	"OK":               "OK",
+	"FAILED":           "FALHOU",
+	"Incorrect Usage.": "Uso incorreto.",
+	"y":                "s",
+	"yes":              "sim",
+	"Please use '%s api' to set an API endpoint and then '%s login' to login.": "Use '%s api' para definir um endpoint de API e depois '%s login' para fazer login.",
+	"Not logged in. Use '%s' to log in.":                                       "Não conectado. Use '%s' para fazer login.",
+	"API endpoint: %s (API version: %s)":                                       "Endpoint da API: %s (versão da API: %s)",
+	"User:":                                                                    "Usuário:",
+	"Org:":                                                                     "Org:",
+	"Space:":                                                                   "Espaço:",
+	"No org or space targeted, use '%s'":                                       "Nenhuma org ou espaço como alvo, use '%s'",
+	"No org targeted, use '%s'":                                                "Nenhuma org como alvo, use '%s'",
+	"No space targeted, use '%s'":                                              "Nenhum espaço como alvo, use '%s'",
+	"NAME:":                                                                    "NOME:",
+	"USAGE:":                                                                   "USO:",
+	"VERSION:":                                                                 "VERSÃO:",
+	"ENVIRONMENT VARIABLES":                                                    "VARIÁVEIS DE AMBIENTE",
+	"GLOBAL OPTIONS":                                                           "OPÇÕES GLOBAIS",
+	"GETTING STARTED":                                                          "PRIMEIROS PASSOS",
+	"APPS":                                                                     "APLICATIVOS",
+	"SERVICES":                                                                 "SERVIÇOS",
+	"ORGS":                                                                     "ORGS",
+	"SPACES":                                                                   "ESPAÇOS",
+	"DOMAINS":                                                                  "DOMÍNIOS",
+	"ROUTES":                                                                   "ROTAS",
+	"BUILDPACKS":                                                               "BUILDPACKS",
+	"USER ADMIN":                                                               "ADMINISTRAÇÃO DE USUÁRIOS",
+	"ORG ADMIN":                                                                "ADMINISTRAÇÃO DE ORGS",
+	"SERVICE ADMIN":                                                            "ADMINISTRAÇÃO DE SERVIÇOS",
+	"ADVANCED":                                                                 "AVANÇADO",
+	"Deleting space %s will also delete:":                                      "Excluir o espaço %s também excluirá:",
+	"Failed fetching the contents of space %s.\n%s":                         "Falha ao buscar o conteúdo do espaço %s.\n%s",
+	"Dry run, space %s was not deleted":                                     "Simulação, o espaço %s não foi excluído",
+	"Really delete space %s and everything associated with it?%s":           "Realmente excluir o espaço %s e tudo associado a ele?%s",
+	"Deleting space %s in org %s as %s...":                                  "Excluindo o espaço %s na org %s como %s...",
+	"TIP: No space targeted, use '%s target -s' to target a space":          "DICA: Nenhum espaço como alvo, use '%s target -s' para definir um espaço como alvo",
+	"no apps, service instances or routes":                                  "nenhum aplicativo, instância de serviço ou rota",
+	"app %s (%s)":                                                           "aplicativo %s (%s)",
+	"service instance %s (user-provided)":                                   "instância de serviço %s (fornecida pelo usuário)",
+	"service instance %s (%s, will be deprovisioned by its service broker)": "instância de serviço %s (%s, será desprovisionada pelo seu service broker)",
+	"route %s":                 "rota %s",
+	"1 instance":               "1 instância",
+	"%d instances":             "%d instâncias",
+	"Deleting org %s as %s...": "Excluindo a org %s como %s...",
+	"Org %s does not exist.":   "A org %s não existe.",
+	"Failed fetching the contents of org %s.\n%s":               "Falha ao buscar o conteúdo da org %s.\n%s",
+	"Dry run, org %s was not deleted":                           "Simulação, a org %s não foi excluída",
+	"Really delete org %s and everything associated with it?%s": "Realmente excluir a org %s e tudo associado a ela?%s",
+	"Deleting org %s will also delete:":                         "Excluir a org %s também excluirá:",
+	"no spaces":                                                 "nenhum espaço",
+	"space %s":                                                  "espaço %s",
+	"private domain %s":                                         "domínio privado %s",
+	"Input required, but prompts are disabled in non-interactive mode: %s": "Entrada necessária, mas os prompts estão desativados no modo não interativo: %s",
+	"EXIT STATUS": "STATUS DE SAÍDA",
+	"Show help":   "Mostrar ajuda",
+	"A command line tool to interact with Cloud Foundry":                         "Uma ferramenta de linha de comando para interagir com o Cloud Foundry",
+	"Print the requests that would change anything instead of sending them":      "Imprimir as requisições que alterariam algo em vez de enviá-las",
+	"Fail instead of prompting for input":                                        "Falhar em vez de solicitar entrada",
+	"Set or view target api url":                                                 "Definir ou ver a url da api alvo",
+	"EXAMPLE:":                                                                   "EXEMPLO:",
+	"trust the certificates signed by an internal CA":                            "confiar nos certificados assinados por uma CA interna",
+	"Trust the PEM encoded CA certificates in this file, defaults to CF_CA_CERT": "Confiar nos certificados de CA codificados em PEM deste arquivo, o padrão é CF_CA_CERT",
+	"PEM encoded client certificate, for endpoints requiring mutual TLS":         "Certificado de cliente codificado em PEM, para endpoints que exigem TLS mútuo",
+	"PEM encoded private key of the client certificate":                          "Chave privada codificada em PEM do certificado de cliente",
+	"Please don't":                      "Por favor, não use",
+	"Display health and status for app": "Exibir a saúde e o status do aplicativo",
+	"SORT KEYS:":                        "CHAVES DE ORDENAÇÃO:",
+	"Refresh the instance list until interrupted":             "Atualizar a lista de instâncias até ser interrompido",
+	"Sort instances by the given key when watching":           "Ordenar as instâncias pela chave dada durante a observação",
+	"Seconds between refreshes when watching (defaults to 5)": "Segundos entre atualizações durante a observação (padrão é 5)",
+	"List all apps in the target space":                       "Listar todos os aplicativos no espaço alvo",
+	"Authenticate user non-interactively":                     "Autenticar o usuário de forma não interativa",
+	"WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history": "AVISO:\n   Fornecer sua senha como opção de linha de comando é altamente desaconselhado\n   Sua senha pode ficar visível para outros e pode ser registrada no histórico do shell",
+	"use quotes for passwords with a space":                                         "use aspas para senhas com espaço",
+	"escape quotes if used in password":                                             "escape as aspas se usadas na senha",
+	"authenticate a service account as an OAuth client":                             "autenticar uma conta de serviço como cliente OAuth",
+	"Authenticate as an OAuth client, with its ID and secret, instead of a user":    "Autenticar como cliente OAuth, com seu ID e segredo, em vez de um usuário",
+	"Bind a service instance to an app":                                             "Vincular uma instância de serviço a um aplicativo",
+	"List all buildpacks":                                                           "Listar todos os buildpacks",
+	"Check whether a route exists and whether it can be used in the targeted space": "Verificar se uma rota existe e se pode ser usada no espaço alvo",
+	"Create a buildpack":                                                            "Criar um buildpack",
+	"TIP:":                                                                          "DICA:",
+	"Path should be a zip file, a url to a zip file, or a local directory. Position is an integer, sets priority, and is sorted from lowest to highest.": "O caminho deve ser um arquivo zip, uma url para um arquivo zip ou um diretório local. A posição é um número inteiro, define a prioridade e é ordenada da menor para a maior.",
+	"Enable the buildpack":                         "Habilitar o buildpack",
+	"Disable the buildpack":                        "Desabilitar o buildpack",
+	"Create a domain in an org for later use":      "Criar um domínio em uma org para uso posterior",
+	"Create an org":                                "Criar uma org",
+	"Define a new resource quota":                  "Definir uma nova cota de recursos",
+	"Total amount of memory (e.g. 1024M, 1G, 10G)": "Quantidade total de memória (ex.: 1024M, 1G, 10G)",
+	"Maximum amount of memory an application instance can have (e.g. 1024M, 1G, 10G), -1 means unlimited": "Quantidade máxima de memória que uma instância de aplicativo pode ter (ex.: 1024M, 1G, 10G), -1 significa ilimitado",
+	"Total number of routes, -1 means unlimited":                                                          "Número total de rotas, -1 significa ilimitado",
+	"Total number of service instances, -1 means unlimited":                                               "Número total de instâncias de serviço, -1 significa ilimitado",
+	"Can provision instances of paid service plans":                                                       "Pode provisionar instâncias de planos de serviço pagos",
+	"Create a url route in a space for later use":                                                         "Criar uma rota de url em um espaço para uso posterior",
+	"Hostname":                  "Nome do host",
+	"Create a service instance": "Criar uma instância de serviço",
+	"Use '%s' to make user-provided services available to cf apps":                                                 "Use '%s' para disponibilizar serviços fornecidos pelo usuário para aplicativos cf",
+	"Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file": "Objeto JSON válido contendo parâmetros de configuração específicos do serviço, fornecido em linha ou em um arquivo",
+	"Create a service auth token": "Criar um token de autenticação de serviço",
+	"Create a service broker":     "Criar um service broker",
+	"Create a space":              "Criar um espaço",
+	"Organization":                "Organização",
+	"Create a new user":           "Criar um novo usuário",
+	"Make a user-provided service available to cf apps":                "Disponibilizar um serviço fornecido pelo usuário para aplicativos cf",
+	"Pass comma separated parameter names to enable interactive mode:": "Passe nomes de parâmetros separados por vírgula para habilitar o modo interativo:",
+	"Pass parameters as JSON to create a service non-interactively:":   "Passe os parâmetros como JSON para criar um serviço de forma não interativa:",
+	"Parameters":       "Parâmetros",
+	"Syslog Drain Url": "Url de Drenagem do Syslog",
+	"Executes a raw request, content-type set to application/json by default":        "Executa uma requisição bruta, content-type definido como application/json por padrão",
+	"Custom headers to include in the request, flag can be specified multiple times": "Cabeçalhos personalizados a incluir na requisição, a opção pode ser especificada várias vezes",
+	"HTTP data to include in the request body":                                       "Dados HTTP a incluir no corpo da requisição",
+	"Include response headers in the output":                                         "Incluir os cabeçalhos da resposta na saída",
+	"Enable CF_TRACE output for all requests and responses":                          "Habilitar a saída de CF_TRACE para todas as requisições e respostas",
+	"Delete an app":                       "Excluir um aplicativo",
+	"Force deletion without confirmation": "Forçar a exclusão sem confirmação",
+	"Delete a buildpack":                  "Excluir um buildpack",
+	"Delete a domain":                     "Excluir um domínio",
+	"Delete a shared domain":              "Excluir um domínio compartilhado",
+	"Delete an org":                       "Excluir uma org",
+	"List everything that would be deleted without deleting it, implied by the global --dry-run": "Listar tudo o que seria excluído sem excluir, implícito pelo --dry-run global",
+	"Delete the routes in the target space that are not mapped to any app":                       "Excluir as rotas do espaço alvo que não estão mapeadas para nenhum aplicativo",
+	"Delete a quota":              "Excluir uma cota",
+	"Delete a route":              "Excluir uma rota",
+	"Delete a service instance":   "Excluir uma instância de serviço",
+	"Delete a service auth token": "Excluir um token de autenticação de serviço",
+	"Delete a service broker":     "Excluir um service broker",
+	"Delete a space":              "Excluir um espaço",
+	"Delete a user":               "Excluir um usuário",
+	"Disable access to a service or service plan for one or all orgs":                             "Desabilitar o acesso a um serviço ou plano de serviço para uma ou todas as orgs",
+	"Disable access to a particular service plan":                                                 "Desabilitar o acesso a um plano de serviço específico",
+	"Disable access for a particular organization":                                                "Desabilitar o acesso para uma organização específica",
+	"Enable access to a service or service plan for one or all orgs":                              "Habilitar o acesso a um serviço ou plano de serviço para uma ou todas as orgs",
+	"Enable access to a particular service plan":                                                  "Habilitar o acesso a um plano de serviço específico",
+	"Enable access for a particular organization":                                                 "Habilitar o acesso para uma organização específica",
+	"List domains in the target org":                                                              "Listar os domínios na org alvo",
+	"Show all env variables for an app":                                                           "Mostrar todas as variáveis de ambiente de um aplicativo",
+	"Show recent app events":                                                                      "Mostrar os eventos recentes do aplicativo",
+	"Event types are crash, create, update and delete, several can be given separated by commas.": "Os tipos de evento são crash, create, update e delete, vários podem ser dados separados por vírgulas.",
+	"Dates are given as YYYY-MM-DD or as YYYY-MM-DDTHH:MM:SS in local time.":                      "As datas são dadas como YYYY-MM-DD ou como YYYY-MM-DDTHH:MM:SS no horário local.",
+	"Show events for every app in the targeted space":                                             "Mostrar os eventos de todos os aplicativos no espaço alvo",
+	"Show events for every app in the targeted org":                                               "Mostrar os eventos de todos os aplicativos na org alvo",
+	"Only show events of the given types":                                                         "Mostrar apenas eventos dos tipos dados",
+	"Only show events caused by the given user":                                                   "Mostrar apenas eventos causados pelo usuário dado",
+	"Only show events from this date on":                                                          "Mostrar apenas eventos a partir desta data",
+	"Only show events up to this date":                                                            "Mostrar apenas eventos até esta data",
+	"Print events as csv or json instead of a table":                                              "Imprimir os eventos como csv ou json em vez de uma tabela",
+	"Save all buildpacks with their settings and bits to a directory":                             "Salvar todos os buildpacks com suas configurações e bits em um diretório",
+	"Writes a zip file for each buildpack and a buildpacks.json file with\n   their names, positions and enabled/locked flags.": "Grava um arquivo zip para cada buildpack e um arquivo buildpacks.json com\n   seus nomes, posições e opções de habilitado/bloqueado.",
+	"Use '%s' to copy the buildpacks to another Cloud Foundry":                                                                  "Use '%s' para copiar os buildpacks para outro Cloud Foundry",
+	"Export the users of an org and their roles as CSV":                                                                         "Exportar os usuários de uma org e suas funções como CSV",
+	"Org to export": "Org a exportar",
+	"Print out a list of files in a directory or the contents of a specific file":                        "Imprimir uma lista de arquivos em um diretório ou o conteúdo de um arquivo específico",
+	"list the logs directory of the fourth instance":                                                     "listar o diretório logs da quarta instância",
+	"recursively download the logs directory":                                                            "baixar o diretório logs recursivamente",
+	"follow a log file as it grows":                                                                      "acompanhar um arquivo de log à medida que cresce",
+	"show a file on every instance":                                                                      "mostrar um arquivo em todas as instâncias",
+	"Index of the app instance (defaults to 0)":                                                          "Índice da instância do aplicativo (padrão é 0)",
+	"Show the path on every instance, prefixing each line with the instance index":                       "Mostrar o caminho em todas as instâncias, prefixando cada linha com o índice da instância",
+	"Recursively download the path to a local directory":                                                 "Baixar o caminho recursivamente para um diretório local",
+	"Follow a file as it grows":                                                                          "Acompanhar um arquivo à medida que cresce",
+	"Show the commands run from this machine":                                                            "Mostrar os comandos executados nesta máquina",
+	"Only show commands started since a duration ago (e.g. 2h, 3d) or a date (e.g. 2014-05-01)":          "Mostrar apenas comandos iniciados desde uma duração atrás (ex.: 2h, 3d) ou uma data (ex.: 2014-05-01)",
+	"Only show commands that failed":                                                                     "Mostrar apenas comandos que falharam",
+	"Create or update buildpacks from a directory written by export-buildpacks":                          "Criar ou atualizar buildpacks a partir de um diretório gravado por export-buildpacks",
+	"Buildpacks that already exist are updated, others are created.":                                     "Buildpacks que já existem são atualizados, os outros são criados.",
+	"Buildpacks that are not in DIR are left unchanged.":                                                 "Buildpacks que não estão em DIR não são alterados.",
+	"Create users and assign their org and space roles from a CSV file":                                  "Criar usuários e atribuir suas funções de org e espaço a partir de um arquivo CSV",
+	"Each row has the columns username,password,org,org_role,space,space_role.":                          "Cada linha tem as colunas username,password,org,org_role,space,space_role.",
+	"Users that already exist are not created again, rows for them can leave the password empty.":        "Usuários que já existem não são criados novamente, as linhas deles podem deixar a senha vazia.",
+	"Org roles are OrgUser, OrgManager, BillingManager and OrgAuditor.":                                  "As funções de org são OrgUser, OrgManager, BillingManager e OrgAuditor.",
+	"Space roles are SpaceManager, SpaceDeveloper and SpaceAuditor.":                                     "As funções de espaço são SpaceManager, SpaceDeveloper e SpaceAuditor.",
+	"Check the file and show what would be done without making changes, implied by the global --dry-run": "Verificar o arquivo e mostrar o que seria feito sem fazer alterações, implícito pelo --dry-run global",
+	"Log user in": "Fazer login do usuário",
+	"omit username and password to login interactively -- %s will prompt for both": "omita usuário e senha para fazer login interativamente -- %s solicitará ambos",
+	"specify username and password as arguments":                                   "especificar usuário e senha como argumentos",
+	"log in with a one-time passcode from your single sign-on provider":            "fazer login com uma senha de uso único do seu provedor de login único",
+	"Username":                            "Usuário",
+	"Password":                            "Senha",
+	"Org":                                 "Org",
+	"Space":                               "Espaço",
+	"Log in with a one-time passcode":     "Fazer login com uma senha de uso único",
+	"Log user out":                        "Fazer logout do usuário",
+	"Tail or show recent logs for an app": "Acompanhar ou mostrar os logs recentes de um aplicativo",
+	"Dump recent logs instead of tailing": "Despejar os logs recentes em vez de acompanhar",
+	"List available offerings in the marketplace":                                     "Listar as ofertas disponíveis no marketplace",
+	"Show plan details for a particular service offering":                             "Mostrar os detalhes dos planos de uma oferta de serviço específica",
+	"Only show offerings whose name, description, provider or plans contain the term": "Mostrar apenas ofertas cujo nome, descrição, provedor ou planos contenham o termo",
+	"Only show offerings from the given service broker":                               "Mostrar apenas ofertas do service broker dado",
+	"Only show offerings from the given provider":                                     "Mostrar apenas ofertas do provedor dado",
+	"Add a url route to an app":                                                       "Adicionar uma rota de url a um aplicativo",
+	"Print a fresh OAuth token, e.g. for use with other tools":                        "Imprimir um token OAuth novo, por exemplo para uso com outras ferramentas",
+	"Show org info":             "Mostrar as informações da org",
+	"Show org users by role":    "Mostrar os usuários da org por função",
+	"List all users in the org": "Listar todos os usuários da org",
+	"List all orgs":             "Listar todas as orgs",
+	"Change user password":      "Alterar a senha do usuário",
+	"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker": "Remover recursivamente um serviço e os objetos filhos do banco de dados do Cloud Foundry sem fazer requisições a um service broker",
+	"WARNING:": "AVISO:",
+	"This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.": "Esta operação pressupõe que o service broker responsável por esta oferta de serviço não está mais disponível e que todas as instâncias de serviço foram excluídas, deixando registros órfãos no banco de dados do Cloud Foundry. Todo o conhecimento do serviço será removido do Cloud Foundry, incluindo instâncias de serviço e vínculos de serviço. Nenhuma tentativa será feita de contatar o service broker; executar este comando sem destruir o service broker causará instâncias de serviço órfãs. Depois de executar este comando, você pode querer executar delete-service-auth-token ou delete-service-broker para concluir a limpeza.",
+	"Provider": "Provedor",
+	"Push a new app or sync changes to an existing app": "Enviar um novo aplicativo ou sincronizar as alterações de um aplicativo existente",
+	"Push a single app (with or without a manifest):":   "Enviar um único aplicativo (com ou sem um manifesto):",
+	"Push multiple apps with a manifest:":               "Enviar vários aplicativos com um manifesto:",
+	"Custom buildpack by name (e.g. my-buildpack) or GIT URL (e.g. https://github.com/heroku/heroku-buildpack-play.git)": "Buildpack personalizado pelo nome (ex.: my-buildpack) ou URL GIT (ex.: https://github.com/heroku/heroku-buildpack-play.git)",
+	"Startup command, set to null to reset to default start command":                                                     "Comando de inicialização, defina como null para voltar ao comando de inicialização padrão",
+	"Domain (e.g. example.com)":                                        "Domínio (ex.: example.com)",
+	"Path to manifest":                                                 "Caminho do manifesto",
+	"Number of instances":                                              "Número de instâncias",
+	"Memory limit (e.g. 256M, 1024M, 1G)":                              "Limite de memória (ex.: 256M, 1024M, 1G)",
+	"Hostname (e.g. my-subdomain)":                                     "Nome do host (ex.: my-subdomain)",
+	"Path of app directory or zip file":                                "Caminho do diretório do aplicativo ou arquivo zip",
+	"Stack to use":                                                     "Stack a usar",
+	"Start timeout in seconds":                                         "Tempo limite de inicialização em segundos",
+	"Map the root domain to this app":                                  "Mapear o domínio raiz para este aplicativo",
+	"Ignore manifest file":                                             "Ignorar o arquivo de manifesto",
+	"Do not map a route to this app":                                   "Não mapear uma rota para este aplicativo",
+	"Do not start an app after pushing":                                "Não iniciar o aplicativo após o envio",
+	"Unmap routes that are no longer listed for this app":              "Desmapear as rotas que não estão mais listadas para este aplicativo",
+	"Create a random route for this app":                               "Criar uma rota aleatória para este aplicativo",
+	"Show quota info":                                                  "Mostrar as informações da cota",
+	"List available usage quotas ":                                     "Listar as cotas de uso disponíveis ",
+	"Rename an app":                                                    "Renomear um aplicativo",
+	"Rename an org":                                                    "Renomear uma org",
+	"Rename a service instance":                                        "Renomear uma instância de serviço",
+	"Rename a service broker":                                          "Renomear um service broker",
+	"Rename a space":                                                   "Renomear um espaço",
+	"Set the priority order of all buildpacks at once":                 "Definir a ordem de prioridade de todos os buildpacks de uma vez",
+	"Every buildpack has to be listed, the first one gets position 1.": "Todo buildpack tem que ser listado, o primeiro fica na posição 1.",
+	"Restart an app":                                                   "Reiniciar um aplicativo",
+	"restart two instances at a time":                                  "reiniciar duas instâncias por vez",
+	"Restart instances in batches, waiting for each batch to be running before the next": "Reiniciar as instâncias em lotes, esperando cada lote estar em execução antes do próximo",
+	"Number of instances to restart at a time with --rolling (defaults to 1)":            "Número de instâncias a reiniciar por vez com --rolling (padrão é 1)",
+	"Restart a single instance of an app":                                                "Reiniciar uma única instância de um aplicativo",
+	"List all routes":                                                                    "Listar todas as rotas",
+	"Only list routes that are not mapped to any app":                                    "Listar apenas as rotas que não estão mapeadas para nenhum aplicativo",
+	"Change or view the instance count, disk space limit, and memory limit for an app":   "Alterar ou ver o número de instâncias, o limite de espaço em disco e o limite de memória de um aplicativo",
+	"Disk limit (e.g. 256M, 1024M, 1G)":                                                  "Limite de disco (ex.: 256M, 1024M, 1G)",
+	"Force restart of app without prompt":                                                "Forçar a reinicialização do aplicativo sem perguntar",
+	"Show service instance info":                                                         "Mostrar as informações da instância de serviço",
+	"List service access settings":                                                       "Listar as configurações de acesso aos serviços",
+	"List service auth tokens":                                                           "Listar os tokens de autenticação de serviço",
+	"List service brokers":                                                               "Listar os service brokers",
+	"List all services in the target space":                                              "Listar todos os serviços no espaço alvo",
+	"Migrate service instances from one service plan to another":                         "Migrar instâncias de serviço de um plano de serviço para outro",
+	"WARNING: This operation is internal to Cloud Foundry; service brokers will not be contacted and resources for service instances will not be altered. The primary use case for this operation is to replace a service broker which implements the v1 Service Broker API with a broker which implements the v2 API by remapping service instances from v1 plans to v2 plans.  We recommend making the v1 plan private or shutting down the v1 broker to prevent additional instances from being created. Once service instances have been migrated, the v1 services and plans can be removed from Cloud Foundry.": "AVISO: Esta operação é interna ao Cloud Foundry; os service brokers não serão contatados e os recursos das instâncias de serviço não serão alterados. O principal caso de uso desta operação é substituir um service broker que implementa a API de Service Broker v1 por um broker que implementa a API v2, remapeando as instâncias de serviço dos planos v1 para os planos v2. Recomendamos tornar o plano v1 privado ou desligar o broker v1 para evitar que instâncias adicionais sejam criadas. Depois que as instâncias de serviço forem migradas, os serviços e planos v1 podem ser removidos do Cloud Foundry.",
+	"Force migration without confirmation": "Forçar a migração sem confirmação",
+	"Set an env variable for an app":       "Definir uma variável de ambiente de um aplicativo",
+	"Assign an org role to a user":         "Atribuir uma função de org a um usuário",
+	"ROLES:":                               "FUNÇÕES:",
+	"Invite and manage users, select and change plans, and set spending limits": "Convidar e gerenciar usuários, selecionar e alterar planos e definir limites de gastos",
+	"Create and manage the billing account and payment info":                    "Criar e gerenciar a conta de faturamento e as informações de pagamento",
+	"Read-only access to org info and reports":                                  "Acesso somente leitura às informações e relatórios da org",
+	"Define the quota for an org":                                               "Definir a cota de uma org",
+	"View allowable quotas with '%s'":                                           "Veja as cotas permitidas com '%s'",
+	"Assign a space role to a user":                                             "Atribuir uma função de espaço a um usuário",
+	"Invite and manage users, and enable features for a given space":            "Convidar e gerenciar usuários e habilitar recursos para um determinado espaço",
+	"Create and manage apps and services, and see logs and reports":             "Criar e gerenciar aplicativos e serviços e ver logs e relatórios",
+	"View logs, reports, and settings on this space":                            "Ver os logs, relatórios e configurações deste espaço",
+	"Create a domain that can be used by all orgs (admin-only)":                 "Criar um domínio que pode ser usado por todas as orgs (somente administrador)",
+	"Show space info":           "Mostrar as informações do espaço",
+	"Show space users by role":  "Mostrar os usuários do espaço por função",
+	"List all spaces in an org": "Listar todos os espaços de uma org",
+	"List all stacks":           "Listar todos os stacks",
+	"Run a buildpack against an app on this machine to debug staging":                                                             "Executar um buildpack em um aplicativo nesta máquina para depurar a preparação",
+	"BUILDPACK can be a directory, a zip file or a URL. When it is not given,\n   the buildpack from the app's manifest is used.": "BUILDPACK pode ser um diretório, um arquivo zip ou uma URL. Quando não é dado,\n   o buildpack do manifesto do aplicativo é usado.",
+	"The files ignored by .cfignore are left out, as they are when pushing.":                                                      "Os arquivos ignorados pelo .cfignore são deixados de fora, como ao enviar.",
+	"Buildpacks can only be run on Linux.":                                                                                        "Os buildpacks só podem ser executados no Linux.",
+	"Buildpack directory, zip file or URL":                                                                                        "Diretório, arquivo zip ou URL do buildpack",
+	"Path to the app directory (default is the current directory)":                                                                "Caminho do diretório do aplicativo (o padrão é o diretório atual)",
+	"Start an app":                          "Iniciar um aplicativo",
+	"Stop an app":                           "Parar um aplicativo",
+	"Set or view the targeted org or space": "Definir ou ver a org ou o espaço alvo",
+	"organization":                          "organização",
+	"space":                                 "espaço",
+	"Display a refreshing dashboard of all apps in the target space": "Exibir um painel atualizado de todos os aplicativos no espaço alvo",
+	"Sort apps by the given key":                                     "Ordenar os aplicativos pela chave dada",
+	"Seconds between refreshes (defaults to 5)":                      "Segundos entre atualizações (padrão é 5)",
+	"Unbind a service instance from an app":                          "Desvincular uma instância de serviço de um aplicativo",
+	"Remove a url route from an app":                                 "Remover uma rota de url de um aplicativo",
+	"Remove an env variable":                                         "Remover uma variável de ambiente",
+	"Remove an org role from a user":                                 "Remover uma função de org de um usuário",
+	"Remove a space role from a user":                                "Remover uma função de espaço de um usuário",
+	"Update a buildpack":                                             "Atualizar um buildpack",
+	"Buildpack position among other buildpacks":                      "Posição do buildpack entre os outros buildpacks",
+	"Path to directory or zip file":                                  "Caminho do diretório ou arquivo zip",
+	"Lock the buildpack":                                             "Bloquear o buildpack",
+	"Unlock the buildpack":                                           "Desbloquear o buildpack",
+	"Update an existing resource quota":                              "Atualizar uma cota de recursos existente",
+	"Cannot provision instances of paid service plans":               "Não pode provisionar instâncias de planos de serviço pagos",
+	"New name": "Novo nome",
+	"Change the plan or the configuration parameters of a service instance":                                    "Alterar o plano ou os parâmetros de configuração de uma instância de serviço",
+	"Not every service broker supports plan changes, the broker may refuse the update.":                        "Nem todo service broker suporta alterações de plano, o broker pode recusar a atualização.",
+	"Change the service plan of the instance":                                                                  "Alterar o plano de serviço da instância",
+	"Update a service broker":                                                                                  "Atualizar um service broker",
+	"Update a service auth token":                                                                              "Atualizar um token de autenticação de serviço",
+	"Update user-provided service name value pairs":                                                            "Atualizar os pares de nome e valor de um serviço fornecido pelo usuário",
+	"Show resource usage of an org compared to its quota":                                                      "Mostrar o uso de recursos de uma org comparado à sua cota",
+	"Memory counts the started apps only, the same way the quota is enforced.":                                 "A memória conta apenas os aplicativos iniciados, da mesma forma que a cota é aplicada.",
+	"Orgs using %d%% or more of a limit are flagged.":                                                          "Orgs usando %d%% ou mais de um limite são sinalizadas.",
+	"Show usage for the given org instead of the targeted org":                                                 "Mostrar o uso da org dada em vez da org alvo",
+	"Show usage for every org":                                                                                 "Mostrar o uso de todas as orgs",
+	"Show every org and space role of a user":                                                                  "Mostrar todas as funções de org e espaço de um usuário",
+	"export the roles of every user as CSV":                                                                    "exportar as funções de todos os usuários como CSV",
+	"Export the roles of every user in every org as CSV":                                                       "Exportar as funções de todos os usuários em todas as orgs como CSV",
+	"Check a buildpack directory, zip file or URL for problems before uploading it":                            "Verificar se há problemas em um diretório, arquivo zip ou URL de buildpack antes de enviá-lo",
+	"%s and %s run the same checks before uploading":                                                           "%s e %s executam as mesmas verificações antes de enviar",
+	"Check the catalog of a service broker before registering it":                                              "Verificar o catálogo de um service broker antes de registrá-lo",
+	"Username for the broker's basic auth":                                                                     "Usuário da autenticação básica do broker",
+	"Password for the broker's basic auth":                                                                     "Senha da autenticação básica do broker",
+	"Show who you are logged in as, with your scopes and when your session expires":                            "Mostrar como quem você está logado, com seus escopos e quando sua sessão expira",
+	"Trust the CA certificates in this file when setting the api endpoint":                                     "Confiar nos certificados de CA deste arquivo ao definir o endpoint da api",
+	"Do not colorize output":                                                                                   "Não colorir a saída",
+	"Override path to default config directory":                                                                "Substituir o caminho do diretório de configuração padrão",
+	"Language of messages, overrides LANG (en_US, ja_JP or pt_BR)":                                             "Idioma das mensagens, substitui LANG (en_US, ja_JP ou pt_BR)",
+	"Max wait time for buildpack staging, in minutes":                                                          "Tempo máximo de espera pela preparação do buildpack, em minutos",
+	"Max wait time for app instance startup, in minutes":                                                       "Tempo máximo de espera pela inicialização da instância do aplicativo, em minutos",
+	"Print API request diagnostics to stdout":                                                                  "Imprimir o diagnóstico das requisições da API no stdout",
+	"Append API request diagnostics to a log file":                                                             "Anexar o diagnóstico das requisições da API a um arquivo de log",
+	"Enable HTTP proxying for API requests":                                                                    "Habilitar o proxy HTTP para as requisições da API",
+	"Print the version":                                                                                        "Imprimir a versão",
+	"Success":                                                                                                  "Sucesso",
+	"Any failure not listed below":                                                                             "Qualquer falha não listada abaixo",
+	"Incorrect usage, or input needed in non-interactive mode":                                                 "Uso incorreto, ou entrada necessária no modo não interativo",
+	"Not logged in, bad credentials or an expired session":                                                     "Não logado, credenciais inválidas ou sessão expirada",
+	"The app, space, service or other resource does not exist":                                                 "O aplicativo, espaço, serviço ou outro recurso não existe",
+	"The server failed or could not be reached":                                                                "O servidor falhou ou não pôde ser alcançado",
+	"The server, staging or an async job took too long":                                                        "O servidor, a preparação ou um job assíncrono demorou demais",
+	"No api endpoint set. Use '%s' to set an endpoint":                                                         "Nenhum endpoint de api definido. Use '%s' para definir um endpoint",
+	"Setting api endpoint to %s...":                                                                            "Definindo o endpoint da api como %s...",
+	"TIP: Use '%s' to trust a custom certificate authority, or '%s' to continue with an insecure API endpoint": "DICA: Use '%s' para confiar em uma autoridade certificadora personalizada, ou '%s' para continuar com um endpoint de API inseguro",
+	"Invalid SSL Cert for %s\n%s":                                                                              "Certificado SSL inválido para %s\n%s",
+	"Warning: Insecure http API endpoint detected: secure https API endpoints are recommended":                 "Aviso: Endpoint de API http inseguro detectado: endpoints de API https seguros são recomendados",
+	"Could not read CA certificate file %s\n%s":                                                                "Não foi possível ler o arquivo de certificado de CA %s\n%s",
+	"Could not read client certificate file %s\n%s":                                                            "Não foi possível ler o arquivo de certificado de cliente %s\n%s",
+	"Could not read client key file %s\n%s":                                                                    "Não foi possível ler o arquivo de chave de cliente %s\n%s",
+	"Really delete %s?%s":                                                                                      "Realmente excluir %s?%s",
+	"Deleting app %s in org %s / space %s as %s...":                                                            "Excluindo o aplicativo %s na org %s / espaço %s como %s...",
+	"App %s does not exist.":                                                                                   "O aplicativo %s não existe.",
+	"Getting env variables for app %s in org %s / space %s as %s...":                                           "Obtendo as variáveis de ambiente do aplicativo %s na org %s / espaço %s como %s...",
+	"No env variables exist":                                                                                   "Não existem variáveis de ambiente",
+	"org %s":                                                                                                   "org %s",
+	"org %s / space %s":                                                                                        "org %s / espaço %s",
+	"app %s":                                                                                                   "aplicativo %s",
+	"app %s in org %s / space %s":                                                                              "aplicativo %s na org %s / espaço %s",
+	"Failed fetching events.\n%s":                                                                              "Falha ao buscar os eventos.\n%s",
+	"Getting events for %s as %s...\n":                                                                         "Obtendo os eventos de %s como %s...\n",
+	"No events for %s":                                                                                         "Nenhum evento para %s",
+	"time":                                                                                                     "hora",
+	"event":                                                                                                    "evento",
+	"actor":                                                                                                    "autor",
+	"description":                                                                                              "descrição",
+	"target":                                                                                                   "alvo",
+	"the app exited on its own":                                                                                "o aplicativo terminou por conta própria",
+	"the start command is not executable":                                                                      "o comando de inicialização não é executável",
+	"the start command was not found":                                                                          "o comando de inicialização não foi encontrado",
+	"killed by SIGKILL, usually because the app exceeded its memory limit":                                     "morto por SIGKILL, geralmente porque o aplicativo excedeu seu limite de memória",
+	"terminated by SIGTERM":                                                                                    "terminado por SIGTERM",
+	"segmentation fault":                                                                                       "falha de segmentação",
+	"aborted by SIGABRT":                                                                                       "abortado por SIGABRT",
+	"killed by signal %d":                                                                                      "morto pelo sinal %d",
+	"the app exited with an error":                                                                             "o aplicativo terminou com um erro",
+	"Instance %d of app %s does not exist. The app has %d instance(s).":                                        "A instância %d do aplicativo %s não existe. O aplicativo tem %d instância(s).",
+	"Getting files for app %s in org %s / space %s as %s...":                                                   "Obtendo os arquivos do aplicativo %s na org %s / espaço %s como %s...",
+	"Getting files for all instances of app %s in org %s / space %s as %s...":                                  "Obtendo os arquivos de todas as instâncias do aplicativo %s na org %s / espaço %s como %s...",
+	"Downloading %s from instance %d of app %s in org %s / space %s as %s...":                                  "Baixando %s da instância %d do aplicativo %s na org %s / espaço %s como %s...",
+	"Downloaded %d file(s) to %s":                                                                              "%d arquivo(s) baixado(s) para %s",
+	"Tailing %s on instance %d of app %s in org %s / space %s as %s...":                                        "Acompanhando %s na instância %d do aplicativo %s na org %s / espaço %s como %s...",
+	"Getting apps in org %s / space %s as %s...":                                                               "Obtendo os aplicativos na org %s / espaço %s como %s...",
+	"No apps found":                                                                                            "Nenhum aplicativo encontrado",
+	"name":                                                                                                     "nome",
+	"requested state":                                                                                          "estado solicitado",
+	"instances":                                                                                                "instâncias",
+	"memory":                                                                                                   "memória",
+	"disk":                                                                                                     "disco",
+	"urls":                                                                                                     "urls",
+	"Connected, dumping recent logs for app %s in org %s / space %s as %s...\n":                                "Conectado, despejando os logs recentes do aplicativo %s na org %s / espaço %s como %s...\n",
+	"Connected, tailing logs for app %s in org %s / space %s as %s...\n":                                       "Conectado, acompanhando os logs do aplicativo %s na org %s / espaço %s como %s...\n",
+	"TIP: use the --skip-ssl-validation to suppress this error":                                                "DICA: use o --skip-ssl-validation para suprimir este erro",
+	"Uploading %s...":                                                                                          "Enviando %s...",
+	"Error uploading application.\n%s":                                                                         "Erro ao enviar o aplicativo.\n%s",
+	"Could not find service %s to bind to %s":                                                                  "Não foi possível encontrar o serviço %s para vincular a %s",
+	"Binding service %s to %s in org %s / space %s as %s":                                                      "Vinculando o serviço %s a %s na org %s / espaço %s como %s",
+	"Could not find to service %s\nError: %s":                                                                  "Não foi possível encontrar o serviço %s\nErro: %s",
+	"Uploading from: %s\n%s, %d files":                                                                         "Enviando de: %s\n%s, %d arquivos",
+	"Using stack %s...":                                                                                        "Usando o stack %s...",
+	"App %s is a worker, skipping route creation":                                                              "O aplicativo %s é um worker, pulando a criação de rota",
+	"Using route %s":                                                                                           "Usando a rota %s",
+	"Creating route %s...":                                                                                     "Criando a rota %s...",
+	"Binding %s to %s...":                                                                                      "Vinculando %s a %s...",
+	"The route %s is already in use.\nTIP: Change the hostname with -n HOSTNAME or use --random-route to generate a new route and then push again.": "A rota %s já está em uso.\nDICA: Altere o nome do host com -n HOSTNAME ou use --random-route para gerar uma nova rota e então envie novamente.",
+	"Unbinding %s from %s...":                       "Desvinculando %s de %s...",
+	"No default domain exists":                      "Não existe nenhum domínio padrão",
+	"No domain found for route %s":                  "Nenhum domínio encontrado para a rota %s",
+	"Could not find a default domain":               "Não foi possível encontrar um domínio padrão",
+	"Error: No name found for app":                  "Erro: Nenhum nome encontrado para o aplicativo",
+	"Creating app %s in org %s / space %s as %s...": "Criando o aplicativo %s na org %s / espaço %s como %s...",
+	"Updating app %s in org %s / space %s as %s...": "Atualizando o aplicativo %s na org %s / espaço %s como %s...",
+	"Error: %s": "Erro: %s",
+	"Incorrect Usage. Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file.": "Uso Incorreto. As opções de linha de comando (exceto -f) não podem ser aplicadas ao enviar vários aplicativos de um arquivo de manifesto.",
+	"Could not determine the current working directory!":                                                                 "Não foi possível determinar o diretório de trabalho atual!",
+	"Error reading manifest file:\n%s":                                                                                   "Erro ao ler o arquivo de manifesto:\n%s",
+	"Using manifest file %s\n":                                                                                           "Usando o arquivo de manifesto %s\n",
+	"Could not find app named '%s' in manifest":                                                                          "Não foi possível encontrar o aplicativo chamado '%s' no manifesto",
+	"app name is a required field":                                                                                       "o nome do aplicativo é um campo obrigatório",
+	"Could not find named app in manifest":                                                                               "Não foi possível encontrar o aplicativo nomeado no manifesto",
+	"Invalid memory param: %s\n%s":                                                                                       "Parâmetro de memória inválido: %s\n%s",
+	"Invalid instances param: %s\n%s":                                                                                    "Parâmetro de instâncias inválido: %s\n%s",
+	"Invalid timeout param: %s\n%s":                                                                                      "Parâmetro de tempo limite inválido: %s\n%s",
+	"Renaming app %s to %s in org %s / space %s as %s...":                                                                "Renomeando o aplicativo %s para %s na org %s / espaço %s como %s...",
+	"Restarting app %s in org %s / space %s as %s, %d instance(s) at a time...":                                          "Reiniciando o aplicativo %s na org %s / espaço %s como %s, %d instância(s) por vez...",
+	"App %s is not started. Use '%s' to start it.":                                                                       "O aplicativo %s não está iniciado. Use '%s' para iniciá-lo.",
+	"App %s has only one instance, it will be unavailable while it restarts.":                                            "O aplicativo %s tem apenas uma instância, ele ficará indisponível enquanto reinicia.",
+	"Restarting %s...": "Reiniciando %s...",
+	"%s\n\nRolling restart aborted, instances after %s were not restarted.\n\nTIP: use '%s' for more information": "%s\n\nReinicialização gradual abortada, as instâncias após %s não foram reiniciadas.\n\nDICA: use '%s' para mais informações",
+	"App restarted":                       "Aplicativo reiniciado",
+	"Timed out waiting for %s to start":   "Tempo esgotado esperando %s iniciar",
+	"Instance #%d is crashing":            "A instância #%d está falhando",
+	"%d of %d instances in batch running": "%d de %d instâncias do lote em execução",
+	"instances %s":                        "instâncias %s",
+	"instance %s":                         "instância %s",
+	"Restarting instance %d of app %s in org %s / space %s as %s...": "Reiniciando a instância %d do aplicativo %s na org %s / espaço %s como %s...",
+	"Showing current scale of app %s in org %s / space %s as %s...":  "Mostrando a escala atual do aplicativo %s na org %s / espaço %s como %s...",
+	"memory:":                  "memória:",
+	"disk:":                    "disco:",
+	"instances:":               "instâncias:",
+	"Invalid value for memory": "Valor inválido para memória",
+	"Invalid value for disk":   "Valor inválido para disco",
+	"Scaling app %s in org %s / space %s as %s...":                               "Escalando o aplicativo %s na org %s / espaço %s como %s...",
+	"This will cause the app to restage. Are you sure you want to scale %s?":     "Isto fará o aplicativo ser preparado novamente. Tem certeza de que deseja escalar %s?",
+	"Setting env variable '%s' to '%s' for app %s in org %s / space %s as %s...": "Definindo a variável de ambiente '%s' como '%s' para o aplicativo %s na org %s / espaço %s como %s...",
+	"TIP: Use '%s' to ensure your env variable changes take effect":              "DICA: Use '%s' para garantir que as alterações das variáveis de ambiente tenham efeito",
+	"Showing health and status for app %s in org %s / space %s as %s...":         "Mostrando a saúde e o status do aplicativo %s na org %s / espaço %s como %s...",
+	"requested state:":     "estado solicitado:",
+	"%s %s x %d instances": "%s %s x %d instâncias",
+	"usage:":               "uso:",
+	"urls:":                "urls:",
+	"There are no running instances of this app.": "Não há instâncias deste aplicativo em execução.",
+	"state":    "estado",
+	"since":    "desde",
+	"cpu":      "cpu",
+	"%s of %s": "%s de %s",
+	"Error finding the current directory: %s": "Erro ao encontrar o diretório atual: %s",
+	"No buildpack given.\nTIP: Use '%s stage-local -b BUILDPACK' or set a buildpack in the app's manifest.": "Nenhum buildpack dado.\nDICA: Use '%s stage-local -b BUILDPACK' ou defina um buildpack no manifesto do aplicativo.",
+	"Staging app in %s locally with buildpack %s...":                                                        "Preparando o aplicativo em %s localmente com o buildpack %s...",
+	"detected buildpack:": "buildpack detectado:",
+	"start command:":      "comando de inicialização:",
+	"release:":            "release:",
+	"invalid value for env var CF_STAGING_TIMEOUT\n%s": "valor inválido para a variável de ambiente CF_STAGING_TIMEOUT\n%s",
+	"invalid value for env var CF_STARTUP_TIMEOUT\n%s": "valor inválido para a variável de ambiente CF_STARTUP_TIMEOUT\n%s",
+	"App %s is already started":                        "O aplicativo %s já está iniciado",
+	"Starting app %s in org %s / space %s as %s...":    "Iniciando o aplicativo %s na org %s / espaço %s como %s...",
+	"App started":                                              "Aplicativo iniciado",
+	"Warning: error tailing logs":                              "Aviso: erro ao acompanhar os logs",
+	"%s\n\nTIP: use '%s' for more information":                 "%s\n\nDICA: use '%s' para mais informações",
+	"Start app timeout\n\nTIP: use '%s' for more information":  "Tempo esgotado ao iniciar o aplicativo\n\nDICA: use '%s' para mais informações",
+	"Start unsuccessful\n\nTIP: use '%s' for more information": "Inicialização sem sucesso\n\nDICA: use '%s' para mais informações",
+	"%d of %d instances running":                               "%d de %d instâncias em execução",
+	"%d starting":                                              "%d iniciando",
+	"%d down":                                                  "%d fora do ar",
+	"%d failing":                                               "%d falhando",
+	"App %s is already stopped":                                "O aplicativo %s já está parado",
+	"Stopping app %s in org %s / space %s as %s...":            "Parando o aplicativo %s na org %s / espaço %s como %s...",
+	"Watching apps in org %s / space %s as %s...":              "Observando os aplicativos na org %s / espaço %s como %s...",
+	"%d/%d (%d crashing)":                                      "%d/%d (%d falhando)",
+	"Watching app %s in org %s / space %s as %s...":            "Observando o aplicativo %s na org %s / espaço %s como %s...",
+	"%d of %d instances are crashing or down":                  "%d de %d instâncias estão falhando ou fora do ar",
+	"%s %s, %s every %s, press Ctrl-C to quit":                 "%s %s, %s a cada %s, pressione Ctrl-C para sair",
+	"sorted by":  "ordenado por",
+	"refreshing": "atualizando",
+	"Removing env variable %s from app %s in org %s / space %s as %s...": "Removendo a variável de ambiente %s do aplicativo %s na org %s / espaço %s como %s...",
+	"Env variable %s was not set.":                                       "A variável de ambiente %s não estava definida.",
+	"API endpoint: %s":                                                   "Endpoint da API: %s",
+	"Authenticating...":                                                  "Autenticando...",
+	"Use '%s' to view or set your target org and space":                  "Use '%s' para ver ou definir a org e o espaço alvo",
+	"Creating buildpack %s...":                                           "Criando o buildpack %s...",
+	"Buildpack %s already exists":                                        "O buildpack %s já existe",
+	"TIP: use '%s' to update this buildpack":                             "DICA: use '%s' para atualizar este buildpack",
+	"Uploading buildpack %s...":                                          "Enviando o buildpack %s...",
+	"Invalid position. %s":                                               "Posição inválida. %s",
+	"Cannot specify both enabled and disabled.":                          "Não é possível especificar habilitado e desabilitado ao mesmo tempo.",
+	"Are you sure you want to delete the buildpack %s ?":                 "Tem certeza de que deseja excluir o buildpack %s ?",
+	"Deleting buildpack %s...":                                           "Excluindo o buildpack %s...",
+	"Buildpack %s does not exist.":                                       "O buildpack %s não existe.",
+	"Error deleting buildpack %s\n%s":                                    "Erro ao excluir o buildpack %s\n%s",
+	"Exporting buildpacks to %s...":                                      "Exportando os buildpacks para %s...",
+	"Failed fetching buildpacks.\n%s":                                    "Falha ao buscar os buildpacks.\n%s",
+	"Error creating directory %s: %s":                                    "Erro ao criar o diretório %s: %s",
+	"  downloading %s":                                                   "  baixando %s",
+	"Failed downloading buildpack %s.\n%s":                               "Falha ao baixar o buildpack %s.\n%s",
+	"Error writing %s: %s":                                               "Erro ao gravar %s: %s",
+	"Exported %d buildpacks":                                             "%d buildpacks exportados",
+	"Importing buildpacks from %s...":                                    "Importando os buildpacks de %s...",
+	"  updating %s":                                                      "  atualizando %s",
+	"  creating %s":                                                      "  criando %s",
+	"Failed importing buildpack %s.\n%s":                                 "Falha ao importar o buildpack %s.\n%s",
+	"Imported %d buildpacks":                                             "%d buildpacks importados",
+	"Error reading %s: %s":                                               "Erro ao ler %s: %s",
+	"Error parsing %s: %s":                                               "Erro ao analisar %s: %s",
+	"Error parsing %s: every buildpack needs a name":                     "Erro ao analisar %s: todo buildpack precisa de um nome",
+	"Getting buildpacks...\n":                                            "Obtendo os buildpacks...\n",
+	"buildpack":                                                          "buildpack",
+	"position":                                                           "posição",
+	"enabled":                                                            "habilitado",
+	"locked":                                                             "bloqueado",
+	"filename":                                                           "nome do arquivo",
+	"No buildpacks found":                                                "Nenhum buildpack encontrado",
+	"Reordering buildpacks...":                                           "Reordenando os buildpacks...",
+	"Failed moving buildpack %s.\n%s":                                    "Falha ao mover o buildpack %s.\n%s",
+	"%s\nThe original order could not be restored: %s":                   "%s\nA ordem original não pôde ser restaurada: %s",
+	"%s\nThe original order was restored.":                               "%s\nA ordem original foi restaurada.",
+	"Buildpack %s not found":                                             "Buildpack %s não encontrado",
+	"Buildpack %s is listed more than once":                              "O buildpack %s está listado mais de uma vez",
+	"The order must include every buildpack. Missing: %s":                "A ordem deve incluir todos os buildpacks. Faltando: %s",
+	"Failed verifying the buildpack order.\n%s":                          "Falha ao verificar a ordem dos buildpacks.\n%s",
+	"The buildpack order could not be verified.\nExpected: %s\nActual:   %s": "A ordem dos buildpacks não pôde ser verificada.\nEsperada: %s\nAtual:    %s",
+	"Updating buildpack %s...":                          "Atualizando o buildpack %s...",
+	"Cannot specify both enabled and disabled options.": "Não é possível especificar as opções habilitado e desabilitado ao mesmo tempo.",
+	"Cannot specify both lock and unlock options.":      "Não é possível especificar as opções bloquear e desbloquear ao mesmo tempo.",
+	"Cannot specify buildpack bits and lock/unlock.":    "Não é possível especificar os bits do buildpack e bloquear/desbloquear.",
+	"Error updating buildpack %s\n%s":                   "Erro ao atualizar o buildpack %s\n%s",
+	"Error uploading buildpack %s\n%s":                  "Erro ao enviar o buildpack %s\n%s",
+	"Validating buildpack %s...":                        "Validando o buildpack %s...",
+	"layout:":                                           "layout:",
+	"files:":                                            "arquivos:",
+	"size:":                                             "tamanho:",
+	"sha1:":                                             "sha1:",
+	"Buildpack %s is not valid":                         "O buildpack %s não é válido",
+	"Error creating request:\n%s":                       "Erro ao criar a requisição:\n%s",
+	"Creating domain %s for org %s as %s...":            "Criando o domínio %s para a org %s como %s...",
+	"Creating shared domain %s as %s...":                "Criando o domínio compartilhado %s como %s...",
+	"Deleting domain %s as %s...":                       "Excluindo o domínio %s como %s...",
+	"Error finding domain %s\n%s":                       "Erro ao encontrar o domínio %s\n%s",
+	"Are you sure you want to delete the domain %s and all of its associations?": "Tem certeza de que deseja excluir o domínio %s e todas as suas associações?",
+	"Error deleting domain %s\n%s": "Erro ao excluir o domínio %s\n%s",
+	"This domain is shared across all orgs.\nDeleting it will remove all associated routes, and will make any app with this domain unreachable.\nAre you sure you want to delete the domain %s? ": "Este domínio é compartilhado por todas as orgs.\nExcluí-lo removerá todas as rotas associadas e tornará inacessível qualquer aplicativo com este domínio.\nTem certeza de que deseja excluir o domínio %s? ",
+	"Getting domains in org %s as %s...":    "Obtendo os domínios na org %s como %s...",
+	"status":                                "status",
+	"Failed fetching shared domains.\n%s":   "Falha ao buscar os domínios compartilhados.\n%s",
+	"Failed fetching private domains.\n%s":  "Falha ao buscar os domínios privados.\n%s",
+	"No domains found":                      "Nenhum domínio encontrado",
+	"shared":                                "compartilhado",
+	"owned":                                 "próprio",
+	"Getting command history...":            "Obtendo o histórico de comandos...",
+	"Error reading the command history: %s": "Erro ao ler o histórico de comandos: %s",
+	"No commands found":                     "Nenhum comando encontrado",
+	"started":                               "iniciado",
+	"user":                                  "usuário",
+	"api":                                   "api",
+	"org":                                   "org",
+	"command":                               "comando",
+	"failed (%d)":                           "falhou (%d)",
+	"ok":                                    "ok",
+	"Invalid value for --since: %s\nUse a duration like 2h or 3d, or a date like 2014-05-01": "Valor inválido para --since: %s\nUse uma duração como 2h ou 3d, ou uma data como 2014-05-01",
+	"API endpoint%s":                                                  "Endpoint da API%s",
+	"Unable to authenticate.":                                         "Não foi possível autenticar.",
+	"Single sign-on is not supported by %s":                           "O login único não é suportado por %s",
+	"Error finding avilable orgs\n%s":                                 "Erro ao encontrar as orgs disponíveis\n%s",
+	"Error finding org %s\n%s":                                        "Erro ao encontrar a org %s\n%s",
+	"Select an org (or press enter to skip):":                         "Selecione uma org (ou pressione enter para pular):",
+	"Targeted org %s\n":                                               "Org alvo %s\n",
+	"Error finding available spaces\n%s":                              "Erro ao encontrar os espaços disponíveis\n%s",
+	"Error finding space %s\n%s":                                      "Erro ao encontrar o espaço %s\n%s",
+	"Select a space (or press enter to skip):":                        "Selecione um espaço (ou pressione enter para pular):",
+	"Targeted space %s\n":                                             "Espaço alvo %s\n",
+	"There are too many options to display, please type in the name.": "Há opções demais para exibir, por favor digite o nome.",
+	"Logging out...":                                                  "Fazendo logout...",
+	"Creating org %s as %s...":                                        "Criando a org %s como %s...",
+	"Org %s already exists":                                           "A org %s já existe",
+	"\nTIP: Use '%s' to target new org":                               "\nDICA: Use '%s' para definir a nova org como alvo",
+	"Creating quota %s as %s...":                                      "Criando a cota %s como %s...",
+	"Quota %s already exists":                                         "A cota %s já existe",
+	"TIP: use '%s' to change its limits":                              "DICA: use '%s' para alterar seus limites",
+	"Really delete the quota %s?%s":                                   "Realmente excluir a cota %s?%s",
+	"Deleting quota %s as %s...":                                      "Excluindo a cota %s como %s...",
+	"Quota %s does not exist":                                         "A cota %s não existe",
+	"Getting orgs as %s...\n":                                         "Obtendo as orgs como %s...\n",
+	"Failed fetching orgs.\n%s":                                       "Falha ao buscar as orgs.\n%s",
+	"No orgs found":                                                   "Nenhuma org encontrada",
+	"Getting quotas as %s...":                                         "Obtendo as cotas como %s...",
+	"total memory limit":                                              "limite total de memória",
+	"instance memory limit":                                           "limite de memória por instância",
+	"routes":                                                          "rotas",
+	"service instances":                                               "instâncias de serviço",
+	"paid service plans":                                              "planos de serviço pagos",
+	"unlimited":                                                       "ilimitado",
+	"allowed":                                                         "permitido",
+	"disallowed":                                                      "não permitido",
+	"Total memory limit cannot be unlimited":                          "O limite total de memória não pode ser ilimitado",
+	"Paid service plans cannot be both allowed and disallowed":        "Os planos de serviço pagos não podem ser permitidos e não permitidos ao mesmo tempo",
+	"Renaming org %s to %s as %s...":                                  "Renomeando a org %s para %s como %s...",
+	"Setting quota %s to org %s as %s...":                             "Definindo a cota %s para a org %s como %s...",
+	"Getting info for org %s as %s...":                                "Obtendo as informações da org %s como %s...",
+	"%s (%dM memory limit)":                                           "%s (limite de memória de %dM)",
+	"  domains: %s":                                                   "  domínios: %s",
+	"  quota:   %s":                                                   "  cota:     %s",
+	"  spaces:  %s":                                                   "  espaços:  %s",
+	"Getting quota %s info as %s...":                                  "Obtendo as informações da cota %s como %s...",
+	"total memory limit:":                                             "limite total de memória:",
+	"instance memory limit:":                                          "limite de memória por instância:",
+	"routes:":                                                         "rotas:",
+	"service instances:":                                              "instâncias de serviço:",
+	"paid service plans:":                                             "planos de serviço pagos:",
+	"Updating quota %s as %s...":                                      "Atualizando a cota %s como %s...",
+	"Getting usage for all orgs as %s...":                             "Obtendo o uso de todas as orgs como %s...",
+	"Getting usage for org %s as %s...":                               "Obtendo o uso da org %s como %s...",
+	"Failed fetching usage for org %s.\n%s":                           "Falha ao buscar o uso da org %s.\n%s",
+	"%d of %d orgs are close to their quota limits: %s":               "%d de %d orgs estão perto dos limites de suas cotas: %s",
+	"quota":                                "cota",
+	"No spaces found":                      "Nenhum espaço encontrado",
+	"apps":                                 "aplicativos",
+	"Org %s is close to its quota limits.": "A org %s está perto dos limites de sua cota.",
+	"%s of unlimited":                      "%s de ilimitado",
+	"%s of %s (%d%%)":                      "%s de %s (%d%%)",
+	"Current Password%s":                   "Senha Atual%s",
+	"New Password%s":                       "Nova Senha%s",
+	"Verify Password%s":                    "Confirmar Senha%s",
+	"Password verification does not match": "A confirmação da senha não confere",
+	"Changing password...":                 "Alterando a senha...",
+	"Current password did not match":       "A senha atual não confere",
+	"Please log in again":                  "Por favor, faça login novamente",
+	"Checking route %s as %s...":           "Verificando a rota %s como %s...",
+	"none":                                 "nenhum",
+	"route:":                               "rota:",
+	"space:":                               "espaço:",
+	"apps:":                                "aplicativos:",
+	"Route %s belongs to space %s and can't be used in space %s":                                "A rota %s pertence ao espaço %s e não pode ser usada no espaço %s",
+	"Route %s belongs to space %s and can be mapped to its apps":                                "A rota %s pertence ao espaço %s e pode ser mapeada para seus aplicativos",
+	"Route %s was not found in the spaces visible to you, it may still be taken by another org": "A rota %s não foi encontrada nos espaços visíveis para você, ela ainda pode estar em uso por outra org",
+	"Route %s is already taken by a space you don't have access to":                             "A rota %s já está em uso por um espaço ao qual você não tem acesso",
+	"Route %s does not exist and can be created in space %s":                                    "A rota %s não existe e pode ser criada no espaço %s",
+	"Creating route %s for org %s / space %s as %s...":                                          "Criando a rota %s para a org %s / espaço %s como %s...",
+	"Route %s already exists":                                                                   "A rota %s já existe",
+	"Getting routes not mapped to any app in org %s / space %s as %s...":                        "Obtendo as rotas não mapeadas para nenhum aplicativo na org %s / espaço %s como %s...",
+	"Failed fetching routes.\n%s":                                                               "Falha ao buscar as rotas.\n%s",
+	"No orphaned routes found":                                                                  "Nenhuma rota órfã encontrada",
+	"route":                                                                                     "rota",
+	"Really delete these %d routes?%s":                                                          "Realmente excluir estas %d rotas?%s",
+	"Deleting orphaned routes as %s...":                                                         "Excluindo as rotas órfãs como %s...",
+	"Could not delete route %s: %s":                                                             "Não foi possível excluir a rota %s: %s",
+	"Failed deleting %d of %d orphaned routes":                                                  "Falha ao excluir %d de %d rotas órfãs",
+	"Deleted %d orphaned routes":                                                                "%d rotas órfãs excluídas",
+	"Really delete route %s?%s":                                                                 "Realmente excluir a rota %s?%s",
+	"Deleting route %s...":                                                                      "Excluindo a rota %s...",
+	"Route %s does not exist.":                                                                  "A rota %s não existe.",
+	"Getting routes as %s ...\n":                                                                "Obtendo as rotas como %s ...\n",
+	"host":                                                                                      "host",
+	"domain":                                                                                    "domínio",
+	"No routes found":                                                                           "Nenhuma rota encontrada",
+	"Getting routes not mapped to any app as %s ...\n":                                          "Obtendo as rotas não mapeadas para nenhum aplicativo como %s ...\n",
+	"Error resolving route:\n%s":                                                                "Erro ao resolver a rota:\n%s",
+	"Adding route %s to app %s in org %s / space %s as %s...":                                   "Adicionando a rota %s ao aplicativo %s na org %s / espaço %s como %s...",
+	"Removing route %s from app %s in org %s / space %s as %s...":                               "Removendo a rota %s do aplicativo %s na org %s / espaço %s como %s...",
+	"Error finding command %s":                                                                  "Erro ao encontrar o comando %s",
+	"Binding service %s to app %s in org %s / space %s as %s...":                                "Vinculando o serviço %s ao aplicativo %s na org %s / espaço %s como %s...",
+	"App %s is already bound to %s.":                                                            "O aplicativo %s já está vinculado a %s.",
+	"TIP: Use '%s push' to ensure your env variable changes take effect":                        "DICA: Use '%s push' para garantir que as alterações nas variáveis de ambiente tenham efeito",
+	"Creating service %s in org %s / space %s as %s...":                                         "Criando o serviço %s na org %s / espaço %s como %s...",
+	"Service %s already exists":                                                                 "O serviço %s já existe",
+	"Could not find any offerings with name %s":                                                 "Não foi possível encontrar ofertas com o nome %s",
+	"Could not find plan with name %s":                                                          "Não foi possível encontrar o plano com o nome %s",
+	"Creating user provided service %s in org %s / space %s as %s...":                           "Criando o serviço fornecido pelo usuário %s na org %s / espaço %s como %s...",
+	"Are you sure you want to delete the service %s ?":                                          "Tem certeza de que deseja excluir o serviço %s ?",
+	"Deleting service %s in org %s / space %s as %s...":                                         "Excluindo o serviço %s na org %s / espaço %s como %s...",
+	"Service %s does not exist.":                                                                "O serviço %s não existe.",
+	"Getting services in org %s / space %s as %s...":                                            "Obtendo os serviços na org %s / espaço %s como %s...",
+	"No services found":                                                                         "Nenhum serviço encontrado",
+	"service":                                                                                   "serviço",
+	"plan":                                                                                      "plano",
+	"bound apps":                                                                                "aplicativos vinculados",
+	"user-provided":                                                                             "fornecido pelo usuário",
+	"services from marketplace":                                                                 "serviços do marketplace",
+	"service plan information for service %s":                                                   "informações de planos do serviço %s",
+	"Getting %s in org %s / space %s as %s...":                                                  "Obtendo %s na org %s / espaço %s como %s...",
+	"all services from marketplace":                                                             "todos os serviços do marketplace",
+	"Getting %s...":                                                                             "Obtendo %s...",
+	"Cannot list marketplace services without a targeted space":                                 "Não é possível listar os serviços do marketplace sem um espaço alvo",
+	"No service offerings found":                                                                "Nenhuma oferta de serviço encontrada",
+	"plans":                                                                                     "planos",
+	"Service offering %s not found":                                                             "Oferta de serviço %s não encontrada",
+	"service:":                                                                                  "serviço:",
+	"description:":                                                                              "descrição:",
+	"provider:":                                                                                 "provedor:",
+	"documentation:":                                                                            "documentação:",
+	"paid":                                                                                      "pago",
+	"free":                                                                                      "grátis",
+	"No service plans found":                                                                    "Nenhum plano de serviço encontrado",
+	"service plan":                                                                              "plano de serviço",
+	"free or paid":                                                                              "grátis ou pago",
+	"display name":                                                                              "nome de exibição",
+	"costs":                                                                                     "custos",
+	"features":                                                                                  "recursos",
+	"Plan %s cannot be found":                                                                   "O plano %s não foi encontrado",
+	"Plan %s has no service instances to migrate":                                               "O plano %s não tem instâncias de serviço para migrar",
+	"Really migrate %s from plan %s to %s?>":                                                    "Realmente migrar %s do plano %s para %s?>",
+	"Attempting to migrate %s...":                                                               "Tentando migrar %s...",
+	"%s migrated.":                                                                              "%s migrado(s).",
+	"%d service instance":                                                                       "%d instância de serviço",
+	"%d service instances":                                                                      "%d instâncias de serviço",
+	"Service offering does not exist\nTIP: If you are trying to purge a v1 service offering, you must set the -p flag.": "A oferta de serviço não existe\nDICA: Se você está tentando remover uma oferta de serviço v1, deve definir a opção -p.",
+	"Warning: This operation assumes that the service broker responsible for this service offering is no longer available, and all service instances have been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service will be removed from Cloud Foundry, including service instances and service bindings. No attempt will be made to contact the service broker; running this command without destroying the service broker will cause orphan service instances. After running this command you may want to run either delete-service-auth-token or delete-service-broker to complete the cleanup.": "Aviso: Esta operação pressupõe que o service broker responsável por esta oferta de serviço não está mais disponível e que todas as instâncias de serviço foram excluídas, deixando registros órfãos no banco de dados do Cloud Foundry. Todo o conhecimento do serviço será removido do Cloud Foundry, incluindo instâncias de serviço e vínculos de serviço. Não haverá tentativa de contatar o service broker; executar este comando sem destruir o service broker causará instâncias de serviço órfãs. Depois de executar este comando, talvez você queira executar delete-service-auth-token ou delete-service-broker para concluir a limpeza.",
+	"Really purge service offering %s from Cloud Foundry?":                   "Realmente remover a oferta de serviço %s do Cloud Foundry?",
+	"Purging service %s...":                                                  "Removendo o serviço %s...",
+	"Renaming service %s to %s in org %s / space %s as %s...":                "Renomeando o serviço %s para %s na org %s / espaço %s como %s...",
+	"%s\nTIP: Use '%s services' to view all services in this org and space.": "%s\nDICA: Use '%s services' para ver todos os serviços nesta org e espaço.",
+	"Invalid configuration provided for -c flag. Please provide a valid JSON object or the path to a file containing a valid JSON object.": "Configuração inválida fornecida para a opção -c. Forneça um objeto JSON válido ou o caminho para um arquivo contendo um objeto JSON válido.",
+	"Service instance: %s":  "Instância de serviço: %s",
+	"Service: %s":           "Serviço: %s",
+	"Plan: %s":              "Plano: %s",
+	"Description: %s":       "Descrição: %s",
+	"Documentation url: %s": "URL da documentação: %s",
+	"Unbinding app %s from service %s in org %s / space %s as %s...":                                                               "Desvinculando o aplicativo %s do serviço %s na org %s / espaço %s como %s...",
+	"Binding between %s and %s did not exist":                                                                                      "O vínculo entre %s e %s não existia",
+	"Updating service instance %s in org %s / space %s as %s...":                                                                   "Atualizando a instância de serviço %s na org %s / espaço %s como %s...",
+	"Service instance %s is user-provided and has no plan.\nTIP: Use '%s update-user-provided-service' to change its credentials.": "A instância de serviço %s é fornecida pelo usuário e não tem plano.\nDICA: Use '%s update-user-provided-service' para alterar suas credenciais.",
+	"Service instance %s already uses plan %s":                                                                                     "A instância de serviço %s já usa o plano %s",
+	"%s\nTIP: Use '%s marketplace' to see the plans of service %s.":                                                                "%s\nDICA: Use '%s marketplace' para ver os planos do serviço %s.",
+	"The service broker refused to update service instance %s%s.\n%s":                                                              "O service broker recusou a atualização da instância de serviço %s%s.\n%s",
+	" from plan %s to plan %s":                                                                                                     " do plano %s para o plano %s",
+	"Service Instance is not user provided":                                                                                        "A instância de serviço não é fornecida pelo usuário",
+	"JSON is invalid: %s":                                                                                                          "O JSON é inválido: %s",
+	"Updating user provided service %s in org %s / space %s as %s...":                                                              "Atualizando o serviço fornecido pelo usuário %s na org %s / espaço %s como %s...",
+	"TIP: To make these changes take effect, use '%s unbind-service' to unbind the service, '%s bind-service' to rebind, and then '%s push' to update the app with the new env variables": "DICA: Para que estas alterações tenham efeito, use '%s unbind-service' para desvincular o serviço, '%s bind-service' para vinculá-lo novamente e depois '%s push' para atualizar o aplicativo com as novas variáveis de ambiente",
+	"No flags specified. No changes were made.":      "Nenhuma opção especificada. Nenhuma alteração foi feita.",
+	"Disabling access to %s for %s as %s...":         "Desabilitando o acesso a %s para %s como %s...",
+	"Failed fetching service plan visibilities.\n%s": "Falha ao buscar as visibilidades dos planos de serviço.\n%s",
+	"Failed disabling access to plan %s.\n%s":        "Falha ao desabilitar o acesso ao plano %s.\n%s",
+	"Plan %s is public and remains accessible to org %s.\nTIP: Use '%s disable-service-access %s -p %s' and then enable it for each org that should keep access.": "O plano %s é público e continua acessível para a org %s.\nDICA: Use '%s disable-service-access %s -p %s' e depois habilite-o para cada org que deve manter o acesso.",
+	"Enabling access to %s for %s as %s...":                "Habilitando o acesso a %s para %s como %s...",
+	"Failed enabling access to plan %s.\n%s":               "Falha ao habilitar o acesso ao plano %s.\n%s",
+	"Failed enabling access to plan %s for org %s.\n%s":    "Falha ao habilitar o acesso ao plano %s para a org %s.\n%s",
+	"Plan %s is public and already accessible to all orgs": "O plano %s é público e já está acessível para todas as orgs",
+	"Getting service access as %s...":                      "Obtendo o acesso aos serviços como %s...",
+	"Failed fetching service offerings.\n%s":               "Falha ao buscar as ofertas de serviço.\n%s",
+	"access":                                               "acesso",
+	"orgs":                                                 "orgs",
+	"all":                                                  "todos",
+	"limited":                                              "limitado",
+	"Service plan %s not found for service %s":             "Plano de serviço %s não encontrado para o serviço %s",
+	"Service %s has no plans":                              "O serviço %s não tem planos",
+	"all plans of service %s":                              "todos os planos do serviço %s",
+	"plan %s of service %s":                                "plano %s do serviço %s",
+	"all orgs":                                             "todas as orgs",
+	"Creating service auth token as %s...":                 "Criando o token de autenticação de serviço como %s...",
+	"Are you sure you want to delete %s?%s":                "Tem certeza de que deseja excluir %s?%s",
+	"Deleting service auth token as %s":                    "Excluindo o token de autenticação de serviço como %s",
+	"Service Auth Token %s %s does not exist.":             "O token de autenticação de serviço %s %s não existe.",
+	"Getting service auth tokens as %s...":                 "Obtendo os tokens de autenticação de serviço como %s...",
+	"label":                                                "rótulo",
+	"provider":                                             "provedor",
+	"Updating service auth token as %s...":                 "Atualizando o token de autenticação de serviço como %s...",
+	"Creating service broker %s as %s...":                  "Criando o service broker %s como %s...",
+	"%s\nTIP: Use '%s validate-service-broker %s' to check the broker's catalog": "%s\nDICA: Use '%s validate-service-broker %s' para verificar o catálogo do broker",
+	"Deleting service broker %s as %s...":                                        "Excluindo o service broker %s como %s...",
+	"Service Broker %s does not exist.":                                          "O service broker %s não existe.",
+	"Getting service brokers as %s...\n":                                         "Obtendo os service brokers como %s...\n",
+	"url":                                                                        "url",
+	"Failed fetching service brokers.\n%s":                                       "Falha ao buscar os service brokers.\n%s",
+	"No service brokers found":                                                   "Nenhum service broker encontrado",
+	"Renaming service broker %s to %s as %s":                                     "Renomeando o service broker %s para %s como %s",
+	"Updating service broker %s as %s...":                                        "Atualizando o service broker %s como %s...",
+	"Validating catalog of service broker at %s...":                              "Validando o catálogo do service broker em %s...",
+	"Could not fetch the catalog.\n%s":                                           "Não foi possível buscar o catálogo.\n%s",
+	"Found %d problems in the catalog":                                           "%d problemas encontrados no catálogo",
+	"Catalog is valid: %d services with %d plans":                                "O catálogo é válido: %d serviços com %d planos",
+	"TIP: Use '%s create-service-broker' to register the broker":                 "DICA: Use '%s create-service-broker' para registrar o broker",
+	"Creating space %s in org %s as %s...":                                       "Criando o espaço %s na org %s como %s...",
+	"Org %s does not exist or is not accessible":                                 "A org %s não existe ou não está acessível",
+	"Space %s already exists":                                                    "O espaço %s já existe",
+	"\nTIP: Use '%s' to target new space":                                        "\nDICA: Use '%s' para definir o novo espaço como alvo",
+	"Getting spaces in org %s as %s...\n":                                        "Obtendo os espaços na org %s como %s...\n",
+	"Failed fetching spaces.\n%s":                                                "Falha ao buscar os espaços.\n%s",
+	"Renaming space %s to %s in org %s as %s...":                                 "Renomeando o espaço %s para %s na org %s como %s...",
+	"Getting info for space %s in org %s as %s...":                               "Obtendo as informações do espaço %s na org %s como %s...",
+	"  Org: %s":      "  Org: %s",
+	"  Apps: %s":     "  Aplicativos: %s",
+	"  Domains: %s":  "  Domínios: %s",
+	"  Services: %s": "  Serviços: %s",
+	"Getting stacks in org %s / space %s as %s...":                       "Obtendo as stacks na org %s / espaço %s como %s...",
+	"Could not target org.\n%s":                                          "Não foi possível definir a org como alvo.\n%s",
+	"An org must be targeted before targeting a space":                   "Uma org deve ser definida como alvo antes de um espaço",
+	"Unable to access space %s.\n%s":                                     "Não foi possível acessar o espaço %s.\n%s",
+	"Creating user %s as %s...":                                          "Criando o usuário %s como %s...",
+	"Error creating user %s.\n%s":                                        "Erro ao criar o usuário %s.\n%s",
+	"\nTIP: Assign roles with '%s set-org-role' and '%s set-space-role'": "\nDICA: Atribua papéis com '%s set-org-role' e '%s set-space-role'",
+	"Really delete user %s?%s":                                           "Realmente excluir o usuário %s?%s",
+	"Deleting user %s as %s...":                                          "Excluindo o usuário %s como %s...",
+	"User %s does not exist.":                                            "O usuário %s não existe.",
+	"Failed fetching users in org %s.\n%s":                               "Falha ao buscar os usuários na org %s.\n%s",
+	"Checking users in %s as %s (dry run, no changes will be made)...":   "Verificando os usuários em %s como %s (simulação, nenhuma alteração será feita)...",
+	"Importing users from %s as %s...":                                   "Importando os usuários de %s como %s...",
+	"Could not read %s\n%s":                                              "Não foi possível ler %s\n%s",
+	"No users found in %s":                                               "Nenhum usuário encontrado em %s",
+	"failed: %s":                                                         "falhou: %s",
+	"line":                                                               "linha",
+	"username":                                                           "nome de usuário",
+	"result":                                                             "resultado",
+	"%d of %d rows could not be imported":                                "%d de %d linhas não puderam ser importadas",
+	"assigned %s in %s":                                                  "%s atribuído em %s",
+	"assigned %s in %s / %s":                                             "%s atribuído em %s / %s",
+	"would assign %s in %s":                                              "atribuiria %s em %s",
+	"would assign %s in %s / %s":                                         "atribuiria %s em %s / %s",
+	"user exists":                                                        "o usuário existe",
+	"user does not exist and no password was given":                      "o usuário não existe e nenhuma senha foi fornecida",
+	"would create user":                                                  "criaria o usuário",
+	"created user":                                                       "usuário criado",
+	"ORG MANAGER":                                                        "GERENTE DA ORG",
+	"BILLING MANAGER":                                                    "GERENTE DE COBRANÇA",
+	"ORG AUDITOR":                                                        "AUDITOR DA ORG",
+	"USERS":                                                              "USUÁRIOS",
+	"Getting users in org %s as %s...":                                   "Obtendo os usuários na org %s como %s...",
+	"Failed fetching org-users for role %s.\n%s":                         "Falha ao buscar os usuários da org para o papel %s.\n%s",
+	"Assigning role %s to user %s in org %s as %s...":                    "Atribuindo o papel %s ao usuário %s na org %s como %s...",
+	"Assigning role %s to user %s in org %s / space %s as %s...":         "Atribuindo o papel %s ao usuário %s na org %s / espaço %s como %s...",
+	"SPACE MANAGER":                                                      "GERENTE DO ESPAÇO",
+	"SPACE DEVELOPER":                                                    "DESENVOLVEDOR DO ESPAÇO",
+	"SPACE AUDITOR":                                                      "AUDITOR DO ESPAÇO",
+	"Getting users in org %s / space %s as %s":                           "Obtendo os usuários na org %s / espaço %s como %s",
+	"Failed fetching space-users for role %s.\n%s":                       "Falha ao buscar os usuários do espaço para o papel %s.\n%s",
+	"Removing role %s from user %s in org %s as %s...":                   "Removendo o papel %s do usuário %s na org %s como %s...",
+	"Removing role %s from user %s in org %s / space %s as %s...":        "Removendo o papel %s do usuário %s na org %s / espaço %s como %s...",
+	"Getting roles of user %s as %s...":                                  "Obtendo os papéis do usuário %s como %s...",
+	"Failed fetching roles.\n%s":                                         "Falha ao buscar os papéis.\n%s",
+	"User %s has no org or space roles":                                  "O usuário %s não tem papéis de org ou espaço",
+	"role":                                                               "papel",
+	"Failed fetching org %s.\n%s":                                        "Falha ao buscar a org %s.\n%s",
+	"username is missing":                                                "o nome de usuário está faltando",
+	"org is missing":                                                     "a org está faltando",
+	"invalid org role %s":                                                "papel de org inválido %s",
+	"space role is missing":                                              "o papel de espaço está faltando",
+	"space is missing":                                                   "o espaço está faltando",
+	"invalid space role %s":                                              "papel de espaço inválido %s",
+	"line %d has %d columns, expected at most %d":                        "a linha %d tem %d colunas, o esperado era no máximo %d",
+	"user:    %s":                                                        "usuário:  %s",
+	"email:   %s":                                                        "e-mail:   %s",
+	"id:      %s":                                                        "id:       %s",
+	"client:  %s":                                                        "cliente:  %s",
+	"scopes:  %s":                                                        "escopos:  %s",
+	"expires: %s":                                                        "expira:   %s",
+	"unknown":                                                            "desconhecido",
+	"%s (expired, it will be refreshed on the next request)":             "%s (expirado, será renovado na próxima requisição)",
+	"%s (in %s)":                                                         "%s (em %s)",
+	"ALIAS:":                                                             "APELIDO:",
+	"OPTIONS:":                                                           "OPÇÕES:",
+	"%s (dry run)":                                                       "%s (simulação)",
+	"Refusing to download %s, it is not a file or directory name": "Recusando o download de %s, não é um nome de arquivo ou diretório",
+	"error: %s": "erro: %s",
+	"No API endpoint targeted. Use '%s' or '%s' to target an endpoint.": "Nenhum endpoint da API definido como alvo. Use '%s' ou '%s' para definir um endpoint como alvo.",
+	"No org and space targeted, use '%s' to target an org and space":    "Nenhuma org e espaço definidos como alvo, use '%s' para definir uma org e um espaço como alvo",
+	"No space targeted, use '%s' to target a space":                     "Nenhum espaço definido como alvo, use '%s' para definir um espaço como alvo",
+	"No org targeted, use '%s' to target an org.":                       "Nenhuma org definida como alvo, use '%s' para definir uma org como alvo.",
+	"Config error: %s": "Erro de configuração: %s",
+}
@@ -0,0 +1,100 @@
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	CF_LOCALE      = "CF_LOCALE"
+	DEFAULT_LOCALE = "en_US"
+)
+
+// the catalog of every locale maps the English message to its translation;
+// the English catalog lists every message there is
+var catalogs = map[string]map[string]string{
+	"en_US": en_US,
+	"ja_JP": ja_JP,
+	"pt_BR": pt_BR,
+}
+
+var currentLocale = DEFAULT_LOCALE
+
+func init() {
+	SetLocale(LocaleFromEnv())
+}
+
+// CF_LOCALE wins over LANG, so the CLI can be switched without
+// changing the locale of everything else in the shell
+func LocaleFromEnv() string {
+	locale := os.Getenv(CF_LOCALE)
+	if locale == "" {
+		locale = os.Getenv("LANG")
+	}
+	return locale
+}
+
+// SetLocale picks the supported locale closest to the given one, e.g. ja_JP
+// for ja_JP.UTF-8 or ja, and English when there is none
+func SetLocale(locale string) {
+	currentLocale = supportedLocale(locale)
+}
+
+func Locale() string {
+	return currentLocale
+}
+
+func SupportedLocales() (locales []string) {
+	for locale, _ := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return
+}
+
+// T translates a message into the current locale. Without args the message
+// is returned as a format, ready for ui.Say and friends.
+func T(message string, args ...interface{}) string {
+	translation, found := catalogs[currentLocale][message]
+	if !found || translation == "" {
+		translation = message
+	}
+
+	if len(args) == 0 {
+		return translation
+	}
+	return fmt.Sprintf(translation, args...)
+}
+
+func supportedLocale(locale string) string {
+	locale = strings.SplitN(locale, ".", 2)[0]
+	locale = strings.SplitN(locale, "@", 2)[0]
+	locale = strings.Replace(locale, "-", "_", -1)
+
+	parts := strings.SplitN(locale, "_", 2)
+	language := strings.ToLower(parts[0])
+	if language == "" {
+		return DEFAULT_LOCALE
+	}
+
+	if len(parts) == 2 {
+		exact := language + "_" + strings.ToUpper(parts[1])
+		if _, found := catalogs[exact]; found {
+			return exact
+		}
+	}
+
+	for _, supported := range SupportedLocales() {
+		if strings.HasPrefix(supported, language+"_") {
+			return supported
+		}
+	}
+	return DEFAULT_LOCALE
+}
+
+// Catalog is the catalog of a supported locale, nil for any other
+func Catalog(locale string) map[string]string {
+	return catalogs[locale]
+}
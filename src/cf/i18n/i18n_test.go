@@ -0,0 +1,233 @@
+package i18n_test
+
+import (
+	. "cf/i18n"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ = Describe("i18n", func() {
+	AfterEach(func() {
+		SetLocale(DEFAULT_LOCALE)
+	})
+
+	Describe("choosing a locale", func() {
+		It("uses a supported locale as it is", func() {
+			SetLocale("ja_JP")
+			Expect(Locale()).To(Equal("ja_JP"))
+		})
+
+		It("ignores the encoding and modifier of the locale", func() {
+			SetLocale("pt_BR.UTF-8")
+			Expect(Locale()).To(Equal("pt_BR"))
+
+			SetLocale("ja_JP.eucJP@euro")
+			Expect(Locale()).To(Equal("ja_JP"))
+		})
+
+		It("accepts language tags written with a dash", func() {
+			SetLocale("pt-br")
+			Expect(Locale()).To(Equal("pt_BR"))
+		})
+
+		It("falls back to another locale of the same language", func() {
+			SetLocale("pt_PT")
+			Expect(Locale()).To(Equal("pt_BR"))
+
+			SetLocale("ja")
+			Expect(Locale()).To(Equal("ja_JP"))
+		})
+
+		It("falls back to English", func() {
+			SetLocale("de_DE.UTF-8")
+			Expect(Locale()).To(Equal(DEFAULT_LOCALE))
+
+			SetLocale("C")
+			Expect(Locale()).To(Equal(DEFAULT_LOCALE))
+
+			SetLocale("")
+			Expect(Locale()).To(Equal(DEFAULT_LOCALE))
+		})
+
+		Describe("from the environment", func() {
+			var oldLocale, oldLang string
+
+			BeforeEach(func() {
+				oldLocale = os.Getenv(CF_LOCALE)
+				oldLang = os.Getenv("LANG")
+			})
+
+			AfterEach(func() {
+				os.Setenv(CF_LOCALE, oldLocale)
+				os.Setenv("LANG", oldLang)
+			})
+
+			It("prefers CF_LOCALE over LANG", func() {
+				os.Setenv(CF_LOCALE, "ja_JP")
+				os.Setenv("LANG", "pt_BR.UTF-8")
+				Expect(LocaleFromEnv()).To(Equal("ja_JP"))
+			})
+
+			It("uses LANG when CF_LOCALE is not set", func() {
+				os.Setenv(CF_LOCALE, "")
+				os.Setenv("LANG", "pt_BR.UTF-8")
+				Expect(LocaleFromEnv()).To(Equal("pt_BR.UTF-8"))
+			})
+		})
+	})
+
+	Describe("translating", func() {
+		It("translates into the current locale", func() {
+			SetLocale("pt_BR")
+			Expect(T("FAILED")).To(Equal("FALHOU"))
+		})
+
+		It("formats the translation with the args", func() {
+			SetLocale("pt_BR")
+			Expect(T("Org %s does not exist.", "my-org")).To(Equal("A org my-org não existe."))
+		})
+
+		It("keeps the order of the args in translations that reorder them", func() {
+			SetLocale("ja_JP")
+			Expect(T("Deleting org %s as %s...", "my-org", "my-user")).To(Equal("my-user として組織 my-org を削除しています..."))
+		})
+
+		It("returns the message untouched when there is no translation", func() {
+			SetLocale("ja_JP")
+			Expect(T("Not a message %s")).To(Equal("Not a message %s"))
+			Expect(T("Not a message %s", "at all")).To(Equal("Not a message at all"))
+		})
+	})
+
+	Describe("the catalogs", func() {
+		It("translate every message into every locale", func() {
+			english := Catalog(DEFAULT_LOCALE)
+
+			for _, locale := range SupportedLocales() {
+				catalog := Catalog(locale)
+
+				for message, _ := range english {
+					translation, found := catalog[message]
+					Expect(found).To(BeTrue(), locale+" has no translation of: "+message)
+					Expect(placeholders(translation)).To(Equal(placeholders(message)), locale+" has other placeholders for: "+message)
+				}
+
+				for message, _ := range catalog {
+					_, found := english[message]
+					Expect(found).To(BeTrue(), locale+" translates a message that is not in "+DEFAULT_LOCALE+": "+message)
+				}
+			}
+		})
+
+		It("have every message the CLI translates", func() {
+			english := Catalog(DEFAULT_LOCALE)
+
+			for _, message := range translatedMessages("..", "../../main") {
+				_, found := english[message]
+				Expect(found).To(BeTrue(), "no catalog entry for: "+message)
+			}
+		})
+	})
+})
+
+var placeholderRegexp = regexp.MustCompile(`%%|%(\[(\d+)\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z]`)
+
+// the verbs a message is formatted with, in the order of their args, so
+// translations can reorder them with %[n]s
+func placeholders(message string) (verbs []string) {
+	next := 0
+	byArg := map[int]string{}
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(message, -1) {
+		if match[0] == "%%" {
+			continue
+		}
+
+		arg := next
+		if match[2] != "" {
+			arg, _ = strconv.Atoi(match[2])
+			arg--
+		}
+		byArg[arg] = match[0][len(match[0])-1:]
+		next = arg + 1
+	}
+
+	for arg := 0; arg < len(byArg); arg++ {
+		verbs = append(verbs, byArg[arg])
+	}
+	return
+}
+
+// the messages of every i18n.T call; they have to be constant, as a message
+// computed at runtime cannot be checked against the catalog
+func translatedMessages(sourceDirs ...string) (messages []string) {
+	fileSet := token.NewFileSet()
+
+	for _, sourceDir := range sourceDirs {
+		filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
+			Expect(err).NotTo(HaveOccurred())
+
+			if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return nil
+			}
+
+			file, err := parser.ParseFile(fileSet, path, nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			ast.Inspect(file, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok || !isTranslateCall(call) {
+					return true
+				}
+
+				Expect(call.Args).NotTo(BeEmpty(), fileSet.Position(call.Pos()).String())
+				message, ok := constantString(call.Args[0])
+				Expect(ok).To(BeTrue(), "the message is not a string constant at "+fileSet.Position(call.Pos()).String())
+				messages = append(messages, message)
+				return true
+			})
+			return nil
+		})
+	}
+
+	Expect(messages).NotTo(BeEmpty())
+	return
+}
+
+func isTranslateCall(call *ast.CallExpr) bool {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "T" {
+		return false
+	}
+	pkg, ok := selector.X.(*ast.Ident)
+	return ok && pkg.Name == "i18n"
+}
+
+// string literals and concatenations of them
+func constantString(expr ast.Expr) (value string, ok bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return
+		}
+		value, err := strconv.Unquote(expr.Value)
+		return value, err == nil
+	case *ast.BinaryExpr:
+		if expr.Op != token.ADD {
+			return
+		}
+		left, leftOk := constantString(expr.X)
+		right, rightOk := constantString(expr.Y)
+		return left + right, leftOk && rightOk
+	case *ast.ParenExpr:
+		return constantString(expr.X)
+	}
+	return
+}
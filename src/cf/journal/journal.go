@@ -0,0 +1,96 @@
+package journal
+
+import (
+	"bufio"
+	"cf/configuration"
+	"encoding/json"
+	"fileutils"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type Entry struct {
+	Command    string    `json:"command"`
+	Args       []string  `json:"args"`
+	Target     string    `json:"target"`
+	Org        string    `json:"org"`
+	Space      string    `json:"space"`
+	User       string    `json:"user"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	ExitStatus int       `json:"exit_status"`
+	ApiCalls   []string  `json:"api_calls"`
+	DryRun     bool      `json:"dry_run,omitempty"`
+}
+
+func (entry Entry) Failed() bool {
+	return entry.ExitStatus != 0
+}
+
+type Journal interface {
+	Append(entry Entry) (err error)
+	Entries() (entries []Entry, err error)
+}
+
+// DiskJournal keeps one JSON encoded entry per line, and only ever
+// appends to the file
+type DiskJournal struct {
+	path string
+}
+
+func DefaultFilePath() string {
+	return filepath.Join(filepath.Dir(configuration.DefaultFilePath()), "journal.log")
+}
+
+func NewDiskJournal(path string) (journal DiskJournal) {
+	journal.path = path
+	return
+}
+
+func (journal DiskJournal) Append(entry Entry) (err error) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	file, err := fileutils.OpenFile(journal.path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(data, '\n'))
+	return
+}
+
+// lines that can't be parsed, e.g. one cut short by a full disk,
+// are skipped rather than hiding the rest of the journal
+func (journal DiskJournal) Entries() (entries []Entry, err error) {
+	entries = []Entry{}
+
+	file, err := os.Open(journal.path)
+	if os.IsNotExist(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			entry := Entry{}
+			if json.Unmarshal(line, &entry) == nil {
+				entries = append(entries, entry)
+			}
+		}
+		if readErr != nil {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,76 @@
+package journal_test
+
+import (
+	. "cf/journal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var _ = Describe("DiskJournal", func() {
+	var (
+		dir     string
+		path    string
+		journal DiskJournal
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "journal")
+		Expect(err).NotTo(HaveOccurred())
+
+		path = filepath.Join(dir, ".cf", "journal.log")
+		journal = NewDiskJournal(path)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("has no entries before anything is appended", func() {
+		entries, err := journal.Entries()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("appends entries and reads them back in order", func() {
+		startedAt := time.Date(2014, 5, 1, 10, 0, 0, 0, time.UTC)
+
+		err := journal.Append(Entry{Command: "create-space", Args: []string{"create-space", "my-space"}, StartedAt: startedAt})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = journal.Append(Entry{Command: "delete-space", ExitStatus: 1, ApiCalls: []string{"DELETE https://api.example.com/v2/spaces/my-space-guid"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		entries, err := journal.Entries()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(entries)).To(Equal(2))
+
+		Expect(entries[0].Command).To(Equal("create-space"))
+		Expect(entries[0].Args).To(Equal([]string{"create-space", "my-space"}))
+		Expect(entries[0].StartedAt.Equal(startedAt)).To(BeTrue())
+		Expect(entries[0].Failed()).To(BeFalse())
+
+		Expect(entries[1].Command).To(Equal("delete-space"))
+		Expect(entries[1].Failed()).To(BeTrue())
+		Expect(entries[1].ApiCalls).To(Equal([]string{"DELETE https://api.example.com/v2/spaces/my-space-guid"}))
+	})
+
+	It("skips lines that can't be parsed", func() {
+		err := journal.Append(Entry{Command: "apps"})
+		Expect(err).NotTo(HaveOccurred())
+
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0666)
+		Expect(err).NotTo(HaveOccurred())
+		file.WriteString(`{"command":"cut sh`)
+		file.Close()
+
+		entries, err := journal.Entries()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(entries)).To(Equal(1))
+		Expect(entries[0].Command).To(Equal("apps"))
+	})
+})
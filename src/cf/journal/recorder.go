@@ -0,0 +1,142 @@
+package journal
+
+import (
+	"cf/configuration"
+	"cf/net"
+	"strings"
+	"time"
+)
+
+// flags whose value is a secret, by command and alias
+var secretFlags = map[string][]string{
+	"create-user-provided-service": []string{"p"},
+	"cups":                         []string{"p"},
+	"l":                            []string{"p"},
+	"login":                        []string{"p"},
+	"update-user-provided-service": []string{"p"},
+	"uups":                         []string{"p"},
+}
+
+// positions of the arguments that are secrets, by command, counting
+// the arguments that are not flags from 0
+var secretArgs = map[string][]int{
+	"auth":                      []int{1},
+	"create-service-auth-token": []int{2},
+	"create-service-broker":     []int{2},
+	"create-user":               []int{1},
+	"update-service-auth-token": []int{2},
+	"update-service-broker":     []int{2},
+}
+
+type Recorder struct {
+	journal  Journal
+	config   configuration.Reader
+	entry    Entry
+	finished bool
+}
+
+// NewRecorder starts the entry for a command run with the given command line
+// arguments and records the mutating requests it makes until it is finished
+func NewRecorder(journal Journal, config configuration.Reader, args []string) (recorder *Recorder) {
+	recorder = new(Recorder)
+	recorder.journal = journal
+	recorder.config = config
+
+	entry := Entry{
+		Command:   commandName(args),
+		StartedAt: time.Now(),
+		ApiCalls:  []string{},
+	}
+	entry.Args = redactArgs(entry.Command, args)
+	recorder.entry = entry
+
+	net.RecordMutatingRequests(func(method, url string) {
+		recorder.entry.ApiCalls = append(recorder.entry.ApiCalls, method+" "+url)
+	})
+	return
+}
+
+// only the first call appends the entry, a command that exits early
+// finishes it before the deferred call does
+func (recorder *Recorder) Finish(exitStatus int) (err error) {
+	if recorder.finished {
+		return
+	}
+	recorder.finished = true
+
+	net.RecordMutatingRequests(nil)
+
+	// the target is read at the end, so that commands like login, api
+	// and target record the one they switched to
+	recorder.entry.Target = recorder.config.ApiEndpoint()
+	recorder.entry.Org = recorder.config.OrganizationFields().Name
+	recorder.entry.Space = recorder.config.SpaceFields().Name
+	recorder.entry.User = recorder.config.Username()
+	recorder.entry.FinishedAt = time.Now()
+	recorder.entry.ExitStatus = exitStatus
+	recorder.entry.DryRun = net.IsDryRunEnabled()
+	return recorder.journal.Append(recorder.entry)
+}
+
+func commandName(args []string) string {
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			return arg
+		}
+	}
+	return ""
+}
+
+// the arguments that aren't known secrets still go through Sanitize, which
+// catches passwords and tokens in JSON values like those given with -c
+func redactArgs(command string, args []string) (redacted []string) {
+	redacted = []string{}
+	position := -1
+	commandSeen := false
+	redactNext := false
+
+	for _, arg := range args {
+		switch {
+		case redactNext:
+			arg = net.PRIVATE_DATA_PLACEHOLDER
+			redactNext = false
+		case strings.HasPrefix(arg, "-"):
+			parts := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)
+			if contains(secretFlags[command], parts[0]) {
+				if len(parts) == 2 {
+					arg = "-" + parts[0] + "=" + net.PRIVATE_DATA_PLACEHOLDER
+				} else {
+					redactNext = true
+				}
+			}
+		case !commandSeen:
+			commandSeen = true
+		default:
+			position++
+			if containsInt(secretArgs[command], position) {
+				arg = net.PRIVATE_DATA_PLACEHOLDER
+			}
+		}
+
+		redacted = append(redacted, net.Sanitize(arg))
+	}
+	return
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package journal_test
+
+import (
+	. "cf/journal"
+	"cf/models"
+	"cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	testconfig "testhelpers/configuration"
+	testjournal "testhelpers/journal"
+)
+
+var _ = Describe("Recorder", func() {
+	var journal *testjournal.FakeJournal
+
+	BeforeEach(func() {
+		journal = &testjournal.FakeJournal{}
+	})
+
+	It("records the command, where it ran and how it ended", func() {
+		config := testconfig.NewRepositoryWithDefaults()
+
+		recorder := NewRecorder(journal, config, []string{"--dry-run", "create-space", "new-space"})
+		err := recorder.Finish(1)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(len(journal.Appended)).To(Equal(1))
+		entry := journal.Appended[0]
+		Expect(entry.Command).To(Equal("create-space"))
+		Expect(entry.Args).To(Equal([]string{"--dry-run", "create-space", "new-space"}))
+		Expect(entry.Target).To(Equal(config.ApiEndpoint()))
+		Expect(entry.Org).To(Equal("my-org"))
+		Expect(entry.Space).To(Equal("my-space"))
+		Expect(entry.User).To(Equal("my-user"))
+		Expect(entry.ExitStatus).To(Equal(1))
+		Expect(entry.FinishedAt.Before(entry.StartedAt)).To(BeFalse())
+	})
+
+	It("records the target the command switched to", func() {
+		config := testconfig.NewRepositoryWithDefaults()
+
+		recorder := NewRecorder(journal, config, []string{"target", "-s", "other-space"})
+		config.SetSpaceFields(models.SpaceFields{Name: "other-space", Guid: "other-space-guid"})
+		recorder.Finish(0)
+
+		Expect(journal.Appended[0].Org).To(Equal("my-org"))
+		Expect(journal.Appended[0].Space).To(Equal("other-space"))
+	})
+
+	It("appends the entry once when it is finished again", func() {
+		recorder := NewRecorder(journal, testconfig.NewRepository(), []string{"create-space"})
+		recorder.Finish(2)
+		recorder.Finish(1)
+
+		Expect(len(journal.Appended)).To(Equal(1))
+		Expect(journal.Appended[0].ExitStatus).To(Equal(2))
+	})
+
+	It("records the mutating requests made until it is finished", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {}))
+		defer server.Close()
+
+		config := testconfig.NewRepository()
+		gateway := net.NewCloudControllerGateway(config)
+
+		recorder := NewRecorder(journal, config, []string{"delete-space", "my-space"})
+		Expect(gateway.GetResource(server.URL+"/v2/spaces", "", &struct{}{})).NotTo(HaveOccurred())
+		Expect(gateway.DeleteResource(server.URL+"/v2/spaces/my-space-guid", "")).NotTo(HaveOccurred())
+		recorder.Finish(0)
+
+		Expect(gateway.CreateResource(server.URL+"/v2/spaces", "", strings.NewReader("{}"))).NotTo(HaveOccurred())
+
+		Expect(journal.Appended[0].ApiCalls).To(Equal([]string{
+			"DELETE " + server.URL + "/v2/spaces/my-space-guid?async=true",
+		}))
+	})
+
+	Describe("under --dry-run", func() {
+		AfterEach(func() {
+			net.DisableDryRun()
+		})
+
+		It("marks the entry, since its requests were never sent", func() {
+			recorder := NewRecorder(journal, testconfig.NewRepository(), []string{"--dry-run", "delete-space", "my-space"})
+			net.EnableDryRun(ioutil.Discard)
+			recorder.Finish(0)
+
+			Expect(journal.Appended[0].DryRun).To(BeTrue())
+		})
+
+		It("leaves other entries unmarked", func() {
+			NewRecorder(journal, testconfig.NewRepository(), []string{"delete-space", "my-space"}).Finish(0)
+
+			Expect(journal.Appended[0].DryRun).To(BeFalse())
+		})
+	})
+
+	Describe("redacting secrets", func() {
+		redactedArgs := func(args ...string) []string {
+			NewRecorder(journal, testconfig.NewRepository(), args).Finish(0)
+			return journal.Appended[len(journal.Appended)-1].Args
+		}
+
+		It("redacts the password of login", func() {
+			Expect(redactedArgs("login", "-u", "my-user", "-p", "secret")).To(Equal([]string{
+				"login", "-u", "my-user", "-p", net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+			Expect(redactedArgs("l", "--p=secret")).To(Equal([]string{
+				"l", "-p=" + net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+		})
+
+		It("redacts passwords and tokens given as arguments", func() {
+			Expect(redactedArgs("auth", "my-user", "secret")).To(Equal([]string{
+				"auth", "my-user", net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+			Expect(redactedArgs("auth", "--client-credentials", "my-robot", "secret")).To(Equal([]string{
+				"auth", "--client-credentials", "my-robot", net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+			Expect(redactedArgs("create-user", "new-user", "secret")).To(Equal([]string{
+				"create-user", "new-user", net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+			Expect(redactedArgs("update-service-broker", "my-broker", "admin", "secret", "http://broker.example.com")).To(Equal([]string{
+				"update-service-broker", "my-broker", "admin", net.PRIVATE_DATA_PLACEHOLDER, "http://broker.example.com",
+			}))
+			Expect(redactedArgs("create-service-auth-token", "my-label", "my-provider", "secret")).To(Equal([]string{
+				"create-service-auth-token", "my-label", "my-provider", net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+		})
+
+		It("redacts the credentials of user-provided services", func() {
+			Expect(redactedArgs("cups", "my-db", "-p", `{"username":"admin"}`)).To(Equal([]string{
+				"cups", "my-db", "-p", net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+			Expect(redactedArgs("update-user-provided-service", "my-db", "-p=host,port")).To(Equal([]string{
+				"update-user-provided-service", "my-db", "-p=" + net.PRIVATE_DATA_PLACEHOLDER,
+			}))
+		})
+
+		It("sanitizes the other arguments", func() {
+			Expect(redactedArgs("create-service", "mysql", "small", "my-db", "-c", `{"password":"secret"}`)).To(Equal([]string{
+				"create-service", "mysql", "small", "my-db", "-c", `{"password":"[PRIVATE DATA HIDDEN]"}`,
+			}))
+		})
+
+		It("leaves the arguments of other commands alone", func() {
+			Expect(redactedArgs("push", "my-app", "-p", "path/to/app")).To(Equal([]string{
+				"push", "my-app", "-p", "path/to/app",
+			}))
+		})
+
+		It("keeps global flags given before the command", func() {
+			Expect(redactedArgs("--dry-run", "delete-space", "my-space", "-f")).To(Equal([]string{
+				"--dry-run", "delete-space", "my-space", "-f",
+			}))
+		})
+	})
+})
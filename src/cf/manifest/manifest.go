@@ -130,6 +130,9 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (appParams models.AppP
 	appParams.DiskQuota = bytesVal(yamlMap, "disk_quota", &errs)
 	appParams.Domain = stringVal(yamlMap, "domain", &errs)
 	appParams.Host = stringVal(yamlMap, "host", &errs)
+	appParams.Hosts = sliceOrNilVal(yamlMap, "hosts", &errs)
+	appParams.Domains = sliceOrNilVal(yamlMap, "domains", &errs)
+	appParams.Routes = sliceOrNilVal(yamlMap, "routes", &errs)
 	appParams.Name = stringVal(yamlMap, "name", &errs)
 	appParams.Path = stringVal(yamlMap, "path", &errs)
 	appParams.StackName = stringVal(yamlMap, "stack", &errs)
@@ -285,6 +288,15 @@ func sliceOrEmptyVal(yamlMap generic.Map, key string, errs *ManifestErrors) *[]s
 	return &stringSlice
 }
 
+// unlike services, a missing list of hosts, domains or routes is not the
+// same as an empty one
+func sliceOrNilVal(yamlMap generic.Map, key string, errs *ManifestErrors) *[]string {
+	if !yamlMap.Has(key) {
+		return nil
+	}
+	return sliceOrEmptyVal(yamlMap, key, errs)
+}
+
 func envVarOrEmptyMap(yamlMap generic.Map, errs *ManifestErrors) *map[string]string {
 	key := "env"
 	switch envVars := yamlMap.Get(key).(type) {
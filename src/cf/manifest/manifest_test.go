@@ -226,6 +226,54 @@ var _ = Describe("Manifests", func() {
 		Expect(apps[0].UseRandomHostname).To(BeTrue())
 	})
 
+	It("parses lists of hosts, domains and routes", func() {
+		m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				map[interface{}]interface{}{
+					"name":    "my-app-name",
+					"hosts":   []interface{}{"api", "api-v2"},
+					"domains": []interface{}{"example.com", "corp.internal"},
+					"routes":  []interface{}{"admin.example.com"},
+				},
+			},
+		}))
+
+		apps, errs := m.Applications()
+		Expect(errs).To(BeEmpty())
+		Expect(*apps[0].Hosts).To(Equal([]string{"api", "api-v2"}))
+		Expect(*apps[0].Domains).To(Equal([]string{"example.com", "corp.internal"}))
+		Expect(*apps[0].Routes).To(Equal([]string{"admin.example.com"}))
+	})
+
+	It("leaves hosts, domains and routes unset when they are not in the manifest", func() {
+		m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				map[interface{}]interface{}{"name": "my-app-name"},
+			},
+		}))
+
+		apps, errs := m.Applications()
+		Expect(errs).To(BeEmpty())
+		Expect(apps[0].Hosts).To(BeNil())
+		Expect(apps[0].Domains).To(BeNil())
+		Expect(apps[0].Routes).To(BeNil())
+	})
+
+	It("returns an error when routes is not a list of strings", func() {
+		m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				map[interface{}]interface{}{
+					"name":   "my-app-name",
+					"routes": "api.example.com",
+				},
+			},
+		}))
+
+		_, errs := m.Applications()
+		Expect(errs).NotTo(BeEmpty())
+		Expect(errs.Error()).To(ContainSubstring("Expected routes to be a list of strings"))
+	})
+
 	Describe("old-style property syntax", func() {
 		It("returns an error when the manifest contains non-whitelist properties", func() {
 			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
@@ -7,4 +7,15 @@ type EventFields struct {
 	Name        string
 	Timestamp   time.Time
 	Description string
+
+	Actor     string
+	ActorName string
+	ActeeName string
+
+	ExitStatus      int
+	ExitDescription string
+}
+
+func (event EventFields) IsCrash() bool {
+	return event.Name == "app.crash" || event.Name == "app crashed"
 }
@@ -67,6 +67,9 @@ type AppParams struct {
 	Guid               *string
 	HealthCheckTimeout *int
 	Host               *string
+	Hosts              *[]string
+	Domains            *[]string
+	Routes             *[]string
 	InstanceCount      *int
 	Memory             *uint64
 	Name               *string
@@ -105,6 +108,15 @@ func (app *AppParams) Merge(other *AppParams) {
 	if other.Host != nil {
 		app.Host = other.Host
 	}
+	if other.Hosts != nil {
+		app.Hosts = other.Hosts
+	}
+	if other.Domains != nil {
+		app.Domains = other.Domains
+	}
+	if other.Routes != nil {
+		app.Routes = other.Routes
+	}
 	if other.InstanceCount != nil {
 		app.InstanceCount = other.InstanceCount
 	}
@@ -0,0 +1,13 @@
+package models
+
+type BuildpackReport struct {
+	Layout   string
+	Files    int
+	Size     int64
+	Checksum string
+	Problems []string
+}
+
+func (report BuildpackReport) IsValid() bool {
+	return len(report.Problems) == 0
+}
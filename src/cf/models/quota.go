@@ -6,8 +6,15 @@ func NewQuotaFields(name string, memory uint64) (q QuotaFields) {
 	return
 }
 
+// limits of -1 mean unlimited
+const UnlimitedQuota = -1
+
 type QuotaFields struct {
-	Guid        string
-	Name        string
-	MemoryLimit uint64 // in Megabytes
+	Guid                    string
+	Name                    string
+	MemoryLimit             uint64 // in Megabytes
+	InstanceMemoryLimit     int64  // in Megabytes
+	RoutesLimit             int
+	ServicesLimit           int
+	NonBasicServicesAllowed bool
 }
@@ -1,8 +1,24 @@
 package models
 
 type ServicePlanFields struct {
-	Guid string
-	Name string
+	Guid        string
+	Name        string
+	Description string
+	Free        bool
+	Public      bool
+	Extra       ServicePlanExtra
+}
+
+// the broker-provided metadata a plan carries in its "extra" JSON
+type ServicePlanExtra struct {
+	DisplayName string
+	Bullets     []string
+	Costs       []ServicePlanCost
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64
+	Unit   string
 }
 
 type ServicePlan struct {
@@ -0,0 +1,7 @@
+package models
+
+type ServicePlanVisibilityFields struct {
+	Guid             string
+	ServicePlanGuid  string
+	OrganizationGuid string
+}
@@ -0,0 +1,10 @@
+package models
+
+type SpaceUsage struct {
+	Space        SpaceFields
+	AppCount     int
+	Memory       uint64 // in Megabytes, memory x instances of started apps
+	DiskQuota    uint64 // in Megabytes, disk quota x instances of all apps
+	ServiceCount int
+	RouteCount   int
+}
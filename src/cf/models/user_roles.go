@@ -27,3 +27,9 @@ var SpaceRoleToUserInput = map[string]string{
 	SPACE_DEVELOPER: "SpaceDeveloper",
 	SPACE_AUDITOR:   "SpaceAuditor",
 }
+
+type UserRole struct {
+	Organization OrganizationFields
+	Space        SpaceFields // empty for org roles
+	Role         string
+}
@@ -0,0 +1,130 @@
+package net
+
+import (
+	"bytes"
+	"cf/terminal"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const DRY_RUN_GUID = "dry-run-guid"
+
+// with dry run enabled the gateways still send GET requests, but only print
+// the requests that would change something and answer those themselves
+var dryRunOutput io.Writer
+
+func EnableDryRun(output io.Writer) {
+	dryRunOutput = output
+}
+
+func DisableDryRun() {
+	dryRunOutput = nil
+}
+
+func IsDryRunEnabled() bool {
+	return dryRunOutput != nil
+}
+
+func shouldInterceptRequest(request *http.Request) bool {
+	return IsDryRunEnabled() && isMutatingRequest(request)
+}
+
+func interceptRequest(request *http.Request) (response *http.Response) {
+	body := []byte{}
+	if request.Body != nil {
+		body, _ = ioutil.ReadAll(request.Body)
+	}
+
+	fmt.Fprintf(dryRunOutput, "%s %s %s\n", terminal.HeaderColor("DRY RUN:"), request.Method, request.URL.String())
+	if strings.Contains(request.Header.Get("Content-Type"), "multipart/form-data") {
+		fmt.Fprintln(dryRunOutput, "[MULTIPART/FORM-DATA CONTENT HIDDEN]")
+	} else if len(body) > 0 {
+		fmt.Fprintln(dryRunOutput, Sanitize(redactSecrets(body)))
+	}
+
+	response = &http.Response{
+		Status:     "201 Created",
+		StatusCode: http.StatusCreated,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    request,
+	}
+
+	responseBody := []byte{}
+	if request.Method == "DELETE" {
+		response.Status = "204 No Content"
+		response.StatusCode = http.StatusNoContent
+	} else {
+		responseBody = synthesizedResource(request, body)
+	}
+
+	response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+	response.ContentLength = int64(len(responseBody))
+	return
+}
+
+// dry-run output ends up in change tickets, so besides what Sanitize catches
+// the value of any key naming a password, secret or credentials is hidden,
+// e.g. the auth_password of a service broker or the credentials of a
+// user-provided service
+var secretKeyRegexp = regexp.MustCompile(`(?i)password|secret|credentials`)
+
+func redactSecrets(body []byte) string {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if decoder.Decode(&value) != nil {
+		return string(body)
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if encoder.Encode(redactSecretValues(value)) != nil {
+		return string(body)
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+func redactSecretValues(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range value {
+			if secretKeyRegexp.MatchString(key) {
+				value[key] = PRIVATE_DATA_PLACEHOLDER
+			} else {
+				value[key] = redactSecretValues(nested)
+			}
+		}
+	case []interface{}:
+		for index, nested := range value {
+			value[index] = redactSecretValues(nested)
+		}
+	}
+	return value
+}
+
+// the request body becomes the entity of the resource, so commands that
+// read back what they just created find the values they sent
+func synthesizedResource(request *http.Request, body []byte) []byte {
+	entity := map[string]interface{}{}
+	json.Unmarshal(body, &entity)
+
+	resource := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"guid": DRY_RUN_GUID,
+			"url":  request.URL.Path,
+		},
+		"entity": entity,
+	}
+
+	data, _ := json.Marshal(resource)
+	return data
+}
@@ -22,6 +22,7 @@ const (
 	JOB_FAILED               = "failed"
 	DEFAULT_POLLING_THROTTLE = 5 * time.Second
 	ASYNC_REQUEST_TIMEOUT    = 20 * time.Second
+	TOKEN_REFRESH_MARGIN     = time.Minute
 )
 
 type JobEntity struct {
@@ -57,12 +58,14 @@ type Gateway struct {
 	PollingEnabled  bool
 	PollingThrottle time.Duration
 	trustedCerts    []tls.Certificate
+	tlsConfigs      *TLSConfigCache
 	config          configuration.Reader
 }
 
 func newGateway(errHandler apiErrorHandler, config configuration.Reader) (gateway Gateway) {
 	gateway.errHandler = errHandler
 	gateway.config = config
+	gateway.tlsConfigs = new(TLSConfigCache)
 	gateway.PollingThrottle = DEFAULT_POLLING_THROTTLE
 	return
 }
@@ -278,7 +281,7 @@ func (gateway Gateway) waitForJob(jobUrl, accessToken string, timeout time.Durat
 	startTime := time.Now()
 	for true {
 		if time.Since(startTime) > timeout {
-			apiErr = errors.NewWithFmt("Error: timed out waiting for async job '%s' to finish", jobUrl)
+			apiErr = errors.NewTimeoutError("Error: timed out waiting for async job '%s' to finish", jobUrl)
 			return
 		}
 
@@ -313,6 +316,16 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (rawResponse *htt
 		httpReq.Body = ioutil.NopCloser(request.SeekableBody)
 	}
 
+	// refresh the auth token before it expires rather than after a request fails
+	if gateway.authenticator != nil && tokenExpiresSoon(httpReq.Header.Get("Authorization")) {
+		var newToken string
+		newToken, apiErr = gateway.authenticator.RefreshAuthToken()
+		if apiErr != nil {
+			return
+		}
+		httpReq.Header.Set("Authorization", newToken)
+	}
+
 	// perform request
 	rawResponse, apiErr = gateway.doRequestAndHandlerError(request)
 	if apiErr == nil || gateway.authenticator == nil {
@@ -342,8 +355,22 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (rawResponse *htt
 	return
 }
 
+// tokens that don't tell when they expire are refreshed once they are rejected
+func tokenExpiresSoon(accessToken string) bool {
+	expiresAt := configuration.NewTokenInfo(accessToken).ExpiresAt()
+	if expiresAt.IsZero() {
+		return false
+	}
+	return time.Now().Add(TOKEN_REFRESH_MARGIN).After(expiresAt)
+}
+
 func (gateway Gateway) doRequestAndHandlerError(request *Request) (rawResponse *http.Response, apiErr error) {
-	rawResponse, err := gateway.doRequest(request.HttpReq)
+	tlsConfig, apiErr := gateway.tlsConfigs.Get(gateway.trustedCerts, gateway.config)
+	if apiErr != nil {
+		return
+	}
+
+	rawResponse, err := gateway.doRequest(newHttpClient(tlsConfig), request.HttpReq)
 	if err != nil {
 		apiErr = WrapSSLErrors(request.HttpReq.URL.Host, err)
 		return
@@ -359,10 +386,15 @@ func (gateway Gateway) doRequestAndHandlerError(request *Request) (rawResponse *
 	return
 }
 
-func (gateway Gateway) doRequest(request *http.Request) (response *http.Response, err error) {
-	httpClient := newHttpClient(gateway.trustedCerts, gateway.config.IsSSLDisabled())
-
+func (gateway Gateway) doRequest(httpClient *http.Client, request *http.Request) (response *http.Response, err error) {
 	dumpRequest(request)
+	recordMutatingRequest(request)
+
+	if shouldInterceptRequest(request) {
+		response = interceptRequest(request)
+		dumpResponse(response)
+		return
+	}
 
 	response, err = httpClient.Do(request)
 	if err != nil {
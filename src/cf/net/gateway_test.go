@@ -7,6 +7,7 @@ import (
 	"cf/errors"
 	. "cf/net"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -242,6 +243,71 @@ var _ = Describe("Gateway", func() {
 		})
 	})
 
+	Describe("refreshing the auth token before it expires", func() {
+		var (
+			apiServer  *httptest.Server
+			authServer *httptest.Server
+			authStatus int
+		)
+
+		BeforeEach(func() {
+			authStatus = http.StatusOK
+			authServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(authStatus)
+				fmt.Fprintln(w, `{
+					"access_token": "new-access-token",
+					"token_type": "bearer",
+					"refresh_token": "new-refresh-token"
+				}`)
+			}))
+			apiServer = httptest.NewTLSServer(refreshTokenApiEndPoint("", testnet.TestResponse{Status: http.StatusOK}))
+			ccGateway.SetTrustedCerts(apiServer.TLS.Certificates)
+		})
+
+		AfterEach(func() {
+			apiServer.Close()
+			authServer.Close()
+		})
+
+		performRequestWithTokenExpiringIn := func(lifetime time.Duration) (configuration.ReadWriter, error) {
+			config, auth := createAuthenticationRepository(apiServer, authServer)
+			accessToken, err := testconfig.EncodeAccessToken(configuration.TokenInfo{
+				Username: "my-user",
+				Expiry:   time.Now().Add(lifetime).Unix(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			config.SetAccessToken(accessToken)
+
+			ccGateway.SetTokenRefresher(auth)
+			request, apiErr := ccGateway.NewRequest("POST", config.ApiEndpoint()+"/v2/foo", config.AccessToken(), strings.NewReader("expected body"))
+			Expect(apiErr).NotTo(HaveOccurred())
+			return config, ccGateway.PerformRequest(request)
+		}
+
+		It("refreshes a token that is about to expire before making the request", func() {
+			config, apiErr := performRequestWithTokenExpiringIn(30 * time.Second)
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+			Expect(config.RefreshToken()).To(Equal("new-refresh-token"))
+		})
+
+		It("does not refresh a token that is still good", func() {
+			config, apiErr := performRequestWithTokenExpiringIn(time.Hour)
+
+			Expect(apiErr).To(HaveOccurred())
+			Expect(config.RefreshToken()).To(Equal("initial-refresh-token"))
+		})
+
+		It("returns a token refresh error when the token cannot be refreshed", func() {
+			authStatus = http.StatusUnauthorized
+			_, apiErr := performRequestWithTokenExpiringIn(30 * time.Second)
+
+			_, ok := apiErr.(*errors.TokenRefreshError)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
 	Describe("SSL certificate validation errors", func() {
 		var (
 			request   *Request
@@ -295,6 +361,47 @@ var _ = Describe("Gateway", func() {
 			})
 		})
 
+		Context("when the server's certificate is signed by a CA in the config", func() {
+			BeforeEach(func() {
+				certPEM, keyPEM := testnet.MakeTLSCertPEM()
+				cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+				Expect(err).NotTo(HaveOccurred())
+				apiServer.TLS.Certificates = []tls.Certificate{cert}
+				config.SetSSLCACert(certPEM)
+			})
+
+			It("succeeds", func() {
+				apiErr := ccGateway.PerformRequest(request)
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the server requires a client certificate", func() {
+			var clientCertPEM, clientKeyPEM string
+
+			BeforeEach(func() {
+				clientCertPEM, clientKeyPEM = testnet.MakeTLSCertPEM()
+				clientCAs := x509.NewCertPool()
+				clientCAs.AppendCertsFromPEM([]byte(clientCertPEM))
+
+				apiServer.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+				apiServer.TLS.ClientCAs = clientCAs
+				config.SetSSLDisabled(true)
+			})
+
+			It("presents the client certificate from the config", func() {
+				config.SetSSLClientCertificate(clientCertPEM, clientKeyPEM)
+
+				apiErr := ccGateway.PerformRequest(request)
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+
+			It("fails without one", func() {
+				apiErr := ccGateway.PerformRequest(request)
+				Expect(apiErr).To(HaveOccurred())
+			})
+		})
+
 		Context("when SSL validation is disabled", func() {
 			BeforeEach(func() {
 				apiServer.TLS.Certificates = []tls.Certificate{testnet.MakeExpiredTLSCert()}
@@ -17,16 +17,17 @@ const (
 	PRIVATE_DATA_PLACEHOLDER = "[PRIVATE DATA HIDDEN]"
 )
 
-func newHttpClient(trustedCerts []tls.Certificate, disableSSL bool) *http.Client {
+func newHttpClient(tlsConfig *tls.Config) (client *http.Client) {
 	tr := &http.Transport{
-		TLSClientConfig: NewTLSConfig(trustedCerts, disableSSL),
+		TLSClientConfig: tlsConfig,
 		Proxy:           http.ProxyFromEnvironment,
 	}
 
-	return &http.Client{
+	client = &http.Client{
 		Transport:     tr,
 		CheckRedirect: PrepareRedirect,
 	}
+	return
 }
 
 func PrepareRedirect(req *http.Request, via []*http.Request) error {
@@ -0,0 +1,32 @@
+package net
+
+import (
+	"net/http"
+	"strings"
+)
+
+type MutatingRequestRecorder func(method, url string)
+
+var mutatingRequestRecorder MutatingRequestRecorder
+
+// the recorder is told about every request that changes something on the
+// server, whether it is sent or intercepted by a dry run
+func RecordMutatingRequests(recorder MutatingRequestRecorder) {
+	mutatingRequestRecorder = recorder
+}
+
+// token requests don't change anything on the server, and the commands
+// could not fetch anything without a token
+func isMutatingRequest(request *http.Request) bool {
+	switch request.Method {
+	case "POST", "PUT", "DELETE":
+		return !strings.HasSuffix(request.URL.Path, "/oauth/token")
+	}
+	return false
+}
+
+func recordMutatingRequest(request *http.Request) {
+	if mutatingRequestRecorder != nil && isMutatingRequest(request) {
+		mutatingRequestRecorder(request.Method, request.URL.String())
+	}
+}
@@ -0,0 +1,27 @@
+package net
+
+import (
+	"cf/configuration"
+	"cf/errors"
+	"encoding/json"
+)
+
+type serviceBrokerErrorResponse struct {
+	Description string
+}
+
+func serviceBrokerErrorHandler(statusCode int, body []byte) error {
+	response := serviceBrokerErrorResponse{}
+	json.Unmarshal(body, &response)
+
+	if response.Description == "" {
+		response.Description = string(body)
+	}
+	return errors.NewHttpError(statusCode, "", response.Description)
+}
+
+// talks to service brokers directly, without going through the
+// cloud controller, so there is no token to refresh
+func NewServiceBrokerGateway(config configuration.Reader) Gateway {
+	return newGateway(serviceBrokerErrorHandler, config)
+}
@@ -0,0 +1,53 @@
+package net_test
+
+import (
+	"cf/configuration"
+	"cf/errors"
+	. "cf/net"
+	"fmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net/http"
+	"net/http/httptest"
+	testconfig "testhelpers/configuration"
+)
+
+var _ = Describe("Service Broker Gateway", func() {
+	var gateway Gateway
+	var config configuration.Reader
+
+	BeforeEach(func() {
+		config = testconfig.NewRepository()
+		gateway = NewServiceBrokerGateway(config)
+	})
+
+	It("parses error responses", func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(writer, `{"description": "The catalog is on fire"}`)
+		}))
+		defer ts.Close()
+
+		request, apiErr := gateway.NewRequest("GET", ts.URL, "", nil)
+		apiErr = gateway.PerformRequest(request)
+
+		Expect(apiErr).NotTo(BeNil())
+		Expect(apiErr.Error()).To(ContainSubstring("The catalog is on fire"))
+		Expect(apiErr.(errors.HttpError).StatusCode()).To(Equal(http.StatusInternalServerError))
+	})
+
+	It("uses the body as the message when the broker does not send JSON", func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(writer, "Not authorized")
+		}))
+		defer ts.Close()
+
+		request, apiErr := gateway.NewRequest("GET", ts.URL, "", nil)
+		apiErr = gateway.PerformRequest(request)
+
+		Expect(apiErr).NotTo(BeNil())
+		Expect(apiErr.Error()).To(ContainSubstring("Not authorized"))
+		Expect(apiErr.(errors.HttpError).StatusCode()).To(Equal(http.StatusUnauthorized))
+	})
+})
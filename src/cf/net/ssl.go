@@ -1,14 +1,55 @@
 package net
 
 import (
+	"bytes"
+	"cf"
+	"cf/configuration"
 	"cf/errors"
+	"cf/terminal"
 	"code.google.com/p/go.net/websocket"
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 	"net/url"
 )
 
-func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *tls.Config) {
+// TLSConfigCache keeps the TLS config built from the certificates, and only
+// builds it again once they or the ssl settings in the config change
+type TLSConfigCache struct {
+	key       string
+	tlsConfig *tls.Config
+}
+
+func (cache *TLSConfigCache) Get(trustedCerts []tls.Certificate, config configuration.Reader) (tlsConfig *tls.Config, err error) {
+	key := tlsConfigKey(trustedCerts, config)
+	if cache.tlsConfig != nil && cache.key == key {
+		return cache.tlsConfig, nil
+	}
+
+	tlsConfig, err = NewTLSConfig(trustedCerts, config)
+	if err != nil {
+		err = errors.NewWithFmt("%s\nTIP: Use '%s' to set the certificates of the API endpoint again",
+			err.Error(), terminal.CommandColor(cf.Name()+" api --ca-cert PATH"))
+		return
+	}
+
+	cache.key = key
+	cache.tlsConfig = tlsConfig
+	return
+}
+
+func tlsConfigKey(trustedCerts []tls.Certificate, config configuration.Reader) string {
+	key := bytes.NewBufferString(fmt.Sprintf("%t\x00%s\x00%s\x00%s", config.IsSSLDisabled(), config.SSLCACert(), config.SSLClientCert(), config.SSLClientKey()))
+	for _, cert := range trustedCerts {
+		for _, der := range cert.Certificate {
+			key.WriteByte(0)
+			key.Write(der)
+		}
+	}
+	return key.String()
+}
+
+func NewTLSConfig(trustedCerts []tls.Certificate, config configuration.Reader) (TLSConfig *tls.Config, err error) {
 	TLSConfig = &tls.Config{}
 
 	if len(trustedCerts) > 0 {
@@ -20,11 +61,57 @@ func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *t
 		TLSConfig.RootCAs = certPool
 	}
 
-	TLSConfig.InsecureSkipVerify = disableSSL
+	if config.SSLCACert() != "" {
+		TLSConfig.RootCAs, err = addCACerts(TLSConfig.RootCAs, config.SSLCACert())
+		if err != nil {
+			return
+		}
+	}
+
+	if config.SSLClientCert() != "" {
+		var clientCert tls.Certificate
+		clientCert, err = NewClientCertificate(config.SSLClientCert(), config.SSLClientKey())
+		if err != nil {
+			return
+		}
+		TLSConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	TLSConfig.InsecureSkipVerify = config.IsSSLDisabled()
+
+	return
+}
+
+func ValidateCACert(caCertPEM string) (err error) {
+	_, err = addCACerts(x509.NewCertPool(), caCertPEM)
+	return
+}
 
+func NewClientCertificate(certPEM, keyPEM string) (cert tls.Certificate, err error) {
+	cert, err = tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		err = errors.NewWithError("Invalid client certificate or key", err)
+	}
 	return
 }
 
+// a custom CA is trusted in addition to the system's, so endpoints with
+// public certificates keep working
+func addCACerts(certPool *x509.CertPool, caCertPEM string) (*x509.CertPool, error) {
+	if certPool == nil {
+		var err error
+		certPool, err = x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+	}
+
+	if !certPool.AppendCertsFromPEM([]byte(caCertPEM)) {
+		return nil, errors.New("Invalid CA certificate: no PEM encoded certificates found")
+	}
+	return certPool, nil
+}
+
 func WrapSSLErrors(host string, err error) error {
 	urlError, ok := err.(*url.Error)
 	if ok {
@@ -36,7 +123,7 @@ func WrapSSLErrors(host string, err error) error {
 		return wrapSSLErrorInternal(host, websocketError.Err)
 	}
 
-	return errors.NewWithError("Error performing request", err)
+	return errors.NewConnectionError(err)
 }
 
 func wrapSSLErrorInternal(host string, err error) error {
@@ -48,6 +135,6 @@ func wrapSSLErrorInternal(host string, err error) error {
 	case x509.CertificateInvalidError:
 		return errors.NewInvalidSSLCert(host, "")
 	default:
-		return errors.NewWithError("Error performing request", err)
+		return errors.NewConnectionError(err)
 	}
 }
@@ -1,15 +1,116 @@
 package net_test
 
 import (
+	"cf/configuration"
 	"cf/errors"
 	. "cf/net"
 	"crypto/x509"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"net/url"
+	testconfig "testhelpers/configuration"
+	testnet "testhelpers/net"
 )
 
 var _ = Describe("SSL", func() {
+	Describe("NewTLSConfig", func() {
+		var (
+			config  configuration.ReadWriter
+			certPEM string
+			keyPEM  string
+		)
+
+		BeforeEach(func() {
+			config = testconfig.NewRepository()
+			certPEM, keyPEM = testnet.MakeTLSCertPEM()
+		})
+
+		It("skips verification when SSL validation is disabled", func() {
+			config.SetSSLDisabled(true)
+
+			tlsConfig, err := NewTLSConfig(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tlsConfig.InsecureSkipVerify).To(BeTrue())
+		})
+
+		It("trusts the CA certificates from the config", func() {
+			config.SetSSLCACert(certPEM)
+
+			tlsConfig, err := NewTLSConfig(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tlsConfig.RootCAs).NotTo(BeNil())
+			Expect(tlsConfig.InsecureSkipVerify).To(BeFalse())
+		})
+
+		It("returns an error when the CA certificates are not PEM encoded", func() {
+			config.SetSSLCACert("not a certificate")
+
+			_, err := NewTLSConfig(nil, config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid CA certificate"))
+		})
+
+		It("presents the client certificate from the config", func() {
+			config.SetSSLClientCertificate(certPEM, keyPEM)
+
+			tlsConfig, err := NewTLSConfig(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tlsConfig.Certificates).To(HaveLen(1))
+		})
+
+		It("returns an error when the client key does not match the certificate", func() {
+			_, otherKeyPEM := testnet.MakeTLSCertPEM()
+			config.SetSSLClientCertificate(certPEM, otherKeyPEM)
+
+			_, err := NewTLSConfig(nil, config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid client certificate or key"))
+		})
+	})
+
+	Describe("TLSConfigCache", func() {
+		var (
+			config configuration.ReadWriter
+			cache  *TLSConfigCache
+		)
+
+		BeforeEach(func() {
+			config = testconfig.NewRepository()
+			cache = new(TLSConfigCache)
+		})
+
+		It("builds the TLS config once while the config stays the same", func() {
+			first, err := cache.Get(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			second, err := cache.Get(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).To(BeIdenticalTo(first))
+		})
+
+		It("builds the TLS config again when the certificates change", func() {
+			first, err := cache.Get(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			certPEM, _ := testnet.MakeTLSCertPEM()
+			config.SetSSLCACert(certPEM)
+
+			second, err := cache.Get(nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).NotTo(BeIdenticalTo(first))
+			Expect(second.RootCAs).NotTo(BeNil())
+		})
+
+		It("tells the user how to fix certificates that can't be parsed", func() {
+			config.SetSSLCACert("not a certificate")
+
+			_, err := cache.Get(nil, config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid CA certificate"))
+			Expect(err.Error()).To(ContainSubstring("api --ca-cert"))
+		})
+	})
+
 	Describe("WrapSSLErrors", func() {
 		var (
 			err  error
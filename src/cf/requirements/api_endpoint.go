@@ -3,6 +3,7 @@ package requirements
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/terminal"
 	"fmt"
 )
@@ -20,7 +21,8 @@ func (req ApiEndpointRequirement) Execute() (success bool) {
 	if req.config.ApiEndpoint() == "" {
 		loginTip := terminal.CommandColor(fmt.Sprintf("%s login", cf.Name()))
 		apiTip := terminal.CommandColor(fmt.Sprintf("%s api", cf.Name()))
-		req.ui.Say("No API endpoint targeted. Use '%s' or '%s' to target an endpoint.", loginTip, apiTip)
+		terminal.SetExitStatus(terminal.EXIT_AUTH_FAILURE)
+		req.ui.Say(i18n.T("No API endpoint targeted. Use '%s' or '%s' to target an endpoint."), loginTip, apiTip)
 		return false
 	}
 	return true
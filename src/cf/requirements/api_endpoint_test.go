@@ -2,6 +2,7 @@ package requirements_test
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	. "cf/requirements"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -35,4 +36,20 @@ var _ = Describe("ApiEndpointRequirement", func() {
 
 		testassert.SliceContains(ui.Outputs, testassert.Lines{{"No API endpoint"}})
 	})
+
+	Context("when the locale is not English", func() {
+		BeforeEach(func() {
+			i18n.SetLocale("pt_BR")
+		})
+
+		AfterEach(func() {
+			i18n.SetLocale(i18n.DEFAULT_LOCALE)
+		})
+
+		It("tells the user in their language", func() {
+			NewApiEndpointRequirement(ui, config).Execute()
+
+			testassert.SliceContains(ui.Outputs, testassert.Lines{{"Nenhum endpoint da API", "api"}})
+		})
+	})
 })
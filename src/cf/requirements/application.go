@@ -31,7 +31,7 @@ func (req *applicationApiRequirement) Execute() (success bool) {
 	req.application, apiErr = req.appRepo.Read(req.name)
 
 	if apiErr != nil {
-		req.ui.Failed(apiErr.Error())
+		req.ui.FailWithError(apiErr)
 		return false
 	}
 
@@ -31,7 +31,7 @@ func (req *buildpackApiRequirement) Execute() (success bool) {
 	req.buildpack, apiErr = req.buildpackRepo.FindByName(req.name)
 
 	if apiErr != nil {
-		req.ui.Failed(apiErr.Error())
+		req.ui.FailWithError(apiErr)
 		return false
 	}
 
@@ -34,7 +34,7 @@ func (req *domainApiRequirement) Execute() bool {
 	req.domain, apiErr = req.domainRepo.FindByNameInOrg(req.name, req.config.OrganizationFields().Guid)
 
 	if apiErr != nil {
-		req.ui.Failed(apiErr.Error())
+		req.ui.FailWithError(apiErr)
 		return false
 	}
 
@@ -21,7 +21,8 @@ func (req LoginRequirement) Execute() (success bool) {
 	}
 
 	if !req.config.IsLoggedIn() {
-		req.ui.Say(terminal.NotLoggedInText())
+		terminal.SetExitStatus(terminal.EXIT_AUTH_FAILURE)
+		req.ui.Say("%s", terminal.NotLoggedInText())
 		return false
 	}
 
@@ -3,6 +3,7 @@ package requirements_test
 import (
 	"cf/configuration"
 	. "cf/requirements"
+	"cf/terminal"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	testassert "testhelpers/assert"
@@ -17,6 +18,10 @@ var _ = Describe("LoginRequirement", func() {
 		ui = new(testterm.FakeUI)
 	})
 
+	AfterEach(func() {
+		terminal.SetExitStatus(terminal.EXIT_OK)
+	})
+
 	It("succeeds when given a config with an API endpoint and authentication", func() {
 		config := testconfig.NewRepositoryWithAccessToken(configuration.TokenInfo{Username: "my-user"})
 		config.SetApiEndpoint("api.example.com")
@@ -33,6 +38,7 @@ var _ = Describe("LoginRequirement", func() {
 		Expect(success).To(BeFalse())
 
 		testassert.SliceContains(ui.Outputs, testassert.Lines{{"Not logged in."}})
+		Expect(terminal.ExitStatus()).To(Equal(terminal.EXIT_AUTH_FAILURE))
 	})
 
 	It("fails when given a config with neither an API endpoint nor authentication", func() {
@@ -31,7 +31,7 @@ func (req *organizationApiRequirement) Execute() (success bool) {
 	req.org, apiErr = req.orgRepo.FindByName(req.name)
 
 	if apiErr != nil {
-		req.ui.Failed(apiErr.Error())
+		req.ui.FailWithError(apiErr)
 		return false
 	}
 
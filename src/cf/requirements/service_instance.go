@@ -31,7 +31,7 @@ func (req *serviceInstanceApiRequirement) Execute() (success bool) {
 	req.serviceInstance, apiErr = req.serviceRepo.FindInstanceByName(req.name)
 
 	if apiErr != nil {
-		req.ui.Failed(apiErr.Error())
+		req.ui.FailWithError(apiErr)
 		return false
 	}
 
@@ -31,7 +31,7 @@ func (req *spaceApiRequirement) Execute() (success bool) {
 	req.space, apiErr = req.spaceRepo.FindByName(req.name)
 
 	if apiErr != nil {
-		req.ui.Failed(apiErr.Error())
+		req.ui.FailWithError(apiErr)
 		return false
 	}
 
@@ -3,9 +3,9 @@ package requirements
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/models"
 	"cf/terminal"
-	"fmt"
 )
 
 type TargetedOrgRequirement interface {
@@ -24,9 +24,8 @@ func NewTargetedOrgRequirement(ui terminal.UI, config configuration.Reader) Targ
 
 func (req targetedOrgApiRequirement) Execute() (success bool) {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf("No org targeted, use '%s' to target an org.",
+		req.ui.Failed(i18n.T("No org targeted, use '%s' to target an org."),
 			terminal.CommandColor(cf.Name()+" target -o ORG"))
-		req.ui.Failed(message)
 		return false
 	}
 
@@ -3,8 +3,8 @@ package requirements
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/terminal"
-	"fmt"
 )
 
 type TargetedSpaceRequirement struct {
@@ -18,15 +18,13 @@ func NewTargetedSpaceRequirement(ui terminal.UI, config configuration.Reader) Ta
 
 func (req TargetedSpaceRequirement) Execute() (success bool) {
 	if !req.config.HasOrganization() {
-		message := fmt.Sprintf("No org and space targeted, use '%s' to target an org and space",
+		req.ui.Failed(i18n.T("No org and space targeted, use '%s' to target an org and space"),
 			terminal.CommandColor(cf.Name()+" target -o ORG -s SPACE"))
-		req.ui.Failed(message)
 		return false
 	}
 
 	if !req.config.HasSpace() {
-		message := fmt.Sprintf("No space targeted, use '%s' to target a space", terminal.CommandColor("cf target -s"))
-		req.ui.Failed(message)
+		req.ui.Failed(i18n.T("No space targeted, use '%s' to target a space"), terminal.CommandColor(cf.Name()+" target -s"))
 		return false
 	}
 
@@ -31,7 +31,7 @@ func (req *userApiRequirement) Execute() (success bool) {
 	req.user, apiErr = req.userRepo.FindByUsername(req.username)
 
 	if apiErr != nil {
-		req.ui.Failed(apiErr.Error())
+		req.ui.FailWithError(apiErr)
 		return false
 	}
 
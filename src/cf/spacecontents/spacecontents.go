@@ -0,0 +1,104 @@
+package spacecontents
+
+import (
+	"cf/api"
+	"cf/i18n"
+	"cf/models"
+	"cf/terminal"
+)
+
+// Contents is what deleting a space removes along with it
+type Contents struct {
+	Space            models.SpaceFields
+	Apps             []models.AppSummary
+	ServiceInstances []models.ServiceInstance
+	OrphanedRoutes   []models.Route
+}
+
+func Get(appSummaryRepo api.AppSummaryRepository, serviceSummaryRepo api.ServiceSummaryRepository, routeRepo api.RouteRepository, spaces []models.SpaceFields) (contents []Contents, apiErr error) {
+	for _, space := range spaces {
+		spaceContents := Contents{Space: space}
+
+		spaceContents.Apps, apiErr = appSummaryRepo.GetSummariesInSpace(space.Guid)
+		if apiErr != nil {
+			return
+		}
+
+		spaceContents.ServiceInstances, apiErr = serviceSummaryRepo.GetSummariesInSpace(space.Guid)
+		if apiErr != nil {
+			return
+		}
+
+		apiErr = routeRepo.ListRoutesInSpace(space.Guid, func(route models.Route) bool {
+			if len(route.Apps) == 0 {
+				spaceContents.OrphanedRoutes = append(spaceContents.OrphanedRoutes, route)
+			}
+			return true
+		})
+		if apiErr != nil {
+			return
+		}
+
+		contents = append(contents, spaceContents)
+	}
+	return
+}
+
+// routes mapped to more than one app are only listed once
+func (contents Contents) Routes() (routes []models.RouteSummary) {
+	seen := map[string]bool{}
+	add := func(route models.RouteSummary) {
+		if seen[route.URL()] {
+			return
+		}
+		seen[route.URL()] = true
+		routes = append(routes, route)
+	}
+
+	for _, app := range contents.Apps {
+		for _, route := range app.RouteSummaries {
+			add(route)
+		}
+	}
+	for _, route := range contents.OrphanedRoutes {
+		add(route.RouteSummary)
+	}
+	return
+}
+
+func (contents Contents) IsEmpty() bool {
+	return len(contents.Apps) == 0 && len(contents.ServiceInstances) == 0 && len(contents.OrphanedRoutes) == 0
+}
+
+func Print(ui terminal.UI, contents Contents, indent string) {
+	if contents.IsEmpty() {
+		ui.Say(indent + i18n.T("no apps, service instances or routes"))
+		return
+	}
+
+	for _, app := range contents.Apps {
+		ui.Say(indent+i18n.T("app %s (%s)"), terminal.EntityNameColor(app.Name), instanceCount(app.InstanceCount))
+	}
+
+	for _, instance := range contents.ServiceInstances {
+		if instance.IsUserProvided() {
+			ui.Say(indent+i18n.T("service instance %s (user-provided)"), terminal.EntityNameColor(instance.Name))
+		} else {
+			ui.Say(indent+i18n.T("service instance %s (%s, will be deprovisioned by its service broker)"),
+				terminal.EntityNameColor(instance.Name),
+				instance.ServiceOffering.Label,
+			)
+		}
+	}
+
+	for _, route := range contents.Routes() {
+		ui.Say(indent+i18n.T("route %s"), terminal.EntityNameColor(route.URL()))
+	}
+}
+
+func instanceCount(count int) string {
+	if count == 1 {
+		return i18n.T("1 instance")
+	}
+	return i18n.T("%d instances", count)
+}
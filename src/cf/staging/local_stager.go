@@ -0,0 +1,198 @@
+package staging
+
+import (
+	"bufio"
+	"bytes"
+	"cf/app_files"
+	"errors"
+	"fileutils"
+	"fmt"
+	"generic"
+	"github.com/fraenkel/candiedyaml"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+type LogFunc func(line string, isStderr bool)
+
+type Result struct {
+	DetectedBuildpack string
+	ReleaseYAML       string
+	StartCommand      string
+}
+
+type Stager interface {
+	Stage(appDir, buildpackDir string, onLog LogFunc) (result Result, err error)
+}
+
+type LocalStager struct{}
+
+func NewLocalStager() LocalStager {
+	return LocalStager{}
+}
+
+// Stage runs a buildpack against a copy of the app the same way the DEA does,
+// so only the files that would be pushed are visible to the buildpack
+func (stager LocalStager) Stage(appDir, buildpackDir string, onLog LogFunc) (result Result, err error) {
+	if runtime.GOOS != "linux" {
+		err = errors.New("Buildpacks can only be run on Linux")
+		return
+	}
+
+	appDir, err = filepath.Abs(appDir)
+	if err != nil {
+		return
+	}
+
+	fileutils.TempDir("stage-local-build", func(buildDir string, tmpErr error) {
+		if tmpErr != nil {
+			err = tmpErr
+			return
+		}
+
+		fileutils.TempDir("stage-local-cache", func(cacheDir string, tmpErr error) {
+			if tmpErr != nil {
+				err = tmpErr
+				return
+			}
+
+			err = copyAppFiles(appDir, buildDir)
+			if err != nil {
+				err = errors.New("Error copying app files: " + err.Error())
+				return
+			}
+
+			result, err = runBuildpack(buildpackDir, buildDir, cacheDir, onLog)
+		})
+	})
+	return
+}
+
+func copyAppFiles(appDir, buildDir string) error {
+	return app_files.WalkAppFiles(appDir, func(fileName, fullPath string) (err error) {
+		toPath := filepath.Join(buildDir, fileName)
+		err = fileutils.CopyFilePaths(fullPath, toPath)
+		if err != nil {
+			return
+		}
+		return fileutils.SetExecutableBitsWithPaths(toPath, fullPath)
+	})
+}
+
+func runBuildpack(buildpackDir, buildDir, cacheDir string, onLog LogFunc) (result Result, err error) {
+	detectOutput, err := runScript(buildpackDir, buildDir, "detect", nil)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Buildpack did not detect a compatible app: %s", err.Error()))
+		return
+	}
+	result.DetectedBuildpack = strings.TrimSpace(detectOutput)
+
+	_, err = runScript(buildpackDir, buildDir, "compile", onLog, cacheDir)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Buildpack compilation step failed: %s", err.Error()))
+		return
+	}
+
+	result.ReleaseYAML, err = runScript(buildpackDir, buildDir, "release", nil)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Buildpack release step failed: %s", err.Error()))
+		return
+	}
+
+	result.StartCommand, err = startCommandFromRelease(result.ReleaseYAML)
+	return
+}
+
+// the output of a script is returned when onLog is nil, otherwise each line
+// is handed to onLog as soon as the script writes it
+func runScript(buildpackDir, buildDir, script string, onLog LogFunc, extraArgs ...string) (output string, err error) {
+	scriptPath := filepath.Join(buildpackDir, "bin", script)
+	if _, err = os.Stat(scriptPath); err != nil {
+		err = errors.New(fmt.Sprintf("bin/%s is missing", script))
+		return
+	}
+
+	command := exec.Command(scriptPath, append([]string{buildDir}, extraArgs...)...)
+	command.Dir = buildDir
+
+	outputBuffer := &bytes.Buffer{}
+	if onLog == nil {
+		command.Stdout = outputBuffer
+		command.Stderr = outputBuffer
+		err = command.Run()
+		output = outputBuffer.String()
+		if err != nil && strings.TrimSpace(output) != "" {
+			err = errors.New(fmt.Sprintf("%s\n%s", err.Error(), strings.TrimSpace(output)))
+		}
+		return
+	}
+
+	stdout, err := command.StdoutPipe()
+	if err != nil {
+		return
+	}
+	stderr, err := command.StderrPipe()
+	if err != nil {
+		return
+	}
+
+	err = command.Start()
+	if err != nil {
+		return
+	}
+
+	var logLock sync.Mutex
+	var wg sync.WaitGroup
+	// a Scanner gives up on lines over 64KB and stops draining the pipe,
+	// which would leave the script blocked on a full pipe, so lines of any
+	// length are read until the pipe is closed
+	streamLines := func(reader io.Reader, isStderr bool) {
+		defer wg.Done()
+		bufferedReader := bufio.NewReader(reader)
+		for {
+			line, readErr := bufferedReader.ReadString('\n')
+			if line != "" {
+				line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+				logLock.Lock()
+				onLog(line, isStderr)
+				logLock.Unlock()
+			}
+			if readErr != nil {
+				return
+			}
+		}
+	}
+
+	wg.Add(2)
+	go streamLines(stdout, false)
+	go streamLines(stderr, true)
+	wg.Wait()
+
+	err = command.Wait()
+	return
+}
+
+func startCommandFromRelease(releaseYAML string) (startCommand string, err error) {
+	release := generic.NewMap()
+	err = candiedyaml.NewDecoder(strings.NewReader(releaseYAML)).Decode(release)
+	if err != nil {
+		err = errors.New("Buildpack release output is not valid YAML: " + err.Error())
+		return
+	}
+
+	processTypes := release.Get("default_process_types")
+	if !generic.IsMappable(processTypes) {
+		return
+	}
+
+	web, ok := generic.NewMap(processTypes).Get("web").(string)
+	if ok {
+		startCommand = web
+	}
+	return
+}
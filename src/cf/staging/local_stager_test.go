@@ -0,0 +1,143 @@
+package staging_test
+
+import (
+	. "cf/staging"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var _ = Describe("LocalStager", func() {
+	var (
+		appDir       string
+		buildpackDir string
+		logLines     []string
+		stderrLines  []string
+		onLog        LogFunc
+	)
+
+	writeFile := func(path, contents string, mode os.FileMode) {
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		Expect(err).NotTo(HaveOccurred())
+		err = ioutil.WriteFile(path, []byte(contents), mode)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	writeScript := func(name, contents string) {
+		writeFile(filepath.Join(buildpackDir, "bin", name), "#!/bin/sh\n"+contents, 0755)
+	}
+
+	BeforeEach(func() {
+		var err error
+		appDir, err = ioutil.TempDir("", "stage-local-app")
+		Expect(err).NotTo(HaveOccurred())
+		buildpackDir, err = ioutil.TempDir("", "stage-local-buildpack")
+		Expect(err).NotTo(HaveOccurred())
+
+		writeFile(filepath.Join(appDir, "index.html"), "hello", 0644)
+		writeFile(filepath.Join(appDir, "secret.txt"), "ignored", 0644)
+		writeFile(filepath.Join(appDir, ".cfignore"), "secret.txt\n", 0644)
+
+		writeScript("detect", "test -f $1/index.html && echo static\n")
+		writeScript("compile", `echo "listing $1"
+ls $1
+echo "cache at $2" >&2
+`)
+		writeScript("release", `cat <<YAML
+---
+default_process_types:
+  web: sh boot.sh
+YAML
+`)
+
+		logLines = []string{}
+		stderrLines = []string{}
+		onLog = func(line string, isStderr bool) {
+			if isStderr {
+				stderrLines = append(stderrLines, line)
+			} else {
+				logLines = append(logLines, line)
+			}
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(appDir)
+		os.RemoveAll(buildpackDir)
+	})
+
+	It("runs detect, compile and release against a copy of the app", func() {
+		result, err := NewLocalStager().Stage(appDir, buildpackDir, onLog)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.DetectedBuildpack).To(Equal("static"))
+		Expect(result.ReleaseYAML).To(ContainSubstring("default_process_types:"))
+		Expect(result.StartCommand).To(Equal("sh boot.sh"))
+
+		Expect(logLines[0]).To(HavePrefix("listing "))
+		Expect(logLines[0]).NotTo(ContainSubstring(appDir))
+		Expect(stderrLines).To(HaveLen(1))
+		Expect(stderrLines[0]).To(HavePrefix("cache at "))
+	})
+
+	It("leaves out the files matched by .cfignore", func() {
+		_, err := NewLocalStager().Stage(appDir, buildpackDir, onLog)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(logLines).To(ContainElement("index.html"))
+		Expect(logLines).NotTo(ContainElement("secret.txt"))
+		Expect(logLines).NotTo(ContainElement(".cfignore"))
+	})
+
+	It("fails when the buildpack does not detect the app", func() {
+		writeScript("detect", "echo no index.html\nexit 1\n")
+
+		_, err := NewLocalStager().Stage(appDir, buildpackDir, onLog)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Buildpack did not detect a compatible app"))
+		Expect(err.Error()).To(ContainSubstring("no index.html"))
+		Expect(logLines).To(BeEmpty())
+	})
+
+	It("fails when compile fails, after streaming its output", func() {
+		writeScript("compile", "echo compiling\nexit 3\n")
+
+		_, err := NewLocalStager().Stage(appDir, buildpackDir, onLog)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Buildpack compilation step failed"))
+		Expect(logLines).To(Equal([]string{"compiling"}))
+	})
+
+	It("streams lines of any length and the output after them", func() {
+		writeScript("compile", `head -c 100000 /dev/zero | tr '\0' x
+echo
+echo done
+printf 'no newline'
+`)
+
+		_, err := NewLocalStager().Stage(appDir, buildpackDir, onLog)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logLines).To(HaveLen(3))
+		Expect(logLines[0]).To(Equal(strings.Repeat("x", 100000)))
+		Expect(logLines[1:]).To(Equal([]string{"done", "no newline"}))
+	})
+
+	It("fails when a script is missing", func() {
+		os.Remove(filepath.Join(buildpackDir, "bin", "release"))
+
+		_, err := NewLocalStager().Stage(appDir, buildpackDir, onLog)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("bin/release is missing"))
+	})
+
+	It("fails when release does not print YAML", func() {
+		writeScript("release", "echo '{{{'\n")
+
+		_, err := NewLocalStager().Stage(appDir, buildpackDir, onLog)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not valid YAML"))
+	})
+})
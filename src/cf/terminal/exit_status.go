@@ -0,0 +1,99 @@
+package terminal
+
+import (
+	"cf/errors"
+	"os"
+)
+
+// The exit statuses of the CLI, which scripts can rely on
+const (
+	EXIT_OK           = 0
+	EXIT_FAILURE      = 1 // any failure not listed below
+	EXIT_USAGE        = 2 // incorrect usage, or input needed in non-interactive mode
+	EXIT_AUTH_FAILURE = 3 // not logged in, bad credentials or an expired session
+	EXIT_NOT_FOUND    = 4 // the app, space, service or other resource does not exist
+	EXIT_SERVER_ERROR = 5 // the server failed or could not be reached
+	EXIT_TIMEOUT      = 6 // the server, staging or an async job took too long
+)
+
+const CF_NONINTERACTIVE = "CF_NONINTERACTIVE"
+
+var (
+	exitStatus     = EXIT_OK
+	nonInteractive = false
+	exitHandler    func(status int)
+)
+
+func ExitStatus() int {
+	return exitStatus
+}
+
+func SetExitStatus(status int) {
+	exitStatus = status
+}
+
+// OnExit registers a handler that runs when the CLI exits before the command
+// returns, e.g. on incorrect usage, since deferred functions are skipped then
+func OnExit(handler func(status int)) {
+	exitHandler = handler
+}
+
+func Exit(status int) {
+	SetExitStatus(status)
+	if exitHandler != nil {
+		exitHandler(status)
+	}
+	os.Exit(status)
+}
+
+// prompts fail right away instead of waiting for input that never comes,
+// e.g. in CI
+func EnableNonInteractive() {
+	nonInteractive = true
+}
+
+func DisableNonInteractive() {
+	nonInteractive = false
+}
+
+func IsNonInteractive() bool {
+	return nonInteractive || os.Getenv(CF_NONINTERACTIVE) == "true"
+}
+
+func ExitStatusForError(err error) int {
+	switch err := err.(type) {
+	case errors.ModelNotFoundError, errors.HttpNotFoundError:
+		return EXIT_NOT_FOUND
+	case errors.InvalidTokenError, *errors.TokenRefreshError:
+		return EXIT_AUTH_FAILURE
+	case errors.TimeoutError:
+		return EXIT_TIMEOUT
+	case *errors.ConnectionError:
+		if err.Timeout() {
+			return EXIT_TIMEOUT
+		}
+		return EXIT_SERVER_ERROR
+	case errors.HttpError:
+		return exitStatusForHttpError(err)
+	}
+	return EXIT_FAILURE
+}
+
+func exitStatusForHttpError(err errors.HttpError) int {
+	switch err.ErrorCode() {
+	case "unauthorized", "invalid_token", "invalid_grant", "access_denied":
+		return EXIT_AUTH_FAILURE
+	}
+
+	switch {
+	case err.StatusCode() == 401 || err.StatusCode() == 403:
+		return EXIT_AUTH_FAILURE
+	case err.StatusCode() == 404:
+		return EXIT_NOT_FOUND
+	case err.StatusCode() == 504:
+		return EXIT_TIMEOUT
+	case err.StatusCode() >= 500:
+		return EXIT_SERVER_ERROR
+	}
+	return EXIT_FAILURE
+}
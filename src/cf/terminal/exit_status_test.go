@@ -0,0 +1,51 @@
+package terminal_test
+
+import (
+	"cf/errors"
+	. "cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"net"
+)
+
+type fakeNetError struct {
+	timeout bool
+}
+
+func (err fakeNetError) Error() string   { return "i/o error" }
+func (err fakeNetError) Timeout() bool   { return err.timeout }
+func (err fakeNetError) Temporary() bool { return false }
+
+var _ net.Error = fakeNetError{}
+
+var _ = Describe("exit status for errors", func() {
+	It("tells resources that do not exist", func() {
+		Expect(ExitStatusForError(errors.NewModelNotFoundError("App", "my-app"))).To(Equal(EXIT_NOT_FOUND))
+		Expect(ExitStatusForError(errors.NewHttpError(404, "100004", "The app could not be found"))).To(Equal(EXIT_NOT_FOUND))
+	})
+
+	It("tells authentication failures", func() {
+		Expect(ExitStatusForError(errors.NewInvalidTokenError("expired"))).To(Equal(EXIT_AUTH_FAILURE))
+		Expect(ExitStatusForError(errors.NewTokenRefreshError(errors.NewHttpError(500, "", "server error")))).To(Equal(EXIT_AUTH_FAILURE))
+		Expect(ExitStatusForError(errors.NewHttpError(401, "1000", "Invalid Auth Token"))).To(Equal(EXIT_AUTH_FAILURE))
+		Expect(ExitStatusForError(errors.NewHttpError(403, "10003", "You are not authorized"))).To(Equal(EXIT_AUTH_FAILURE))
+		Expect(ExitStatusForError(errors.NewHttpError(0, "unauthorized", "Bad credentials"))).To(Equal(EXIT_AUTH_FAILURE))
+	})
+
+	It("tells server errors", func() {
+		Expect(ExitStatusForError(errors.NewHttpError(500, "10001", "Unknown error"))).To(Equal(EXIT_SERVER_ERROR))
+		Expect(ExitStatusForError(errors.NewHttpError(503, "", "Service Unavailable"))).To(Equal(EXIT_SERVER_ERROR))
+		Expect(ExitStatusForError(errors.NewConnectionError(fakeNetError{timeout: false}))).To(Equal(EXIT_SERVER_ERROR))
+	})
+
+	It("tells timeouts", func() {
+		Expect(ExitStatusForError(errors.NewConnectionError(fakeNetError{timeout: true}))).To(Equal(EXIT_TIMEOUT))
+		Expect(ExitStatusForError(errors.NewTimeoutError("timed out"))).To(Equal(EXIT_TIMEOUT))
+		Expect(ExitStatusForError(errors.NewHttpError(504, "", "Gateway Timeout"))).To(Equal(EXIT_TIMEOUT))
+	})
+
+	It("falls back to a plain failure", func() {
+		Expect(ExitStatusForError(errors.New("uh oh"))).To(Equal(EXIT_FAILURE))
+		Expect(ExitStatusForError(errors.NewHttpError(400, "170001", "Staging error"))).To(Equal(EXIT_FAILURE))
+	})
+})
@@ -0,0 +1,26 @@
+package terminal
+
+import "strings"
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a row of block characters scaled against max.
+// Values at or below zero use the lowest tick, values at or above max the highest.
+func Sparkline(values []float64, max float64) string {
+	if max <= 0 {
+		return strings.Repeat(string(sparkTicks[0]), len(values))
+	}
+
+	line := make([]rune, len(values))
+	for index, value := range values {
+		tick := int(value / max * float64(len(sparkTicks)-1))
+		if tick < 0 {
+			tick = 0
+		}
+		if tick >= len(sparkTicks) {
+			tick = len(sparkTicks) - 1
+		}
+		line[index] = sparkTicks[tick]
+	}
+	return string(line)
+}
@@ -0,0 +1,25 @@
+package terminal_test
+
+import (
+	. "cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sparkline", func() {
+	It("scales values between the lowest and highest tick", func() {
+		Expect(Sparkline([]float64{0, 0.5, 1}, 1)).To(Equal("▁▄█"))
+	})
+
+	It("clamps values outside of the range", func() {
+		Expect(Sparkline([]float64{-1, 2}, 1)).To(Equal("▁█"))
+	})
+
+	It("renders a flat line when max is zero", func() {
+		Expect(Sparkline([]float64{3, 4}, 0)).To(Equal("▁▁"))
+	})
+
+	It("is empty without values", func() {
+		Expect(Sparkline([]float64{}, 1)).To(Equal(""))
+	})
+})
@@ -41,7 +41,7 @@ func (t *PrintableTable) Print(rows [][]string) {
 
 func (t *PrintableTable) calculateMaxSize(row []string) {
 	for index, value := range row {
-		cellLength := len(decolorize(value))
+		cellLength := DisplayWidth(value)
 		if t.maxSizes[index] < cellLength {
 			t.maxSizes[index] = cellLength
 		}
@@ -53,7 +53,7 @@ func (t *PrintableTable) printHeader() {
 	for col, value := range t.header {
 		output = output + t.cellValue(col, HeaderColor(value))
 	}
-	t.ui.Say(output)
+	t.ui.Say("%s", output)
 }
 
 func (t *PrintableTable) printRow(row []string) {
@@ -65,13 +65,13 @@ func (t *PrintableTable) printRow(row []string) {
 
 		output = output + t.cellValue(col, value)
 	}
-	t.ui.Say(output)
+	t.ui.Say("%s", output)
 }
 
 func (t *PrintableTable) cellValue(col int, value string) string {
 	padding := ""
 	if col < len(t.header)-1 {
-		padding = strings.Repeat(" ", t.maxSizes[col]-len(decolorize(value)))
+		padding = strings.Repeat(" ", t.maxSizes[col]-DisplayWidth(value))
 	}
 	return fmt.Sprintf("%s%s   ", value, padding)
 }
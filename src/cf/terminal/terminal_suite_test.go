@@ -1,6 +1,7 @@
 package terminal_test
 
 import (
+	"cf/i18n"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -8,6 +9,7 @@ import (
 )
 
 func TestTerminal(t *testing.T) {
+	i18n.SetLocale(i18n.DEFAULT_LOCALE)
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Terminal Suite")
 }
@@ -3,11 +3,11 @@ package terminal
 import (
 	"cf"
 	"cf/configuration"
+	"cf/i18n"
 	"cf/trace"
 	"fmt"
 	"github.com/codegangsta/cli"
 	"io"
-	"os"
 	"strings"
 	"time"
 )
@@ -15,7 +15,7 @@ import (
 type ColoringFunction func(value string, row int, col int) string
 
 func NotLoggedInText() string {
-	return fmt.Sprintf("Not logged in. Use '%s' to log in.", CommandColor(cf.Name()+" login"))
+	return i18n.T("Not logged in. Use '%s' to log in.", CommandColor(cf.Name()+" login"))
 }
 
 type UI interface {
@@ -27,11 +27,13 @@ type UI interface {
 	Confirm(message string, args ...interface{}) bool
 	Ok()
 	Failed(message string, args ...interface{})
+	FailWithError(err error)
 	FailWithUsage(ctxt *cli.Context, cmdName string)
 	ConfigFailure(err error)
 	ShowConfiguration(configuration.Reader)
 	LoadingIndication()
 	Wait(duration time.Duration)
+	ClearScreen()
 	DisplayTable(table [][]string)
 	Table(headers []string) Table
 }
@@ -69,13 +71,15 @@ func (c terminalUI) Warn(message string, args ...interface{}) {
 func (c terminalUI) Confirm(message string, args ...interface{}) bool {
 	response := c.Ask(message, args...)
 	switch strings.ToLower(response) {
-	case "y", "yes":
+	case "y", "yes", i18n.T("y"), i18n.T("yes"):
 		return true
 	}
 	return false
 }
 
 func (c terminalUI) Ask(prompt string, args ...interface{}) (answer string) {
+	c.failIfNonInteractive(prompt, args...)
+
 	fmt.Println("")
 	fmt.Printf(prompt+" ", args...)
 	fmt.Fscanln(c.stdin, &answer)
@@ -83,14 +87,25 @@ func (c terminalUI) Ask(prompt string, args ...interface{}) (answer string) {
 }
 
 func (c terminalUI) Ok() {
-	c.Say(SuccessColor("OK"))
+	c.Say(SuccessColor(i18n.T("OK")))
 }
 
 const FailedWasCalled = "FailedWasCalled"
 
 func (c terminalUI) Failed(message string, args ...interface{}) {
+	c.failWithExitStatus(EXIT_FAILURE, message, args...)
+}
+
+// FailWithError exits with the status that tells what kind of error it was
+func (c terminalUI) FailWithError(err error) {
+	c.failWithExitStatus(ExitStatusForError(err), "%s", err.Error())
+}
+
+func (c terminalUI) failWithExitStatus(status int, message string, args ...interface{}) {
+	SetExitStatus(status)
+
 	message = fmt.Sprintf(message, args...)
-	c.Say(FailureColor("FAILED"))
+	c.Say(FailureColor(i18n.T("FAILED")))
 	c.Say(message)
 
 	trace.Logger.Print("FAILED")
@@ -99,50 +114,70 @@ func (c terminalUI) Failed(message string, args ...interface{}) {
 }
 
 func (c terminalUI) FailWithUsage(ctxt *cli.Context, cmdName string) {
-	c.Say(FailureColor("FAILED"))
-	c.Say("Incorrect Usage.\n")
+	c.Say(FailureColor(i18n.T("FAILED")))
+	c.Say(i18n.T("Incorrect Usage.") + "\n")
 	cli.ShowCommandHelp(ctxt, cmdName)
 	c.Say("")
-	os.Exit(1)
+	Exit(EXIT_USAGE)
+}
+
+// the name of the missing input is the prompt, without the trailing >
+func (c terminalUI) failIfNonInteractive(prompt string, args ...interface{}) {
+	if !IsNonInteractive() {
+		return
+	}
+
+	input := decolorize(fmt.Sprintf(prompt, args...))
+	input = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(input), ">"))
+	c.failWithExitStatus(EXIT_USAGE, i18n.T("Input required, but prompts are disabled in non-interactive mode: %s"), input)
 }
 
 func (c terminalUI) ConfigFailure(err error) {
-	c.Failed("Please use '%s api' to set an API endpoint and then '%s login' to login.", cf.Name(), cf.Name())
+	c.Failed(i18n.T("Please use '%s api' to set an API endpoint and then '%s login' to login."), cf.Name(), cf.Name())
 }
 
 func (ui terminalUI) ShowConfiguration(config configuration.Reader) {
 	if config.HasAPIEndpoint() {
-		ui.Say("API endpoint: %s (API version: %s)",
+		ui.Say(i18n.T("API endpoint: %s (API version: %s)"),
 			EntityNameColor(config.ApiEndpoint()),
 			EntityNameColor(config.ApiVersion()))
 	}
 
 	if !config.IsLoggedIn() {
-		ui.Say(NotLoggedInText())
+		ui.Say("%s", NotLoggedInText())
 		return
 	} else {
-		ui.Say("User:         %s", EntityNameColor(config.UserEmail()))
+		ui.Say("%s %s", configurationLabel(i18n.T("User:")), EntityNameColor(config.UserEmail()))
 	}
 
 	if !config.HasOrganization() && !config.HasSpace() {
 		command := fmt.Sprintf("%s target -o ORG -s SPACE", cf.Name())
-		ui.Say("No org or space targeted, use '%s'", CommandColor(command))
+		ui.Say(i18n.T("No org or space targeted, use '%s'"), CommandColor(command))
 		return
 	}
 
 	if config.HasOrganization() {
-		ui.Say("Org:          %s", EntityNameColor(config.OrganizationFields().Name))
+		ui.Say("%s %s", configurationLabel(i18n.T("Org:")), EntityNameColor(config.OrganizationFields().Name))
 	} else {
 		command := fmt.Sprintf("%s target -o Org", cf.Name())
-		ui.Say("Org:          No org targeted, use '%s'", CommandColor(command))
+		ui.Say("%s %s", configurationLabel(i18n.T("Org:")), i18n.T("No org targeted, use '%s'", CommandColor(command)))
 	}
 
 	if config.HasSpace() {
-		ui.Say("Space:        %s", EntityNameColor(config.SpaceFields().Name))
+		ui.Say("%s %s", configurationLabel(i18n.T("Space:")), EntityNameColor(config.SpaceFields().Name))
 	} else {
 		command := fmt.Sprintf("%s target -s SPACE", cf.Name())
-		ui.Say("Space:        No space targeted, use '%s'", CommandColor(command))
+		ui.Say("%s %s", configurationLabel(i18n.T("Space:")), i18n.T("No space targeted, use '%s'", CommandColor(command)))
+	}
+}
+
+// the labels are padded so the values line up whatever the language
+func configurationLabel(label string) string {
+	padding := 13 - DisplayWidth(label)
+	if padding < 0 {
+		padding = 0
 	}
+	return label + strings.Repeat(" ", padding)
 }
 
 func (c terminalUI) LoadingIndication() {
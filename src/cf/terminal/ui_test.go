@@ -3,6 +3,8 @@ package terminal_test
 import (
 	"bytes"
 	"cf/configuration"
+	"cf/errors"
+	"cf/i18n"
 	"cf/models"
 	. "cf/terminal"
 	. "github.com/onsi/ginkgo"
@@ -61,6 +63,39 @@ var _ = Describe("UI", func() {
 				testassert.SliceContains(out, testassert.Lines{{"Hello World?"}})
 			})
 		})
+
+		Context("when the locale is not English", func() {
+			BeforeEach(func() {
+				i18n.SetLocale("pt_BR")
+			})
+
+			AfterEach(func() {
+				i18n.SetLocale(i18n.DEFAULT_LOCALE)
+			})
+
+			It("treats the translated yes as an affirmative confirmation", func() {
+				simulateStdin("sim\n", func(reader io.Reader) {
+					captureOutput(func() {
+						Expect(NewUI(reader).Confirm("Hello %s", "World?")).To(BeTrue())
+					})
+				})
+			})
+
+			It("still treats 'y' as an affirmative confirmation", func() {
+				simulateStdin("y\n", func(reader io.Reader) {
+					captureOutput(func() {
+						Expect(NewUI(reader).Confirm("Hello %s", "World?")).To(BeTrue())
+					})
+				})
+			})
+
+			It("translates what it prints", func() {
+				out := captureOutput(func() {
+					NewUI(os.Stdin).ShowConfiguration(testconfig.NewRepository())
+				})
+				testassert.SliceContains(out, testassert.Lines{{"Não conectado"}})
+			})
+		})
 	})
 
 	Context("when user is not logged in", func() {
@@ -158,6 +193,33 @@ var _ = Describe("UI", func() {
 					})
 				})
 			})
+
+			Context("when the labels are translated into double-width characters", func() {
+				BeforeEach(func() {
+					i18n.SetLocale("ja_JP")
+					config.SetOrganizationFields(models.OrganizationFields{Name: "org-name", Guid: "org-guid"})
+					config.SetSpaceFields(models.SpaceFields{Name: "my-space", Guid: "space-guid"})
+				})
+
+				AfterEach(func() {
+					i18n.SetLocale(i18n.DEFAULT_LOCALE)
+				})
+
+				It("lines up the values by their display width", func() {
+					valueColumn := func(value string) int {
+						for _, line := range output {
+							if index := strings.Index(line, value); index >= 0 {
+								return DisplayWidth(line[:index])
+							}
+						}
+						return -1
+					}
+
+					Expect(valueColumn("my-user-email")).To(BeNumerically(">", 0))
+					Expect(valueColumn("org-name")).To(Equal(valueColumn("my-user-email")))
+					Expect(valueColumn("my-space")).To(Equal(valueColumn("my-user-email")))
+				})
+			})
 		})
 
 		It("prompts the user to target an org and space when no org or space is targeted", func() {
@@ -210,6 +272,85 @@ var _ = Describe("UI", func() {
 				})
 			})
 		})
+
+		AfterEach(func() {
+			SetExitStatus(EXIT_OK)
+		})
+
+		It("sets the exit status for a failure", func() {
+			captureOutput(func() {
+				testassert.AssertPanic(FailedWasCalled, func() {
+					NewUI(os.Stdin).Failed("uh oh")
+				})
+			})
+			Expect(ExitStatus()).To(Equal(EXIT_FAILURE))
+		})
+
+		It("sets the exit status for the kind of error", func() {
+			out := captureOutput(func() {
+				testassert.AssertPanic(FailedWasCalled, func() {
+					NewUI(os.Stdin).FailWithError(errors.NewModelNotFoundError("App", "my-app"))
+				})
+			})
+			testassert.SliceContains(out, testassert.Lines{{"FAILED"}, {"App my-app not found"}})
+			Expect(ExitStatus()).To(Equal(EXIT_NOT_FOUND))
+		})
+	})
+
+	Describe("in non-interactive mode", func() {
+		BeforeEach(func() {
+			EnableNonInteractive()
+		})
+
+		AfterEach(func() {
+			DisableNonInteractive()
+			SetExitStatus(EXIT_OK)
+		})
+
+		It("fails instead of asking, naming the missing input", func() {
+			simulateStdin("my-user\n", func(reader io.Reader) {
+				out := captureOutput(func() {
+					testassert.AssertPanic(FailedWasCalled, func() {
+						NewUI(reader).Ask("Email%s", PromptColor(">"))
+					})
+				})
+				testassert.SliceContains(out, testassert.Lines{
+					{"FAILED"},
+					{"prompts are disabled in non-interactive mode: Email"},
+				})
+			})
+			Expect(ExitStatus()).To(Equal(EXIT_USAGE))
+		})
+
+		It("fails instead of asking for confirmation", func() {
+			simulateStdin("y\n", func(reader io.Reader) {
+				captureOutput(func() {
+					testassert.AssertPanic(FailedWasCalled, func() {
+						NewUI(reader).Confirm("Really delete?")
+					})
+				})
+			})
+		})
+
+		It("fails instead of asking for a password", func() {
+			captureOutput(func() {
+				testassert.AssertPanic(FailedWasCalled, func() {
+					NewUI(os.Stdin).AskForPassword("Password%s", PromptColor(">"))
+				})
+			})
+			Expect(ExitStatus()).To(Equal(EXIT_USAGE))
+		})
+
+		It("is turned on by CF_NONINTERACTIVE", func() {
+			DisableNonInteractive()
+			defer os.Setenv(CF_NONINTERACTIVE, os.Getenv(CF_NONINTERACTIVE))
+
+			os.Setenv(CF_NONINTERACTIVE, "true")
+			Expect(IsNonInteractive()).To(BeTrue())
+
+			os.Setenv(CF_NONINTERACTIVE, "")
+			Expect(IsNonInteractive()).To(BeFalse())
+		})
 	})
 })
 
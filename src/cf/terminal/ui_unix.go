@@ -26,7 +26,16 @@ var sttyArgvEOn []string = []string{"stty", "echo"}
 
 var ws syscall.WaitStatus = 0
 
+// Moves the cursor to the top left corner and erases the screen.
+const clearScreenSequence = "\033[H\033[2J"
+
+func (ui terminalUI) ClearScreen() {
+	fmt.Print(clearScreenSequence)
+}
+
 func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd string) {
+	ui.failIfNonInteractive(prompt, args...)
+
 	sig := make(chan os.Signal, 10)
 
 	// Display the prompt.
@@ -96,6 +105,6 @@ func catchSignal(fd []uintptr, sig chan os.Signal) {
 	select {
 	case <-sig:
 		echoOn(fd)
-		os.Exit(2)
+		Exit(2)
 	}
 }
@@ -12,6 +12,8 @@ import (
 const ENABLE_ECHO_INPUT = 0x0004
 
 func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd string) {
+	ui.failIfNonInteractive(prompt, args...)
+
 	hStdin := syscall.Handle(os.Stdin.Fd())
 	var originalMode uint32
 
@@ -33,6 +35,12 @@ func (ui terminalUI) AskForPassword(prompt string, args ...interface{}) (passwd
 	return ui.Ask(prompt, args...)
 }
 
+// the windows console does not understand ANSI escape sequences,
+// so each refresh is separated by a blank line instead
+func (ui terminalUI) ClearScreen() {
+	ui.Say("")
+}
+
 func setConsoleMode(console syscall.Handle, mode uint32) (err error) {
 	dll := syscall.MustLoadDLL("kernel32")
 	proc := dll.MustFindProc("SetConsoleMode")
@@ -0,0 +1,31 @@
+package terminal
+
+// East Asian wide and fullwidth characters take up two columns
+var wideRuneRanges = [][2]rune{
+	{0x1100, 0x115F},
+	{0x2E80, 0x303E},
+	{0x3040, 0xA4CF},
+	{0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF},
+	{0xFE30, 0xFE4F},
+	{0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6},
+	{0x20000, 0x3FFFD},
+}
+
+// DisplayWidth is the number of terminal columns the string takes up, colors left out
+func DisplayWidth(s string) (width int) {
+	for _, r := range decolorize(s) {
+		width += runeWidth(r)
+	}
+	return
+}
+
+func runeWidth(r rune) int {
+	for _, wideRange := range wideRuneRanges {
+		if r >= wideRange[0] && r <= wideRange[1] {
+			return 2
+		}
+	}
+	return 1
+}
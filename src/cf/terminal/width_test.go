@@ -0,0 +1,22 @@
+package terminal_test
+
+import (
+	. "cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DisplayWidth", func() {
+	It("counts one column per narrow character", func() {
+		Expect(DisplayWidth("Usuário:")).To(Equal(8))
+	})
+
+	It("counts two columns per wide character", func() {
+		Expect(DisplayWidth("ユーザー:")).To(Equal(9))
+		Expect(DisplayWidth("組織")).To(Equal(4))
+	})
+
+	It("leaves colors out", func() {
+		Expect(DisplayWidth("\033[1;38mスペース\033[0m")).To(Equal(8))
+	})
+})
@@ -6,6 +6,8 @@ import (
 	"cf/app"
 	"cf/commands"
 	"cf/configuration"
+	"cf/i18n"
+	"cf/journal"
 	"cf/manifest"
 	"cf/net"
 	"cf/requirements"
@@ -37,13 +39,14 @@ func setupDependencies() (deps *cliDependencies) {
 
 	deps.configRepo = configuration.NewRepositoryFromFilepath(configuration.DefaultFilePath(), func(err error) {
 		if err != nil {
-			deps.termUI.Failed(fmt.Sprintf("Config error: %s", err))
+			deps.termUI.Failed(i18n.T("Config error: %s"), err.Error())
 		}
 	})
 
 	deps.apiRepoLocator = api.NewRepositoryLocator(deps.configRepo, map[string]net.Gateway{
 		"auth":             net.NewUAAGateway(deps.configRepo),
 		"cloud-controller": net.NewCloudControllerGateway(deps.configRepo),
+		"service-broker":   net.NewServiceBrokerGateway(deps.configRepo),
 		"uaa":              net.NewUAAGateway(deps.configRepo),
 	})
 
@@ -56,6 +59,12 @@ func main() {
 	deps := setupDependencies()
 	defer deps.configRepo.Close()
 
+	recorder := journal.NewRecorder(journal.NewDiskJournal(journal.DefaultFilePath()), deps.configRepo, os.Args[1:])
+	defer finishJournalEntry(recorder)
+	terminal.OnExit(func(status int) {
+		recorder.Finish(status)
+	})
+
 	cmdFactory := commands.NewFactory(deps.termUI, deps.configRepo, deps.manifestRepo, deps.apiRepoLocator)
 	reqFactory := requirements.NewFactory(deps.termUI, deps.configRepo, deps.apiRepoLocator)
 	cmdRunner := commands.NewRunner(cmdFactory, reqFactory)
@@ -94,22 +103,34 @@ ENVIRONMENT VARIABLES:
    HTTP_PROXY=http://proxy.example.com:8080 - enable HTTP proxying for API requests
 `
 
-	cli.CommandHelpTemplate = `NAME:
+	cli.CommandHelpTemplate = i18n.T("NAME:") + `
    {{.Name}} - {{.Description}}
 {{with .ShortName}}
-ALIAS:
+` + i18n.T("ALIAS:") + `
    {{.}}
 {{end}}
-USAGE:
+` + i18n.T("USAGE:") + `
    {{.Usage}}{{with .Flags}}
 
-OPTIONS:
+` + i18n.T("OPTIONS:") + `
    {{range .}}{{.}}
    {{end}}{{else}}
 {{end}}`
 
 }
 
+// a failed command panics on its way out, so the panic is passed on
+// once the journal entry is written
+func finishJournalEntry(recorder *journal.Recorder) {
+	err := recover()
+
+	recorder.Finish(exitStatus(err))
+
+	if err != nil {
+		panic(err)
+	}
+}
+
 func handlePanics() {
 	err := recover()
 	if err != nil && err != terminal.FailedWasCalled {
@@ -123,9 +144,19 @@ func handlePanics() {
 		}
 	}
 
-	if err != nil {
-		os.Exit(1)
+	status := exitStatus(err)
+	if status != terminal.EXIT_OK {
+		os.Exit(status)
+	}
+}
+
+// a crash leaves no exit status behind, a failure always does
+func exitStatus(panicErr interface{}) int {
+	status := terminal.ExitStatus()
+	if panicErr != nil && status == terminal.EXIT_OK {
+		status = terminal.EXIT_FAILURE
 	}
+	return status
 }
 
 func displayCrashDialog(errorMessage string) {
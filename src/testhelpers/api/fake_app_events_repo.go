@@ -1,17 +1,42 @@
 package api
 
-import "cf/models"
+import (
+	"cf/models"
+	"time"
+)
 
 type FakeAppEventsRepo struct {
 	AppGuid string
 	Events  []models.EventFields
 	Error   error
+
+	SpaceGuid        string
+	OrganizationGuid string
+	Since            time.Time
 }
 
-func (repo FakeAppEventsRepo) ListEvents(appGuid string, cb func(models.EventFields) bool) error {
+func (repo *FakeAppEventsRepo) ListEvents(appGuid string, cb func(models.EventFields) bool) error {
 	repo.AppGuid = appGuid
+	return repo.listEvents(cb)
+}
+
+func (repo *FakeAppEventsRepo) ListEventsInSpace(spaceGuid string, since time.Time, cb func(models.EventFields) bool) error {
+	repo.SpaceGuid = spaceGuid
+	repo.Since = since
+	return repo.listEvents(cb)
+}
+
+func (repo *FakeAppEventsRepo) ListEventsInOrg(orgGuid string, since time.Time, cb func(models.EventFields) bool) error {
+	repo.OrganizationGuid = orgGuid
+	repo.Since = since
+	return repo.listEvents(cb)
+}
+
+func (repo *FakeAppEventsRepo) listEvents(cb func(models.EventFields) bool) error {
 	for _, e := range repo.Events {
-		cb(e)
+		if !cb(e) {
+			break
+		}
 	}
 	return repo.Error
 }
@@ -1,16 +1,47 @@
 package api
 
 type FakeAppFilesRepo struct {
-	AppGuid  string
-	Path     string
-	FileList string
+	AppGuid   string
+	Instance  int
+	Path      string
+	FileList  string
+	FilesList map[string]string
+
+	Instances []int
+	Paths     []string
+
+	ReadFileFromOffsets   []int64
+	ReadFileFromResponses []string
+	ReadFileFromError     error
+}
+
+func (repo *FakeAppFilesRepo) ListFiles(appGuid string, instance int, path string) (files string, apiErr error) {
+	repo.AppGuid = appGuid
+	repo.Instance = instance
+	repo.Path = path
+	repo.Instances = append(repo.Instances, instance)
+	repo.Paths = append(repo.Paths, path)
+
+	files, found := repo.FilesList[path]
+	if !found {
+		files = repo.FileList
+	}
+
+	return
 }
 
-func (repo *FakeAppFilesRepo) ListFiles(appGuid, path string) (files string, apiErr error) {
+func (repo *FakeAppFilesRepo) ReadFileFrom(appGuid string, instance int, path string, offset int64) (contents string, apiErr error) {
 	repo.AppGuid = appGuid
+	repo.Instance = instance
 	repo.Path = path
+	repo.ReadFileFromOffsets = append(repo.ReadFileFromOffsets, offset)
 
-	files = repo.FileList
+	if len(repo.ReadFileFromResponses) == 0 {
+		apiErr = repo.ReadFileFromError
+		return
+	}
 
+	contents = repo.ReadFileFromResponses[0]
+	repo.ReadFileFromResponses = repo.ReadFileFromResponses[1:]
 	return
 }
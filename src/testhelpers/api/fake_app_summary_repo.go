@@ -8,6 +8,10 @@ import (
 type FakeAppSummaryRepo struct {
 	GetSummariesInCurrentSpaceApps []models.AppSummary
 
+	GetSummariesInSpaceGuids []string
+	GetSummariesInSpaceApps  map[string][]models.AppSummary
+	GetSummariesInSpaceErr   error
+
 	GetSummaryErrorCode string
 	GetSummaryAppGuid   string
 	GetSummarySummary   models.AppSummary
@@ -18,6 +22,13 @@ func (repo *FakeAppSummaryRepo) GetSummariesInCurrentSpace() (apps []models.AppS
 	return
 }
 
+func (repo *FakeAppSummaryRepo) GetSummariesInSpace(spaceGuid string) (apps []models.AppSummary, apiErr error) {
+	repo.GetSummariesInSpaceGuids = append(repo.GetSummariesInSpaceGuids, spaceGuid)
+	apps = repo.GetSummariesInSpaceApps[spaceGuid]
+	apiErr = repo.GetSummariesInSpaceErr
+	return
+}
+
 func (repo *FakeAppSummaryRepo) GetSummary(appGuid string) (summary models.AppSummary, apiErr error) {
 	repo.GetSummaryAppGuid = appGuid
 	summary = repo.GetSummarySummary
@@ -10,10 +10,19 @@ type FakeAuthenticationRepository struct {
 	AuthenticateArgs struct {
 		Credentials []map[string]string
 	}
+	AuthenticateWithClientCredentialsArgs struct {
+		ClientId     string
+		ClientSecret string
+	}
 	GetLoginPromptsReturns struct {
 		Error   error
 		Prompts map[string]configuration.AuthPrompt
 	}
+	RefreshAuthTokenReturns struct {
+		Token string
+		Error error
+	}
+	RefreshAuthTokenCalled bool
 
 	AuthError    bool
 	AccessToken  string
@@ -38,7 +47,29 @@ func (auth *FakeAuthenticationRepository) Authenticate(credentials map[string]st
 	return
 }
 
+func (auth *FakeAuthenticationRepository) AuthenticateWithClientCredentials(clientId, clientSecret string) (apiErr error) {
+	auth.AuthenticateWithClientCredentialsArgs.ClientId = clientId
+	auth.AuthenticateWithClientCredentialsArgs.ClientSecret = clientSecret
+
+	if auth.AuthError {
+		apiErr = errors.New("Error authenticating.")
+		return
+	}
+
+	if auth.AccessToken == "" {
+		auth.AccessToken = "BEARER some_access_token"
+	}
+
+	auth.Config.SetAccessToken(auth.AccessToken)
+	auth.Config.SetUAAOAuthClient(clientId)
+	auth.Config.SetUAAOAuthClientSecret(clientSecret)
+	return
+}
+
 func (auth *FakeAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiErr error) {
+	auth.RefreshAuthTokenCalled = true
+	updatedToken = auth.RefreshAuthTokenReturns.Token
+	apiErr = auth.RefreshAuthTokenReturns.Error
 	return
 }
 
@@ -3,12 +3,29 @@ package api
 import (
 	"cf/errors"
 	"cf/models"
+	"io/ioutil"
 )
 
 type FakeBuildpackBitsRepository struct {
 	UploadBuildpackErr         bool
 	UploadBuildpackApiResponse error
 	UploadBuildpackPath        string
+	UploadedBuildpackPaths     []string
+
+	InspectBuildpackPath   string
+	InspectBuildpackReport models.BuildpackReport
+	InspectBuildpackErr    error
+
+	ExtractBuildpackPath    string
+	ExtractBuildpackDestDir string
+	ExtractBuildpackErr     error
+
+	DownloadedBuildpackGuids []string
+	DownloadBuildpackErr     error
+
+	FetchedBuildpackLocations []string
+	FetchBuildpackLocalPath   string
+	FetchBuildpackErr         error
 }
 
 func (repo *FakeBuildpackBitsRepository) UploadBuildpack(buildpack models.Buildpack, dir string) error {
@@ -17,5 +34,41 @@ func (repo *FakeBuildpackBitsRepository) UploadBuildpack(buildpack models.Buildp
 	}
 
 	repo.UploadBuildpackPath = dir
+	repo.UploadedBuildpackPaths = append(repo.UploadedBuildpackPaths, dir)
 	return repo.UploadBuildpackApiResponse
 }
+
+func (repo *FakeBuildpackBitsRepository) InspectBuildpack(buildpackLocation string) (report models.BuildpackReport, apiErr error) {
+	repo.InspectBuildpackPath = buildpackLocation
+	report = repo.InspectBuildpackReport
+	apiErr = repo.InspectBuildpackErr
+	return
+}
+
+func (repo *FakeBuildpackBitsRepository) ExtractBuildpack(buildpackLocation, destDir string) (apiErr error) {
+	repo.ExtractBuildpackPath = buildpackLocation
+	repo.ExtractBuildpackDestDir = destDir
+	return repo.ExtractBuildpackErr
+}
+
+func (repo *FakeBuildpackBitsRepository) DownloadBuildpack(buildpack models.Buildpack, destPath string) (apiErr error) {
+	repo.DownloadedBuildpackGuids = append(repo.DownloadedBuildpackGuids, buildpack.Guid)
+	if repo.DownloadBuildpackErr != nil {
+		return repo.DownloadBuildpackErr
+	}
+	return ioutil.WriteFile(destPath, []byte(buildpack.Name+"-bits"), 0644)
+}
+
+func (repo *FakeBuildpackBitsRepository) FetchBuildpack(buildpackLocation string, cb func(localPath string, apiErr error)) {
+	repo.FetchedBuildpackLocations = append(repo.FetchedBuildpackLocations, buildpackLocation)
+	if repo.FetchBuildpackErr != nil {
+		cb("", repo.FetchBuildpackErr)
+		return
+	}
+
+	localPath := buildpackLocation
+	if repo.FetchBuildpackLocalPath != "" {
+		localPath = repo.FetchBuildpackLocalPath
+	}
+	cb(localPath, nil)
+}
@@ -16,11 +16,14 @@ type FakeBuildpackRepository struct {
 	CreateBuildpackExists bool
 	CreateBuildpack       models.Buildpack
 	CreateApiResponse     error
+	CreatedBuildpacks     []models.Buildpack
 
 	DeleteBuildpackGuid string
 	DeleteApiResponse   error
 
-	UpdateBuildpack models.Buildpack
+	UpdateBuildpack   models.Buildpack
+	UpdatedBuildpacks []models.Buildpack
+	UpdateErrs        map[string]error
 }
 
 func (repo *FakeBuildpackRepository) ListBuildpacks(cb func(models.Buildpack) bool) error {
@@ -47,6 +50,7 @@ func (repo *FakeBuildpackRepository) Create(name string, position *int, enabled
 	}
 
 	repo.CreateBuildpack = models.Buildpack{Name: name, Position: position, Enabled: enabled, Locked: locked}
+	repo.CreatedBuildpacks = append(repo.CreatedBuildpacks, repo.CreateBuildpack)
 	return repo.CreateBuildpack, repo.CreateApiResponse
 }
 
@@ -56,7 +60,53 @@ func (repo *FakeBuildpackRepository) Delete(buildpackGuid string) (apiErr error)
 	return
 }
 
+// an error in UpdateErrs is returned once for the buildpack with that name;
+// successful position changes move the buildpack within Buildpacks the way
+// the cloud controller shifts the other buildpacks
 func (repo *FakeBuildpackRepository) Update(buildpack models.Buildpack) (updatedBuildpack models.Buildpack, apiErr error) {
+	if err, found := repo.UpdateErrs[buildpack.Name]; found {
+		delete(repo.UpdateErrs, buildpack.Name)
+		apiErr = err
+		return
+	}
+
 	repo.UpdateBuildpack = buildpack
+	repo.UpdatedBuildpacks = append(repo.UpdatedBuildpacks, buildpack)
+	updatedBuildpack = buildpack
+
+	if buildpack.Position != nil {
+		repo.moveBuildpack(buildpack.Guid, *buildpack.Position)
+	}
 	return
 }
+
+func (repo *FakeBuildpackRepository) moveBuildpack(guid string, position int) {
+	others := []models.Buildpack{}
+	var moved *models.Buildpack
+	for index := range repo.Buildpacks {
+		if repo.Buildpacks[index].Guid == guid {
+			moved = &repo.Buildpacks[index]
+			continue
+		}
+		others = append(others, repo.Buildpacks[index])
+	}
+	if moved == nil {
+		return
+	}
+
+	if position < 1 {
+		position = 1
+	}
+	if position > len(others)+1 {
+		position = len(others) + 1
+	}
+
+	reordered := append([]models.Buildpack{}, others[:position-1]...)
+	reordered = append(reordered, *moved)
+	reordered = append(reordered, others[position-1:]...)
+	for index := range reordered {
+		newPosition := index + 1
+		reordered[index].Position = &newPosition
+	}
+	repo.Buildpacks = reordered
+}
@@ -20,6 +20,7 @@ type FakeDomainRepository struct {
 	FindByNameInOrgGuid        string
 	FindByNameInOrgDomain      models.DomainFields
 	FindByNameInOrgApiResponse error
+	FindByNameInOrgDomains     map[string]models.DomainFields
 
 	FindByNameName     string
 	FindByNameDomain   models.DomainFields
@@ -77,6 +78,15 @@ func (repo *FakeDomainRepository) FindByName(name string) (domain models.DomainF
 func (repo *FakeDomainRepository) FindByNameInOrg(name string, owningOrgGuid string) (domain models.DomainFields, apiErr error) {
 	repo.FindByNameInOrgName = name
 	repo.FindByNameInOrgGuid = owningOrgGuid
+
+	if repo.FindByNameInOrgDomains != nil {
+		domain, found := repo.FindByNameInOrgDomains[name]
+		if !found {
+			apiErr = errors.NewModelNotFoundError("Domain", name)
+		}
+		return domain, apiErr
+	}
+
 	domain = repo.FindByNameInOrgDomain
 	apiErr = repo.FindByNameInOrgApiResponse
 	return
@@ -15,6 +15,11 @@ type FakeQuotaRepository struct {
 
 	UpdateOrgGuid   string
 	UpdateQuotaGuid string
+
+	CreateQuota    models.QuotaFields
+	UpdatedQuota   models.QuotaFields
+	DeleteGuid     string
+	CreateQuotaErr error
 }
 
 func (repo *FakeQuotaRepository) FindAll() (quotas []models.QuotaFields, apiErr error) {
@@ -42,3 +47,19 @@ func (repo *FakeQuotaRepository) Update(orgGuid, quotaGuid string) (apiErr error
 	repo.UpdateQuotaGuid = quotaGuid
 	return
 }
+
+func (repo *FakeQuotaRepository) Create(quota models.QuotaFields) (apiErr error) {
+	repo.CreateQuota = quota
+	apiErr = repo.CreateQuotaErr
+	return
+}
+
+func (repo *FakeQuotaRepository) UpdateQuota(quota models.QuotaFields) (apiErr error) {
+	repo.UpdatedQuota = quota
+	return
+}
+
+func (repo *FakeQuotaRepository) Delete(quotaGuid string) (apiErr error) {
+	repo.DeleteGuid = quotaGuid
+	return
+}
@@ -15,10 +15,18 @@ type FakeRouteRepository struct {
 	FindByHostAndDomainRoute    models.Route
 	FindByHostAndDomainErr      bool
 	FindByHostAndDomainNotFound bool
+	FindByHostAndDomainApiErr   error
 
-	CreatedHost       string
-	CreatedDomainGuid string
-	CreatedRoute      models.Route
+	IsReservedHost    string
+	IsReservedDomain  string
+	IsReservedReturns bool
+	IsReservedApiErr  error
+
+	CreatedHost        string
+	CreatedDomainGuid  string
+	CreatedRoute       models.Route
+	CreatedHosts       []string
+	CreatedDomainGuids []string
 
 	CreateInSpaceHost         string
 	CreateInSpaceDomainGuid   string
@@ -26,17 +34,24 @@ type FakeRouteRepository struct {
 	CreateInSpaceCreatedRoute models.Route
 	CreateInSpaceErr          bool
 
-	BindErr        error
-	BoundRouteGuid string
-	BoundAppGuid   string
+	BindErr         error
+	BoundRouteGuid  string
+	BoundAppGuid    string
+	BoundRouteGuids []string
 
-	UnboundRouteGuid string
-	UnboundAppGuid   string
+	UnboundRouteGuid  string
+	UnboundAppGuid    string
+	UnboundRouteGuids []string
 
 	ListErr bool
 	Routes  []models.Route
 
-	DeleteRouteGuid string
+	ListRoutesInSpaceGuids         []string
+	ListOrphanedRoutesInSpaceGuids []string
+
+	DeleteRouteGuid   string
+	DeletedRouteGuids []string
+	DeleteErrs        map[string]error
 }
 
 func (repo *FakeRouteRepository) ListRoutes(cb func(models.Route) bool) (apiErr error) {
@@ -52,6 +67,37 @@ func (repo *FakeRouteRepository) ListRoutes(cb func(models.Route) bool) (apiErr
 	return
 }
 
+func (repo *FakeRouteRepository) ListRoutesInSpace(spaceGuid string, cb func(models.Route) bool) (apiErr error) {
+	repo.ListRoutesInSpaceGuids = append(repo.ListRoutesInSpaceGuids, spaceGuid)
+
+	return repo.ListRoutes(func(route models.Route) bool {
+		if route.Space.Guid != spaceGuid {
+			return true
+		}
+		return cb(route)
+	})
+}
+
+func (repo *FakeRouteRepository) ListOrphanedRoutes(cb func(models.Route) bool) (apiErr error) {
+	return repo.ListRoutes(func(route models.Route) bool {
+		if len(route.Apps) > 0 {
+			return true
+		}
+		return cb(route)
+	})
+}
+
+func (repo *FakeRouteRepository) ListOrphanedRoutesInSpace(spaceGuid string, cb func(models.Route) bool) (apiErr error) {
+	repo.ListOrphanedRoutesInSpaceGuids = append(repo.ListOrphanedRoutesInSpaceGuids, spaceGuid)
+
+	return repo.ListOrphanedRoutes(func(route models.Route) bool {
+		if route.Space.Guid != spaceGuid {
+			return true
+		}
+		return cb(route)
+	})
+}
+
 func (repo *FakeRouteRepository) FindByHost(host string) (route models.Route, apiErr error) {
 	repo.FindByHostHost = host
 
@@ -75,13 +121,25 @@ func (repo *FakeRouteRepository) FindByHostAndDomain(host, domain string) (route
 		apiErr = errors.NewModelNotFoundError("Org", host+"."+domain)
 	}
 
+	if repo.FindByHostAndDomainApiErr != nil {
+		apiErr = repo.FindByHostAndDomainApiErr
+	}
+
 	route = repo.FindByHostAndDomainRoute
 	return
 }
 
+func (repo *FakeRouteRepository) IsReserved(host, domain string) (reserved bool, apiErr error) {
+	repo.IsReservedHost = host
+	repo.IsReservedDomain = domain
+	return repo.IsReservedReturns, repo.IsReservedApiErr
+}
+
 func (repo *FakeRouteRepository) Create(host, domainGuid string) (createdRoute models.Route, apiErr error) {
 	repo.CreatedHost = host
 	repo.CreatedDomainGuid = domainGuid
+	repo.CreatedHosts = append(repo.CreatedHosts, host)
+	repo.CreatedDomainGuids = append(repo.CreatedDomainGuids, domainGuid)
 
 	createdRoute.Guid = host + "-route-guid"
 
@@ -105,16 +163,22 @@ func (repo *FakeRouteRepository) CreateInSpace(host, domainGuid, spaceGuid strin
 func (repo *FakeRouteRepository) Bind(routeGuid, appGuid string) (apiErr error) {
 	repo.BoundRouteGuid = routeGuid
 	repo.BoundAppGuid = appGuid
+	repo.BoundRouteGuids = append(repo.BoundRouteGuids, routeGuid)
 	return repo.BindErr
 }
 
 func (repo *FakeRouteRepository) Unbind(routeGuid, appGuid string) (apiErr error) {
 	repo.UnboundRouteGuid = routeGuid
 	repo.UnboundAppGuid = appGuid
+	repo.UnboundRouteGuids = append(repo.UnboundRouteGuids, routeGuid)
 	return
 }
 
 func (repo *FakeRouteRepository) Delete(routeGuid string) (apiErr error) {
 	repo.DeleteRouteGuid = routeGuid
+	if err, found := repo.DeleteErrs[routeGuid]; found {
+		return err
+	}
+	repo.DeletedRouteGuids = append(repo.DeletedRouteGuids, routeGuid)
 	return
 }
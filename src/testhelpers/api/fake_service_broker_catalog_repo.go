@@ -0,0 +1,24 @@
+package api
+
+type FakeServiceBrokerCatalogRepo struct {
+	GetCatalogUrl      string
+	GetCatalogUsername string
+	GetCatalogPassword string
+
+	Catalog       string
+	GetCatalogErr error
+}
+
+func (repo *FakeServiceBrokerCatalogRepo) GetCatalog(brokerUrl, username, password string) (catalog []byte, apiErr error) {
+	repo.GetCatalogUrl = brokerUrl
+	repo.GetCatalogUsername = username
+	repo.GetCatalogPassword = password
+
+	if repo.GetCatalogErr != nil {
+		apiErr = repo.GetCatalogErr
+		return
+	}
+
+	catalog = []byte(repo.Catalog)
+	return
+}
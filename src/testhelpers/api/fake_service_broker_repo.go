@@ -14,6 +14,7 @@ type FakeServiceBrokerRepo struct {
 	CreateUrl      string
 	CreateUsername string
 	CreatePassword string
+	CreateErr      error
 
 	UpdatedServiceBroker     models.ServiceBroker
 	RenamedServiceBrokerGuid string
@@ -54,6 +55,7 @@ func (repo *FakeServiceBrokerRepo) Create(name, url, username, password string)
 	repo.CreateUrl = url
 	repo.CreateUsername = username
 	repo.CreatePassword = password
+	apiErr = repo.CreateErr
 	return
 }
 
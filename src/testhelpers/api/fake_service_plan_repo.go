@@ -0,0 +1,22 @@
+package api
+
+import (
+	"cf/errors"
+)
+
+type FakeServicePlanRepo struct {
+	UpdatedPlanGuids []string
+	UpdatedPublic    []bool
+	UpdateErr        bool
+}
+
+func (repo *FakeServicePlanRepo) Update(planGuid string, public bool) (apiErr error) {
+	if repo.UpdateErr {
+		apiErr = errors.New("Error updating service plan")
+		return
+	}
+
+	repo.UpdatedPlanGuids = append(repo.UpdatedPlanGuids, planGuid)
+	repo.UpdatedPublic = append(repo.UpdatedPublic, public)
+	return
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"cf/errors"
+	"cf/models"
+)
+
+type FakeServicePlanVisibilityRepo struct {
+	Visibilities []models.ServicePlanVisibilityFields
+	ListErr      bool
+
+	// "planGuid/orgGuid" for each visibility created
+	Created []string
+	Deleted []string
+}
+
+func (repo *FakeServicePlanVisibilityRepo) List() (visibilities []models.ServicePlanVisibilityFields, apiErr error) {
+	if repo.ListErr {
+		apiErr = errors.New("Error finding service plan visibilities")
+		return
+	}
+
+	visibilities = repo.Visibilities
+	return
+}
+
+func (repo *FakeServicePlanVisibilityRepo) Create(planGuid, orgGuid string) (apiErr error) {
+	repo.Created = append(repo.Created, planGuid+"/"+orgGuid)
+	return
+}
+
+func (repo *FakeServicePlanVisibilityRepo) Delete(visibilityGuid string) (apiErr error) {
+	repo.Deleted = append(repo.Deleted, visibilityGuid)
+	return
+}
@@ -33,8 +33,14 @@ type FakeServiceRepo struct {
 
 	CreateServiceInstanceName     string
 	CreateServiceInstancePlanGuid string
+	CreateServiceInstanceParams   map[string]interface{}
 	CreateServiceAlreadyExists    bool
 
+	UpdateServiceInstanceGuid     string
+	UpdateServiceInstancePlanGuid string
+	UpdateServiceInstanceParams   map[string]interface{}
+	UpdateServiceInstanceErr      error
+
 	FindInstanceByNameName            string
 	FindInstanceByNameServiceInstance models.ServiceInstance
 	FindInstanceByNameErr             bool
@@ -104,14 +110,23 @@ func (repo *FakeServiceRepo) FindServiceOfferingByLabelAndProvider(name, provide
 	return
 }
 
-func (repo *FakeServiceRepo) CreateServiceInstance(name, planGuid string) (identicalAlreadyExists bool, apiErr error) {
+func (repo *FakeServiceRepo) CreateServiceInstance(name, planGuid string, params map[string]interface{}) (identicalAlreadyExists bool, apiErr error) {
 	repo.CreateServiceInstanceName = name
 	repo.CreateServiceInstancePlanGuid = planGuid
+	repo.CreateServiceInstanceParams = params
 	identicalAlreadyExists = repo.CreateServiceAlreadyExists
 
 	return
 }
 
+func (repo *FakeServiceRepo) UpdateServiceInstance(instanceGuid, planGuid string, params map[string]interface{}) (apiErr error) {
+	repo.UpdateServiceInstanceGuid = instanceGuid
+	repo.UpdateServiceInstancePlanGuid = planGuid
+	repo.UpdateServiceInstanceParams = params
+	apiErr = repo.UpdateServiceInstanceErr
+	return
+}
+
 func (repo *FakeServiceRepo) FindInstanceByName(name string) (instance models.ServiceInstance, apiErr error) {
 	repo.FindInstanceByNameName = name
 
@@ -4,9 +4,18 @@ import "cf/models"
 
 type FakeServiceSummaryRepo struct {
 	GetSummariesInCurrentSpaceInstances []models.ServiceInstance
+
+	GetSummariesInSpaceGuids     []string
+	GetSummariesInSpaceInstances map[string][]models.ServiceInstance
 }
 
 func (repo *FakeServiceSummaryRepo) GetSummariesInCurrentSpace() (instances []models.ServiceInstance, apiErr error) {
 	instances = repo.GetSummariesInCurrentSpaceInstances
 	return
 }
+
+func (repo *FakeServiceSummaryRepo) GetSummariesInSpace(spaceGuid string) (instances []models.ServiceInstance, apiErr error) {
+	repo.GetSummariesInSpaceGuids = append(repo.GetSummariesInSpaceGuids, spaceGuid)
+	instances = repo.GetSummariesInSpaceInstances[spaceGuid]
+	return
+}
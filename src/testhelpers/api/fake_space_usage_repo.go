@@ -0,0 +1,25 @@
+package api
+
+import (
+	"cf/errors"
+	"cf/models"
+)
+
+type FakeSpaceUsageRepository struct {
+	UsageBySpaceGuid map[string]models.SpaceUsage
+	RequestedSpaces  []string
+	GetUsageErr      bool
+}
+
+func (repo *FakeSpaceUsageRepository) GetUsage(space models.SpaceFields) (usage models.SpaceUsage, apiErr error) {
+	repo.RequestedSpaces = append(repo.RequestedSpaces, space.Name)
+
+	if repo.GetUsageErr {
+		apiErr = errors.New("Error getting space usage.")
+		return
+	}
+
+	usage = repo.UsageBySpaceGuid[space.Guid]
+	usage.Space = space
+	return
+}
@@ -9,20 +9,25 @@ type FakeUserRepository struct {
 	FindByUsernameUsername   string
 	FindByUsernameUserFields models.UserFields
 	FindByUsernameNotFound   bool
+	UsersByUsername          map[string]models.UserFields
 
 	ListUsersOrganizationGuid string
 	ListUsersSpaceGuid        string
 	ListUsersByRole           map[string][]models.UserFields
+	ListUsersByGuidAndRole    map[string]map[string][]models.UserFields
 
 	CreateUserUsername string
 	CreateUserPassword string
 	CreateUserExists   bool
+	CreatedUsernames   []string
+	ExistingUsernames  []string
 
 	DeleteUserGuid string
 
 	SetOrgRoleUserGuid         string
 	SetOrgRoleOrganizationGuid string
 	SetOrgRoleRole             string
+	SetOrgRoles                []string
 
 	UnsetOrgRoleUserGuid         string
 	UnsetOrgRoleOrganizationGuid string
@@ -32,16 +37,30 @@ type FakeUserRepository struct {
 	SetSpaceRoleOrgGuid   string
 	SetSpaceRoleSpaceGuid string
 	SetSpaceRoleRole      string
+	SetSpaceRoles         []string
 
 	UnsetSpaceRoleUserGuid  string
 	UnsetSpaceRoleSpaceGuid string
 	UnsetSpaceRoleRole      string
+
+	ListRolesUserGuid string
+	ListRolesRoles    []models.UserRole
+	ListRolesErr      bool
 }
 
 func (repo *FakeUserRepository) FindByUsername(username string) (user models.UserFields, apiErr error) {
 	repo.FindByUsernameUsername = username
 	user = repo.FindByUsernameUserFields
 
+	if repo.UsersByUsername != nil {
+		var found bool
+		user, found = repo.UsersByUsername[username]
+		if !found {
+			apiErr = errors.NewModelNotFoundError("User", username)
+		}
+		return
+	}
+
 	if repo.FindByUsernameNotFound {
 		apiErr = errors.NewModelNotFoundError("User", "")
 	}
@@ -51,11 +70,17 @@ func (repo *FakeUserRepository) FindByUsername(username string) (user models.Use
 
 func (repo *FakeUserRepository) ListUsersInOrgForRole(orgGuid string, roleName string) ([]models.UserFields, error) {
 	repo.ListUsersOrganizationGuid = orgGuid
+	if repo.ListUsersByGuidAndRole != nil {
+		return repo.ListUsersByGuidAndRole[orgGuid][roleName], nil
+	}
 	return repo.ListUsersByRole[roleName], nil
 }
 
 func (repo *FakeUserRepository) ListUsersInSpaceForRole(spaceGuid string, roleName string) ([]models.UserFields, error) {
 	repo.ListUsersSpaceGuid = spaceGuid
+	if repo.ListUsersByGuidAndRole != nil {
+		return repo.ListUsersByGuidAndRole[spaceGuid][roleName], nil
+	}
 	return repo.ListUsersByRole[roleName], nil
 }
 
@@ -65,8 +90,18 @@ func (repo *FakeUserRepository) Create(username, password string) (apiErr error)
 
 	if repo.CreateUserExists {
 		apiErr = errors.NewHttpError(400, errors.USER_EXISTS, "User already exists")
+		return
+	}
+
+	for _, existing := range repo.ExistingUsernames {
+		if existing == username {
+			apiErr = errors.NewHttpError(400, errors.USER_EXISTS, "User already exists")
+			return
+		}
 	}
 
+	repo.CreatedUsernames = append(repo.CreatedUsernames, username)
+
 	return
 }
 
@@ -79,6 +114,7 @@ func (repo *FakeUserRepository) SetOrgRole(userGuid, orgGuid, role string) (apiE
 	repo.SetOrgRoleUserGuid = userGuid
 	repo.SetOrgRoleOrganizationGuid = orgGuid
 	repo.SetOrgRoleRole = role
+	repo.SetOrgRoles = append(repo.SetOrgRoles, userGuid+"/"+orgGuid+"/"+role)
 	return
 }
 
@@ -94,6 +130,7 @@ func (repo *FakeUserRepository) SetSpaceRole(userGuid, spaceGuid, orgGuid, role
 	repo.SetSpaceRoleOrgGuid = orgGuid
 	repo.SetSpaceRoleSpaceGuid = spaceGuid
 	repo.SetSpaceRoleRole = role
+	repo.SetSpaceRoles = append(repo.SetSpaceRoles, userGuid+"/"+spaceGuid+"/"+role)
 	return
 }
 
@@ -103,3 +140,13 @@ func (repo *FakeUserRepository) UnsetSpaceRole(userGuid, spaceGuid, role string)
 	repo.UnsetSpaceRoleRole = role
 	return
 }
+
+func (repo *FakeUserRepository) ListRolesForUser(userGuid string) (roles []models.UserRole, apiErr error) {
+	repo.ListRolesUserGuid = userGuid
+	roles = repo.ListRolesRoles
+
+	if repo.ListRolesErr {
+		apiErr = errors.New("Error listing roles.")
+	}
+	return
+}
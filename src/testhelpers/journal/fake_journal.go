@@ -0,0 +1,20 @@
+package journal
+
+import "cf/journal"
+
+type FakeJournal struct {
+	Appended  []journal.Entry
+	AppendErr error
+
+	EntriesEntries []journal.Entry
+	EntriesErr     error
+}
+
+func (fake *FakeJournal) Append(entry journal.Entry) (err error) {
+	fake.Appended = append(fake.Appended, entry)
+	return fake.AppendErr
+}
+
+func (fake *FakeJournal) Entries() (entries []journal.Entry, err error) {
+	return fake.EntriesEntries, fake.EntriesErr
+}
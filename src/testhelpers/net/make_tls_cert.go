@@ -29,7 +29,23 @@ func MakeUnauthorizedTLSCert() tls.Certificate {
 	return generateCert([]string{"127.0.0.1", "::1"}, time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC), false)
 }
 
+// MakeTLSCertPEM is a valid certificate for the local host, for servers and
+// clients alike, along with its private key
+func MakeTLSCertPEM() (certPEM, keyPEM string) {
+	certBytes, keyBytes := generateCertPEM([]string{"127.0.0.1", "::1"}, time.Now().AddDate(1, 0, 0), true)
+	return string(certBytes), string(keyBytes)
+}
+
 func generateCert(hosts []string, notAfter time.Time, isAuthorizedToSign bool) tls.Certificate {
+	cert, err := tls.X509KeyPair(generateCertPEM(hosts, notAfter, isAuthorizedToSign))
+	if err != nil {
+		panic(err)
+	}
+
+	return cert
+}
+
+func generateCertPEM(hosts []string, notAfter time.Time, isAuthorizedToSign bool) (certPEM, keyPEM []byte) {
 	priv, err := rsa.GenerateKey(rand.Reader, 1024)
 	if err != nil {
 		panic(err)
@@ -44,7 +60,7 @@ func generateCert(hosts []string, notAfter time.Time, isAuthorizedToSign bool) t
 		NotAfter:  notAfter,
 
 		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
 		BasicConstraintsValid: true,
 	}
 
@@ -72,10 +88,5 @@ func generateCert(hosts []string, notAfter time.Time, isAuthorizedToSign bool) t
 	keyOut := new(bytes.Buffer)
 	pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)})
 
-	cert, err := tls.X509KeyPair(certOut.Bytes(), keyOut.Bytes())
-	if err != nil {
-		panic(err)
-	}
-
-	return cert
+	return certOut.Bytes(), keyOut.Bytes()
 }
@@ -0,0 +1,30 @@
+package staging
+
+import (
+	"cf/staging"
+)
+
+type FakeStager struct {
+	AppDir       string
+	BuildpackDir string
+	LogLines     []string
+	StderrLines  []string
+	Result       staging.Result
+	StageErr     error
+}
+
+func (stager *FakeStager) Stage(appDir, buildpackDir string, onLog staging.LogFunc) (result staging.Result, err error) {
+	stager.AppDir = appDir
+	stager.BuildpackDir = buildpackDir
+
+	for _, line := range stager.LogLines {
+		onLog(line, false)
+	}
+	for _, line := range stager.StderrLines {
+		onLog(line, true)
+	}
+
+	result = stager.Result
+	err = stager.StageErr
+	return
+}
@@ -2,6 +2,7 @@ package terminal
 
 import (
 	"cf/configuration"
+	"cf/i18n"
 	term "cf/terminal"
 	"fmt"
 	"github.com/codegangsta/cli"
@@ -11,6 +12,11 @@ import (
 
 const FailedWasCalled = "FailedWasCalled"
 
+// tests assert on the English messages whatever locale they run in
+func init() {
+	i18n.SetLocale(i18n.DEFAULT_LOCALE)
+}
+
 type FakeUI struct {
 	Outputs                    []string
 	Prompts                    []string
@@ -19,6 +25,7 @@ type FakeUI struct {
 	FailedWithUsage            bool
 	FailedWithUsageCommandName string
 	ShowConfigurationCalled    bool
+	ClearScreenCount           int
 }
 
 func (ui *FakeUI) PrintPaginator(rows []string, err error) {
@@ -85,6 +92,10 @@ func (ui *FakeUI) Failed(message string, args ...interface{}) {
 	return
 }
 
+func (ui *FakeUI) FailWithError(err error) {
+	ui.Failed("%s", err.Error())
+}
+
 func (ui *FakeUI) ConfigFailure(err error) {
 	ui.Failed("Error loading config file.\n%s", err.Error())
 }
@@ -114,6 +125,10 @@ func (c FakeUI) Wait(duration time.Duration) {
 	time.Sleep(duration)
 }
 
+func (ui *FakeUI) ClearScreen() {
+	ui.ClearScreenCount++
+}
+
 func (ui *FakeUI) DisplayTable(table [][]string) {
 
 	for _, line := range table {